@@ -7,12 +7,14 @@ import (
 
 	"go.einride.tech/sage/sg"
 	"go.einride.tech/sage/sgtool"
+	"go.einride.tech/sage/tools/sgbuf"
 	"go.einride.tech/sage/tools/sgconvco"
 	"go.einride.tech/sage/tools/sggit"
 	"go.einride.tech/sage/tools/sggo"
 	"go.einride.tech/sage/tools/sggolangcilint"
 	"go.einride.tech/sage/tools/sggolicenses"
 	"go.einride.tech/sage/tools/sgmdformat"
+	"go.einride.tech/sage/tools/sgprotocgengo"
 	"go.einride.tech/sage/tools/sgyamlfmt"
 )
 
@@ -99,15 +101,89 @@ func GenerateTestdata(ctx context.Context) error {
 		ctx,
 		"go",
 		"run",
-		"cmd/cantool/main.go",
+		"./cmd/cantool",
 		"generate",
 		"testdata/dbc",
 		"testdata/gen/go",
 	)
 	cmd.Dir = sg.FromGitRoot()
+	if err := cmd.Run(); err != nil {
+		return err
+	}
+	cmd = sg.Command(
+		ctx,
+		"go",
+		"run",
+		"./cmd/cantool",
+		"generate",
+		"--network",
+		"vehicle",
+		"testdata/network/vehicle",
+		"testdata/gen/go/vehicle",
+	)
+	cmd.Dir = sg.FromGitRoot()
+	if err := cmd.Run(); err != nil {
+		return err
+	}
+	cmd = sg.Command(
+		ctx,
+		"go",
+		"run",
+		"./cmd/cantool",
+		"generate",
+		"--typed-units",
+		"testdata/units",
+		"testdata/gen/go/units",
+	)
+	cmd.Dir = sg.FromGitRoot()
+	if err := cmd.Run(); err != nil {
+		return err
+	}
+	cmd = sg.Command(
+		ctx,
+		"go",
+		"run",
+		"./cmd/cantool",
+		"generate",
+		"--receive-streams",
+		"testdata/streams",
+		"testdata/gen/go/streams",
+	)
+	cmd.Dir = sg.FromGitRoot()
+	if err := cmd.Run(); err != nil {
+		return err
+	}
+	cmd = sg.Command(
+		ctx,
+		"go",
+		"run",
+		"./cmd/cantool",
+		"generate",
+		"--proto",
+		"testdata/gen/proto",
+		"testdata/proto",
+		"testdata/gen/go/telemetry",
+	)
+	cmd.Dir = sg.FromGitRoot()
+	if err := cmd.Run(); err != nil {
+		return err
+	}
+	return BufGenerateTestdata(ctx)
+}
+
+func BufGenerateTestdata(ctx context.Context) error {
+	sg.Deps(ctx, ProtocGenGo)
+	sg.Logger(ctx).Println("generating protobuf testdata...")
+	cmd := sgbuf.Command(ctx, "generate", "--template", "buf.gen.yaml")
+	cmd.Dir = sg.FromGitRoot("testdata", "gen", "proto")
 	return cmd.Run()
 }
 
+func ProtocGenGo(ctx context.Context) error {
+	sg.Logger(ctx).Println("installing protoc-gen-go...")
+	return sgprotocgengo.PrepareCommand(ctx)
+}
+
 func BuildIntegrationTests(ctx context.Context) error {
 	sg.Logger(ctx).Println("building integration test...")
 	testDir := sg.FromGitRoot("build", "tests")
@@ -0,0 +1,68 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"go.einride.tech/can/internal/generate"
+)
+
+// generateConfig is the configuration of the generate command, read from a JSON file.
+//
+// Example:
+//
+//	{
+//	  "package": "vehiclecan",
+//	  "messages": {"include": ["Motor*"], "exclude": ["*Debug"]},
+//	  "nodes": {"exclude": ["TESTER"]},
+//	  "splitNodes": true
+//	}
+type generateConfig struct {
+	// Package is the name of the generated Go packages, instead of the names derived from the database files.
+	Package string `json:"package"`
+	// ImportPath is the import path of the output directory of a network, instead of the import path resolved from
+	// go.mod.
+	ImportPath string `json:"importPath"`
+	// Messages selects the messages to generate code for.
+	Messages patternFilter `json:"messages"`
+	// Nodes selects the nodes to generate code for.
+	Nodes patternFilter `json:"nodes"`
+	// SplitNodes generates a package per node, with the node and the messages it sends or receives.
+	SplitNodes bool `json:"splitNodes"`
+}
+
+// patternFilter selects names by glob patterns.
+type patternFilter struct {
+	Include []string `json:"include"`
+	Exclude []string `json:"exclude"`
+}
+
+func readGenerateConfig(configFile string) (*generateConfig, error) {
+	f, err := os.Open(configFile)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	dec := json.NewDecoder(f)
+	dec.DisallowUnknownFields()
+	var config generateConfig
+	if err := dec.Decode(&config); err != nil {
+		return nil, fmt.Errorf("read config %s: %w", configFile, err)
+	}
+	return &config, nil
+}
+
+func (c *generateConfig) filter() generate.Filter {
+	return generate.Filter{
+		IncludeMessages: c.Messages.Include,
+		ExcludeMessages: c.Messages.Exclude,
+		IncludeNodes:    c.Nodes.Include,
+		ExcludeNodes:    c.Nodes.Exclude,
+	}
+}
+
+func (c *generateConfig) isFiltered() bool {
+	f := c.filter()
+	return len(f.IncludeMessages)+len(f.ExcludeMessages)+len(f.IncludeNodes)+len(f.ExcludeNodes) > 0
+}
@@ -0,0 +1,42 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/alecthomas/kingpin/v2"
+	"go.einride.tech/can/pkg/kcd"
+	"go.einride.tech/can/pkg/sym"
+)
+
+func convertCommand(app *kingpin.Application) {
+	command := app.Command("convert", "convert a CAN database to the KCD or SYM format")
+	inputFile := command.
+		Arg("input-file", "DBC, ARXML, KCD or SYM file").
+		Required().
+		ExistingFile()
+	outputFile := command.
+		Arg("output-file", "KCD or SYM file").
+		Required().
+		String()
+	command.Action(func(_ *kingpin.ParseContext) error {
+		db, err := compileDatabase(*inputFile)
+		if err != nil {
+			return err
+		}
+		var output []byte
+		switch filepath.Ext(*outputFile) {
+		case ".kcd":
+			output, err = kcd.Export(db)
+		case ".sym":
+			output, err = sym.Export(db)
+		default:
+			return fmt.Errorf("convert: unsupported output format: %s", *outputFile)
+		}
+		if err != nil {
+			return err
+		}
+		return os.WriteFile(*outputFile, output, 0o600)
+	})
+}
@@ -0,0 +1,68 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/alecthomas/kingpin/v2"
+	"github.com/fatih/color"
+	"go.einride.tech/can/pkg/candiff"
+)
+
+func diffCommand(app *kingpin.Application) {
+	command := app.Command("diff", "semantic diff between two DBC files")
+	oldFile := command.
+		Arg("old-file", "old DBC file").
+		Required().
+		ExistingFile()
+	newFile := command.
+		Arg("new-file", "new DBC file").
+		Required().
+		ExistingFile()
+	format := command.
+		Flag("format", "output format").
+		Default("text").
+		Enum("text", "json")
+	failOnBreaking := command.
+		Flag("fail-on-breaking", "exit with an error if there are wire-breaking changes").
+		Bool()
+	command.Action(func(_ *kingpin.ParseContext) error {
+		oldDB, err := compileDatabase(*oldFile)
+		if err != nil {
+			return err
+		}
+		newDB, err := compileDatabase(*newFile)
+		if err != nil {
+			return err
+		}
+		changes := candiff.Diff(oldDB, newDB)
+		switch *format {
+		case "json":
+			enc := json.NewEncoder(os.Stdout)
+			enc.SetIndent("", "  ")
+			if changes == nil {
+				changes = []*candiff.Change{}
+			}
+			if err := enc.Encode(changes); err != nil {
+				return err
+			}
+		default:
+			for _, c := range changes {
+				switch {
+				case c.IsBreaking:
+					fmt.Println(color.RedString("%s", c.String()))
+				case c.Type == candiff.ChangeTypeAdded:
+					fmt.Println(color.GreenString("%s", c.String()))
+				default:
+					fmt.Println(c.String())
+				}
+			}
+		}
+		if *failOnBreaking && candiff.HasBreakingChanges(changes) {
+			return errors.New("one or more wire-breaking changes")
+		}
+		return nil
+	})
+}
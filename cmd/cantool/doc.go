@@ -0,0 +1,45 @@
+package main
+
+import (
+	"bytes"
+	"os"
+
+	"github.com/alecthomas/kingpin/v2"
+	"go.einride.tech/can/pkg/candoc"
+)
+
+func docCommand(app *kingpin.Application) {
+	command := app.Command("doc", "generate documentation from a DBC file")
+	inputFile := command.
+		Arg("dbc-file", "DBC file").
+		Required().
+		ExistingFile()
+	format := command.
+		Flag("format", "output format").
+		Default("markdown").
+		Enum("markdown", "html")
+	outputFile := command.
+		Flag("output", "output file (default: stdout)").
+		Short('o').
+		String()
+	command.Action(func(_ *kingpin.ParseContext) error {
+		db, err := compileDatabase(*inputFile)
+		if err != nil {
+			return err
+		}
+		var output bytes.Buffer
+		if *format == "html" {
+			err = candoc.WriteHTML(&output, db)
+		} else {
+			err = candoc.WriteMarkdown(&output, db)
+		}
+		if err != nil {
+			return err
+		}
+		if *outputFile == "" {
+			_, err := os.Stdout.Write(output.Bytes())
+			return err
+		}
+		return os.WriteFile(*outputFile, output.Bytes(), 0o600)
+	})
+}
@@ -0,0 +1,192 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"os"
+	"os/signal"
+	"sync"
+
+	"github.com/alecthomas/kingpin/v2"
+	"go.einride.tech/can"
+	"go.einride.tech/can/pkg/cantext"
+	"go.einride.tech/can/pkg/descriptor"
+	"go.einride.tech/can/pkg/socketcan"
+	"golang.org/x/sync/errgroup"
+)
+
+func emulateCommand(app *kingpin.Application) {
+	command := app.Command("emulate", "emulate a CAN bus over UDP multicast")
+	address := command.
+		Flag("address", "multicast address of the emulated bus").
+		Default("239.64.142.206:50000").
+		String()
+	bridge := command.
+		Flag("bridge", "(v)can interface to bridge the emulated bus to").
+		String()
+	dbcFile := command.
+		Flag("dbc", "DBC file used to decode logged traffic").
+		ExistingFile()
+	quiet := command.
+		Flag("quiet", "do not log traffic").
+		Bool()
+	command.Action(func(_ *kingpin.ParseContext) error {
+		opts := emulateOptions{address: *address, bridge: *bridge, quiet: *quiet}
+		if *dbcFile != "" {
+			var err error
+			if opts.db, err = compileDatabase(*dbcFile); err != nil {
+				return err
+			}
+		}
+		ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
+		defer cancel()
+		return emulate(ctx, opts, log.New(os.Stderr, "emulator: ", log.Ltime))
+	})
+}
+
+type emulateOptions struct {
+	address string
+	bridge  string
+	db      *descriptor.Database
+	quiet   bool
+}
+
+// emulate runs an emulated bus until the context is canceled.
+func emulate(ctx context.Context, opts emulateOptions, logger *log.Logger) error {
+	e, err := socketcan.NewEmulator(
+		socketcan.WithMulticastAddress(opts.address),
+		socketcan.WithLogger(logger),
+	)
+	if err != nil {
+		return fmt.Errorf("emulate: %w", err)
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	g, ctx := errgroup.WithContext(ctx)
+	g.Go(func() error {
+		return e.Run(ctx)
+	})
+	// stop stops the running emulator when the setup fails, and returns the setup error.
+	stop := func(err error) error {
+		cancel()
+		_ = g.Wait()
+		return err
+	}
+	rx, err := e.Receiver()
+	if err != nil {
+		return stop(fmt.Errorf("emulate: %w", err))
+	}
+	var b *bridgeFilter
+	if opts.bridge != "" {
+		conn, err := socketcan.DialContext(ctx, "can", opts.bridge)
+		if err != nil {
+			return stop(fmt.Errorf("emulate: bridge %s: %w", opts.bridge, err))
+		}
+		g.Go(func() error {
+			<-ctx.Done()
+			return conn.Close()
+		})
+		b = &bridgeFilter{tx: socketcan.NewTransmitter(conn), bridged: map[can.Frame]int{}}
+		g.Go(func() error {
+			// forward frames from the interface to the emulated bus
+			bridgeRx := socketcan.NewReceiver(conn)
+			for bridgeRx.Receive() {
+				if bridgeRx.HasErrorFrame() {
+					continue
+				}
+				f := bridgeRx.Frame()
+				b.add(f)
+				if err := e.TransmitFrame(ctx, f); err != nil {
+					return fmt.Errorf("emulate: bridge %s: %w", opts.bridge, err)
+				}
+			}
+			if err := bridgeRx.Err(); err != nil && !errors.Is(err, net.ErrClosed) {
+				return fmt.Errorf("emulate: bridge %s: %w", opts.bridge, err)
+			}
+			return nil
+		})
+		logger.Printf("bridging udp://%s to %s", e.Addr(), opts.bridge)
+	}
+	g.Go(func() error {
+		for rx.Receive() {
+			f := rx.Frame()
+			if !opts.quiet {
+				logger.Println(formatFrame(opts.db, f))
+			}
+			if b == nil || b.remove(f) {
+				continue
+			}
+			if err := b.tx.TransmitFrame(ctx, f); err != nil {
+				return fmt.Errorf("emulate: bridge %s: %w", opts.bridge, err)
+			}
+		}
+		if err := rx.Err(); err != nil && !errors.Is(err, net.ErrClosed) {
+			return fmt.Errorf("emulate: %w", err)
+		}
+		return nil
+	})
+	return g.Wait()
+}
+
+// bridgeFilter keeps track of frames bridged to the emulated bus, so that they are not echoed back.
+type bridgeFilter struct {
+	tx      *socketcan.Transmitter
+	mu      sync.Mutex
+	bridged map[can.Frame]int
+}
+
+func (b *bridgeFilter) add(f can.Frame) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.bridged[f]++
+}
+
+// remove returns true if the frame was bridged to the emulated bus.
+func (b *bridgeFilter) remove(f can.Frame) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.bridged[f] == 0 {
+		return false
+	}
+	b.bridged[f]--
+	if b.bridged[f] == 0 {
+		delete(b.bridged, f)
+	}
+	return true
+}
+
+func formatFrame(db *descriptor.Database, f can.Frame) string {
+	if db == nil {
+		return f.String()
+	}
+	m, ok := messageOf(db, f)
+	if !ok {
+		return f.String()
+	}
+	buf := []byte(f.String())
+	buf = append(buf, ' ')
+	buf = append(buf, m.Name...)
+	buf = append(buf, " {"...)
+	for i, s := range m.Signals {
+		buf = cantext.AppendSignalCompact(buf, s, f.Data)
+		if i != len(m.Signals)-1 {
+			buf = append(buf, ", "...)
+		}
+	}
+	buf = append(buf, '}')
+	return string(buf)
+}
+
+// messageOf returns the message of a frame, matching both the ID and the ID format of the frame.
+func messageOf(db *descriptor.Database, f can.Frame) (*descriptor.Message, bool) {
+	key := descriptor.MessageKeyOf(f)
+	for _, m := range db.Messages {
+		if m.Key() == key {
+			return m, true
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,113 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"go.einride.tech/can"
+	"go.einride.tech/can/pkg/descriptor"
+	"go.einride.tech/can/pkg/socketcan"
+	"gotest.tools/v3/assert"
+)
+
+func TestEmulate_StartStop(t *testing.T) {
+	// Given: a free port for the emulated bus
+	pc, err := net.ListenPacket("udp4", "127.0.0.1:0")
+	assert.NilError(t, err)
+	port := pc.LocalAddr().(*net.UDPAddr).Port
+	assert.NilError(t, pc.Close())
+	address := fmt.Sprintf("239.64.142.206:%d", port)
+	// When: I start the emulator
+	var out lockedBuffer
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	done := make(chan error, 1)
+	go func() {
+		done <- emulate(ctx, emulateOptions{address: address}, log.New(&out, "", 0))
+	}()
+	// Then: frames transmitted on the emulated bus are logged
+	conn, err := socketcan.DialContext(ctx, "udp", address)
+	assert.NilError(t, err)
+	defer conn.Close()
+	f := can.Frame{ID: 0x42, Length: 1, Data: can.Data{0x01}}
+	tx := socketcan.NewTransmitter(conn)
+	for !strings.Contains(out.String(), f.String()) {
+		assert.NilError(t, tx.TransmitFrame(ctx, f))
+		select {
+		case err := <-done:
+			t.Fatalf("emulator stopped: %v", err)
+		case <-ctx.Done():
+			t.Fatal("timeout waiting for frame to be logged")
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+	// And: the emulator shuts down without error when canceled
+	cancel()
+	assert.NilError(t, <-done)
+}
+
+// lockedBuffer is a bytes.Buffer safe for concurrent use.
+type lockedBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *lockedBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *lockedBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+func TestEmulate_BridgeError(t *testing.T) {
+	// Given: a free port for the emulated bus
+	pc, err := net.ListenPacket("udp4", "127.0.0.1:0")
+	assert.NilError(t, err)
+	port := pc.LocalAddr().(*net.UDPAddr).Port
+	assert.NilError(t, pc.Close())
+	address := fmt.Sprintf("239.64.142.206:%d", port)
+	// When: I start the emulator bridged to an interface that does not exist
+	done := make(chan error, 1)
+	go func() {
+		opts := emulateOptions{address: address, bridge: "nonexistentcan0", quiet: true}
+		done <- emulate(context.Background(), opts, log.New(io.Discard, "", 0))
+	}()
+	// Then: the emulator is stopped and the error is returned
+	select {
+	case err := <-done:
+		assert.ErrorContains(t, err, "emulate: bridge nonexistentcan0")
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout waiting for the emulator to stop")
+	}
+}
+
+func TestFormatFrame(t *testing.T) {
+	db := &descriptor.Database{
+		Messages: []*descriptor.Message{
+			{
+				Name:   "Standard",
+				ID:     100,
+				Length: 1,
+				Signals: []*descriptor.Signal{
+					{Name: "Value", Length: 8, Scale: 1},
+				},
+			},
+		},
+	}
+	assert.Equal(t, "064#01 Standard {Value: 1}", formatFrame(db, can.Frame{ID: 100, Length: 1, Data: can.Data{1}}))
+	extended := can.Frame{ID: 100, IsExtended: true, Length: 1, Data: can.Data{1}}
+	assert.Equal(t, extended.String(), formatFrame(db, extended))
+}
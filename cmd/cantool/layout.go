@@ -0,0 +1,63 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/alecthomas/kingpin/v2"
+	"go.einride.tech/can/pkg/canlayout"
+)
+
+func layoutCommand(app *kingpin.Application) {
+	command := app.Command("layout", "render message bit layouts")
+	inputFile := command.
+		Arg("dbc-file", "DBC file").
+		Required().
+		ExistingFile()
+	messageNames := command.
+		Arg("messages", "names of the messages to render (default: all)").
+		Strings()
+	format := command.
+		Flag("format", "output format (svg requires a single message)").
+		Default("text").
+		Enum("text", "svg", "html")
+	command.Action(func(_ *kingpin.ParseContext) error {
+		db, err := compileDatabase(*inputFile)
+		if err != nil {
+			return err
+		}
+		var layouts []*canlayout.Layout
+		if len(*messageNames) == 0 {
+			for _, m := range db.Messages {
+				layouts = append(layouts, canlayout.New(m))
+			}
+		}
+		for _, name := range *messageNames {
+			m, ok := db.MessageByName(name)
+			if !ok {
+				return fmt.Errorf("layout: no message named %s in %s", name, *inputFile)
+			}
+			layouts = append(layouts, canlayout.New(m))
+		}
+		switch *format {
+		case "svg":
+			if len(layouts) != 1 {
+				return errors.New("layout: svg output requires a single message")
+			}
+			return canlayout.WriteSVG(os.Stdout, layouts[0])
+		case "html":
+			return canlayout.WriteHTML(os.Stdout, db.Name(), layouts)
+		default:
+			for i, l := range layouts {
+				if i > 0 {
+					fmt.Println()
+				}
+				if err := canlayout.WriteText(os.Stdout, l); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	})
+}
@@ -1,17 +1,20 @@
 package main
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 	"text/scanner"
 
 	"github.com/alecthomas/kingpin/v2"
 	"github.com/fatih/color"
 	"go.einride.tech/can/internal/generate"
+	"go.einride.tech/can/pkg/arxml"
 	"go.einride.tech/can/pkg/dbc"
 	"go.einride.tech/can/pkg/dbc/analysis"
 	"go.einride.tech/can/pkg/dbc/analysis/passes/definitiontypeorder"
@@ -33,12 +36,21 @@ import (
 	"go.einride.tech/can/pkg/dbc/analysis/passes/unitsuffixes"
 	"go.einride.tech/can/pkg/dbc/analysis/passes/valuedescriptions"
 	"go.einride.tech/can/pkg/dbc/analysis/passes/version"
+	"go.einride.tech/can/pkg/descriptor"
+	"go.einride.tech/can/pkg/kcd"
+	"go.einride.tech/can/pkg/sym"
 )
 
 func main() {
 	app := kingpin.New("cantool", "CAN tool for Go programmers")
 	generateCommand(app)
 	lintCommand(app)
+	sendCommand(app)
+	emulateCommand(app)
+	diffCommand(app)
+	layoutCommand(app)
+	docCommand(app)
+	convertCommand(app)
 	kingpin.MustParse(app.Parse(os.Args[1:]))
 }
 
@@ -52,21 +64,124 @@ func generateCommand(app *kingpin.Application) {
 		Arg("output-dir", "output directory").
 		Required().
 		String()
+	network := command.
+		Flag("network", "compile all DBC files as the buses of a network with the provided name").
+		String()
+	protoDir := command.
+		Flag("proto", "output directory of protobuf schemas, generated along with converters to their messages").
+		String()
+	typedUnits := command.
+		Flag("typed-units", "generate physical values of signals with known units as canunit types").
+		Bool()
+	receiveStreams := command.
+		Flag("receive-streams", "generate channels and iterators of received messages on the Rx messages of nodes").
+		Bool()
+	plugins := command.
+		Flag("plugin", "plugin generating additional files, as <name>[:<parameter>] (repeatable)").
+		Strings()
+	templates := command.
+		Flag("template", "template generating an additional file per DBC file (repeatable)").
+		ExistingFiles()
+	configFile := command.
+		Flag("config", "JSON configuration file of the generated code").
+		ExistingFile()
+	packageName := command.
+		Flag("package", "name of the generated Go packages").
+		String()
+	importPath := command.
+		Flag("import-path", "import path of the output directory of --network, instead of resolving it from go.mod").
+		String()
+	includeMessages := command.
+		Flag("include-message", "glob pattern of messages to generate code for (repeatable)").
+		Strings()
+	excludeMessages := command.
+		Flag("exclude-message", "glob pattern of messages to not generate code for (repeatable)").
+		Strings()
+	includeNodes := command.
+		Flag("include-node", "glob pattern of nodes to generate code for (repeatable)").
+		Strings()
+	excludeNodes := command.
+		Flag("exclude-node", "glob pattern of nodes to not generate code for (repeatable)").
+		Strings()
+	splitNodes := command.
+		Flag("split-nodes", "generate a package per node, with the node and the messages it sends or receives").
+		Bool()
 	command.Action(func(_ *kingpin.ParseContext) error {
+		config := &generateConfig{}
+		if *configFile != "" {
+			var err error
+			if config, err = readGenerateConfig(*configFile); err != nil {
+				return err
+			}
+		}
+		if *packageName != "" {
+			config.Package = *packageName
+		}
+		if *importPath != "" {
+			config.ImportPath = *importPath
+		}
+		config.Messages.Include = append(config.Messages.Include, *includeMessages...)
+		config.Messages.Exclude = append(config.Messages.Exclude, *excludeMessages...)
+		config.Nodes.Include = append(config.Nodes.Include, *includeNodes...)
+		config.Nodes.Exclude = append(config.Nodes.Exclude, *excludeNodes...)
+		config.SplitNodes = config.SplitNodes || *splitNodes
+		var opts []generate.Option
+		if *typedUnits {
+			opts = append(opts, generate.WithTypedUnits())
+		}
+		if *receiveStreams {
+			opts = append(opts, generate.WithReceiveStreams())
+		}
+		if *network != "" {
+			if *protoDir != "" {
+				return errors.New("generate: protobuf schemas are not supported for networks")
+			}
+			if len(*plugins) > 0 || len(*templates) > 0 {
+				return errors.New("generate: plugins and templates are not supported for networks")
+			}
+			if config.Package != "" || config.SplitNodes || config.isFiltered() {
+				return errors.New("generate: package names, filters and split nodes are not supported for networks")
+			}
+			return genNetwork(*network, *inputDir, *outputDir, config.ImportPath, opts...)
+		}
+		if config.ImportPath != "" {
+			return errors.New("generate: import paths are only supported for networks")
+		}
+		if config.SplitNodes && *protoDir != "" {
+			return errors.New("generate: protobuf schemas are not supported with split nodes")
+		}
+		if config.Package != "" {
+			opts = append(opts, generate.WithPackageName(config.Package))
+		}
 		return filepath.Walk(*inputDir, func(p string, i os.FileInfo, err error) error {
 			if err != nil {
 				return err
 			}
-			if i.IsDir() || filepath.Ext(p) != ".dbc" {
+			if i.IsDir() || !isDatabaseFile(p) {
 				return nil
 			}
 			relPath, err := filepath.Rel(*inputDir, p)
 			if err != nil {
 				return err
 			}
+			db, err := compileGenerated(p, config.filter())
+			if err != nil {
+				return err
+			}
+			if config.SplitNodes {
+				return genNodes(db, *outputDir, relPath, *plugins, *templates, opts...)
+			}
 			outputFile := relPath + ".go"
 			outputPath := filepath.Join(*outputDir, outputFile)
-			return genGo(p, outputPath)
+			if err := genGo(db, outputPath, opts...); err != nil {
+				return err
+			}
+			if *protoDir != "" {
+				if err := genProto(db, filepath.Join(*protoDir, filepath.Dir(relPath)), outputPath, opts...); err != nil {
+					return err
+				}
+			}
+			return genPlugins(context.Background(), db, *outputDir, outputFile, *plugins, *templates, opts...)
 		})
 	})
 }
@@ -143,32 +258,106 @@ func analyzers() []*analysis.Analyzer {
 	}
 }
 
-func genGo(inputFile, outputFile string) error {
-	if err := os.MkdirAll(filepath.Dir(outputFile), 0o755); err != nil {
-		return err
-	}
+// compileGenerated compiles a database file to generate code for, with the messages and nodes selected by a filter.
+func compileGenerated(inputFile string, filter generate.Filter) (*descriptor.Database, error) {
 	input, err := os.ReadFile(inputFile)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	result, err := generate.Compile(inputFile, input)
+	db, warnings, err := compile(inputFile, input)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	for _, warning := range result.Warnings {
-		return warning
+	for _, warning := range warnings {
+		return nil, warning
 	}
-	output, err := generate.Database(result.Database)
+	return generate.FilterDatabase(db, filter)
+}
+
+func genGo(db *descriptor.Database, outputFile string, opts ...generate.Option) error {
+	output, err := generate.Database(db, opts...)
 	if err != nil {
 		return err
 	}
-	if err := os.WriteFile(outputFile, output, 0o600); err != nil {
-		return err
+	return writeGeneratedFile(outputFile, output)
+}
+
+// genNodes generates a package per node of a database, with the node and the messages it sends or receives.
+//
+// The package of a node is written to a sub-directory named after the node, next to the generated Go file of the
+// database.
+func genNodes(
+	db *descriptor.Database,
+	outputDir, relPath string,
+	plugins, templates []string,
+	opts ...generate.Option,
+) error {
+	databasePackageName := generate.DatabasePackageName(db, opts...)
+	for _, n := range db.Nodes {
+		nodeOpts := append(slices.Clip(opts), generate.WithPackageName(
+			generate.NodePackageName(databasePackageName, n.Name),
+		))
+		nodeDB := generate.NodeDatabase(db, n)
+		outputFile := filepath.Join(filepath.Dir(relPath), strings.ToLower(n.Name), filepath.Base(relPath)+".go")
+		if err := genGo(nodeDB, filepath.Join(outputDir, outputFile), nodeOpts...); err != nil {
+			return err
+		}
+		if err := genPlugins(
+			context.Background(), nodeDB, outputDir, outputFile, plugins, templates, nodeOpts...,
+		); err != nil {
+			return err
+		}
 	}
-	fmt.Println("wrote:", outputFile)
 	return nil
 }
 
+func compileDatabase(inputFile string) (*descriptor.Database, error) {
+	input, err := os.ReadFile(inputFile)
+	if err != nil {
+		return nil, err
+	}
+	db, _, err := compile(inputFile, input)
+	return db, err
+}
+
+// isDatabaseFile returns true if the file at the provided path is a DBC, ARXML, KCD or SYM file.
+func isDatabaseFile(path string) bool {
+	switch filepath.Ext(path) {
+	case ".dbc", ".arxml", ".kcd", ".sym":
+		return true
+	}
+	return false
+}
+
+// compile compiles a DBC file, or imports an ARXML, KCD or SYM file, into a database.
+func compile(inputFile string, input []byte) (*descriptor.Database, []error, error) {
+	switch filepath.Ext(inputFile) {
+	case ".arxml":
+		result, err := arxml.Import(inputFile, input)
+		if err != nil {
+			return nil, nil, err
+		}
+		return result.Database, result.Warnings, nil
+	case ".kcd":
+		result, err := kcd.Import(inputFile, input)
+		if err != nil {
+			return nil, nil, err
+		}
+		return result.Database, result.Warnings, nil
+	case ".sym":
+		result, err := sym.Import(inputFile, input)
+		if err != nil {
+			return nil, nil, err
+		}
+		return result.Database, result.Warnings, nil
+	}
+	result, err := generate.Compile(inputFile, input)
+	if err != nil {
+		return nil, nil, err
+	}
+	return result.Database, result.Warnings, nil
+}
+
 func resolveFileOrDirectory(fileOrDirectory string) ([]string, error) {
 	fileInfo, err := os.Stat(fileOrDirectory)
 	if err != nil {
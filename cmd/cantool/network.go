@@ -0,0 +1,117 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"go.einride.tech/can/internal/generate"
+)
+
+// genNetwork generates a package per bus of a network, and a network package composing the nodes of the buses.
+//
+// The network package is written to the output directory, and the package of each bus to a sub-directory named after
+// the DBC file of the bus. The import path of the output directory is resolved from go.mod when empty.
+func genNetwork(name, inputDir, outputDir, importPath string, opts ...generate.Option) error {
+	if importPath == "" {
+		var err error
+		if importPath, err = resolveImportPath(outputDir); err != nil {
+			return err
+		}
+	}
+	inputFiles, err := resolveFileOrDirectory(inputDir)
+	if err != nil {
+		return err
+	}
+	sources := make([]generate.NetworkSource, 0, len(inputFiles))
+	for _, inputFile := range inputFiles {
+		data, err := os.ReadFile(inputFile)
+		if err != nil {
+			return err
+		}
+		sources = append(sources, generate.NetworkSource{
+			Bus:        strings.TrimSuffix(filepath.Base(inputFile), filepath.Ext(inputFile)),
+			SourceFile: inputFile,
+			Data:       data,
+		})
+	}
+	result, err := generate.CompileNetwork(name, sources)
+	if err != nil {
+		return err
+	}
+	for _, warning := range result.Warnings {
+		return warning
+	}
+	for _, b := range result.Network.Buses {
+		output, err := generate.Database(b.Database, opts...)
+		if err != nil {
+			return err
+		}
+		outputFile := filepath.Join(outputDir, b.Name, filepath.Base(b.Database.SourceFile)+".go")
+		if err := writeGeneratedFile(outputFile, output); err != nil {
+			return err
+		}
+	}
+	output, err := generate.Network(result.Network, importPath)
+	if err != nil {
+		return err
+	}
+	return writeGeneratedFile(filepath.Join(outputDir, name+".go"), output)
+}
+
+func writeGeneratedFile(outputFile string, output []byte) error {
+	if err := os.MkdirAll(filepath.Dir(outputFile), 0o755); err != nil {
+		return err
+	}
+	if err := os.WriteFile(outputFile, output, 0o600); err != nil {
+		return err
+	}
+	fmt.Println("wrote:", outputFile)
+	return nil
+}
+
+// resolveImportPath resolves the Go import path of a directory from the go.mod file of its module.
+func resolveImportPath(dir string) (string, error) {
+	absDir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+	for moduleDir := absDir; ; moduleDir = filepath.Dir(moduleDir) {
+		modulePath, err := readModulePath(filepath.Join(moduleDir, "go.mod"))
+		if err == nil {
+			relDir, err := filepath.Rel(moduleDir, absDir)
+			if err != nil {
+				return "", err
+			}
+			return path.Join(modulePath, filepath.ToSlash(relDir)), nil
+		}
+		if !errors.Is(err, os.ErrNotExist) {
+			return "", err
+		}
+		if filepath.Dir(moduleDir) == moduleDir {
+			return "", fmt.Errorf("resolve import path of %s: no go.mod found", dir)
+		}
+	}
+}
+
+func readModulePath(goModFile string) (string, error) {
+	f, err := os.Open(goModFile)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		if modulePath, ok := strings.CutPrefix(strings.TrimSpace(sc.Text()), "module "); ok {
+			return strings.Trim(strings.TrimSpace(modulePath), `"`), nil
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return "", err
+	}
+	return "", fmt.Errorf("read module path: no module directive in %s", goModFile)
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"go.einride.tech/can/internal/generate"
+	"go.einride.tech/can/pkg/canplugin"
+	"go.einride.tech/can/pkg/descriptor"
+)
+
+// genPlugins runs plugins and executes templates for a database, and writes their files next to the generated Go file
+// of the database.
+//
+// Plugins are given as "<name>" or "<name>:<parameter>", where the name is either the path of the plugin executable,
+// or the suffix of an executable named cantool-gen-<name> in the PATH.
+func genPlugins(
+	ctx context.Context,
+	db *descriptor.Database,
+	outputDir, goFile string,
+	plugins, templates []string,
+	opts ...generate.Option,
+) error {
+	goDir := filepath.Join(outputDir, filepath.Dir(goFile))
+	for _, plugin := range plugins {
+		name, parameter, _ := strings.Cut(plugin, ":")
+		executable, err := lookupPlugin(name)
+		if err != nil {
+			return err
+		}
+		files, err := generate.RunPlugin(ctx, executable, &canplugin.Request{
+			Parameter: parameter,
+			GoFile:    filepath.ToSlash(goFile),
+			GoPackage: generate.DatabasePackageName(db, opts...),
+			Database:  db,
+		})
+		if err != nil {
+			return err
+		}
+		for _, file := range files {
+			if err := writeGeneratedFile(filepath.Join(goDir, file.Name), []byte(file.Content)); err != nil {
+				return err
+			}
+		}
+	}
+	for _, templateFile := range templates {
+		text, err := os.ReadFile(templateFile)
+		if err != nil {
+			return err
+		}
+		name := filepath.Base(templateFile)
+		output, err := generate.Template(db, name, string(text), opts...)
+		if err != nil {
+			return err
+		}
+		// the output of example.go.tmpl for example.dbc is written to example.dbc.example.go
+		outputFile := strings.TrimSuffix(filepath.Join(outputDir, goFile), ".go") + "." + strings.TrimSuffix(name, ".tmpl")
+		if err := writeGeneratedFile(outputFile, output); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func lookupPlugin(name string) (string, error) {
+	if strings.ContainsRune(name, filepath.Separator) || strings.ContainsRune(name, '/') {
+		return name, nil
+	}
+	return exec.LookPath(canplugin.ExecutablePrefix + name)
+}
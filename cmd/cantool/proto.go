@@ -0,0 +1,35 @@
+package main
+
+import (
+	"path/filepath"
+	"strings"
+
+	"go.einride.tech/can/internal/generate"
+	"go.einride.tech/can/pkg/descriptor"
+)
+
+// genProto generates the protobuf schema of a database, and converters between its messages and their protobuf
+// messages.
+//
+// The schema is written to a sub-directory of the proto directory named after the Go package of its protobuf
+// messages, and the converters next to the generated Go file of the database.
+func genProto(db *descriptor.Database, protoDir, goOutputFile string, opts ...generate.Option) error {
+	schemaDir := filepath.Join(protoDir, generate.ProtoPackageName(db))
+	importPath, err := resolveImportPath(schemaDir)
+	if err != nil {
+		return err
+	}
+	schema, err := generate.ProtoSchema(db, importPath)
+	if err != nil {
+		return err
+	}
+	baseName := strings.TrimSuffix(filepath.Base(db.SourceFile), filepath.Ext(db.SourceFile))
+	if err := writeGeneratedFile(filepath.Join(schemaDir, baseName+".proto"), schema); err != nil {
+		return err
+	}
+	converters, err := generate.ProtoConverters(db, importPath, opts...)
+	if err != nil {
+		return err
+	}
+	return writeGeneratedFile(strings.TrimSuffix(goOutputFile, ".go")+".proto.go", converters)
+}
@@ -0,0 +1,192 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/alecthomas/kingpin/v2"
+	"go.einride.tech/can"
+	"go.einride.tech/can/pkg/descriptor"
+	"go.einride.tech/can/pkg/socketcan"
+)
+
+func sendCommand(app *kingpin.Application) {
+	command := app.Command("send", "encode and transmit a CAN message")
+	inputFile := command.
+		Arg("dbc-file", "DBC file").
+		Required().
+		ExistingFile()
+	messageName := command.
+		Arg("message", "message name").
+		Required().
+		String()
+	signalValues := command.
+		Arg("signals", "signal values (Signal=value), as physical values or value descriptions").
+		StringMap()
+	network := command.
+		Flag("network", `network to transmit on ("can" or "udp")`).
+		Default("can").
+		String()
+	address := command.
+		Flag("address", "interface name or emulator multicast address").
+		Required().
+		String()
+	interval := command.
+		Flag("interval", "transmit cyclically with the provided interval").
+		Duration()
+	cyclic := command.
+		Flag("cyclic", "transmit cyclically with the cycle time of the message").
+		Bool()
+	count := command.
+		Flag("count", "number of cyclic transmissions, 0 means until interrupted").
+		Int()
+	command.Action(func(_ *kingpin.ParseContext) error {
+		db, err := compileDatabase(*inputFile)
+		if err != nil {
+			return err
+		}
+		message, ok := db.MessageByName(*messageName)
+		if !ok {
+			return fmt.Errorf("send: no message named %s in %s", *messageName, *inputFile)
+		}
+		f, err := encodeFrame(message, *signalValues)
+		if err != nil {
+			return fmt.Errorf("send %s: %w", message.Name, err)
+		}
+		if *cyclic {
+			if message.CycleTime == 0 {
+				return fmt.Errorf("send %s: message has no cycle time", message.Name)
+			}
+			*interval = message.CycleTime
+		}
+		ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
+		defer cancel()
+		conn, err := socketcan.DialContext(ctx, *network, *address)
+		if err != nil {
+			return fmt.Errorf("send %s: %w", message.Name, err)
+		}
+		defer func() {
+			_ = conn.Close()
+		}()
+		tx := socketcan.NewTransmitter(conn)
+		return transmitFrame(ctx, tx, f, *interval, *count)
+	})
+}
+
+func transmitFrame(
+	ctx context.Context,
+	tx *socketcan.Transmitter,
+	f can.Frame,
+	interval time.Duration,
+	count int,
+) error {
+	transmit := func() error {
+		ctx, cancel := context.WithTimeout(ctx, time.Second)
+		defer cancel()
+		if err := tx.TransmitFrame(ctx, f); err != nil {
+			return err
+		}
+		fmt.Println("sent:", f.String())
+		return nil
+	}
+	if interval == 0 {
+		return transmit()
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for i := 0; count == 0 || i < count; i++ {
+		if i > 0 {
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-ticker.C:
+			}
+		}
+		if err := transmit(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// encodeFrame encodes a frame of the message from signal default values and the provided signal values.
+func encodeFrame(m *descriptor.Message, values map[string]string) (can.Frame, error) {
+	f := can.Frame{ID: m.ID, IsExtended: m.IsExtended, Length: m.Length}
+	for name := range values {
+		if _, ok := m.Signal(name); !ok {
+			return can.Frame{}, fmt.Errorf("no signal named %s", name)
+		}
+	}
+	// multiplexers are encoded first, outermost first, since they determine which multiplexed signals are present
+	for depth := 0; depth < len(m.Signals); depth++ {
+		for _, s := range m.Signals {
+			if !s.IsMultiplexer || m.MultiplexerDepth(s) != depth || !m.IsSignalPresent(s, f.Data) {
+				continue
+			}
+			if err := encodeSignal(&f.Data, s, values); err != nil {
+				return can.Frame{}, err
+			}
+		}
+	}
+	for _, s := range m.Signals {
+		if !m.IsSignalPresent(s, f.Data) {
+			if _, ok := values[s.Name]; ok {
+				if mux, ok := m.Multiplexer(s); ok {
+					return can.Frame{}, fmt.Errorf(
+						"signal %s requires multiplexer %s=%d (got %d)",
+						s.Name, mux.Name, s.MultiplexerValue, mux.UnmarshalUnsigned(f.Data),
+					)
+				}
+				return can.Frame{}, fmt.Errorf("signal %s is not present", s.Name)
+			}
+			continue
+		}
+		if s.IsMultiplexer {
+			continue
+		}
+		if err := encodeSignal(&f.Data, s, values); err != nil {
+			return can.Frame{}, err
+		}
+	}
+	return f, nil
+}
+
+func encodeSignal(d *can.Data, s *descriptor.Signal, values map[string]string) error {
+	str, ok := values[s.Name]
+	if !ok {
+		if s.IsSigned {
+			s.MarshalSigned(d, int64(s.DefaultValue))
+		} else {
+			s.MarshalUnsigned(d, uint64(s.DefaultValue))
+		}
+		return nil
+	}
+	if value, ok := s.ValueDescriptionValue(str); ok {
+		if s.IsSigned {
+			s.MarshalSigned(d, value)
+		} else {
+			s.MarshalUnsigned(d, uint64(value))
+		}
+		return nil
+	}
+	if s.Length == 1 {
+		if b, err := strconv.ParseBool(str); err == nil {
+			s.MarshalBool(d, b)
+			return nil
+		}
+	}
+	// physical values can be followed by the unit of the signal, as in the notation of cantext
+	physical, err := strconv.ParseFloat(strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(str), s.Unit)), 64)
+	if err != nil {
+		return fmt.Errorf("invalid value for signal %s: %s", s.Name, str)
+	}
+	if err := s.CheckedMarshalPhysical(d, physical); err != nil {
+		return fmt.Errorf("value for signal %s: %w", s.Name, err)
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+package main
+
+import (
+	"testing"
+
+	"go.einride.tech/can"
+	examplecan "go.einride.tech/can/testdata/gen/go/example"
+	"gotest.tools/v3/assert"
+)
+
+func TestEncodeFrame(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		message  string
+		values   map[string]string
+		expected can.Frame
+		err      string
+	}{
+		{
+			name:     "defaults",
+			message:  "DriverHeartbeat",
+			expected: can.Frame{ID: 100, Length: 1},
+		},
+		{
+			name:     "value description",
+			message:  "DriverHeartbeat",
+			values:   map[string]string{"Command": "Reboot"},
+			expected: can.Frame{ID: 100, Length: 1, Data: can.Data{2}},
+		},
+		{
+			name:     "bool and unit",
+			message:  "MotorStatus",
+			values:   map[string]string{"WheelError": "true", "SpeedKph": "12.5 km/h"},
+			expected: can.Frame{ID: 400, Length: 3, Data: can.Data{1, 0xd4, 0x30}},
+		},
+		{
+			name:     "unit without space",
+			message:  "MotorStatus",
+			values:   map[string]string{"SpeedKph": "12.5km/h"},
+			expected: can.Frame{ID: 400, Length: 3, Data: can.Data{0, 0xd4, 0x30}},
+		},
+		{
+			name:     "signed with offset",
+			message:  "MotorCommand",
+			values:   map[string]string{"Steer": "-2", "Drive": "9"},
+			expected: can.Frame{ID: 101, Length: 1, Data: can.Data{0x93}},
+		},
+		{
+			name:     "multiplexer selection",
+			message:  "SensorSonars",
+			values:   map[string]string{"Mux": "1", "NoFiltLeft": "1.5"},
+			expected: can.Frame{ID: 200, Length: 8, Data: can.Data{1, 0, 0x0f}},
+		},
+		{
+			name:    "multiplexed signal not selected",
+			message: "SensorSonars",
+			values:  map[string]string{"NoFiltLeft": "1.5"},
+			err:     "signal NoFiltLeft requires multiplexer Mux=1 (got 0)",
+		},
+		{
+			name:    "out of range",
+			message: "MotorCommand",
+			values:  map[string]string{"Drive": "10"},
+			err:     "value for signal Drive: physical value out of range [0, 9]: 10",
+		},
+		{
+			name:    "negative unsigned",
+			message: "IODebug",
+			values:  map[string]string{"TestUnsigned": "-1"},
+			err:     "value for signal TestUnsigned: physical value -1: raw value out of bounds [0, 255]: -1",
+		},
+		{
+			name:    "unknown value description",
+			message: "DriverHeartbeat",
+			values:  map[string]string{"Command": "Shutdown"},
+			err:     "invalid value for signal Command: Shutdown",
+		},
+		{
+			name:    "unknown signal",
+			message: "DriverHeartbeat",
+			values:  map[string]string{"Foo": "1"},
+			err:     "no signal named Foo",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			m, ok := examplecan.Messages().Database().MessageByName(tt.message)
+			assert.Assert(t, ok)
+			f, err := encodeFrame(m, tt.values)
+			if tt.err != "" {
+				assert.Error(t, err, tt.err)
+				return
+			}
+			assert.NilError(t, err)
+			assert.Equal(t, tt.expected, f)
+		})
+	}
+}
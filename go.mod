@@ -15,12 +15,13 @@ require (
 	golang.org/x/net v0.38.0
 	golang.org/x/sync v0.11.0
 	golang.org/x/sys v0.31.0
+	google.golang.org/protobuf v1.36.10
 	gotest.tools/v3 v3.5.1
 )
 
 require (
 	github.com/alecthomas/units v0.0.0-20211218093645-b94a6e3cc137 // indirect
-	github.com/google/go-cmp v0.6.0 // indirect
+	github.com/google/go-cmp v0.7.0 // indirect
 	github.com/josharian/native v1.1.0 // indirect
 	github.com/mattn/go-colorable v0.1.13 // indirect
 	github.com/mattn/go-isatty v0.0.20 // indirect
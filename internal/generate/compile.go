@@ -27,9 +27,62 @@ func Compile(sourceFile string, data []byte) (result *CompileResult, err error)
 	c.collectDescriptors()
 	c.addMetadata()
 	c.sortDescriptors()
+	c.linkValueTables()
 	return &CompileResult{Database: c.db, Warnings: c.warnings}, nil
 }
 
+type CompileNetworkResult struct {
+	Network  *descriptor.Network
+	Warnings []error
+}
+
+// NetworkSource is the DBC source file of a bus in a network.
+type NetworkSource struct {
+	// Bus is the name of the bus.
+	Bus        string
+	SourceFile string
+	Data       []byte
+}
+
+// CompileNetwork compiles the DBC source files of the buses of a network, merging nodes present on multiple buses.
+func CompileNetwork(name string, sources []NetworkSource) (*CompileNetworkResult, error) {
+	result := &CompileNetworkResult{Network: &descriptor.Network{Name: name}}
+	nodes := map[string]*descriptor.NetworkNode{}
+	for _, source := range sources {
+		if _, ok := result.Network.Bus(source.Bus); ok {
+			return nil, fmt.Errorf("compile %s network: duplicate bus: %s", name, source.Bus)
+		}
+		busResult, err := Compile(source.SourceFile, source.Data)
+		if err != nil {
+			return nil, fmt.Errorf("compile %s network: %s bus: %w", name, source.Bus, err)
+		}
+		result.Warnings = append(result.Warnings, busResult.Warnings...)
+		result.Network.Buses = append(result.Network.Buses, &descriptor.Bus{
+			Name:     source.Bus,
+			Database: busResult.Database,
+		})
+		for _, n := range busResult.Database.Nodes {
+			nn, ok := nodes[n.Name]
+			if !ok {
+				nn = &descriptor.NetworkNode{Name: n.Name}
+				nodes[n.Name] = nn
+				result.Network.Nodes = append(result.Network.Nodes, nn)
+			}
+			nn.Buses = append(nn.Buses, source.Bus)
+		}
+	}
+	sort.Slice(result.Network.Buses, func(i, j int) bool {
+		return result.Network.Buses[i].Name < result.Network.Buses[j].Name
+	})
+	sort.Slice(result.Network.Nodes, func(i, j int) bool {
+		return result.Network.Nodes[i].Name < result.Network.Nodes[j].Name
+	})
+	for _, nn := range result.Network.Nodes {
+		sort.Strings(nn.Buses)
+	}
+	return result, nil
+}
+
 type compileError struct {
 	def    dbc.Def
 	reason string
@@ -91,6 +144,42 @@ func (c *compiler) collectDescriptors() {
 			for _, node := range def.NodeNames {
 				c.db.Nodes = append(c.db.Nodes, &descriptor.Node{Name: string(node)})
 			}
+		case *dbc.ValueTableDef:
+			c.db.ValueTables = append(c.db.ValueTables, &descriptor.ValueTable{
+				Name:              string(def.TableName),
+				ValueDescriptions: compileValueDescriptions(def.ValueDescriptions),
+			})
+		case *dbc.EnvironmentVariableDef:
+			environmentVariable := &descriptor.EnvironmentVariable{
+				Name:         string(def.Name),
+				ID:           def.ID,
+				Min:          def.Minimum,
+				Max:          def.Maximum,
+				Unit:         def.Unit,
+				InitialValue: def.InitialValue,
+			}
+			switch def.Type {
+			case dbc.EnvironmentVariableTypeInteger:
+				environmentVariable.Type = descriptor.EnvironmentVariableTypeInteger
+			case dbc.EnvironmentVariableTypeFloat:
+				environmentVariable.Type = descriptor.EnvironmentVariableTypeFloat
+			case dbc.EnvironmentVariableTypeString:
+				environmentVariable.Type = descriptor.EnvironmentVariableTypeString
+			}
+			switch def.AccessType {
+			case dbc.AccessTypeUnrestricted:
+				environmentVariable.AccessType = descriptor.AccessTypeUnrestricted
+			case dbc.AccessTypeRead:
+				environmentVariable.AccessType = descriptor.AccessTypeRead
+			case dbc.AccessTypeWrite:
+				environmentVariable.AccessType = descriptor.AccessTypeWrite
+			case dbc.AccessTypeReadWrite:
+				environmentVariable.AccessType = descriptor.AccessTypeReadWrite
+			}
+			for _, node := range def.AccessNodes {
+				environmentVariable.AccessNodes = append(environmentVariable.AccessNodes, string(node))
+			}
+			c.db.EnvironmentVariables = append(c.db.EnvironmentVariables, environmentVariable)
 		}
 	}
 }
@@ -147,56 +236,359 @@ func (c *compiler) addMetadata() {
 					continue
 				}
 				node.Description = def.Comment
+			case dbc.ObjectTypeEnvironmentVariable:
+				environmentVariable, ok := c.db.EnvironmentVariable(string(def.EnvironmentVariableName))
+				if !ok {
+					c.addWarning(&compileError{def: def, reason: "no declared environment variable"})
+					continue
+				}
+				environmentVariable.Description = def.Comment
+			}
+		case *dbc.MessageTransmittersDef:
+			message, ok := c.db.Message(def.MessageID.ToCAN())
+			if !ok {
+				c.addWarning(&compileError{def: def, reason: "no declared message"})
+				continue
+			}
+			for _, transmitter := range def.Transmitters {
+				message.TransmitterNodes = append(message.TransmitterNodes, string(transmitter))
+			}
+		case *dbc.SignalGroupDef:
+			message, ok := c.db.Message(def.MessageID.ToCAN())
+			if !ok {
+				c.addWarning(&compileError{def: def, reason: "no declared message"})
+				continue
 			}
+			signalGroup := &descriptor.SignalGroup{Name: string(def.GroupName), Repetitions: def.Repetitions}
+			for _, signalName := range def.SignalNames {
+				if _, ok := message.Signal(string(signalName)); !ok {
+					c.addWarning(&compileError{def: def, reason: "no declared signal: " + string(signalName)})
+					signalGroup = nil
+					break
+				}
+				signalGroup.SignalNames = append(signalGroup.SignalNames, string(signalName))
+			}
+			if signalGroup != nil {
+				message.SignalGroups = append(message.SignalGroups, signalGroup)
+			}
+		case *dbc.SignalMultiplexValueDef:
+			signal, ok := c.db.Signal(def.MessageID.ToCAN(), string(def.Signal))
+			if !ok {
+				c.addWarning(&compileError{def: def, reason: "no declared signal"})
+				continue
+			}
+			if _, ok := c.db.Signal(def.MessageID.ToCAN(), string(def.MultiplexerSwitch)); !ok {
+				c.addWarning(&compileError{def: def, reason: "no declared multiplexer signal"})
+				continue
+			}
+			signal.MultiplexerName = string(def.MultiplexerSwitch)
+			signal.MultiplexerRanges = nil
+			for _, r := range def.Ranges {
+				signal.MultiplexerRanges = append(signal.MultiplexerRanges, &descriptor.MultiplexerRange{
+					Min: uint(r.RangeStart),
+					Max: uint(r.RangeEnd),
+				})
+			}
+		case *dbc.EnvironmentVariableDataDef:
+			environmentVariable, ok := c.db.EnvironmentVariable(string(def.EnvironmentVariableName))
+			if !ok {
+				c.addWarning(&compileError{def: def, reason: "no declared environment variable"})
+				continue
+			}
+			environmentVariable.Type = descriptor.EnvironmentVariableTypeData
+			environmentVariable.DataSize = def.DataSize
 		case *dbc.ValueDescriptionsDef:
 			if def.MessageID == dbc.IndependentSignalsMessageID {
 				continue // don't compile
 			}
-			if def.ObjectType != dbc.ObjectTypeSignal {
-				continue // don't compile
+			valueDescriptions := compileValueDescriptions(def.ValueDescriptions)
+			if def.ValueTableName != "" {
+				valueTable, ok := c.db.ValueTable(string(def.ValueTableName))
+				if !ok {
+					c.addWarning(&compileError{def: def, reason: "no declared value table"})
+					continue
+				}
+				for _, vd := range valueTable.ValueDescriptions {
+					valueDescription := *vd
+					valueDescriptions = append(valueDescriptions, &valueDescription)
+				}
 			}
+			switch def.ObjectType {
+			case dbc.ObjectTypeSignal:
+				signal, ok := c.db.Signal(def.MessageID.ToCAN(), string(def.SignalName))
+				if !ok {
+					c.addWarning(&compileError{def: def, reason: "no declared signal"})
+					continue
+				}
+				signal.ValueTable = string(def.ValueTableName)
+				signal.ValueDescriptions = append(signal.ValueDescriptions, valueDescriptions...)
+			case dbc.ObjectTypeEnvironmentVariable:
+				environmentVariable, ok := c.db.EnvironmentVariable(string(def.EnvironmentVariableName))
+				if !ok {
+					c.addWarning(&compileError{def: def, reason: "no declared environment variable"})
+					continue
+				}
+				environmentVariable.ValueTable = string(def.ValueTableName)
+				environmentVariable.ValueDescriptions = append(environmentVariable.ValueDescriptions, valueDescriptions...)
+			}
+		}
+	}
+	c.addAttributes()
+	for _, msg := range c.db.Messages {
+		c.addMessageAttributeMetadata(msg)
+		for _, sig := range msg.Signals {
+			c.addSignalAttributeMetadata(sig)
+		}
+	}
+	c.addSignalTypes()
+}
+
+// addSignalTypes adds the default values and value tables of referenced signal types to signals.
+func (c *compiler) addSignalTypes() {
+	signalTypeDefs := map[dbc.Identifier]*dbc.SignalTypeDef{}
+	for _, def := range c.defs {
+		switch def := def.(type) {
+		case *dbc.SignalTypeDef:
+			signalTypeDefs[def.TypeName] = def
+		case *dbc.SignalTypeRefDef:
 			signal, ok := c.db.Signal(def.MessageID.ToCAN(), string(def.SignalName))
 			if !ok {
 				c.addWarning(&compileError{def: def, reason: "no declared signal"})
 				continue
 			}
-			for _, valueDescription := range def.ValueDescriptions {
-				signal.ValueDescriptions = append(signal.ValueDescriptions, &descriptor.ValueDescription{
-					Description: valueDescription.Description,
-					Value:       int64(valueDescription.Value),
-				})
+			signalTypeDef, ok := signalTypeDefs[def.TypeName]
+			if !ok {
+				c.addWarning(&compileError{def: def, reason: "no declared signal type"})
+				continue
 			}
+			if signalTypeDef.Size != uint64(signal.Length) ||
+				signalTypeDef.IsBigEndian != signal.IsBigEndian ||
+				signalTypeDef.IsSigned != signal.IsSigned ||
+				signalTypeDef.Factor != signal.Scale ||
+				signalTypeDef.Offset != signal.Offset {
+				c.addWarning(&compileError{def: def, reason: "signal type mismatch"})
+				continue
+			}
+			signal.SignalType = string(def.TypeName)
+			if attribute, ok := signal.Attribute("GenSigStartValue"); !ok || attribute.IsDefault {
+				signal.DefaultValue = int(signalTypeDef.DefaultValue)
+			}
+			if signalTypeDef.ValueTableName == "" || len(signal.ValueDescriptions) > 0 {
+				continue
+			}
+			valueTable, ok := c.db.ValueTable(string(signalTypeDef.ValueTableName))
+			if !ok {
+				c.addWarning(&compileError{def: signalTypeDef, reason: "no declared value table"})
+				continue
+			}
+			signal.ValueTable = valueTable.Name
+			for _, vd := range valueTable.ValueDescriptions {
+				valueDescription := *vd
+				signal.ValueDescriptions = append(signal.ValueDescriptions, &valueDescription)
+			}
+		}
+	}
+}
+
+// addAttributes adds the attribute values of the database, nodes, messages and signals, with defaults for attributes
+// without values.
+func (c *compiler) addAttributes() {
+	attributeDefs := map[dbc.Identifier]*dbc.AttributeDef{}
+	var defaultDefs []*dbc.AttributeDefaultValueDef
+	for _, def := range c.defs {
+		switch def := def.(type) {
+		case *dbc.AttributeDef:
+			attributeDefs[def.Name] = def
+		case *dbc.AttributeDefaultValueDef:
+			defaultDefs = append(defaultDefs, def)
 		case *dbc.AttributeValueForObjectDef:
+			attributeDef, ok := attributeDefs[def.AttributeName]
+			if !ok {
+				c.addWarning(&compileError{def: def, reason: "no declared attribute"})
+				continue
+			}
+			attribute := newAttribute(attributeDef, def.IntValue, def.FloatValue, def.StringValue)
 			switch def.ObjectType {
+			case dbc.ObjectTypeUnspecified:
+				c.db.Attributes = setAttribute(c.db.Attributes, attribute)
+			case dbc.ObjectTypeNetworkNode:
+				node, ok := c.db.Node(string(def.NodeName))
+				if !ok {
+					c.addWarning(&compileError{def: def, reason: "no declared node"})
+					continue
+				}
+				node.Attributes = setAttribute(node.Attributes, attribute)
 			case dbc.ObjectTypeMessage:
 				msg, ok := c.db.Message(def.MessageID.ToCAN())
 				if !ok {
 					c.addWarning(&compileError{def: def, reason: "no declared message"})
 					continue
 				}
-				switch def.AttributeName {
-				case "GenMsgSendType":
-					if err := msg.SendType.UnmarshalString(def.StringValue); err != nil {
-						c.addWarning(&compileError{def: def, reason: err.Error()})
-						continue
-					}
-				case "GenMsgCycleTime":
-					msg.CycleTime = time.Duration(def.IntValue) * time.Millisecond
-				case "GenMsgDelayTime":
-					msg.DelayTime = time.Duration(def.IntValue) * time.Millisecond
-				}
+				msg.Attributes = setAttribute(msg.Attributes, attribute)
 			case dbc.ObjectTypeSignal:
 				sig, ok := c.db.Signal(def.MessageID.ToCAN(), string(def.SignalName))
 				if !ok {
 					c.addWarning(&compileError{def: def, reason: "no declared signal"})
 					continue
 				}
-				if def.AttributeName == "GenSigStartValue" {
-					sig.DefaultValue = int(def.IntValue)
+				sig.Attributes = setAttribute(sig.Attributes, attribute)
+			case dbc.ObjectTypeEnvironmentVariable:
+				environmentVariable, ok := c.db.EnvironmentVariable(string(def.EnvironmentVariableName))
+				if !ok {
+					c.addWarning(&compileError{def: def, reason: "no declared environment variable"})
+					continue
+				}
+				environmentVariable.Attributes = setAttribute(environmentVariable.Attributes, attribute)
+			}
+		}
+	}
+	for _, def := range defaultDefs {
+		attributeDef, ok := attributeDefs[def.AttributeName]
+		if !ok {
+			c.addWarning(&compileError{def: def, reason: "no declared attribute"})
+			continue
+		}
+		attribute := newAttribute(attributeDef, def.DefaultIntValue, def.DefaultFloatValue, def.DefaultStringValue)
+		attribute.IsDefault = true
+		switch attributeDef.ObjectType {
+		case dbc.ObjectTypeUnspecified:
+			c.db.Attributes = addDefaultAttribute(c.db.Attributes, attribute)
+		case dbc.ObjectTypeNetworkNode:
+			for _, node := range c.db.Nodes {
+				node.Attributes = addDefaultAttribute(node.Attributes, attribute)
+			}
+		case dbc.ObjectTypeMessage:
+			for _, msg := range c.db.Messages {
+				msg.Attributes = addDefaultAttribute(msg.Attributes, attribute)
+			}
+		case dbc.ObjectTypeSignal:
+			for _, msg := range c.db.Messages {
+				for _, sig := range msg.Signals {
+					sig.Attributes = addDefaultAttribute(sig.Attributes, attribute)
 				}
 			}
+		case dbc.ObjectTypeEnvironmentVariable:
+			for _, environmentVariable := range c.db.EnvironmentVariables {
+				environmentVariable.Attributes = addDefaultAttribute(environmentVariable.Attributes, attribute)
+			}
 		}
 	}
+	sortAttributes(c.db.Attributes)
+	for _, node := range c.db.Nodes {
+		sortAttributes(node.Attributes)
+	}
+	for _, msg := range c.db.Messages {
+		sortAttributes(msg.Attributes)
+		for _, sig := range msg.Signals {
+			sortAttributes(sig.Attributes)
+		}
+	}
+	for _, environmentVariable := range c.db.EnvironmentVariables {
+		sortAttributes(environmentVariable.Attributes)
+	}
+}
+
+func (c *compiler) addMessageAttributeMetadata(msg *descriptor.Message) {
+	for _, attribute := range msg.Attributes {
+		switch attribute.Name {
+		case "GenMsgSendType":
+			if err := msg.SendType.UnmarshalString(attribute.StringValue); err != nil {
+				c.addWarning(fmt.Errorf("failed to compile: %v (message %s)", err, msg.Name))
+			}
+		case "GenMsgCycleTime":
+			msg.CycleTime = time.Duration(attribute.IntValue) * time.Millisecond
+		case "GenMsgCycleTimeFast":
+			msg.CycleTimeFast = time.Duration(attribute.IntValue) * time.Millisecond
+		case "GenMsgDelayTime":
+			msg.DelayTime = time.Duration(attribute.IntValue) * time.Millisecond
+		case "GenMsgNrOfRepetition":
+			msg.RepetitionCount = int(attribute.IntValue)
+		}
+	}
+	if msg.RepetitionCount > 0 && msg.CycleTimeFast == 0 {
+		c.addWarning(fmt.Errorf("failed to compile: repetitions without a fast cycle time (message %s)", msg.Name))
+		msg.RepetitionCount = 0
+	}
+}
+
+func (c *compiler) addSignalAttributeMetadata(sig *descriptor.Signal) {
+	for _, attribute := range sig.Attributes {
+		switch attribute.Name {
+		case "GenSigStartValue":
+			sig.DefaultValue = int(attribute.IntValue)
+		case "GenSigInactiveValue":
+			sig.InactiveValue = int(attribute.IntValue)
+		case "GenSigSendType":
+			if err := sig.SendType.UnmarshalString(attribute.StringValue); err != nil {
+				c.addWarning(fmt.Errorf("failed to compile: %v (signal %s)", err, sig.Name))
+			}
+		}
+	}
+}
+
+func compileValueDescriptions(defs []dbc.ValueDescriptionDef) []*descriptor.ValueDescription {
+	var valueDescriptions []*descriptor.ValueDescription
+	for _, def := range defs {
+		valueDescriptions = append(valueDescriptions, &descriptor.ValueDescription{
+			Description: def.Description,
+			Value:       int64(def.Value),
+		})
+	}
+	return valueDescriptions
+}
+
+func newAttribute(def *dbc.AttributeDef, intValue int64, floatValue float64, stringValue string) *descriptor.Attribute {
+	attribute := &descriptor.Attribute{Name: string(def.Name)}
+	switch def.Type {
+	case dbc.AttributeValueTypeInt:
+		attribute.Type = descriptor.AttributeTypeInt
+		attribute.IntValue = intValue
+	case dbc.AttributeValueTypeHex:
+		attribute.Type = descriptor.AttributeTypeHex
+		attribute.IntValue = intValue
+	case dbc.AttributeValueTypeFloat:
+		attribute.Type = descriptor.AttributeTypeFloat
+		attribute.FloatValue = floatValue
+	case dbc.AttributeValueTypeString:
+		attribute.Type = descriptor.AttributeTypeString
+		attribute.StringValue = stringValue
+	case dbc.AttributeValueTypeEnum:
+		attribute.Type = descriptor.AttributeTypeEnum
+		attribute.StringValue = stringValue
+		for i, enumValue := range def.EnumValues {
+			if enumValue == stringValue {
+				attribute.IntValue = int64(i)
+				break
+			}
+		}
+	}
+	return attribute
+}
+
+func setAttribute(attributes []*descriptor.Attribute, attribute *descriptor.Attribute) []*descriptor.Attribute {
+	for i, a := range attributes {
+		if a.Name == attribute.Name {
+			attributes[i] = attribute
+			return attributes
+		}
+	}
+	return append(attributes, attribute)
+}
+
+func addDefaultAttribute(attributes []*descriptor.Attribute, attribute *descriptor.Attribute) []*descriptor.Attribute {
+	for _, a := range attributes {
+		if a.Name == attribute.Name {
+			return attributes
+		}
+	}
+	defaultAttribute := *attribute
+	return append(attributes, &defaultAttribute)
+}
+
+func sortAttributes(attributes []*descriptor.Attribute) {
+	sort.Slice(attributes, func(i, j int) bool {
+		return attributes[i].Name < attributes[j].Name
+	})
 }
 
 func (c *compiler) sortDescriptors() {
@@ -218,9 +610,59 @@ func (c *compiler) sortDescriptors() {
 		})
 		// Sort value descriptions by value
 		for _, s := range m.Signals {
-			sort.Slice(s.ValueDescriptions, func(k, l int) bool {
-				return s.ValueDescriptions[k].Value < s.ValueDescriptions[l].Value
-			})
+			sortValueDescriptions(s.ValueDescriptions)
+		}
+	}
+	// Sort value tables by name
+	sort.Slice(c.db.ValueTables, func(i, j int) bool {
+		return c.db.ValueTables[i].Name < c.db.ValueTables[j].Name
+	})
+	for _, vt := range c.db.ValueTables {
+		sortValueDescriptions(vt.ValueDescriptions)
+	}
+	// Sort environment variables by name
+	sort.Slice(c.db.EnvironmentVariables, func(i, j int) bool {
+		return c.db.EnvironmentVariables[i].Name < c.db.EnvironmentVariables[j].Name
+	})
+	for _, e := range c.db.EnvironmentVariables {
+		sortValueDescriptions(e.ValueDescriptions)
+	}
+}
+
+func sortValueDescriptions(valueDescriptions []*descriptor.ValueDescription) {
+	sort.Slice(valueDescriptions, func(i, j int) bool {
+		return valueDescriptions[i].Value < valueDescriptions[j].Value
+	})
+}
+
+// linkValueTables links signals to the value tables with identical value descriptions.
+//
+// DBC editors commonly copy the value table of a signal into the value descriptions of the signal, without referencing
+// the value table by name.
+func (c *compiler) linkValueTables() {
+	for _, m := range c.db.Messages {
+		for _, s := range m.Signals {
+			if s.ValueTable != "" || len(s.ValueDescriptions) == 0 {
+				continue
+			}
+			for _, vt := range c.db.ValueTables {
+				if equalValueDescriptions(s.ValueDescriptions, vt.ValueDescriptions) {
+					s.ValueTable = vt.Name
+					break
+				}
+			}
+		}
+	}
+}
+
+func equalValueDescriptions(a, b []*descriptor.ValueDescription) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if *a[i] != *b[i] {
+			return false
 		}
 	}
+	return true
 }
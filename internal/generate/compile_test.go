@@ -45,6 +45,10 @@ func TestCompile_ExampleDBC(t *testing.T) {
 				ID:         1,
 				Name:       "EmptyMessage",
 				SenderNode: "DBG",
+				Attributes: []*descriptor.Attribute{
+					{Name: "GenMsgCycleTime", Type: descriptor.AttributeTypeInt, IsDefault: true},
+					{Name: "GenMsgSendType", Type: descriptor.AttributeTypeEnum, StringValue: "None"},
+				},
 			},
 
 			{
@@ -68,8 +72,16 @@ func TestCompile_ExampleDBC(t *testing.T) {
 							{Value: 2, Description: "Reboot"},
 							{Value: 3, Description: "Headlights On"},
 						},
+						Attributes: []*descriptor.Attribute{
+							{Name: "FieldType", Type: descriptor.AttributeTypeString, StringValue: "Command"},
+							{Name: "GenSigStartValue", Type: descriptor.AttributeTypeInt, IsDefault: true},
+						},
 					},
 				},
+				Attributes: []*descriptor.Attribute{
+					{Name: "GenMsgCycleTime", Type: descriptor.AttributeTypeInt, IntValue: 1000},
+					{Name: "GenMsgSendType", Type: descriptor.AttributeTypeEnum, IntValue: 1, StringValue: "Cyclic"},
+				},
 			},
 
 			{
@@ -90,6 +102,10 @@ func TestCompile_ExampleDBC(t *testing.T) {
 						Min:           -5,
 						Max:           5,
 						ReceiverNodes: []string{"MOTOR"},
+						Attributes: []*descriptor.Attribute{
+							{Name: "FieldType", Type: descriptor.AttributeTypeString, IsDefault: true},
+							{Name: "GenSigStartValue", Type: descriptor.AttributeTypeInt, IsDefault: true},
+						},
 					},
 					{
 						Name:          "Drive",
@@ -98,8 +114,16 @@ func TestCompile_ExampleDBC(t *testing.T) {
 						Scale:         1,
 						Max:           9,
 						ReceiverNodes: []string{"MOTOR"},
+						Attributes: []*descriptor.Attribute{
+							{Name: "FieldType", Type: descriptor.AttributeTypeString, IsDefault: true},
+							{Name: "GenSigStartValue", Type: descriptor.AttributeTypeInt, IsDefault: true},
+						},
 					},
 				},
+				Attributes: []*descriptor.Attribute{
+					{Name: "GenMsgCycleTime", Type: descriptor.AttributeTypeInt, IntValue: 100},
+					{Name: "GenMsgSendType", Type: descriptor.AttributeTypeEnum, IntValue: 1, StringValue: "Cyclic"},
+				},
 			},
 
 			{
@@ -117,6 +141,10 @@ func TestCompile_ExampleDBC(t *testing.T) {
 						Length:        4,
 						Scale:         1,
 						ReceiverNodes: []string{"DRIVER", "IO"},
+						Attributes: []*descriptor.Attribute{
+							{Name: "FieldType", Type: descriptor.AttributeTypeString, IsDefault: true},
+							{Name: "GenSigStartValue", Type: descriptor.AttributeTypeInt, IsDefault: true},
+						},
 					},
 					{
 						Name:          "ErrCount",
@@ -124,6 +152,10 @@ func TestCompile_ExampleDBC(t *testing.T) {
 						Length:        12,
 						Scale:         1,
 						ReceiverNodes: []string{"DRIVER", "IO"},
+						Attributes: []*descriptor.Attribute{
+							{Name: "FieldType", Type: descriptor.AttributeTypeString, IsDefault: true},
+							{Name: "GenSigStartValue", Type: descriptor.AttributeTypeInt, IsDefault: true},
+						},
 					},
 					{
 						Name:             "Left",
@@ -133,6 +165,10 @@ func TestCompile_ExampleDBC(t *testing.T) {
 						Length:           12,
 						Scale:            0.1,
 						ReceiverNodes:    []string{"DRIVER", "IO"},
+						Attributes: []*descriptor.Attribute{
+							{Name: "FieldType", Type: descriptor.AttributeTypeString, IsDefault: true},
+							{Name: "GenSigStartValue", Type: descriptor.AttributeTypeInt, IsDefault: true},
+						},
 					},
 					{
 						Name:             "NoFiltLeft",
@@ -142,6 +178,10 @@ func TestCompile_ExampleDBC(t *testing.T) {
 						Length:           12,
 						Scale:            0.1,
 						ReceiverNodes:    []string{"DBG"},
+						Attributes: []*descriptor.Attribute{
+							{Name: "FieldType", Type: descriptor.AttributeTypeString, IsDefault: true},
+							{Name: "GenSigStartValue", Type: descriptor.AttributeTypeInt, IsDefault: true},
+						},
 					},
 					{
 						Name:             "Middle",
@@ -151,6 +191,10 @@ func TestCompile_ExampleDBC(t *testing.T) {
 						Length:           12,
 						Scale:            0.1,
 						ReceiverNodes:    []string{"DRIVER", "IO"},
+						Attributes: []*descriptor.Attribute{
+							{Name: "FieldType", Type: descriptor.AttributeTypeString, IsDefault: true},
+							{Name: "GenSigStartValue", Type: descriptor.AttributeTypeInt, IsDefault: true},
+						},
 					},
 					{
 						Name:             "NoFiltMiddle",
@@ -160,6 +204,10 @@ func TestCompile_ExampleDBC(t *testing.T) {
 						Length:           12,
 						Scale:            0.1,
 						ReceiverNodes:    []string{"DBG"},
+						Attributes: []*descriptor.Attribute{
+							{Name: "FieldType", Type: descriptor.AttributeTypeString, IsDefault: true},
+							{Name: "GenSigStartValue", Type: descriptor.AttributeTypeInt, IsDefault: true},
+						},
 					},
 					{
 						Name:             "Right",
@@ -169,6 +217,10 @@ func TestCompile_ExampleDBC(t *testing.T) {
 						Length:           12,
 						Scale:            0.1,
 						ReceiverNodes:    []string{"DRIVER", "IO"},
+						Attributes: []*descriptor.Attribute{
+							{Name: "FieldType", Type: descriptor.AttributeTypeString, IsDefault: true},
+							{Name: "GenSigStartValue", Type: descriptor.AttributeTypeInt, IsDefault: true},
+						},
 					},
 					{
 						Name:             "NoFiltRight",
@@ -178,6 +230,10 @@ func TestCompile_ExampleDBC(t *testing.T) {
 						Length:           12,
 						Scale:            0.1,
 						ReceiverNodes:    []string{"DBG"},
+						Attributes: []*descriptor.Attribute{
+							{Name: "FieldType", Type: descriptor.AttributeTypeString, IsDefault: true},
+							{Name: "GenSigStartValue", Type: descriptor.AttributeTypeInt, IsDefault: true},
+						},
 					},
 					{
 						Name:             "Rear",
@@ -187,6 +243,10 @@ func TestCompile_ExampleDBC(t *testing.T) {
 						Length:           12,
 						Scale:            0.1,
 						ReceiverNodes:    []string{"DRIVER", "IO"},
+						Attributes: []*descriptor.Attribute{
+							{Name: "FieldType", Type: descriptor.AttributeTypeString, IsDefault: true},
+							{Name: "GenSigStartValue", Type: descriptor.AttributeTypeInt, IsDefault: true},
+						},
 					},
 					{
 						Name:             "NoFiltRear",
@@ -196,8 +256,16 @@ func TestCompile_ExampleDBC(t *testing.T) {
 						Length:           12,
 						Scale:            0.1,
 						ReceiverNodes:    []string{"DBG"},
+						Attributes: []*descriptor.Attribute{
+							{Name: "FieldType", Type: descriptor.AttributeTypeString, IsDefault: true},
+							{Name: "GenSigStartValue", Type: descriptor.AttributeTypeInt, IsDefault: true},
+						},
 					},
 				},
+				Attributes: []*descriptor.Attribute{
+					{Name: "GenMsgCycleTime", Type: descriptor.AttributeTypeInt, IntValue: 100},
+					{Name: "GenMsgSendType", Type: descriptor.AttributeTypeEnum, IntValue: 1, StringValue: "Cyclic"},
+				},
 			},
 
 			{
@@ -214,6 +282,10 @@ func TestCompile_ExampleDBC(t *testing.T) {
 						Length:        1,
 						Scale:         1,
 						ReceiverNodes: []string{"DRIVER", "IO"},
+						Attributes: []*descriptor.Attribute{
+							{Name: "FieldType", Type: descriptor.AttributeTypeString, IsDefault: true},
+							{Name: "GenSigStartValue", Type: descriptor.AttributeTypeInt, IsDefault: true},
+						},
 					},
 					{
 						Name:          "SpeedKph",
@@ -222,8 +294,16 @@ func TestCompile_ExampleDBC(t *testing.T) {
 						Scale:         0.001,
 						Unit:          "km/h",
 						ReceiverNodes: []string{"DRIVER", "IO"},
+						Attributes: []*descriptor.Attribute{
+							{Name: "FieldType", Type: descriptor.AttributeTypeString, IsDefault: true},
+							{Name: "GenSigStartValue", Type: descriptor.AttributeTypeInt, IsDefault: true},
+						},
 					},
 				},
+				Attributes: []*descriptor.Attribute{
+					{Name: "GenMsgCycleTime", Type: descriptor.AttributeTypeInt, IntValue: 100},
+					{Name: "GenMsgSendType", Type: descriptor.AttributeTypeEnum, IntValue: 1, StringValue: "Cyclic"},
+				},
 			},
 
 			{
@@ -239,6 +319,10 @@ func TestCompile_ExampleDBC(t *testing.T) {
 						Length:        8,
 						Scale:         1,
 						ReceiverNodes: []string{"DBG"},
+						Attributes: []*descriptor.Attribute{
+							{Name: "FieldType", Type: descriptor.AttributeTypeString, IsDefault: true},
+							{Name: "GenSigStartValue", Type: descriptor.AttributeTypeInt, IsDefault: true},
+						},
 					},
 					{
 						Name:          "TestEnum",
@@ -251,6 +335,10 @@ func TestCompile_ExampleDBC(t *testing.T) {
 							{Value: 1, Description: "One"},
 							{Value: 2, Description: "Two"},
 						},
+						Attributes: []*descriptor.Attribute{
+							{Name: "FieldType", Type: descriptor.AttributeTypeString, StringValue: "TestEnum"},
+							{Name: "GenSigStartValue", Type: descriptor.AttributeTypeInt, IntValue: 2},
+						},
 					},
 					{
 						Name:          "TestSigned",
@@ -259,6 +347,10 @@ func TestCompile_ExampleDBC(t *testing.T) {
 						IsSigned:      true,
 						Scale:         1,
 						ReceiverNodes: []string{"DBG"},
+						Attributes: []*descriptor.Attribute{
+							{Name: "FieldType", Type: descriptor.AttributeTypeString, IsDefault: true},
+							{Name: "GenSigStartValue", Type: descriptor.AttributeTypeInt, IsDefault: true},
+						},
 					},
 					{
 						Name:          "TestFloat",
@@ -266,6 +358,10 @@ func TestCompile_ExampleDBC(t *testing.T) {
 						Length:        8,
 						Scale:         0.5,
 						ReceiverNodes: []string{"DBG"},
+						Attributes: []*descriptor.Attribute{
+							{Name: "FieldType", Type: descriptor.AttributeTypeString, IsDefault: true},
+							{Name: "GenSigStartValue", Type: descriptor.AttributeTypeInt, IsDefault: true},
+						},
 					},
 					{
 						Name:          "TestBoolEnum",
@@ -277,6 +373,10 @@ func TestCompile_ExampleDBC(t *testing.T) {
 							{Value: 0, Description: "Zero"},
 							{Value: 1, Description: "One"},
 						},
+						Attributes: []*descriptor.Attribute{
+							{Name: "FieldType", Type: descriptor.AttributeTypeString, IsDefault: true},
+							{Name: "GenSigStartValue", Type: descriptor.AttributeTypeInt, IsDefault: true},
+						},
 					},
 					{
 						Name:          "TestScaledEnum",
@@ -292,8 +392,16 @@ func TestCompile_ExampleDBC(t *testing.T) {
 							{Value: 2, Description: "Four"},
 							{Value: 3, Description: "Six"},
 						},
+						Attributes: []*descriptor.Attribute{
+							{Name: "FieldType", Type: descriptor.AttributeTypeString, IsDefault: true},
+							{Name: "GenSigStartValue", Type: descriptor.AttributeTypeInt, IsDefault: true},
+						},
 					},
 				},
+				Attributes: []*descriptor.Attribute{
+					{Name: "GenMsgCycleTime", Type: descriptor.AttributeTypeInt, IsDefault: true},
+					{Name: "GenMsgSendType", Type: descriptor.AttributeTypeEnum, IntValue: 2, StringValue: "OnEvent"},
+				},
 			},
 			{
 				ID:         600,
@@ -309,6 +417,10 @@ func TestCompile_ExampleDBC(t *testing.T) {
 						IsFloat:       true,
 						Scale:         1,
 						ReceiverNodes: []string{"DBG"},
+						Attributes: []*descriptor.Attribute{
+							{Name: "FieldType", Type: descriptor.AttributeTypeString, IsDefault: true},
+							{Name: "GenSigStartValue", Type: descriptor.AttributeTypeInt, IsDefault: true},
+						},
 					},
 					{
 						Name:          "Float32WithRange",
@@ -320,8 +432,15 @@ func TestCompile_ExampleDBC(t *testing.T) {
 						Min:           -100,
 						Max:           100,
 						ReceiverNodes: []string{"DBG"},
+						Attributes: []*descriptor.Attribute{
+							{Name: "FieldType", Type: descriptor.AttributeTypeString, IsDefault: true},
+							{Name: "GenSigStartValue", Type: descriptor.AttributeTypeInt, IsDefault: true},
+						},
 					},
 				},
+				Attributes: []*descriptor.Attribute{
+					{Name: "GenMsgCycleTime", Type: descriptor.AttributeTypeInt, IsDefault: true},
+				},
 			},
 			{
 				ID:         700,
@@ -337,10 +456,41 @@ func TestCompile_ExampleDBC(t *testing.T) {
 						IsFloat:       false,
 						Scale:         1,
 						ReceiverNodes: []string{"DBG"},
+						Attributes: []*descriptor.Attribute{
+							{Name: "FieldType", Type: descriptor.AttributeTypeString, IsDefault: true},
+							{Name: "GenSigStartValue", Type: descriptor.AttributeTypeInt, IsDefault: true},
+						},
 					},
 				},
+				Attributes: []*descriptor.Attribute{
+					{Name: "GenMsgCycleTime", Type: descriptor.AttributeTypeInt, IsDefault: true},
+				},
 			},
 		},
+		EnvironmentVariables: []*descriptor.EnvironmentVariable{
+			{
+				Name:        "BrakeEngaged",
+				ID:          10,
+				Type:        descriptor.EnvironmentVariableTypeInteger,
+				Max:         1,
+				AccessType:  descriptor.AccessTypeUnrestricted,
+				AccessNodes: []string{"Vector__XXX"},
+				Description: "Brake fully engaged",
+			},
+			{
+				Name:         "Torque",
+				ID:           16,
+				Type:         descriptor.EnvironmentVariableTypeFloat,
+				Max:          30000,
+				Unit:         "mNm",
+				InitialValue: 500,
+				AccessType:   descriptor.AccessTypeUnrestricted,
+				AccessNodes:  []string{"Vector__XXX"},
+			},
+		},
+		Attributes: []*descriptor.Attribute{
+			{Name: "BusType", Type: descriptor.AttributeTypeString, StringValue: "CAN", IsDefault: true},
+		},
 	}
 	input, err := os.ReadFile(exampleDBCFile)
 	assert.NilError(t, err)
@@ -434,3 +584,49 @@ func TestCompile_MetadataInvalidSignalReference(t *testing.T) {
 	// We expect one warning for incorrect signal length in declaration of float32 signal
 	assert.Equal(t, len(result.Warnings), 1)
 }
+
+func TestCompile_Attributes(t *testing.T) {
+	const input = `VERSION ""
+
+NS_ :
+
+BS_:
+
+BU_: ECU
+
+BO_ 100 Message: 1 ECU
+ SG_ Signal : 0|8@1+ (1,0) [0|255] "" Vector__XXX
+
+BA_DEF_ "DBName" STRING ;
+BA_DEF_ BU_ "ECUVariant" ENUM "Base","Premium";
+BA_DEF_ BO_ "ASIL" ENUM "QM","A","B","C","D";
+BA_DEF_ BO_ "Priority" HEX 0 255;
+BA_DEF_ SG_ "Tolerance" FLOAT 0 1;
+BA_DEF_DEF_ "DBName" "";
+BA_DEF_DEF_ "ECUVariant" "Base";
+BA_DEF_DEF_ "ASIL" "QM";
+BA_DEF_DEF_ "Tolerance" 0.5;
+
+BA_ "DBName" "Test";
+BA_ "ASIL" BO_ 100 3;
+BA_ "Priority" BO_ 100 16;
+`
+	result, err := Compile("test.dbc", []byte(input))
+	assert.NilError(t, err)
+	assert.Equal(t, 0, len(result.Warnings))
+	db := result.Database
+	assert.DeepEqual(t, []*descriptor.Attribute{
+		{Name: "DBName", Type: descriptor.AttributeTypeString, StringValue: "Test"},
+	}, db.Attributes)
+	assert.DeepEqual(t, []*descriptor.Attribute{
+		{Name: "ECUVariant", Type: descriptor.AttributeTypeEnum, StringValue: "Base", IsDefault: true},
+	}, db.Nodes[0].Attributes)
+	assert.DeepEqual(t, []*descriptor.Attribute{
+		{Name: "ASIL", Type: descriptor.AttributeTypeEnum, IntValue: 3, StringValue: "C"},
+		{Name: "Priority", Type: descriptor.AttributeTypeHex, IntValue: 16},
+	}, db.Messages[0].Attributes)
+	tolerance, ok := db.Messages[0].Signals[0].Attribute("Tolerance")
+	assert.Assert(t, ok)
+	assert.Equal(t, 0.5, tolerance.Value())
+	assert.Assert(t, tolerance.IsDefault)
+}
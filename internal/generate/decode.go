@@ -0,0 +1,228 @@
+package generate
+
+import (
+	"fmt"
+
+	"go.einride.tech/can/pkg/descriptor"
+)
+
+// DecodeFrame generates a decoder of a message, with the bit layout of each signal resolved at generation time.
+//
+// The data of the frame is packed once per endianness, and each signal is decoded with a constant shift and mask,
+// instead of with the descriptor of the signal as in UnmarshalFrame.
+func DecodeFrame(f *File, m *descriptor.Message) {
+	f.P("// DecodeFrame decodes the message from a CAN frame, with the bit layout of each signal resolved at generation")
+	f.P("// time.")
+	f.P("//")
+	f.P("// DecodeFrame decodes the same values as UnmarshalFrame, without allocating unless the frame is invalid, for")
+	f.P("// decoding frames at high rates into reused messages.")
+	f.P("func (m *", messageStruct(m), ") DecodeFrame(f *can.Frame) error {")
+	isExtendedMismatch := "f.IsExtended"
+	if m.IsExtended {
+		isExtendedMismatch = "!f.IsExtended"
+	}
+	frameChecks(f, m, "decode", fmt.Sprint(m.ID), fmt.Sprint(m.Length), isExtendedMismatch)
+	type decodedSignal struct {
+		signal    *descriptor.Signal
+		condition string
+	}
+	decodedSignals := make([]decodedSignal, 0, len(m.Signals))
+	for _, s := range m.Signals {
+		if !s.IsMultiplexed {
+			decodedSignals = append(decodedSignals, decodedSignal{signal: s})
+		}
+	}
+	// multiplexed signals are decoded after the multiplexers they are selected by
+	for _, s := range multiplexedSignals(m) {
+		if condition, ok := multiplexedSignalCondition(m, s); ok {
+			decodedSignals = append(decodedSignals, decodedSignal{signal: s, condition: condition})
+		}
+	}
+	var hasLittleEndian, hasBigEndian bool
+	for _, ds := range decodedSignals {
+		if packedData(ds.signal) == "be" {
+			hasBigEndian = true
+		} else {
+			hasLittleEndian = true
+		}
+	}
+	if hasLittleEndian {
+		f.P("le := f.Data.PackLittleEndian()")
+	}
+	if hasBigEndian {
+		f.P("be := f.Data.PackBigEndian()")
+	}
+	for _, ds := range decodedSignals {
+		if ds.condition != "" {
+			f.P("if ", ds.condition, " {")
+		}
+		f.P("m.", signalField(ds.signal), " = ", decodeSignal(m, ds.signal))
+		if ds.condition != "" {
+			f.P("}")
+		}
+	}
+	f.P("return nil")
+	f.P("}")
+	f.P()
+}
+
+// FrameBatch generates a batch decoder of the frames of all messages of a database.
+func FrameBatch(f *File, d *descriptor.Database) {
+	f.P("// FrameBatch holds the messages decoded from batches of frames, in a slice per message.")
+	f.P("//")
+	f.P("// The slices are reused after Reset, so that decoding does not allocate once they have grown to the size of a")
+	f.P("// batch.")
+	f.P("type FrameBatch struct {")
+	for _, m := range d.Messages {
+		f.P(m.Name, " []", messageStruct(m))
+	}
+	f.P("}")
+	f.P()
+	f.P("// Reset empties the slices of the batch, keeping their capacity.")
+	f.P("func (b *FrameBatch) Reset() {")
+	for _, m := range d.Messages {
+		f.P("b.", m.Name, " = b.", m.Name, "[:0]")
+	}
+	f.P("}")
+	f.P()
+	f.P("// DecodeFrames decodes a batch of frames, and appends the decoded messages to the batch.")
+	f.P("//")
+	f.P("// Remote frames and frames of unknown messages are skipped.")
+	f.P("func (b *FrameBatch) DecodeFrames(frames []can.Frame) error {")
+	f.P("for i := range frames {")
+	f.P("f := &frames[i]")
+	f.P("if f.IsRemote {")
+	f.P("continue")
+	f.P("}")
+	f.P("switch f.ID {")
+	for _, ms := range messagesByID(d) {
+		f.P("case ", ms[0].ID, ":")
+		for _, m := range ms {
+			if m.IsExtended {
+				f.P("if f.IsExtended {")
+			} else {
+				f.P("if !f.IsExtended {")
+			}
+			f.P("b.", m.Name, " = append(b.", m.Name, ", ", messageStruct(m), "{})")
+			f.P("m := &b.", m.Name, "[len(b.", m.Name, ")-1]")
+			if hasMultiplexedSignals(m) {
+				// signals not selected by the frame have their default values, as in messages created with New
+				f.P("m.Reset()")
+			}
+			f.P("if err := m.DecodeFrame(f); err != nil {")
+			f.P("b.", m.Name, " = b.", m.Name, "[:len(b.", m.Name, ")-1]")
+			f.P("return err")
+			f.P("}")
+			f.P("}")
+		}
+	}
+	f.P("}")
+	f.P("}")
+	f.P("return nil")
+	f.P("}")
+	f.P()
+}
+
+// frameChecks generates checks that the frame f is a frame of a message.
+func frameChecks(f *File, m *descriptor.Message, operation, id, length, isExtendedMismatch string) {
+	idKind := func(isExtended bool) string {
+		if isExtended {
+			return "extended ID"
+		}
+		return "standard ID"
+	}
+	f.P("switch {")
+	f.P("case f.ID != ", id, ":")
+	f.P(`return fmt.Errorf(`)
+	f.P(`"`, operation, ` `, m.Name, `: expects ID `, m.ID, ` (got %s with ID %d)", f.String(), f.ID,`)
+	f.P(`)`)
+	f.P("case f.Length != ", length, ":")
+	f.P(`return fmt.Errorf(`)
+	f.P(`"`, operation, ` `, m.Name, `: expects length `, m.Length, ` (got %s with length %d)", f.String(), f.Length,`)
+	f.P(`)`)
+	f.P("case f.IsRemote:")
+	f.P(`return fmt.Errorf(`)
+	f.P(`"`, operation, ` `, m.Name, `: expects non-remote frame (got remote frame %s)", f.String(),`)
+	f.P(`)`)
+	f.P("case ", isExtendedMismatch, ":")
+	f.P(`return fmt.Errorf(`)
+	f.P(
+		`"`, operation, ` `, m.Name, `: expects `, idKind(m.IsExtended),
+		` (got %s with `, idKind(!m.IsExtended), `)", f.String(),`,
+	)
+	f.P(`)`)
+	f.P("}")
+}
+
+// packedData returns the name of the packed data that a signal is decoded from.
+func packedData(s *descriptor.Signal) string {
+	if s.IsBigEndian && signalSuperType(s) != "Bool" {
+		return "be"
+	}
+	return "le"
+}
+
+// decodeSignal returns an expression decoding a signal from the packed data of a frame.
+//
+// The expressions are equivalent to the bit helpers of can.Data, with the start and length of the signal as constants.
+func decodeSignal(m *descriptor.Message, s *descriptor.Signal) string {
+	t := signalType(m, s)
+	start, length := int(s.Start), int(s.Length)
+	if signalSuperType(s) == "Bool" {
+		if start > 63 {
+			return t + "(false)"
+		}
+		return fmt.Sprintf("%s(le&(1<<%d) != 0)", t, start)
+	}
+	packed, lsb := packedData(s), start
+	if packed == "be" {
+		// the big-endian start bit is the most significant bit of the signal
+		lsb = (7-start/8)*8 + start%8 - length + 1
+	}
+	if signalSuperType(s) == "Signed" {
+		// shift the most significant bit of the signal to the sign bit, and sign-extend it back
+		value := packed
+		if shift := 64 - lsb - length; shift > 0 {
+			value = fmt.Sprintf("%s<<%d", packed, shift)
+		}
+		if shift := 64 - length; shift > 0 {
+			return fmt.Sprintf("%s(int64(%s)>>%d)", t, value, shift)
+		}
+		return fmt.Sprintf("%s(int64(%s))", t, value)
+	}
+	value := packed
+	if lsb > 0 {
+		value = fmt.Sprintf("(%s>>%d)", packed, lsb)
+	}
+	if length < 64 {
+		value = fmt.Sprintf("%s&%#x", value, uint64(1)<<length-1)
+	}
+	if signalSuperType(s) == "Float" {
+		return fmt.Sprintf("%s(math.Float32frombits(uint32(%s)))", t, value)
+	}
+	return fmt.Sprintf("%s(%s)", t, value)
+}
+
+// messagesByID returns the messages of a database grouped by ID, in the order of their first message.
+func messagesByID(d *descriptor.Database) [][]*descriptor.Message {
+	var result [][]*descriptor.Message
+	indices := map[uint32]int{}
+	for _, m := range d.Messages {
+		if i, ok := indices[m.ID]; ok {
+			result[i] = append(result[i], m)
+			continue
+		}
+		indices[m.ID] = len(result)
+		result = append(result, []*descriptor.Message{m})
+	}
+	return result
+}
+
+func hasMultiplexedSignals(m *descriptor.Message) bool {
+	for _, s := range m.Signals {
+		if s.IsMultiplexed {
+			return true
+		}
+	}
+	return false
+}
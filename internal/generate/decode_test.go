@@ -0,0 +1,195 @@
+package generate
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"go.einride.tech/can"
+	"go.einride.tech/can/pkg/generated"
+	decodingcan "go.einride.tech/can/testdata/gen/go/decoding"
+	examplecan "go.einride.tech/can/testdata/gen/go/example"
+	multiplexingcan "go.einride.tech/can/testdata/gen/go/multiplexing"
+	telemetrycan "go.einride.tech/can/testdata/gen/go/telemetry"
+	unitscan "go.einride.tech/can/testdata/gen/go/units"
+	"gotest.tools/v3/assert"
+)
+
+type frameDecoder interface {
+	generated.Message
+	Reset()
+	DecodeFrame(*can.Frame) error
+}
+
+// batchMessages returns a new message of each message type of a generated frame batch.
+func batchMessages(t *testing.T, batch any) []frameDecoder {
+	t.Helper()
+	batchType := reflect.TypeOf(batch).Elem()
+	result := make([]frameDecoder, 0, batchType.NumField())
+	for i := range batchType.NumField() {
+		m, ok := reflect.New(batchType.Field(i).Type.Elem()).Interface().(frameDecoder)
+		assert.Assert(t, ok, batchType.Field(i).Name)
+		result = append(result, m)
+	}
+	return result
+}
+
+func TestDecodeFrame_EquivalentToUnmarshalFrame(t *testing.T) {
+	rng := rand.New(rand.NewSource(0))
+	for _, batch := range []any{
+		&decodingcan.FrameBatch{},
+		&examplecan.FrameBatch{},
+		&multiplexingcan.FrameBatch{},
+		&telemetrycan.FrameBatch{},
+		&unitscan.FrameBatch{},
+	} {
+		for _, m := range batchMessages(t, batch) {
+			t.Run(m.Descriptor().Name, func(t *testing.T) {
+				md := m.Descriptor()
+				expected := reflect.New(reflect.TypeOf(m).Elem()).Interface().(frameDecoder)
+				for range 1000 {
+					f := can.Frame{ID: md.ID, Length: md.Length, IsExtended: md.IsExtended}
+					_, _ = rng.Read(f.Data[:md.Length])
+					expected.Reset()
+					assert.NilError(t, expected.UnmarshalFrame(f))
+					m.Reset()
+					assert.NilError(t, m.DecodeFrame(&f))
+					// compare formatted fields, since float signals can be NaN
+					assert.Equal(t, formatFields(expected), formatFields(m), f.String())
+				}
+			})
+		}
+	}
+}
+
+func formatFields(m frameDecoder) string {
+	return fmt.Sprintf("%+v", reflect.ValueOf(m).Elem().Interface())
+}
+
+func TestDecodeFrame_Errors(t *testing.T) {
+	m := decodingcan.NewExtended()
+	for _, tt := range []struct {
+		frame    can.Frame
+		expected string
+	}{
+		{
+			frame:    can.Frame{ID: 1025, Length: 4, IsExtended: true},
+			expected: "decode Extended: expects ID 1024 (got 00000401#00000000 with ID 1025)",
+		},
+		{
+			frame:    can.Frame{ID: 1024, Length: 8, IsExtended: true},
+			expected: "decode Extended: expects length 4",
+		},
+		{
+			frame:    can.Frame{ID: 1024, Length: 4, IsExtended: true, IsRemote: true},
+			expected: "decode Extended: expects non-remote frame",
+		},
+		{
+			frame:    can.Frame{ID: 1024, Length: 4},
+			expected: "decode Extended: expects extended ID",
+		},
+	} {
+		assert.ErrorContains(t, m.DecodeFrame(&tt.frame), tt.expected)
+	}
+}
+
+func TestFrameBatch_DecodeFrames(t *testing.T) {
+	frames := []can.Frame{
+		decodingcan.NewWide().SetUnsigned64(1).Frame(),
+		decodingcan.NewMultiplexed().SetMux(1).SetHigh(-3).Frame(),
+		{ID: 1024, Length: 4}, // standard frame with the ID of an extended message
+		{ID: 100, Length: 8, IsRemote: true},
+		{ID: 999, Length: 1},
+		decodingcan.NewWide().SetUnsigned64(2).Frame(),
+	}
+	var batch decodingcan.FrameBatch
+	assert.NilError(t, batch.DecodeFrames(frames))
+	assert.Equal(t, 2, len(batch.Wide))
+	assert.Equal(t, uint64(1), batch.Wide[0].Unsigned64())
+	assert.Equal(t, uint64(2), batch.Wide[1].Unsigned64())
+	assert.Equal(t, 1, len(batch.Multiplexed))
+	assert.Equal(t, int16(-3), batch.Multiplexed[0].High())
+	assert.Equal(t, 0, len(batch.Extended))
+	assert.Equal(t, 0, len(batch.BigEndian))
+	batch.Reset()
+	assert.Equal(t, 0, len(batch.Wide))
+	assert.Assert(t, cap(batch.Wide) >= 2)
+	err := batch.DecodeFrames([]can.Frame{{ID: 300, Length: 4}})
+	assert.ErrorContains(t, err, "decode Wide: expects length 8")
+	assert.Equal(t, 0, len(batch.Wide))
+}
+
+func TestFrameBatch_DecodeFramesAllocs(t *testing.T) {
+	frames := benchmarkFrames()
+	var batch decodingcan.FrameBatch
+	assert.NilError(t, batch.DecodeFrames(frames))
+	allocs := testing.AllocsPerRun(100, func() {
+		batch.Reset()
+		_ = batch.DecodeFrames(frames)
+	})
+	assert.Equal(t, 0.0, allocs)
+}
+
+func benchmarkFrames() []can.Frame {
+	rng := rand.New(rand.NewSource(0))
+	messages := []generated.Message{
+		decodingcan.NewBigEndian(),
+		decodingcan.NewLittleEndian(),
+		decodingcan.NewMultiplexed(),
+		decodingcan.NewExtended(),
+	}
+	frames := make([]can.Frame, 1024)
+	for i := range frames {
+		md := messages[i%len(messages)].Descriptor()
+		frames[i] = can.Frame{ID: md.ID, Length: md.Length, IsExtended: md.IsExtended}
+		_, _ = rng.Read(frames[i].Data[:md.Length])
+	}
+	return frames
+}
+
+func BenchmarkBigEndian_UnmarshalFrame(b *testing.B) {
+	f := benchmarkFrames()[0]
+	m := decodingcan.NewBigEndian()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = m.UnmarshalFrame(f)
+	}
+}
+
+func BenchmarkBigEndian_DecodeFrame(b *testing.B) {
+	f := benchmarkFrames()[0]
+	m := decodingcan.NewBigEndian()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = m.DecodeFrame(&f)
+	}
+}
+
+func BenchmarkLittleEndian_UnmarshalFrame(b *testing.B) {
+	f := benchmarkFrames()[1]
+	m := decodingcan.NewLittleEndian()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = m.UnmarshalFrame(f)
+	}
+}
+
+func BenchmarkLittleEndian_DecodeFrame(b *testing.B) {
+	f := benchmarkFrames()[1]
+	m := decodingcan.NewLittleEndian()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = m.DecodeFrame(&f)
+	}
+}
+
+func BenchmarkFrameBatch_DecodeFrames(b *testing.B) {
+	frames := benchmarkFrames()
+	var batch decodingcan.FrameBatch
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		batch.Reset()
+		_ = batch.DecodeFrames(frames)
+	}
+}
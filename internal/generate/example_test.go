@@ -9,6 +9,8 @@ import (
 	"time"
 
 	"go.einride.tech/can"
+	"go.einride.tech/can/internal/clock"
+	"go.einride.tech/can/pkg/canrunner"
 	"go.einride.tech/can/pkg/generated"
 	"go.einride.tech/can/pkg/socketcan"
 	examplecan "go.einride.tech/can/testdata/gen/go/example"
@@ -325,6 +327,63 @@ func TestExample_Nodes(t *testing.T) {
 	assert.NilError(t, g.Wait())
 }
 
+func TestExample_Attributes(t *testing.T) {
+	busType, ok := examplecan.Messages().Database().Attribute("BusType")
+	assert.Assert(t, ok)
+	assert.Equal(t, "CAN", busType.Value())
+	fieldType, ok := examplecan.Messages().IODebug.TestEnum.Attribute("FieldType")
+	assert.Assert(t, ok)
+	assert.Equal(t, "TestEnum", fieldType.StringValue)
+	cycleTime, ok := examplecan.Messages().MotorStatus.Attribute("GenMsgCycleTime")
+	assert.Assert(t, ok)
+	assert.Equal(t, int64(100), cycleTime.Value())
+}
+
+func TestExample_Node_SignalSubscription(t *testing.T) {
+	// given a DRIVER node subscribing to the speed report crossing a threshold
+	driver := examplecan.NewDRIVER("can", "vcan0")
+	var speeds [][2]float64
+	driver.Rx().MotorStatus().SubscribeSpeedKph(
+		canrunner.OnThresholdCrossing(10, canrunner.CrossingDirectionRising),
+		func(_ context.Context, prev, curr examplecan.MotorStatusReader) error {
+			speeds = append(speeds, [2]float64{prev.SpeedKph(), curr.SpeedKph()})
+			return nil
+		},
+	)
+	// when receiving speed reports
+	rx := &sliceFrameReceiver{}
+	for _, speed := range []float64{5, 9, 11, 12, 8, 15} {
+		rx.frames = append(rx.frames, examplecan.NewMotorStatus().SetSpeedKph(speed).Frame())
+	}
+	n, ok := driver.(canrunner.Node)
+	assert.Assert(t, ok)
+	assert.NilError(t, canrunner.RunMessageReceiver(context.Background(), rx, n, clock.System()))
+	// then the subscription should be triggered by the rising threshold crossings
+	assert.DeepEqual(t, [][2]float64{{9, 11}, {8, 15}}, speeds)
+}
+
+// sliceFrameReceiver is a canrunner.FrameReceiver receiving frames from a slice.
+type sliceFrameReceiver struct {
+	frames []can.Frame
+	frame  can.Frame
+}
+
+func (r *sliceFrameReceiver) Receive() bool {
+	if len(r.frames) == 0 {
+		return false
+	}
+	r.frame, r.frames = r.frames[0], r.frames[1:]
+	return true
+}
+
+func (r *sliceFrameReceiver) Frame() can.Frame {
+	return r.frame
+}
+
+func (r *sliceFrameReceiver) Err() error {
+	return nil
+}
+
 func TestExample_Node_NoEmptyMessages(t *testing.T) {
 	const testTimeout = 2 * time.Second
 	requireVCAN0(t)
@@ -7,6 +7,7 @@ import (
 	"go/types"
 	"path"
 	"regexp"
+	"sort"
 	"strings"
 	"unicode"
 	"unicode/utf8"
@@ -16,8 +17,9 @@ import (
 )
 
 type File struct {
-	buf bytes.Buffer
-	err error
+	buf  bytes.Buffer
+	err  error
+	opts options
 }
 
 func NewFile() *File {
@@ -57,35 +59,50 @@ func (f *File) Content() ([]byte, error) {
 	return formatted, nil
 }
 
-func Database(d *descriptor.Database) ([]byte, error) {
+func Database(d *descriptor.Database, opts ...Option) ([]byte, error) {
 	f := NewFile()
+	for _, opt := range opts {
+		opt(&f.opts)
+	}
 	Package(f, d)
 	Imports(f)
+	valueTableTypes := sharedValueTableTypes(d)
+	for _, vt := range d.ValueTables {
+		if t, ok := valueTableTypes[vt.Name]; ok {
+			ValueTableType(f, vt, t)
+		}
+	}
 	for _, m := range d.Messages {
 		MessageType(f, m)
 		for _, s := range m.Signals {
-			if hasCustomType(s) {
+			if !hasCustomType(s) {
+				continue
+			}
+			if _, ok := valueTableTypes[s.ValueTable]; ok {
+				SignalValueTableType(f, m, s)
+			} else {
 				SignalCustomType(f, m, s)
 			}
 		}
+		for _, g := range m.SignalGroups {
+			SignalGroupType(f, m, g)
+		}
 		MarshalFrame(f, m)
 		UnmarshalFrame(f, m)
+		DecodeFrame(f, m)
 	}
 	if hasSendType(d) { // only code-generate nodes for schemas with send types specified
 		for _, n := range d.Nodes {
 			Node(f, d, n)
 		}
 	}
+	FrameBatch(f, d)
 	Descriptors(f, d)
 	return f.Content()
 }
 
 func Package(f *File, d *descriptor.Database) {
-	packageName := strings.TrimSuffix(path.Base(d.SourceFile), path.Ext(d.SourceFile)) + "can"
-	// Remove illegal characters from package name
-	packageName = strings.ReplaceAll(packageName, ".", "")
-	packageName = strings.ReplaceAll(packageName, "-", "")
-	packageName = strings.ReplaceAll(packageName, "_", "")
+	packageName := goPackageName(f, d)
 	f.P("// Package ", packageName, " provides primitives for encoding and decoding ", d.Name(), " CAN messages.")
 	f.P("//")
 	f.P("// Source: ", d.SourceFile)
@@ -93,10 +110,23 @@ func Package(f *File, d *descriptor.Database) {
 	f.P()
 }
 
+func packageName(sourceFile string) string {
+	packageName := strings.TrimSuffix(path.Base(sourceFile), path.Ext(sourceFile)) + "can"
+	// Remove illegal characters from package name
+	packageName = strings.ReplaceAll(packageName, ".", "")
+	packageName = strings.ReplaceAll(packageName, "-", "")
+	packageName = strings.ReplaceAll(packageName, "_", "")
+	return packageName
+}
+
 func Imports(f *File) {
 	f.P("import (")
 	f.P(`"context"`)
 	f.P(`"fmt"`)
+	if f.opts.receiveStreams {
+		f.P(`"iter"`)
+	}
+	f.P(`"math"`)
 	f.P(`"net"`)
 	f.P(`"net/http"`)
 	f.P(`"sync"`)
@@ -109,6 +139,9 @@ func Imports(f *File) {
 	f.P(`"go.einride.tech/can/pkg/descriptor"`)
 	f.P(`"go.einride.tech/can/pkg/generated"`)
 	f.P(`"go.einride.tech/can/pkg/cantext"`)
+	if f.opts.typedUnits {
+		f.P(`"go.einride.tech/can/pkg/canunit"`)
+	}
 	f.P(")")
 	f.P()
 	// we could use goimports for this, but it significantly slows down code generation
@@ -116,6 +149,10 @@ func Imports(f *File) {
 	f.P("var (")
 	f.P("_ = context.Background")
 	f.P("_ = fmt.Print")
+	if f.opts.receiveStreams {
+		f.P("_ iter.Seq[any]")
+	}
+	f.P("_ = math.Float32frombits")
 	f.P("_ = net.Dial")
 	f.P("_ = http.Error")
 	f.P("_ = sync.Mutex{}")
@@ -123,6 +160,9 @@ func Imports(f *File) {
 	f.P("_ = socketcan.Dial")
 	f.P("_ = candebug.ServeMessagesHTTP")
 	f.P("_ = canrunner.Run")
+	if f.opts.typedUnits {
+		f.P("_ = canunit.Lookup")
+	}
 	f.P(")")
 	f.P()
 	f.P("// Generated code. DO NOT EDIT.")
@@ -139,24 +179,53 @@ func SignalCustomType(f *File, m *descriptor.Message, s *descriptor.Signal) {
 	f.P("type ", signalType(m, s), " ", signalPrimitiveType(s))
 	f.P()
 	f.P("// Value descriptions for the ", s.Name, " signal of the ", m.Name, " message.")
+	valueDescriptionsType(f, signalType(m, s), signalPrimitiveType(s), s.ValueDescriptions)
+}
+
+// ValueTableType generates a named enum type for a value table shared by multiple signals.
+func ValueTableType(f *File, vt *descriptor.ValueTable, t types.Type) {
+	f.P("// ", valueTableType(vt.Name), " models the ", vt.Name, " value table.")
+	f.P("type ", valueTableType(vt.Name), " ", t)
+	f.P()
+	f.P("// Value descriptions for the ", vt.Name, " value table.")
+	valueDescriptionsType(f, valueTableType(vt.Name), t, vt.ValueDescriptions)
+}
+
+// SignalValueTableType generates the custom type of a signal as an alias of the type of its shared value table.
+func SignalValueTableType(f *File, m *descriptor.Message, s *descriptor.Signal) {
+	f.P("// ", signalType(m, s), " models the ", s.Name, " signal of the ", m.Name, " message.")
+	f.P("type ", signalType(m, s), " = ", valueTableType(s.ValueTable))
+	f.P()
+	f.P("// Value descriptions for the ", s.Name, " signal of the ", m.Name, " message.")
 	f.P("const (")
 	for _, vd := range s.ValueDescriptions {
+		desc := slugifyString(vd.Description)
+		f.P(signalType(m, s), "_", desc, " = ", valueTableType(s.ValueTable), "_", desc)
+	}
+	f.P(")")
+	f.P()
+}
+
+func valueDescriptionsType(f *File, typeName string, t types.Type, valueDescriptions []*descriptor.ValueDescription) {
+	isBool := t == types.Typ[types.Bool]
+	f.P("const (")
+	for _, vd := range valueDescriptions {
 		desc := slugifyString(vd.Description)
 		switch {
-		case s.Length == 1 && vd.Value == 1:
-			f.P(signalType(m, s), "_", desc, " ", signalType(m, s), " = true")
-		case s.Length == 1 && vd.Value == 0:
-			f.P(signalType(m, s), "_", desc, " ", signalType(m, s), " = false")
+		case isBool && vd.Value == 1:
+			f.P(typeName, "_", desc, " ", typeName, " = true")
+		case isBool && vd.Value == 0:
+			f.P(typeName, "_", desc, " ", typeName, " = false")
 		default:
-			f.P(signalType(m, s), "_", desc, " ", signalType(m, s), " = ", vd.Value)
+			f.P(typeName, "_", desc, " ", typeName, " = ", vd.Value)
 		}
 	}
 	f.P(")")
 	f.P()
-	f.P("func (v ", signalType(m, s), ") String() string {")
-	if s.Length == 1 {
+	f.P("func (v ", typeName, ") String() string {")
+	if isBool {
 		f.P("switch bool(v) {")
-		for _, vd := range s.ValueDescriptions {
+		for _, vd := range valueDescriptions {
 			if vd.Value == 1 {
 				f.P("case true:")
 			} else {
@@ -165,15 +234,15 @@ func SignalCustomType(f *File, m *descriptor.Message, s *descriptor.Signal) {
 			f.P(`return "`, vd.Description, `"`)
 		}
 		f.P("}")
-		f.P(`return fmt.Sprintf("`, signalType(m, s), `(%t)", v)`)
+		f.P(`return fmt.Sprintf("`, typeName, `(%t)", v)`)
 	} else {
 		f.P("switch v {")
-		for _, vd := range s.ValueDescriptions {
+		for _, vd := range valueDescriptions {
 			f.P("case ", vd.Value, ":")
 			f.P(`return "`, vd.Description, `"`)
 		}
 		f.P("default:")
-		f.P(`return fmt.Sprintf("`, signalType(m, s), `(%d)", v)`)
+		f.P(`return fmt.Sprintf("`, typeName, `(%d)", v)`)
 		f.P("}")
 	}
 	f.P("}")
@@ -187,7 +256,7 @@ func MessageType(f *File, m *descriptor.Message) {
 		signalName := capitalize(s.Name)
 		if hasPhysicalRepresentation(s) {
 			f.P("// ", signalName, " returns the physical value of the ", s.Name, " signal.")
-			f.P(signalName, "() float64")
+			f.P(signalName, "() ", physicalType(f, s))
 			f.P("// Raw", s.Name, " returns the raw (encoded) value of the ", s.Name, " signal.")
 			f.P("Raw", s.Name, "() ", signalType(m, s))
 		} else {
@@ -195,23 +264,15 @@ func MessageType(f *File, m *descriptor.Message) {
 			f.P(signalName, "()", signalType(m, s))
 		}
 	}
+	for _, g := range m.SignalGroups {
+		f.P("// ", signalGroupGetter(g), " returns the raw values of the ", g.Name, " signal group.")
+		f.P(signalGroupGetter(g), "() ", signalGroupType(m, g))
+	}
 	f.P("}")
 	f.P()
 	f.P("// ", messageWriterInterface(m), " provides write access to a ", m.Name, " message.")
 	f.P("type ", messageWriterInterface(m), " interface {")
-	f.P("// CopyFrom copies all values from ", messageStruct(m), ".")
-	f.P("CopyFrom(", messageReaderInterface(m), ") *", messageStruct(m))
-	for _, s := range m.Signals {
-		if hasPhysicalRepresentation(s) {
-			f.P("// Set", s.Name, " sets the physical value of the ", s.Name, " signal.")
-			f.P("Set", s.Name, "(float64) *", messageStruct(m))
-			f.P("// SetRaw", s.Name, " sets the raw (encoded) value of the ", s.Name, " signal.")
-			f.P("SetRaw", s.Name, "(", signalType(m, s), ") *", messageStruct(m))
-		} else {
-			f.P("// Set", s.Name, " sets the value of the ", s.Name, " signal.")
-			f.P("Set", s.Name, "(", signalType(m, s), ") *", messageStruct(m))
-		}
-	}
+	MessageWriterMethods(f, m, "*"+messageStruct(m))
 	f.P("}")
 	f.P()
 	f.P("type ", messageStruct(m), " struct {")
@@ -277,12 +338,18 @@ func MessageType(f *File, m *descriptor.Message) {
 			f.P()
 			continue
 		}
-		f.P("func (m *", messageStruct(m), ") ", signalName, "() float64 {")
-		f.P("return ", signalDescriptor(m, s), ".ToPhysical(float64(m.", signalField(s), "))")
+		physical := signalDescriptor(m, s) + ".ToPhysical(float64(m." + signalField(s) + "))"
+		v := "v"
+		if u, ok := signalUnit(f, s); ok {
+			physical = "canunit." + u.Type + "(" + physical + ")"
+			v = "float64(v)"
+		}
+		f.P("func (m *", messageStruct(m), ") ", signalName, "() ", physicalType(f, s), " {")
+		f.P("return ", physical)
 		f.P("}")
 		f.P()
-		f.P("func (m *", messageStruct(m), ") Set", s.Name, "(v float64) *", messageStruct(m), " {")
-		f.P("m.", signalField(s), " = ", signalType(m, s), "(", signalDescriptor(m, s), ".FromPhysical(v))")
+		f.P("func (m *", messageStruct(m), ") Set", s.Name, "(v ", physicalType(f, s), ") *", messageStruct(m), " {")
+		f.P("m.", signalField(s), " = ", signalType(m, s), "(", signalDescriptor(m, s), ".FromPhysical(", v, "))")
 		f.P("return m")
 		f.P("}")
 		f.P()
@@ -379,6 +446,43 @@ func Descriptors(f *File, d *descriptor.Database) {
 	f.P()
 }
 
+// SignalGroupType generates the type and accessors of a signal group, for reading and writing the signals of the group
+// in a single call.
+func SignalGroupType(f *File, m *descriptor.Message, g *descriptor.SignalGroup) {
+	f.P("// ", signalGroupType(m, g), " holds the raw values of the ", g.Name, " signal group.")
+	f.P("type ", signalGroupType(m, g), " struct {")
+	for _, signalName := range g.SignalNames {
+		s, _ := m.Signal(signalName)
+		f.P(capitalize(s.Name), " ", signalType(m, s))
+	}
+	f.P("}")
+	f.P()
+	f.P("func (m *", messageStruct(m), ") ", signalGroupGetter(g), "() ", signalGroupType(m, g), " {")
+	f.P("return ", signalGroupType(m, g), "{")
+	for _, signalName := range g.SignalNames {
+		s, _ := m.Signal(signalName)
+		f.P(capitalize(s.Name), ": m.", signalField(s), ",")
+	}
+	f.P("}")
+	f.P("}")
+	f.P()
+	f.P(
+		"func (m *", messageStruct(m), ") ", signalGroupSetter(g), "(v ", signalGroupType(m, g), ") *",
+		messageStruct(m), " {",
+	)
+	for _, signalName := range g.SignalNames {
+		s, _ := m.Signal(signalName)
+		if hasPhysicalRepresentation(s) {
+			f.P("m.SetRaw", s.Name, "(v.", capitalize(s.Name), ")")
+		} else {
+			f.P("m.Set", s.Name, "(v.", capitalize(s.Name), ")")
+		}
+	}
+	f.P("return m")
+	f.P("}")
+	f.P()
+}
+
 func MarshalFrame(f *File, m *descriptor.Message) {
 	f.P("// Frame returns a CAN frame representing the message.")
 	f.P("func (m *", messageStruct(m), ") Frame() can.Frame {")
@@ -393,18 +497,17 @@ func MarshalFrame(f *File, m *descriptor.Message) {
 			"(&f.Data, ", signalPrimitiveSuperType(s), "(m.", signalField(s), "))",
 		)
 	}
-	if mux, ok := m.MultiplexerSignal(); ok {
-		for _, s := range m.Signals {
-			if !s.IsMultiplexed {
-				continue
-			}
-			f.P("if m.", signalField(mux), " == ", s.MultiplexerValue, " {")
-			f.P(
-				"md.", s.Name, ".Marshal", signalSuperType(s), "(&f.Data, ", signalPrimitiveSuperType(s),
-				"(m.", signalField(s), "))",
-			)
-			f.P("}")
+	for _, s := range multiplexedSignals(m) {
+		condition, ok := multiplexedSignalCondition(m, s)
+		if !ok {
+			continue
 		}
+		f.P("if ", condition, " {")
+		f.P(
+			"md.", s.Name, ".Marshal", signalSuperType(s), "(&f.Data, ", signalPrimitiveSuperType(s),
+			"(m.", signalField(s), "))",
+		)
+		f.P("}")
 	}
 	f.P("return f")
 	f.P("}")
@@ -420,31 +523,7 @@ func UnmarshalFrame(f *File, m *descriptor.Message) {
 	f.P("// UnmarshalFrame decodes the message from a CAN frame.")
 	f.P("func (m *", messageStruct(m), ") UnmarshalFrame(f can.Frame) error {")
 	f.P("md := ", messageDescriptor(m))
-	// generate frame checks
-	id := func(isExtended bool) string {
-		if isExtended {
-			return "extended ID"
-		}
-		return "standard ID"
-	}
-	f.P("switch {")
-	f.P("case f.ID != md.ID:")
-	f.P(`return fmt.Errorf(`)
-	f.P(`"unmarshal `, m.Name, `: expects ID `, m.ID, ` (got %s with ID %d)", f.String(), f.ID,`)
-	f.P(`)`)
-	f.P("case f.Length != md.Length:")
-	f.P(`return fmt.Errorf(`)
-	f.P(`"unmarshal `, m.Name, `: expects length `, m.Length, ` (got %s with length %d)", f.String(), f.Length,`)
-	f.P(`)`)
-	f.P("case f.IsRemote:")
-	f.P(`return fmt.Errorf(`)
-	f.P(`"unmarshal `, m.Name, `: expects non-remote frame (got remote frame %s)", f.String(),`)
-	f.P(`)`)
-	f.P("case f.IsExtended != md.IsExtended:")
-	f.P(`return fmt.Errorf(`)
-	f.P(`"unmarshal `, m.Name, `: expects `, id(m.IsExtended), ` (got %s with `, id(!m.IsExtended), `)", f.String(),`)
-	f.P(`)`)
-	f.P("}")
+	frameChecks(f, m, "unmarshal", "md.ID", "md.Length", "f.IsExtended != md.IsExtended")
 	if len(m.Signals) == 0 {
 		f.P("return nil")
 		f.P("}")
@@ -457,16 +536,15 @@ func UnmarshalFrame(f *File, m *descriptor.Message) {
 		}
 		f.P("m.", signalField(s), " = ", signalType(m, s), "(md.", s.Name, ".Unmarshal", signalSuperType(s), "(f.Data))")
 	}
-	// generate multiplexed signal unmarshaling
-	if mux, ok := m.MultiplexerSignal(); ok {
-		for _, s := range m.Signals {
-			if !s.IsMultiplexed {
-				continue
-			}
-			f.P("if m.", signalField(mux), " == ", s.MultiplexerValue, " {")
-			f.P("m.", signalField(s), " = ", signalType(m, s), "(md.", s.Name, ".Unmarshal", signalSuperType(s), "(f.Data))")
-			f.P("}")
+	// generate multiplexed signal unmarshaling, with outer multiplexers before the signals they multiplex
+	for _, s := range multiplexedSignals(m) {
+		condition, ok := multiplexedSignalCondition(m, s)
+		if !ok {
+			continue
 		}
+		f.P("if ", condition, " {")
+		f.P("m.", signalField(s), " = ", signalType(m, s), "(md.", s.Name, ".Unmarshal", signalSuperType(s), "(f.Data))")
+		f.P("}")
 	}
 	f.P("return nil")
 	f.P("}")
@@ -502,17 +580,41 @@ func Node(f *File, d *descriptor.Database, n *descriptor.Node) {
 		f.P(messageReaderInterface(m))
 		f.P("ReceiveTime() time.Time")
 		f.P("SetAfterReceiveHook(h func(context.Context) error)")
+		for _, s := range m.Signals {
+			f.P("// Subscribe", s.Name, " calls the hook when the trigger fires for the ", s.Name, " signal.")
+			f.P(
+				"Subscribe", s.Name, "(trigger canrunner.SignalTrigger, ",
+				"h func(ctx context.Context, prev, curr ", messageReaderInterface(m), ") error)",
+			)
+		}
+		if f.opts.receiveStreams {
+			f.P("// ReceiveChan returns a channel of received snapshots of the message, closed when the context is done.")
+			f.P(
+				"ReceiveChan(ctx context.Context, size int, policy canrunner.OverflowPolicy) <-chan ",
+				messageReaderInterface(m),
+			)
+			f.P("// ReceiveSeq returns a sequence of received snapshots of the message, ending when the context is done.")
+			f.P(
+				"ReceiveSeq(ctx context.Context, size int, policy canrunner.OverflowPolicy) iter.Seq[",
+				messageReaderInterface(m), "]",
+			)
+		}
 		f.P("}")
 		f.P()
 	}
 	for _, m := range txMessages {
 		f.P("type ", txMessageInterface(n, m), " interface {")
 		f.P(messageReaderInterface(m))
-		f.P(messageWriterInterface(m))
+		if isWriteTracked(m) {
+			// setters of write-tracked messages return the Tx message, so that chained writes are tracked
+			MessageWriterMethods(f, m, txMessageInterface(n, m))
+		} else {
+			f.P(messageWriterInterface(m))
+		}
 		f.P("TransmitTime() time.Time")
 		f.P("Transmit(ctx context.Context) error")
 		f.P("SetBeforeTransmitHook(h func(context.Context) error)")
-		if m.SendType == descriptor.SendTypeCyclic {
+		if m.SendType.IsCyclic() {
 			f.P("// SetCyclicTransmissionEnabled enables/disables cyclic transmission.")
 			f.P("SetCyclicTransmissionEnabled(bool)")
 			f.P("// IsCyclicTransmissionEnabled returns whether cyclic transmission is enabled/disabled.")
@@ -534,8 +636,6 @@ func Node(f *File, d *descriptor.Database, n *descriptor.Node) {
 	f.P()
 	f.P("func New", nodeInterface(n), "(network, address string) ", nodeInterface(n), " {")
 	f.P("n := &", nodeStruct(n), "{network: network, address: address}")
-	f.P("n.rx.parentMutex = &n.Mutex")
-	f.P("n.tx.parentMutex = &n.Mutex")
 	for _, m := range rxMessages {
 		f.P("n.rx.", messageField(m), ".init()")
 		f.P("n.rx.", messageField(m), ".Reset()")
@@ -544,6 +644,16 @@ func Node(f *File, d *descriptor.Database, n *descriptor.Node) {
 		f.P("n.tx.", messageField(m), ".init()")
 		f.P("n.tx.", messageField(m), ".Reset()")
 	}
+	f.P("n.rx.debugHandler = candebug.NewHandler(&n.Mutex, []generated.Message{")
+	for _, m := range rxMessages {
+		f.P("&n.rx.", messageField(m), ",")
+	}
+	f.P("})")
+	f.P("n.tx.debugHandler = candebug.NewHandler(&n.Mutex, []generated.Message{")
+	for _, m := range txMessages {
+		f.P("&n.tx.", messageField(m), ",")
+	}
+	f.P("})")
 	f.P("return n")
 	f.P("}")
 	f.P()
@@ -560,7 +670,7 @@ func Node(f *File, d *descriptor.Database, n *descriptor.Node) {
 	f.P("}")
 	f.P()
 	f.P("type ", rxGroupStruct(n), " struct {")
-	f.P("parentMutex *sync.Mutex")
+	f.P("debugHandler *candebug.Handler")
 	for _, m := range rxMessages {
 		f.P(messageField(m), " ", rxMessageStruct(n, m))
 	}
@@ -569,13 +679,7 @@ func Node(f *File, d *descriptor.Database, n *descriptor.Node) {
 	f.P("var _ ", rxGroupInterface(n), " = &", rxGroupStruct(n), "{}")
 	f.P()
 	f.P("func (rx *", rxGroupStruct(n), ") ServeHTTP(w http.ResponseWriter, r *http.Request) {")
-	f.P("rx.parentMutex.Lock()")
-	f.P("defer rx.parentMutex.Unlock()")
-	f.P("candebug.ServeMessagesHTTP(w, r, []generated.Message{")
-	for _, m := range rxMessages {
-		f.P("&rx.", messageField(m), ",")
-	}
-	f.P("})")
+	f.P("rx.debugHandler.ServeHTTP(w, r)")
 	f.P("}")
 	f.P()
 	for _, m := range rxMessages {
@@ -586,7 +690,7 @@ func Node(f *File, d *descriptor.Database, n *descriptor.Node) {
 	}
 	f.P()
 	f.P("type ", txGroupStruct(n), " struct {")
-	f.P("parentMutex *sync.Mutex")
+	f.P("debugHandler *candebug.Handler")
 	for _, m := range txMessages {
 		f.P(messageField(m), " ", txMessageStruct(n, m))
 	}
@@ -595,13 +699,7 @@ func Node(f *File, d *descriptor.Database, n *descriptor.Node) {
 	f.P("var _ ", txGroupInterface(n), " = &", txGroupStruct(n), "{}")
 	f.P()
 	f.P("func (tx *", txGroupStruct(n), ") ServeHTTP(w http.ResponseWriter, r *http.Request) {")
-	f.P("tx.parentMutex.Lock()")
-	f.P("defer tx.parentMutex.Unlock()")
-	f.P("candebug.ServeMessagesHTTP(w, r, []generated.Message{")
-	for _, m := range txMessages {
-		f.P("&tx.", messageField(m), ",")
-	}
-	f.P("})")
+	f.P("tx.debugHandler.ServeHTTP(w, r)")
 	f.P("}")
 	f.P()
 	for _, m := range txMessages {
@@ -643,6 +741,10 @@ func Node(f *File, d *descriptor.Database, n *descriptor.Node) {
 		f.P(messageStruct(m))
 		f.P("receiveTime time.Time")
 		f.P("afterReceiveHook func(context.Context) error")
+		f.P("signalSubscriptions canrunner.SignalSubscriptions")
+		if f.opts.receiveStreams {
+			f.P("receiveStreams canrunner.ReceiveStreams")
+		}
 		f.P("}")
 		f.P()
 		f.P("func (m *", rxMessageStruct(n, m), ") init() {")
@@ -665,8 +767,67 @@ func Node(f *File, d *descriptor.Database, n *descriptor.Node) {
 		f.P("m.receiveTime = t")
 		f.P("}")
 		f.P()
+		f.P("func (m *", rxMessageStruct(n, m), ") SignalSubscriptions() *canrunner.SignalSubscriptions {")
+		f.P("return &m.signalSubscriptions")
+		f.P("}")
+		f.P()
+		for _, s := range m.Signals {
+			f.P("func (m *", rxMessageStruct(n, m), ") Subscribe", s.Name, "(")
+			f.P("trigger canrunner.SignalTrigger,")
+			f.P("h func(ctx context.Context, prev, curr ", messageReaderInterface(m), ") error,")
+			f.P(") {")
+			f.P("m.signalSubscriptions.Subscribe(")
+			f.P(signalDescriptor(m, s), ",")
+			f.P("trigger,")
+			f.P("func(ctx context.Context, prevFrame, currFrame can.Frame) error {")
+			f.P("var prev, curr ", messageStruct(m))
+			f.P("if err := prev.UnmarshalFrame(prevFrame); err != nil {")
+			f.P("return err")
+			f.P("}")
+			f.P("if err := curr.UnmarshalFrame(currFrame); err != nil {")
+			f.P("return err")
+			f.P("}")
+			f.P("return h(ctx, &prev, &curr)")
+			f.P("},")
+			f.P(")")
+			f.P("}")
+			f.P()
+		}
 		f.P("var _ canrunner.ReceivedMessage = &", rxMessageStruct(n, m), "{}")
 		f.P()
+		if f.opts.receiveStreams {
+			f.P("func (m *", rxMessageStruct(n, m), ") ReceiveStreams() *canrunner.ReceiveStreams {")
+			f.P("return &m.receiveStreams")
+			f.P("}")
+			f.P()
+			f.P("func (m *", rxMessageStruct(n, m), ") ReceiveChan(")
+			f.P("ctx context.Context,")
+			f.P("size int,")
+			f.P("policy canrunner.OverflowPolicy,")
+			f.P(") <-chan ", messageReaderInterface(m), " {")
+			f.P("return canrunner.StreamChan(ctx, &m.receiveStreams, size, policy, m.snapshot)")
+			f.P("}")
+			f.P()
+			f.P("func (m *", rxMessageStruct(n, m), ") ReceiveSeq(")
+			f.P("ctx context.Context,")
+			f.P("size int,")
+			f.P("policy canrunner.OverflowPolicy,")
+			f.P(") iter.Seq[", messageReaderInterface(m), "] {")
+			f.P("return canrunner.StreamSeq(ctx, &m.receiveStreams, size, policy, m.snapshot)")
+			f.P("}")
+			f.P()
+			f.P("func (m *", rxMessageStruct(n, m), ") snapshot(f can.Frame) ", messageReaderInterface(m), " {")
+			f.P("var snapshot ", messageStruct(m))
+			f.P("_ = snapshot.UnmarshalFrame(f) // the frame has already been unmarshaled by the receiver")
+			f.P("return &snapshot")
+			f.P("}")
+			f.P()
+		}
+		f.P("var _ canrunner.SignalSubscriber = &", rxMessageStruct(n, m), "{}")
+		if f.opts.receiveStreams {
+			f.P("var _ canrunner.StreamPublisher = &", rxMessageStruct(n, m), "{}")
+		}
+		f.P()
 	}
 	for _, m := range txMessages {
 		f.P("type ", txMessageStruct(n, m), " struct {")
@@ -676,6 +837,9 @@ func Node(f *File, d *descriptor.Database, n *descriptor.Node) {
 		f.P("isCyclicEnabled bool")
 		f.P("wakeUpChan chan struct{}")
 		f.P("transmitEventChan chan struct{}")
+		if isWriteTracked(m) {
+			f.P("writeTracker canrunner.WriteTracker")
+		}
 		f.P("}")
 		f.P()
 		f.P("var _ ", txMessageInterface(n, m), " = &", txMessageStruct(n, m), "{}")
@@ -732,11 +896,94 @@ func Node(f *File, d *descriptor.Database, n *descriptor.Node) {
 		f.P("return m.transmitEventChan")
 		f.P("}")
 		f.P()
+		if isWriteTracked(m) {
+			TxMessageWriteTracking(f, n, m)
+		}
 		f.P("var _ canrunner.TransmittedMessage = &", txMessageStruct(n, m), "{}")
 		f.P()
 	}
 }
 
+// MessageWriterMethods generates the methods of a message writer interface, returning the provided type.
+func MessageWriterMethods(f *File, m *descriptor.Message, returnType string) {
+	f.P("// CopyFrom copies all values from ", messageStruct(m), ".")
+	f.P("CopyFrom(", messageReaderInterface(m), ") ", returnType)
+	for _, s := range m.Signals {
+		if hasPhysicalRepresentation(s) {
+			f.P("// Set", s.Name, " sets the physical value of the ", s.Name, " signal.")
+			f.P("Set", s.Name, "(", physicalType(f, s), ") ", returnType)
+			f.P("// SetRaw", s.Name, " sets the raw (encoded) value of the ", s.Name, " signal.")
+			f.P("SetRaw", s.Name, "(", signalType(m, s), ") ", returnType)
+		} else {
+			f.P("// Set", s.Name, " sets the value of the ", s.Name, " signal.")
+			f.P("Set", s.Name, "(", signalType(m, s), ") ", returnType)
+		}
+	}
+	for _, g := range m.SignalGroups {
+		f.P("// ", signalGroupSetter(g), " sets the raw values of the ", g.Name, " signal group.")
+		f.P(signalGroupSetter(g), "(", signalGroupType(m, g), ") ", returnType)
+	}
+}
+
+// TxMessageWriteTracking generates setters of a transmitted message that track writes for the runner.
+func TxMessageWriteTracking(f *File, n *descriptor.Node, m *descriptor.Message) {
+	f.P("func (m *", txMessageStruct(n, m), ") WriteTracker() *canrunner.WriteTracker {")
+	f.P("return &m.writeTracker")
+	f.P("}")
+	f.P()
+	f.P(
+		"func (m *", txMessageStruct(n, m), ") CopyFrom(o ", messageReaderInterface(m), ") ",
+		txMessageInterface(n, m), " {",
+	)
+	f.P("m.", messageStruct(m), ".CopyFrom(o)")
+	for _, s := range m.Signals {
+		f.P("m.writeTracker.Written(", signalDescriptor(m, s), ")")
+	}
+	f.P("return m")
+	f.P("}")
+	f.P()
+	for _, s := range m.Signals {
+		valueType := signalType(m, s)
+		if hasPhysicalRepresentation(s) {
+			valueType = physicalType(f, s)
+		}
+		f.P("func (m *", txMessageStruct(n, m), ") Set", s.Name, "(v ", valueType, ") ", txMessageInterface(n, m), " {")
+		f.P("m.", messageStruct(m), ".Set", s.Name, "(v)")
+		f.P("m.writeTracker.Written(", signalDescriptor(m, s), ")")
+		f.P("return m")
+		f.P("}")
+		f.P()
+		if !hasPhysicalRepresentation(s) {
+			continue
+		}
+		f.P(
+			"func (m *", txMessageStruct(n, m), ") SetRaw", s.Name, "(v ", signalType(m, s), ") ",
+			txMessageInterface(n, m), " {",
+		)
+		f.P("m.", messageStruct(m), ".SetRaw", s.Name, "(v)")
+		f.P("m.writeTracker.Written(", signalDescriptor(m, s), ")")
+		f.P("return m")
+		f.P("}")
+		f.P()
+	}
+	for _, g := range m.SignalGroups {
+		f.P(
+			"func (m *", txMessageStruct(n, m), ") ", signalGroupSetter(g), "(v ", signalGroupType(m, g), ") ",
+			txMessageInterface(n, m), " {",
+		)
+		f.P("m.", messageStruct(m), ".", signalGroupSetter(g), "(v)")
+		for _, signalName := range g.SignalNames {
+			s, _ := m.Signal(signalName)
+			f.P("m.writeTracker.Written(", signalDescriptor(m, s), ")")
+		}
+		f.P("return m")
+		f.P("}")
+		f.P()
+	}
+	f.P("var _ canrunner.WriteTracked = &", txMessageStruct(n, m), "{}")
+	f.P()
+}
+
 func txGroupInterface(n *descriptor.Node) string {
 	return n.Name + "_Tx"
 }
@@ -813,10 +1060,147 @@ func hasPhysicalRepresentation(s *descriptor.Signal) bool {
 	return hasScale || hasOffset || hasRange && hasConstrainedRange
 }
 
+// isWriteTracked returns true if writes to the message or its signals may trigger transmissions.
+func isWriteTracked(m *descriptor.Message) bool {
+	if isWriteTriggered(m.SendType) {
+		return true
+	}
+	for _, s := range m.Signals {
+		if isWriteTriggered(s.SendType) {
+			return true
+		}
+	}
+	return false
+}
+
+func isWriteTriggered(sendType descriptor.SendType) bool {
+	switch sendType {
+	case descriptor.SendTypeOnWrite,
+		descriptor.SendTypeOnChange,
+		descriptor.SendTypeIfActive,
+		descriptor.SendTypeCyclicAndOnChange,
+		descriptor.SendTypeCyclicIfActiveFast:
+		return true
+	default:
+		return false
+	}
+}
+
+// multiplexedSignals returns the multiplexed signals of the message, ordered by multiplexer depth.
+func multiplexedSignals(m *descriptor.Message) []*descriptor.Signal {
+	var signals []*descriptor.Signal
+	for _, s := range m.Signals {
+		if s.IsMultiplexed {
+			signals = append(signals, s)
+		}
+	}
+	sort.SliceStable(signals, func(i, j int) bool {
+		return m.MultiplexerDepth(signals[i]) < m.MultiplexerDepth(signals[j])
+	})
+	return signals
+}
+
+// multiplexedSignalCondition returns a condition on the message fields that is true when the signal is present.
+func multiplexedSignalCondition(m *descriptor.Message, s *descriptor.Signal) (string, bool) {
+	var conditions []string
+	for i := 0; s.IsMultiplexed; i++ {
+		mux, ok := m.Multiplexer(s)
+		if !ok || i > len(m.Signals) {
+			return "", false
+		}
+		conditions = append([]string{multiplexerValueCondition("m."+signalField(mux), s)}, conditions...)
+		s = mux
+	}
+	return strings.Join(conditions, " && "), true
+}
+
+func multiplexerValueCondition(field string, s *descriptor.Signal) string {
+	if len(s.MultiplexerRanges) == 0 {
+		return fmt.Sprintf("%s == %d", field, s.MultiplexerValue)
+	}
+	conditions := make([]string, 0, len(s.MultiplexerRanges))
+	for _, r := range s.MultiplexerRanges {
+		switch {
+		case r.Min == r.Max:
+			conditions = append(conditions, fmt.Sprintf("%s == %d", field, r.Min))
+		case r.Min == 0:
+			conditions = append(conditions, fmt.Sprintf("%s <= %d", field, r.Max))
+		default:
+			conditions = append(conditions, fmt.Sprintf("%s >= %d && %s <= %d", field, r.Min, field, r.Max))
+		}
+	}
+	if len(conditions) == 1 {
+		return conditions[0]
+	}
+	return "(" + strings.Join(conditions, " || ") + ")"
+}
+
 func hasCustomType(s *descriptor.Signal) bool {
 	return len(s.ValueDescriptions) > 0
 }
 
+// sharedValueTableTypes returns the primitive types of the value tables shared by multiple signals, by name.
+//
+// Value tables shared by signals of different primitive types, or with names colliding with other generated
+// identifiers, are not shared.
+func sharedValueTableTypes(d *descriptor.Database) map[string]types.Type {
+	signals := map[string][]*descriptor.Signal{}
+	for _, m := range d.Messages {
+		for _, s := range m.Signals {
+			if s.ValueTable != "" && hasCustomType(s) {
+				signals[s.ValueTable] = append(signals[s.ValueTable], s)
+			}
+		}
+	}
+	identifiers := generatedIdentifiers(d)
+	result := map[string]types.Type{}
+ValueTables:
+	for _, vt := range d.ValueTables {
+		if len(signals[vt.Name]) < 2 || identifiers[valueTableType(vt.Name)] {
+			continue
+		}
+		t := signalPrimitiveType(signals[vt.Name][0])
+		for _, s := range signals[vt.Name][1:] {
+			if signalPrimitiveType(s) != t {
+				continue ValueTables
+			}
+		}
+		result[vt.Name] = t
+	}
+	return result
+}
+
+// generatedIdentifiers returns the exported top-level identifiers generated for the messages and nodes of a database.
+func generatedIdentifiers(d *descriptor.Database) map[string]bool {
+	identifiers := map[string]bool{
+		"FrameBatch":         true,
+		"Messages":           true,
+		"MessagesDescriptor": true,
+		"Nodes":              true,
+		"NodesDescriptor":    true,
+	}
+	for _, m := range d.Messages {
+		identifiers[messageStruct(m)] = true
+		identifiers[messageReaderInterface(m)] = true
+		identifiers[messageWriterInterface(m)] = true
+		identifiers[m.Name+"Descriptor"] = true
+		identifiers["New"+m.Name] = true
+		for _, s := range m.Signals {
+			identifiers[m.Name+"_"+s.Name] = true
+		}
+		for _, g := range m.SignalGroups {
+			identifiers[signalGroupType(m, g)] = true
+		}
+	}
+	for _, n := range d.Nodes {
+		identifiers[nodeInterface(n)] = true
+		identifiers[nodeInterface(n)+"_Rx"] = true
+		identifiers[nodeInterface(n)+"_Tx"] = true
+		identifiers["New"+n.Name] = true
+	}
+	return identifiers
+}
+
 func hasSendType(d *descriptor.Database) bool {
 	for _, m := range d.Messages {
 		if m.SendType != descriptor.SendTypeNone {
@@ -833,6 +1217,10 @@ func signalType(m *descriptor.Message, s *descriptor.Signal) string {
 	return signalPrimitiveType(s).String()
 }
 
+func valueTableType(name string) string {
+	return capitalize(name)
+}
+
 func signalPrimitiveType(s *descriptor.Signal) types.Type {
 	var t types.BasicKind
 	switch {
@@ -924,6 +1312,18 @@ func messageDescriptor(m *descriptor.Message) string {
 	return "Messages()." + m.Name
 }
 
+func signalGroupType(m *descriptor.Message, g *descriptor.SignalGroup) string {
+	return m.Name + "_" + g.Name + "Group"
+}
+
+func signalGroupGetter(g *descriptor.SignalGroup) string {
+	return capitalize(g.Name) + "Group"
+}
+
+func signalGroupSetter(g *descriptor.SignalGroup) string {
+	return "Set" + signalGroupGetter(g)
+}
+
 func signalDescriptor(m *descriptor.Message, s *descriptor.Signal) string {
 	return messageDescriptor(m) + "." + s.Name
 }
@@ -0,0 +1,112 @@
+package generate
+
+import (
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+
+	"go.einride.tech/can/pkg/descriptor"
+)
+
+// Filter selects the messages and nodes of a database to generate code for.
+//
+// Patterns are matched against the names of messages and nodes, with the syntax of path.Match. Messages and nodes
+// are selected when there are no include patterns or an include pattern matches, and no exclude pattern matches.
+type Filter struct {
+	// IncludeMessages are the patterns of the messages to include.
+	IncludeMessages []string
+	// ExcludeMessages are the patterns of the messages to exclude.
+	ExcludeMessages []string
+	// IncludeNodes are the patterns of the nodes to include.
+	IncludeNodes []string
+	// ExcludeNodes are the patterns of the nodes to exclude.
+	ExcludeNodes []string
+}
+
+// FilterDatabase returns a copy of a database with the messages and nodes selected by a filter.
+//
+// The nodes of the copy only send and receive the selected messages.
+func FilterDatabase(d *descriptor.Database, filter Filter) (*descriptor.Database, error) {
+	for _, patterns := range [][]string{
+		filter.IncludeMessages, filter.ExcludeMessages, filter.IncludeNodes, filter.ExcludeNodes,
+	} {
+		for _, pattern := range patterns {
+			if _, err := path.Match(pattern, ""); err != nil {
+				return nil, fmt.Errorf("filter database: invalid pattern %q: %w", pattern, err)
+			}
+		}
+	}
+	result := *d
+	result.Messages = nil
+	for _, m := range d.Messages {
+		if isSelected(m.Name, filter.IncludeMessages, filter.ExcludeMessages) {
+			result.Messages = append(result.Messages, m)
+		}
+	}
+	result.Nodes = nil
+	for _, n := range d.Nodes {
+		if isSelected(n.Name, filter.IncludeNodes, filter.ExcludeNodes) {
+			result.Nodes = append(result.Nodes, n)
+		}
+	}
+	return &result, nil
+}
+
+// NodeDatabase returns a copy of a database with a single node, and the messages sent or received by the node.
+func NodeDatabase(d *descriptor.Database, n *descriptor.Node) *descriptor.Database {
+	result := *d
+	result.Nodes = []*descriptor.Node{n}
+	result.Messages = nil
+	for _, m := range d.Messages {
+		if isSentBy(m, n) || isReceivedBy(m, n) {
+			result.Messages = append(result.Messages, m)
+		}
+	}
+	return &result
+}
+
+// NodePackageName returns the name of the package of the generated code of a node database, given the name of the
+// package of the generated code of the complete database.
+//
+// Example:
+//
+//	NodePackageName("examplecan", "DRIVER") // exampledrivercan
+func NodePackageName(databasePackageName, nodeName string) string {
+	return strings.TrimSuffix(databasePackageName, "can") + strings.ToLower(slugifyString(nodeName)) + "can"
+}
+
+// DatabasePackageName returns the name of the package of the generated code of a database.
+func DatabasePackageName(d *descriptor.Database, opts ...Option) string {
+	f := NewFile()
+	for _, opt := range opts {
+		opt(&f.opts)
+	}
+	return goPackageName(f, d)
+}
+
+func isSelected(name string, include, exclude []string) bool {
+	return (len(include) == 0 || matchesAny(name, include)) && !matchesAny(name, exclude)
+}
+
+func matchesAny(name string, patterns []string) bool {
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(pattern, name); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func isSentBy(m *descriptor.Message, n *descriptor.Node) bool {
+	return m.SenderNode == n.Name || slices.Contains(m.TransmitterNodes, n.Name)
+}
+
+func isReceivedBy(m *descriptor.Message, n *descriptor.Node) bool {
+	for _, s := range m.Signals {
+		if slices.Contains(s.ReceiverNodes, n.Name) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,119 @@
+package generate
+
+import (
+	"strings"
+	"testing"
+
+	"go.einride.tech/can/pkg/descriptor"
+	"gotest.tools/v3/assert"
+)
+
+func newFilterTestDatabase() *descriptor.Database {
+	return &descriptor.Database{
+		SourceFile: "example.dbc",
+		Nodes:      []*descriptor.Node{{Name: "DRIVER"}, {Name: "MOTOR"}, {Name: "TESTER"}},
+		Messages: []*descriptor.Message{
+			{
+				Name:       "MotorCommand",
+				ID:         101,
+				SenderNode: "DRIVER",
+				Signals:    []*descriptor.Signal{{Name: "Torque", Length: 8, ReceiverNodes: []string{"MOTOR"}}},
+			},
+			{
+				Name:       "MotorStatus",
+				ID:         102,
+				SenderNode: "MOTOR",
+				Signals:    []*descriptor.Signal{{Name: "Speed", Length: 8, ReceiverNodes: []string{"DRIVER"}}},
+			},
+			{
+				Name:             "MotorDebug",
+				ID:               103,
+				SenderNode:       "MOTOR",
+				TransmitterNodes: []string{"MOTOR", "TESTER"},
+				Signals:          []*descriptor.Signal{{Name: "Code", Length: 8}},
+			},
+		},
+	}
+}
+
+func messageNames(d *descriptor.Database) []string {
+	names := make([]string, 0, len(d.Messages))
+	for _, m := range d.Messages {
+		names = append(names, m.Name)
+	}
+	return names
+}
+
+func nodeNames(d *descriptor.Database) []string {
+	names := make([]string, 0, len(d.Nodes))
+	for _, n := range d.Nodes {
+		names = append(names, n.Name)
+	}
+	return names
+}
+
+func TestFilterDatabase(t *testing.T) {
+	for _, tt := range []struct {
+		name             string
+		filter           Filter
+		expectedMessages []string
+		expectedNodes    []string
+	}{
+		{
+			name:             "empty",
+			expectedMessages: []string{"MotorCommand", "MotorStatus", "MotorDebug"},
+			expectedNodes:    []string{"DRIVER", "MOTOR", "TESTER"},
+		},
+		{
+			name:             "include and exclude messages",
+			filter:           Filter{IncludeMessages: []string{"Motor*"}, ExcludeMessages: []string{"*Debug"}},
+			expectedMessages: []string{"MotorCommand", "MotorStatus"},
+			expectedNodes:    []string{"DRIVER", "MOTOR", "TESTER"},
+		},
+		{
+			name:             "include and exclude nodes",
+			filter:           Filter{IncludeNodes: []string{"*R"}, ExcludeNodes: []string{"TESTER"}},
+			expectedMessages: []string{"MotorCommand", "MotorStatus", "MotorDebug"},
+			expectedNodes:    []string{"DRIVER", "MOTOR"},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			d := newFilterTestDatabase()
+			actual, err := FilterDatabase(d, tt.filter)
+			assert.NilError(t, err)
+			assert.DeepEqual(t, tt.expectedMessages, messageNames(actual))
+			assert.DeepEqual(t, tt.expectedNodes, nodeNames(actual))
+			// the filtered database is a copy
+			assert.Equal(t, 3, len(d.Messages))
+			assert.Equal(t, 3, len(d.Nodes))
+		})
+	}
+}
+
+func TestFilterDatabase_InvalidPattern(t *testing.T) {
+	_, err := FilterDatabase(newFilterTestDatabase(), Filter{ExcludeNodes: []string{"[TESTER"}})
+	assert.ErrorContains(t, err, `invalid pattern "[TESTER"`)
+}
+
+func TestNodeDatabase(t *testing.T) {
+	d := newFilterTestDatabase()
+	driver := NodeDatabase(d, d.Nodes[0])
+	assert.DeepEqual(t, []string{"DRIVER"}, nodeNames(driver))
+	assert.DeepEqual(t, []string{"MotorCommand", "MotorStatus"}, messageNames(driver))
+	tester := NodeDatabase(d, d.Nodes[2])
+	assert.DeepEqual(t, []string{"MotorDebug"}, messageNames(tester))
+}
+
+func TestNodePackageName(t *testing.T) {
+	assert.Equal(t, "exampledrivercan", NodePackageName("examplecan", "DRIVER"))
+	assert.Equal(t, "vehiclesensorecucan", NodePackageName("vehicle", "Sensor_ECU"))
+}
+
+func TestDatabase_WithPackageName(t *testing.T) {
+	d := newFilterTestDatabase()
+	assert.Equal(t, "examplecan", DatabasePackageName(d))
+	assert.Equal(t, "vehiclecan", DatabasePackageName(d, WithPackageName("vehiclecan")))
+	output, err := Database(d, WithPackageName("vehiclecan"))
+	assert.NilError(t, err)
+	assert.Assert(t, strings.Contains(string(output), "\npackage vehiclecan\n"))
+}
@@ -0,0 +1,76 @@
+package generate
+
+import (
+	"os"
+	"testing"
+
+	"go.einride.tech/can/pkg/descriptor"
+	multiplexingcan "go.einride.tech/can/testdata/gen/go/multiplexing"
+	"gotest.tools/v3/assert"
+)
+
+func TestCompile_MultiplexingDBC(t *testing.T) {
+	finish := runTestInDir(t, "../..")
+	defer finish()
+	const multiplexingDBCFile = "testdata/dbc/multiplexing/multiplexing.dbc"
+	input, err := os.ReadFile(multiplexingDBCFile)
+	assert.NilError(t, err)
+	result, err := Compile(multiplexingDBCFile, input)
+	assert.NilError(t, err)
+	assert.Equal(t, 0, len(result.Warnings))
+	subfunction, ok := result.Database.Signal(100, "Subfunction")
+	assert.Assert(t, ok)
+	assert.Assert(t, subfunction.IsMultiplexer)
+	assert.Assert(t, subfunction.IsMultiplexed)
+	assert.Equal(t, "Service", subfunction.MultiplexerName)
+	value, ok := result.Database.Signal(100, "Value")
+	assert.Assert(t, ok)
+	assert.Equal(t, "Subfunction", value.MultiplexerName)
+	assert.DeepEqual(t, []*descriptor.MultiplexerRange{{Min: 2, Max: 2}, {Min: 4, Max: 6}}, value.MultiplexerRanges)
+}
+
+func TestMultiplexing_NestedMultiplexer(t *testing.T) {
+	md := multiplexingcan.Messages().Diagnostics
+	for _, tt := range []struct {
+		name        string
+		service     uint8
+		subfunction uint8
+		present     bool
+	}{
+		{name: "selected", service: 1, subfunction: 2, present: true},
+		{name: "selected by range", service: 1, subfunction: 5, present: true},
+		{name: "not selected by nested multiplexer", service: 1, subfunction: 3},
+		{name: "nested multiplexer not selected", service: 2, subfunction: 2},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			msg := multiplexingcan.NewDiagnostics().
+				SetService(tt.service).
+				SetSubfunction(tt.subfunction).
+				SetValue(0xbeef)
+			f := msg.Frame()
+			assert.Equal(t, tt.present, md.IsSignalPresent(md.Value, f.Data))
+			var actual multiplexingcan.Diagnostics
+			assert.NilError(t, actual.UnmarshalFrame(f))
+			if tt.present {
+				assert.Equal(t, uint16(0xbeef), actual.Value())
+			} else {
+				assert.Equal(t, uint16(0), actual.Value())
+			}
+		})
+	}
+}
+
+func TestMultiplexing_MultipleMultiplexers(t *testing.T) {
+	// when the signals of different multiplexers are set
+	msg := multiplexingcan.NewDiagnostics().
+		SetService(16).
+		SetSession(3).
+		SetPage(2).
+		SetCounter(42)
+	// then the signals should be round-tripped independently
+	var actual multiplexingcan.Diagnostics
+	assert.NilError(t, actual.UnmarshalFrame(msg.Frame()))
+	assert.Equal(t, uint8(3), actual.Session())
+	assert.Equal(t, uint8(42), actual.Counter())
+	assert.Equal(t, uint8(0), actual.Subfunction())
+}
@@ -0,0 +1,178 @@
+package generate
+
+import (
+	"strings"
+
+	"go.einride.tech/can/pkg/descriptor"
+)
+
+// Network generates the package of a network, with nodes composed of the nodes of the bus packages.
+//
+// The package of each bus is imported from a sub-package of the network package, named after the bus.
+func Network(n *descriptor.Network, importPath string) ([]byte, error) {
+	f := NewFile()
+	NetworkPackage(f, n)
+	NetworkImports(f, n, importPath)
+	for _, nn := range n.Nodes {
+		if len(networkNodeBuses(n, nn)) == 0 {
+			continue
+		}
+		NetworkNode(f, n, nn)
+	}
+	NetworkDescriptor(f, n)
+	return f.Content()
+}
+
+func NetworkPackage(f *File, n *descriptor.Network) {
+	packageName := packageName(n.Name)
+	f.P("// Package ", packageName, " provides the nodes of the ", n.Name, " CAN network.")
+	f.P("//")
+	for _, b := range n.Buses {
+		f.P("// Source: ", b.Database.SourceFile)
+	}
+	f.P("package ", packageName)
+	f.P()
+}
+
+func NetworkImports(f *File, n *descriptor.Network, importPath string) {
+	f.P("import (")
+	f.P(`"context"`)
+	f.P(`"sync"`)
+	f.P()
+	f.P(`"go.einride.tech/can/pkg/canrunner"`)
+	f.P(`"go.einride.tech/can/pkg/descriptor"`)
+	for _, b := range n.Buses {
+		f.P(busPackage(b), ` "`, strings.TrimSuffix(importPath, "/"), "/", b.Name, `"`)
+	}
+	f.P(")")
+	f.P()
+	f.P("// prevent unused imports")
+	f.P("var (")
+	f.P("_ = context.Background")
+	f.P("_ = canrunner.RunNetwork")
+	f.P(")")
+	f.P()
+	f.P("// Generated code. DO NOT EDIT.")
+}
+
+func NetworkNode(f *File, n *descriptor.Network, nn *descriptor.NetworkNode) {
+	buses := networkNodeBuses(n, nn)
+	f.P("// ", nn.Name, " is the ", nn.Name, " node of the ", n.Name, " network.")
+	f.P("//")
+	f.P("// The node runs on each of its buses, and locking the node locks the node on all buses.")
+	f.P("type ", nn.Name, " interface {")
+	f.P("sync.Locker")
+	f.P("Descriptor() *descriptor.NetworkNode")
+	for _, b := range buses {
+		f.P("// ", busAccessor(b), " returns the node on the ", b.Name, " bus.")
+		f.P(busAccessor(b), "() ", busPackage(b), ".", nn.Name)
+	}
+	f.P("Run(ctx context.Context) error")
+	f.P("}")
+	f.P()
+	f.P("type ", networkNodeStruct(nn), " struct {")
+	for _, b := range buses {
+		f.P(busField(b), " ", busPackage(b), ".", nn.Name)
+	}
+	f.P("}")
+	f.P()
+	f.P("var _ ", nn.Name, " = &", networkNodeStruct(nn), "{}")
+	f.P()
+	f.P("// New", nn.Name, " returns a new ", nn.Name, " node, connecting to each bus at the provided address.")
+	f.P("func New", nn.Name, "(network string, ", busAddressParams(buses), " string) ", nn.Name, " {")
+	f.P("return &", networkNodeStruct(nn), "{")
+	for _, b := range buses {
+		f.P(busField(b), ": ", busPackage(b), ".New", nn.Name, "(network, ", busField(b), "Address),")
+	}
+	f.P("}")
+	f.P("}")
+	f.P()
+	f.P("func (n *", networkNodeStruct(nn), ") Lock() {")
+	for _, b := range buses {
+		f.P("n.", busField(b), ".Lock()")
+	}
+	f.P("}")
+	f.P()
+	f.P("func (n *", networkNodeStruct(nn), ") Unlock() {")
+	for i := len(buses) - 1; i >= 0; i-- {
+		f.P("n.", busField(buses[i]), ".Unlock()")
+	}
+	f.P("}")
+	f.P()
+	f.P("func (n *", networkNodeStruct(nn), ") Descriptor() *descriptor.NetworkNode {")
+	f.P("nn, _ := network.Node(", `"`, nn.Name, `"`, ")")
+	f.P("return nn")
+	f.P("}")
+	f.P()
+	for _, b := range buses {
+		f.P("func (n *", networkNodeStruct(nn), ") ", busAccessor(b), "() ", busPackage(b), ".", nn.Name, " {")
+		f.P("return n.", busField(b))
+		f.P("}")
+		f.P()
+	}
+	f.P("func (n *", networkNodeStruct(nn), ") Run(ctx context.Context) error {")
+	f.P("return canrunner.RunNetwork(")
+	f.P("ctx,")
+	for _, b := range buses {
+		f.P("n.", busField(b), ",")
+	}
+	f.P(")")
+	f.P("}")
+	f.P()
+}
+
+func NetworkDescriptor(f *File, n *descriptor.Network) {
+	f.P("// Network returns the ", n.Name, " network descriptor.")
+	f.P("func Network() *descriptor.Network {")
+	f.P("return network")
+	f.P("}")
+	f.P()
+	f.P("var network = &descriptor.Network{")
+	f.P("Name: ", `"`, n.Name, `",`)
+	f.P("Buses: []*descriptor.Bus{")
+	for _, b := range n.Buses {
+		f.P(`{Name: "`, b.Name, `", Database: `, busPackage(b), ".Messages().Database()},")
+	}
+	f.P("},")
+	f.P("Nodes: []*descriptor.NetworkNode{")
+	for _, nn := range n.Nodes {
+		f.P(`{Name: "`, nn.Name, `", Buses: []string{"`, strings.Join(nn.Buses, `", "`), `"}},`)
+	}
+	f.P("},")
+	f.P("}")
+}
+
+// networkNodeBuses returns the buses of a network node with generated nodes.
+func networkNodeBuses(n *descriptor.Network, nn *descriptor.NetworkNode) []*descriptor.Bus {
+	var result []*descriptor.Bus
+	for _, b := range n.Buses {
+		if nn.IsOnBus(b.Name) && hasSendType(b.Database) {
+			result = append(result, b)
+		}
+	}
+	return result
+}
+
+func busAddressParams(buses []*descriptor.Bus) string {
+	params := make([]string, 0, len(buses))
+	for _, b := range buses {
+		params = append(params, busField(b)+"Address")
+	}
+	return strings.Join(params, ", ")
+}
+
+func networkNodeStruct(nn *descriptor.NetworkNode) string {
+	return "xxx_" + nn.Name
+}
+
+func busPackage(b *descriptor.Bus) string {
+	return packageName(b.Database.SourceFile)
+}
+
+func busAccessor(b *descriptor.Bus) string {
+	return capitalize(slugifyString(b.Name))
+}
+
+func busField(b *descriptor.Bus) string {
+	return strings.ToLower(slugifyString(b.Name))
+}
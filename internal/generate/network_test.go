@@ -0,0 +1,87 @@
+package generate
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"go.einride.tech/can"
+	"go.einride.tech/can/internal/clock"
+	"go.einride.tech/can/pkg/canrunner"
+	"go.einride.tech/can/pkg/descriptor"
+	vehiclecan "go.einride.tech/can/testdata/gen/go/vehicle"
+	bodycan "go.einride.tech/can/testdata/gen/go/vehicle/body"
+	powertraincan "go.einride.tech/can/testdata/gen/go/vehicle/powertrain"
+	"gotest.tools/v3/assert"
+)
+
+func TestCompileNetwork_VehicleNetwork(t *testing.T) {
+	finish := runTestInDir(t, "../..")
+	defer finish()
+	var sources []NetworkSource
+	for _, bus := range []string{"powertrain", "body"} {
+		sourceFile := filepath.Join("testdata/network/vehicle", bus+".dbc")
+		data, err := os.ReadFile(sourceFile)
+		assert.NilError(t, err)
+		sources = append(sources, NetworkSource{Bus: bus, SourceFile: sourceFile, Data: data})
+	}
+	result, err := CompileNetwork("vehicle", sources)
+	assert.NilError(t, err)
+	assert.Equal(t, 0, len(result.Warnings))
+	network := result.Network
+	assert.Equal(t, "body", network.Buses[0].Name)
+	assert.Equal(t, "powertrain", network.Buses[1].Name)
+	assert.DeepEqual(t, []*descriptor.NetworkNode{
+		{Name: "DASH", Buses: []string{"body"}},
+		{Name: "ECU", Buses: []string{"powertrain"}},
+		{Name: "GATEWAY", Buses: []string{"body", "powertrain"}},
+	}, network.Nodes)
+	// messages routed between buses have per-bus IDs
+	engineStatus := network.MessagesByName("EngineStatus")
+	assert.Equal(t, 2, len(engineStatus))
+	assert.Equal(t, "body", engineStatus[0].Bus)
+	assert.Equal(t, uint32(300), engineStatus[0].Message.ID)
+	assert.Equal(t, "powertrain", engineStatus[1].Bus)
+	assert.Equal(t, uint32(100), engineStatus[1].Message.ID)
+}
+
+func TestCompileNetwork_DuplicateBus(t *testing.T) {
+	const input = `VERSION ""
+
+NS_ :
+
+BS_:
+
+BU_: ECU
+`
+	_, err := CompileNetwork("vehicle", []NetworkSource{
+		{Bus: "body", SourceFile: "a/body.dbc", Data: []byte(input)},
+		{Bus: "body", SourceFile: "b/body.dbc", Data: []byte(input)},
+	})
+	assert.ErrorContains(t, err, "duplicate bus: body")
+}
+
+func TestNetwork_GatewayForwarding(t *testing.T) {
+	gateway := vehiclecan.NewGATEWAY("can", "vcan0", "vcan1")
+	assert.DeepEqual(t, []string{"body", "powertrain"}, gateway.Descriptor().Buses)
+	// given a gateway forwarding engine status from the powertrain bus to the body bus
+	gateway.Powertrain().Rx().EngineStatus().SetAfterReceiveHook(func(context.Context) error {
+		gateway.Lock()
+		defer gateway.Unlock()
+		gateway.Body().Tx().EngineStatus().SetSpeed(gateway.Powertrain().Rx().EngineStatus().Speed())
+		return nil
+	})
+	tx := runTransmitter(t, gateway.Body(), bodycan.Messages().EngineStatus.ID)
+	// when engine status is received on the powertrain bus
+	powertrainNode, ok := gateway.Powertrain().(canrunner.Node)
+	assert.Assert(t, ok)
+	rx := &sliceFrameReceiver{
+		frames: []can.Frame{powertraincan.NewEngineStatus().SetSpeed(3000).Frame()},
+	}
+	assert.NilError(t, canrunner.RunMessageReceiver(context.Background(), rx, powertrainNode, clock.System()))
+	// then engine status should be transmitted on the body bus, with the ID of the body bus
+	f := tx.next(t)
+	assert.Equal(t, uint32(300), f.ID)
+	assert.Equal(t, uint64(3000), bodycan.Messages().EngineStatus.Speed.UnmarshalUnsigned(f.Data))
+}
@@ -0,0 +1,63 @@
+package generate
+
+import (
+	"go.einride.tech/can/pkg/canunit"
+	"go.einride.tech/can/pkg/descriptor"
+)
+
+// Option configures the generated code.
+type Option func(*options)
+
+type options struct {
+	typedUnits     bool
+	receiveStreams bool
+	packageName    string
+}
+
+// WithTypedUnits generates the physical values of signals with known units as types of the canunit package, instead
+// of float64, so that values in different units can't be mixed up.
+func WithTypedUnits() Option {
+	return func(o *options) {
+		o.typedUnits = true
+	}
+}
+
+// WithReceiveStreams generates buffered channels and iterators of received snapshots on the Rx messages of nodes, for
+// processing every received message rather than only the latest value.
+func WithReceiveStreams() Option {
+	return func(o *options) {
+		o.receiveStreams = true
+	}
+}
+
+// WithPackageName sets the name of the package of the generated code, instead of the name derived from the source file
+// of the database.
+func WithPackageName(name string) Option {
+	return func(o *options) {
+		o.packageName = name
+	}
+}
+
+// goPackageName returns the name of the package of the generated code of a database.
+func goPackageName(f *File, d *descriptor.Database) string {
+	if f.opts.packageName != "" {
+		return f.opts.packageName
+	}
+	return packageName(d.SourceFile)
+}
+
+// physicalType returns the type of the physical value of a signal.
+func physicalType(f *File, s *descriptor.Signal) string {
+	if u, ok := signalUnit(f, s); ok {
+		return "canunit." + u.Type
+	}
+	return "float64"
+}
+
+// signalUnit returns the unit type of a signal, when typed units are generated.
+func signalUnit(f *File, s *descriptor.Signal) (canunit.Unit, bool) {
+	if !f.opts.typedUnits {
+		return canunit.Unit{}, false
+	}
+	return canunit.Lookup(s.Unit)
+}
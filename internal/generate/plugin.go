@@ -0,0 +1,117 @@
+package generate
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"go.einride.tech/can/pkg/canplugin"
+	"go.einride.tech/can/pkg/descriptor"
+)
+
+// RunPlugin runs a plugin executable with a request, and returns the files generated by the plugin.
+//
+// Generated Go files are formatted, and files outside of the directory of the generated Go file are rejected.
+func RunPlugin(ctx context.Context, executable string, request *canplugin.Request) ([]*canplugin.File, error) {
+	data, err := json.Marshal(request)
+	if err != nil {
+		return nil, fmt.Errorf("run plugin %s: %w", executable, err)
+	}
+	cmd := exec.CommandContext(ctx, executable)
+	cmd.Stdin = bytes.NewReader(data)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout, cmd.Stderr = &stdout, &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("run plugin %s: %w: %s", executable, err, strings.TrimSpace(stderr.String()))
+	}
+	var response canplugin.Response
+	if err := json.Unmarshal(stdout.Bytes(), &response); err != nil {
+		return nil, fmt.Errorf("run plugin %s: invalid response: %w", executable, err)
+	}
+	if response.Error != "" {
+		return nil, fmt.Errorf("run plugin %s: %s", executable, response.Error)
+	}
+	for _, file := range response.Files {
+		if !filepath.IsLocal(file.Name) {
+			return nil, fmt.Errorf("run plugin %s: invalid file name: %s", executable, file.Name)
+		}
+		if filepath.Ext(file.Name) != ".go" {
+			continue
+		}
+		formatted, err := format.Source([]byte(file.Content))
+		if err != nil {
+			return nil, fmt.Errorf("run plugin %s: %s: %w", executable, file.Name, err)
+		}
+		file.Content = string(formatted)
+	}
+	return response.Files, nil
+}
+
+// TemplateData is the data of templates executed by Template.
+type TemplateData struct {
+	// Database to generate code for.
+	Database *descriptor.Database
+	// GoPackage is the name of the package of the generated Go code of the database.
+	GoPackage string
+}
+
+// Template executes a text template for a database, and returns its output.
+//
+// Templates can use functions returning the identifiers of the generated Go code of the database, which is generated
+// with the same options. The output of templates named *.go.tmpl is formatted as Go code.
+func Template(d *descriptor.Database, name, text string, opts ...Option) ([]byte, error) {
+	f := NewFile()
+	for _, opt := range opts {
+		opt(&f.opts)
+	}
+	t, err := template.New(name).Funcs(templateFuncs(f)).Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("template %s: %w", name, err)
+	}
+	if err := t.Execute(f, &TemplateData{Database: d, GoPackage: goPackageName(f, d)}); err != nil {
+		return nil, fmt.Errorf("template %s: %w", name, err)
+	}
+	if strings.HasSuffix(name, ".go.tmpl") {
+		output, err := f.Content()
+		if err != nil {
+			return nil, fmt.Errorf("template %s: %w", name, err)
+		}
+		return output, nil
+	}
+	return f.buf.Bytes(), f.err
+}
+
+func templateFuncs(f *File) template.FuncMap {
+	return template.FuncMap{
+		"capitalize": capitalize,
+		"snakeCase":  snakeCase,
+		"messageReader": func(m *descriptor.Message) string {
+			return messageReaderInterface(m)
+		},
+		"messageStruct": func(m *descriptor.Message) string {
+			return messageStruct(m)
+		},
+		"messageWriter": func(m *descriptor.Message) string {
+			return messageWriterInterface(m)
+		},
+		"signalGetter": func(s *descriptor.Signal) string {
+			return capitalize(s.Name)
+		},
+		"signalSetter": func(s *descriptor.Signal) string {
+			return "Set" + s.Name
+		},
+		"signalType": signalType,
+		"hasPhysicalValue": func(s *descriptor.Signal) bool {
+			return hasPhysicalRepresentation(s)
+		},
+		"physicalType": func(s *descriptor.Signal) string {
+			return physicalType(f, s)
+		},
+	}
+}
@@ -0,0 +1,129 @@
+package generate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"go.einride.tech/can/pkg/canplugin"
+	"go.einride.tech/can/pkg/descriptor"
+	"gotest.tools/v3/assert"
+)
+
+// pluginEnv makes the test binary act as a plugin, with the behavior given by its value.
+const pluginEnv = "CANTOOL_GEN_TEST_PLUGIN"
+
+func TestMain(m *testing.M) {
+	if behavior, ok := os.LookupEnv(pluginEnv); ok {
+		canplugin.Run(func(request *canplugin.Request) (*canplugin.Response, error) {
+			return testPlugin(behavior, request)
+		})
+		os.Exit(0)
+	}
+	os.Exit(m.Run())
+}
+
+func testPlugin(behavior string, request *canplugin.Request) (*canplugin.Response, error) {
+	switch behavior {
+	case "messages":
+		var content strings.Builder
+		fmt.Fprintf(&content, "package %s\nvar messageNames=[]string{", request.GoPackage)
+		for _, m := range request.Database.Messages {
+			fmt.Fprintf(&content, "%q,", m.Name)
+		}
+		content.WriteString("}\n")
+		return &canplugin.Response{
+			Files: []*canplugin.File{
+				{Name: strings.TrimSuffix(request.GoFile, ".go") + ".names.go", Content: content.String()},
+				{Name: "parameter.txt", Content: request.Parameter},
+			},
+		}, nil
+	case "escape":
+		return &canplugin.Response{Files: []*canplugin.File{{Name: "../escape.go", Content: "package escape"}}}, nil
+	case "error":
+		return nil, errors.New("boom")
+	}
+	return nil, fmt.Errorf("unknown behavior: %s", behavior)
+}
+
+func runTestPlugin(t *testing.T, behavior string, request *canplugin.Request) ([]*canplugin.File, error) {
+	t.Helper()
+	t.Setenv(pluginEnv, behavior)
+	return RunPlugin(context.Background(), os.Args[0], request)
+}
+
+func TestRunPlugin(t *testing.T) {
+	db := &descriptor.Database{
+		SourceFile: "example.dbc",
+		Messages:   []*descriptor.Message{{Name: "DriverHeartbeat"}, {Name: "MotorCommand"}},
+	}
+	files, err := runTestPlugin(t, "messages", &canplugin.Request{
+		Parameter: "foo=bar",
+		GoFile:    "example.dbc.go",
+		GoPackage: DatabasePackageName(db),
+		Database:  db,
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 2, len(files))
+	assert.Equal(t, "example.dbc.names.go", files[0].Name)
+	assert.Equal(t, `package examplecan
+
+var messageNames = []string{"DriverHeartbeat", "MotorCommand"}
+`, files[0].Content)
+	assert.Equal(t, "parameter.txt", files[1].Name)
+	assert.Equal(t, "foo=bar", files[1].Content)
+}
+
+func TestRunPlugin_NonLocalFile(t *testing.T) {
+	_, err := runTestPlugin(t, "escape", &canplugin.Request{Database: &descriptor.Database{}})
+	assert.ErrorContains(t, err, "invalid file name: ../escape.go")
+}
+
+func TestRunPlugin_Error(t *testing.T) {
+	_, err := runTestPlugin(t, "error", &canplugin.Request{Database: &descriptor.Database{}})
+	assert.ErrorContains(t, err, ": boom")
+}
+
+func TestTemplate(t *testing.T) {
+	d := &descriptor.Database{
+		SourceFile: "example.dbc",
+		Messages: []*descriptor.Message{
+			{
+				Name: "MotorStatus",
+				Signals: []*descriptor.Signal{
+					{Name: "WheelError", Length: 1},
+					{Name: "SpeedKph", Start: 8, Length: 16, Scale: 0.001, Max: 65.535, Unit: "km/h"},
+				},
+			},
+		},
+	}
+	const text = `package {{ .GoPackage }}
+{{ range .Database.Messages }}
+func Log{{ .Name }}(m {{ messageReader . }}) {
+{{- range .Signals }}{{ if hasPhysicalValue . }}
+	log.Printf("{{ snakeCase .Name }}: %v", m.{{ signalGetter . }}())
+{{- end }}{{ end }}
+}
+{{ end }}`
+	output, err := Template(d, "log.go.tmpl", text)
+	assert.NilError(t, err)
+	assert.Equal(t, `package examplecan
+
+func LogMotorStatus(m MotorStatusReader) {
+	log.Printf("speed_kph: %v", m.SpeedKph())
+}
+`, string(output))
+	output, err = Template(d, "types.txt.tmpl", `{{ range $m := .Database.Messages }}{{ range .Signals -}}
+{{ signalSetter . }}({{ if hasPhysicalValue . }}{{ physicalType . }}{{ else }}{{ signalType $m . }}{{ end }})
+{{ end }}{{ end }}`, WithTypedUnits())
+	assert.NilError(t, err)
+	assert.Equal(t, "SetWheelError(bool)\nSetSpeedKph(canunit.KilometersPerHour)\n", string(output))
+}
+
+func TestTemplate_Error(t *testing.T) {
+	_, err := Template(&descriptor.Database{SourceFile: "example.dbc"}, "broken.go.tmpl", "package {{ .Missing }}")
+	assert.ErrorContains(t, err, "template broken.go.tmpl")
+}
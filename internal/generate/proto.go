@@ -0,0 +1,407 @@
+package generate
+
+import (
+	"fmt"
+	"go/types"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"go.einride.tech/can/pkg/descriptor"
+)
+
+// ProtoSchema generates the protobuf schema of a database, with a message per CAN message.
+//
+// Signals with value descriptions are represented by enums, signals with a physical representation by their physical
+// value, and all other signals by their raw value. The Go package of the schema is provided as goPackage.
+func ProtoSchema(d *descriptor.Database, goPackage string) ([]byte, error) {
+	f := NewFile()
+	f.P("// Protocol buffers schema for ", d.Name(), " CAN messages.")
+	f.P("//")
+	f.P("// Source: ", d.SourceFile)
+	f.P("//")
+	f.P("// Generated code. DO NOT EDIT.")
+	f.P()
+	f.P(`syntax = "proto3";`)
+	f.P()
+	f.P("package ", packageName(d.SourceFile), ";")
+	f.P()
+	f.P(`option go_package = "`, goPackage, ";", ProtoPackageName(d), `";`)
+	for _, m := range d.Messages {
+		f.P()
+		if err := ProtoMessage(f, m); err != nil {
+			return nil, err
+		}
+	}
+	if f.err != nil {
+		return nil, f.err
+	}
+	return f.buf.Bytes(), nil
+}
+
+// ProtoPackageName returns the Go package name of the protobuf messages of a database.
+func ProtoPackageName(d *descriptor.Database) string {
+	return packageName(d.SourceFile) + "pb"
+}
+
+func ProtoMessage(f *File, m *descriptor.Message) error {
+	fieldNames := make(map[string]string, len(m.Signals))
+	for _, s := range m.Signals {
+		name := protoFieldName(s)
+		if other, ok := fieldNames[name]; ok {
+			return fmt.Errorf(
+				"proto schema: %s: signals %s and %s have the same field name: %s", m.Name, other, s.Name, name,
+			)
+		}
+		fieldNames[name] = s.Name
+	}
+	if m.Description != "" {
+		protoComment(f, "", m.Description)
+	}
+	f.P("message ", m.Name, " {")
+	for i, s := range m.Signals {
+		if i > 0 {
+			f.P()
+		}
+		if s.Description != "" {
+			protoComment(f, "  ", s.Description)
+		}
+		if s.Unit != "" {
+			f.P("  // Unit: ", s.Unit)
+		}
+		f.P("  ", protoFieldType(s), " ", protoFieldName(s), " = ", i+1, ";")
+	}
+	for _, s := range m.Signals {
+		if isProtoEnum(s) {
+			f.P()
+			ProtoEnum(f, s)
+		}
+	}
+	f.P("}")
+	return nil
+}
+
+// ProtoEnum generates the enum of a signal with value descriptions, nested in the message of the signal.
+//
+// The values of the enum are prefixed with the name of the signal, and an unspecified value is added when the value
+// descriptions have no zero value, as required by proto3.
+func ProtoEnum(f *File, s *descriptor.Signal) {
+	f.P("  enum ", protoEnumType(s), " {")
+	for _, v := range protoEnumValues(s) {
+		f.P("    ", v.name, " = ", v.value, ";")
+	}
+	f.P("  }")
+}
+
+func protoComment(f *File, indent, comment string) {
+	for _, line := range strings.Split(comment, "\n") {
+		f.P(indent, strings.TrimRight("// "+line, " "))
+	}
+}
+
+// ProtoConverters generates converters between the messages of a database and their protobuf messages, generated from
+// the schema of the database into the package with the provided import path.
+//
+// The options must be the options of the generated code of the database.
+func ProtoConverters(d *descriptor.Database, protoImportPath string, opts ...Option) ([]byte, error) {
+	f := NewFile()
+	for _, opt := range opts {
+		opt(&f.opts)
+	}
+	f.P("package ", goPackageName(f, d))
+	f.P()
+	f.P("import (")
+	f.P(`"fmt"`)
+	f.P()
+	if hasSignalUnits(f, d) {
+		f.P(`"go.einride.tech/can/pkg/canunit"`)
+	}
+	f.P(`"go.einride.tech/can/pkg/generated"`)
+	f.P(`"google.golang.org/protobuf/proto"`)
+	f.P(ProtoPackageName(d), ` "`, protoImportPath, `"`)
+	f.P(")")
+	f.P()
+	f.P("// Generated code. DO NOT EDIT.")
+	for _, m := range d.Messages {
+		f.P()
+		ProtoMessageConverters(f, d, m)
+	}
+	f.P()
+	ProtoDispatchConverters(f, d)
+	return f.Content()
+}
+
+func ProtoMessageConverters(f *File, d *descriptor.Database, m *descriptor.Message) {
+	pb := ProtoPackageName(d)
+	goNames := protoGoFieldNames(m)
+	f.P("// ", m.Name, "ToProto converts a ", m.Name, " message to its protobuf message.")
+	f.P("func ", m.Name, "ToProto(m ", messageReaderInterface(m), ") *", pb, ".", protoGoName(m.Name), " {")
+	f.P("return &", pb, ".", protoGoName(m.Name), "{")
+	for _, s := range m.Signals {
+		var value string
+		switch {
+		case isProtoEnum(s):
+			value = pb + "." + protoGoEnumType(m, s) + "(m." + rawGetter(s) + "())"
+		case s.Length == 1:
+			value = convertProtoValue("bool", signalType(m, s), "m."+rawGetter(s)+"()")
+		case hasPhysicalRepresentation(s):
+			value = convertProtoValue("float64", physicalType(f, s), "m."+capitalize(s.Name)+"()")
+		default:
+			value = convertProtoValue(protoGoType(s), signalType(m, s), "m."+rawGetter(s)+"()")
+		}
+		f.P(goNames[s], ": ", value, ",")
+	}
+	f.P("}")
+	f.P("}")
+	f.P()
+	f.P("// ", m.Name, "FromProto converts a protobuf message to a ", m.Name, " message.")
+	f.P("func ", m.Name, "FromProto(p *", pb, ".", protoGoName(m.Name), ") *", messageStruct(m), " {")
+	f.P("m := New", messageStruct(m), "()")
+	for _, s := range m.Signals {
+		getter := "p.Get" + goNames[s] + "()"
+		if hasPhysicalRepresentation(s) && !isProtoEnum(s) && s.Length != 1 {
+			f.P("m.Set", s.Name, "(", convertProtoValue(physicalType(f, s), "float64", getter), ")")
+			continue
+		}
+		f.P("m.", rawSetter(s), "(", convertProtoValue(signalType(m, s), protoGoType(s), getter), ")")
+	}
+	f.P("return m")
+	f.P("}")
+}
+
+// ProtoDispatchConverters generates converters between any message of a database and its protobuf message.
+func ProtoDispatchConverters(f *File, d *descriptor.Database) {
+	pb := ProtoPackageName(d)
+	f.P("// MessageToProto converts a ", d.Name(), " message to its protobuf message.")
+	f.P("func MessageToProto(msg generated.Message) (proto.Message, error) {")
+	f.P("switch msg := msg.(type) {")
+	for _, m := range d.Messages {
+		f.P("case *", messageStruct(m), ":")
+		f.P("return ", m.Name, "ToProto(msg), nil")
+	}
+	f.P("default:")
+	f.P(`return nil, fmt.Errorf("message to proto: unsupported message: %T", msg)`)
+	f.P("}")
+	f.P("}")
+	f.P()
+	f.P("// MessageFromProto converts a protobuf message to its ", d.Name(), " message.")
+	f.P("func MessageFromProto(p proto.Message) (generated.Message, error) {")
+	f.P("switch p := p.(type) {")
+	for _, m := range d.Messages {
+		f.P("case *", pb, ".", protoGoName(m.Name), ":")
+		f.P("return ", m.Name, "FromProto(p), nil")
+	}
+	f.P("default:")
+	f.P(`return nil, fmt.Errorf("message from proto: unsupported message: %T", p)`)
+	f.P("}")
+	f.P("}")
+}
+
+// hasSignalUnits returns true if the physical value of any signal of a database has a unit type.
+func hasSignalUnits(f *File, d *descriptor.Database) bool {
+	for _, m := range d.Messages {
+		for _, s := range m.Signals {
+			if _, ok := signalUnit(f, s); ok && hasPhysicalRepresentation(s) && !isProtoEnum(s) && s.Length != 1 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// convertProtoValue converts a value between the types of a signal and its protobuf field, when they differ.
+func convertProtoValue(toType, fromType, value string) string {
+	if toType == fromType {
+		return value
+	}
+	return toType + "(" + value + ")"
+}
+
+// isProtoEnum returns true if a signal is represented by an enum in its protobuf message.
+func isProtoEnum(s *descriptor.Signal) bool {
+	if !hasCustomType(s) || s.Length == 1 || s.IsFloat || (s.Length >= 32 && !(s.IsSigned && s.Length == 32)) {
+		return false
+	}
+	for _, vd := range s.ValueDescriptions {
+		if vd.Value < math.MinInt32 || vd.Value > math.MaxInt32 {
+			return false
+		}
+	}
+	return true
+}
+
+func protoFieldType(s *descriptor.Signal) string {
+	switch {
+	case isProtoEnum(s):
+		return protoEnumType(s)
+	case s.Length == 1:
+		return "bool"
+	case hasPhysicalRepresentation(s):
+		return "double"
+	}
+	switch protoGoType(s) {
+	case "float32":
+		return "float"
+	default:
+		return protoGoType(s)
+	}
+}
+
+// protoGoType returns the Go type of the protobuf field of a signal represented by its raw value.
+func protoGoType(s *descriptor.Signal) string {
+	switch signalPrimitiveType(s) {
+	case types.Typ[types.Bool]:
+		return "bool"
+	case types.Typ[types.Float32]:
+		return "float32"
+	case types.Typ[types.Int8], types.Typ[types.Int16], types.Typ[types.Int32]:
+		return "int32"
+	case types.Typ[types.Int64]:
+		return "int64"
+	case types.Typ[types.Uint64]:
+		return "uint64"
+	default:
+		return "uint32"
+	}
+}
+
+func protoFieldName(s *descriptor.Signal) string {
+	return snakeCase(s.Name)
+}
+
+func protoEnumType(s *descriptor.Signal) string {
+	return capitalize(s.Name)
+}
+
+type protoEnumValue struct {
+	name  string
+	value int64
+}
+
+func protoEnumValues(s *descriptor.Signal) []protoEnumValue {
+	prefix := strings.ToUpper(snakeCase(s.Name)) + "_"
+	valueDescriptions := append([]*descriptor.ValueDescription(nil), s.ValueDescriptions...)
+	sort.SliceStable(valueDescriptions, func(i, j int) bool {
+		// the zero value comes first
+		return valueDescriptions[i].Value == 0 && valueDescriptions[j].Value != 0
+	})
+	var values []protoEnumValue
+	used := map[string]bool{}
+	if len(valueDescriptions) == 0 || valueDescriptions[0].Value != 0 {
+		values = append(values, protoEnumValue{name: prefix + "UNSPECIFIED"})
+		used[prefix+"UNSPECIFIED"] = true
+	}
+	for _, vd := range valueDescriptions {
+		words := strings.Trim(nonAlphaNumericRegexp.ReplaceAllString(vd.Description, "_"), "_")
+		name := prefix + strings.ToUpper(snakeCase(words))
+		if name == prefix || used[name] {
+			name = prefix + "VALUE_" + strings.ReplaceAll(strconv.FormatInt(vd.Value, 10), "-", "MINUS_")
+		}
+		used[name] = true
+		values = append(values, protoEnumValue{name: name, value: vd.Value})
+	}
+	return values
+}
+
+// protoGoFieldNames returns the Go field names generated by protoc-gen-go for the signals of a message.
+//
+// Field names colliding with the methods of a generated message are suffixed with an underscore, as by protoc-gen-go.
+func protoGoFieldNames(m *descriptor.Message) map[*descriptor.Signal]string {
+	used := map[string]bool{
+		"Reset":               true,
+		"String":              true,
+		"ProtoMessage":        true,
+		"Marshal":             true,
+		"Unmarshal":           true,
+		"ExtensionRangeArray": true,
+		"ExtensionMap":        true,
+		"Descriptor":          true,
+	}
+	names := make(map[*descriptor.Signal]string, len(m.Signals))
+	for _, s := range m.Signals {
+		name := protoGoName(protoFieldName(s))
+		for used[name] || used["Get"+name] {
+			name += "_"
+		}
+		used[name], used["Get"+name] = true, true
+		names[s] = name
+	}
+	return names
+}
+
+func protoGoEnumType(m *descriptor.Message, s *descriptor.Signal) string {
+	return protoGoName(m.Name) + "_" + protoGoName(protoEnumType(s))
+}
+
+// protoGoName returns the Go name generated by protoc-gen-go for a protobuf identifier.
+//
+// Underscores followed by a lowercase letter are removed, and each word is capitalized.
+func protoGoName(s string) string {
+	var b []byte
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == '_' && i == 0:
+			b = append(b, 'X')
+		case c == '_' && i+1 < len(s) && isASCIILower(s[i+1]):
+		case isASCIIDigit(c):
+			b = append(b, c)
+		default:
+			if isASCIILower(c) {
+				c -= 'a' - 'A'
+			}
+			b = append(b, c)
+			for ; i+1 < len(s) && isASCIILower(s[i+1]); i++ {
+				b = append(b, s[i+1])
+			}
+		}
+	}
+	return string(b)
+}
+
+// snakeCase converts a CamelCase identifier to snake_case, keeping acronyms as single words.
+func snakeCase(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if isASCIIUpper(c) && i > 0 && s[i-1] != '_' {
+			prev := s[i-1]
+			nextIsLower := i+1 < len(s) && isASCIILower(s[i+1])
+			if isASCIILower(prev) || isASCIIDigit(prev) || (isASCIIUpper(prev) && nextIsLower) {
+				b.WriteByte('_')
+			}
+		}
+		if isASCIIUpper(c) {
+			c += 'a' - 'A'
+		}
+		b.WriteByte(c)
+	}
+	return b.String()
+}
+
+func rawGetter(s *descriptor.Signal) string {
+	if hasPhysicalRepresentation(s) {
+		return "Raw" + s.Name
+	}
+	return capitalize(s.Name)
+}
+
+func rawSetter(s *descriptor.Signal) string {
+	if hasPhysicalRepresentation(s) {
+		return "SetRaw" + s.Name
+	}
+	return "Set" + s.Name
+}
+
+func isASCIILower(c byte) bool {
+	return 'a' <= c && c <= 'z'
+}
+
+func isASCIIUpper(c byte) bool {
+	return 'A' <= c && c <= 'Z'
+}
+
+func isASCIIDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
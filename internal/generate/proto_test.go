@@ -0,0 +1,121 @@
+package generate
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"go.einride.tech/can/pkg/descriptor"
+	telemetrycan "go.einride.tech/can/testdata/gen/go/telemetry"
+	valuetablescan "go.einride.tech/can/testdata/gen/go/valuetables"
+	"go.einride.tech/can/testdata/gen/proto/telemetrycanpb"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"gotest.tools/v3/assert"
+)
+
+func TestProtoSchema_Golden(t *testing.T) {
+	finish := runTestInDir(t, "../..")
+	defer finish()
+	const telemetryDBCFile = "testdata/proto/telemetry.dbc"
+	input, err := os.ReadFile(telemetryDBCFile)
+	assert.NilError(t, err)
+	result, err := Compile(telemetryDBCFile, input)
+	assert.NilError(t, err)
+	schema, err := ProtoSchema(result.Database, "go.einride.tech/can/testdata/gen/proto/telemetrycanpb")
+	assert.NilError(t, err)
+	golden, err := os.ReadFile("testdata/gen/proto/telemetrycanpb/telemetry.proto")
+	assert.NilError(t, err)
+	assert.Equal(t, string(golden), string(schema))
+}
+
+func TestProtoSchema_EnumWithoutZeroValue(t *testing.T) {
+	d := &descriptor.Database{
+		SourceFile: "test.dbc",
+		Messages: []*descriptor.Message{
+			{
+				Name: "Status",
+				Signals: []*descriptor.Signal{
+					{
+						Name:   "LampState",
+						Length: 2,
+						ValueDescriptions: []*descriptor.ValueDescription{
+							{Value: 1, Description: "On"},
+							{Value: 2, Description: "Blinking fast"},
+						},
+					},
+				},
+			},
+		},
+	}
+	schema, err := ProtoSchema(d, "example.com/testpb")
+	assert.NilError(t, err)
+	assert.Assert(t, strings.Contains(string(schema), `  enum LampState {
+    LAMP_STATE_UNSPECIFIED = 0;
+    LAMP_STATE_ON = 1;
+    LAMP_STATE_BLINKING_FAST = 2;
+  }`), string(schema))
+}
+
+func TestProtoSchema_DuplicateFieldName(t *testing.T) {
+	d := &descriptor.Database{
+		SourceFile: "test.dbc",
+		Messages: []*descriptor.Message{
+			{
+				Name: "Status",
+				Signals: []*descriptor.Signal{
+					{Name: "ABSActive", Length: 1},
+					{Name: "AbsActive", Start: 1, Length: 1},
+				},
+			},
+		},
+	}
+	_, err := ProtoSchema(d, "example.com/testpb")
+	assert.ErrorContains(t, err, "signals ABSActive and AbsActive have the same field name: abs_active")
+}
+
+func TestProtoConverters_RoundTrip(t *testing.T) {
+	msg := telemetrycan.NewEngineStatus().
+		SetSpeed(1500.25).
+		SetTemperature(20.5).
+		SetGear(telemetrycan.EngineStatus_Gear_Drive).
+		SetIsRunning(true).
+		SetCounter(7).
+		SetABSActive(true)
+	p := telemetrycan.EngineStatusToProto(msg)
+	assert.Equal(t, 1500.25, p.GetSpeed())
+	assert.Equal(t, 20.5, p.GetTemperature())
+	assert.Equal(t, telemetrycanpb.EngineStatus_GEAR_DRIVE, p.GetGear())
+	assert.Equal(t, true, p.GetIsRunning())
+	assert.Equal(t, uint32(7), p.GetCounter())
+	assert.Equal(t, true, p.GetAbsActive())
+	data, err := proto.Marshal(p)
+	assert.NilError(t, err)
+	var unmarshaled telemetrycanpb.EngineStatus
+	assert.NilError(t, proto.Unmarshal(data, &unmarshaled))
+	actual, err := telemetrycan.MessageFromProto(&unmarshaled)
+	assert.NilError(t, err)
+	assert.Equal(t, msg.Frame(), actual.Frame())
+}
+
+func TestProtoConverters_Multiplexed(t *testing.T) {
+	msg := telemetrycan.NewDiagnostics().
+		SetPage(1).
+		SetErrorCode(-42).
+		SetDriveMode(telemetrycan.Diagnostics_DriveMode_Sport)
+	p, err := telemetrycan.MessageToProto(msg)
+	assert.NilError(t, err)
+	diagnostics, ok := p.(*telemetrycanpb.Diagnostics)
+	assert.Assert(t, ok)
+	assert.Equal(t, int32(-42), diagnostics.GetErrorCode())
+	assert.Equal(t, telemetrycanpb.Diagnostics_DRIVE_MODE_SPORT, diagnostics.GetDriveMode())
+	actual := telemetrycan.DiagnosticsFromProto(diagnostics)
+	assert.Equal(t, msg.Frame(), actual.Frame())
+}
+
+func TestProtoConverters_UnsupportedMessage(t *testing.T) {
+	_, err := telemetrycan.MessageToProto(valuetablescan.NewFrontLights())
+	assert.ErrorContains(t, err, "unsupported message: *valuetablescan.FrontLights")
+	_, err = telemetrycan.MessageFromProto(wrapperspb.String("EngineStatus"))
+	assert.ErrorContains(t, err, "unsupported message: *wrapperspb.StringValue")
+}
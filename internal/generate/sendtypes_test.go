@@ -0,0 +1,345 @@
+package generate
+
+import (
+	"context"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"go.einride.tech/can"
+	"go.einride.tech/can/internal/clock"
+	"go.einride.tech/can/pkg/canrunner"
+	"go.einride.tech/can/pkg/descriptor"
+	sendtypescan "go.einride.tech/can/testdata/gen/go/sendtypes"
+	"golang.org/x/sync/errgroup"
+	"gotest.tools/v3/assert"
+)
+
+func TestCompile_SendTypesDBC(t *testing.T) {
+	finish := runTestInDir(t, "../..")
+	defer finish()
+	const sendTypesDBCFile = "testdata/dbc/sendtypes/sendtypes.dbc"
+	input, err := os.ReadFile(sendTypesDBCFile)
+	assert.NilError(t, err)
+	result, err := Compile(sendTypesDBCFile, input)
+	assert.NilError(t, err)
+	assert.Equal(t, 0, len(result.Warnings))
+	lights, ok := result.Database.MessageByName("Lights")
+	assert.Assert(t, ok)
+	assert.Equal(t, descriptor.SendTypeOnChange, lights.SendType)
+	assert.Equal(t, 10*time.Millisecond, lights.CycleTimeFast)
+	assert.Equal(t, 2, lights.RepetitionCount)
+	status, ok := result.Database.MessageByName("Status")
+	assert.Assert(t, ok)
+	assert.Equal(t, descriptor.SendTypeCyclicIfActiveFast, status.SendType)
+	assert.Equal(t, time.Second, status.CycleTime)
+	assert.Equal(t, 20*time.Millisecond, status.CycleTimeFast)
+	level, ok := status.Signal("Level")
+	assert.Assert(t, ok)
+	assert.Equal(t, 1, level.InactiveValue)
+	command, ok := result.Database.MessageByName("Command")
+	assert.Assert(t, ok)
+	assert.Equal(t, 50*time.Millisecond, command.DelayTime)
+	request, ok := command.Signal("Request")
+	assert.Assert(t, ok)
+	assert.Equal(t, descriptor.SendTypeOnWrite, request.SendType)
+}
+
+func TestCompile_RepetitionsWithoutFastCycleTime(t *testing.T) {
+	const dbcFile = "repetitions.dbc"
+	input := []byte(`VERSION ""
+BU_: ECU
+BO_ 100 Lights: 1 ECU
+ SG_ HeadLights : 0|2@1+ (1,0) [0|3] "" Vector__XXX
+BA_DEF_ BO_ "GenMsgSendType" ENUM "Cyclic","OnWrite","OnChange";
+BA_DEF_ BO_ "GenMsgNrOfRepetition" INT 0 255;
+BA_DEF_DEF_ "GenMsgSendType" "Cyclic";
+BA_DEF_DEF_ "GenMsgNrOfRepetition" 0;
+BA_ "GenMsgSendType" BO_ 100 2;
+BA_ "GenMsgNrOfRepetition" BO_ 100 3;
+`)
+	result, err := Compile(dbcFile, input)
+	assert.NilError(t, err)
+	// repetitions without a fast cycle time would be sent back-to-back
+	assert.Equal(t, 1, len(result.Warnings))
+	lights, ok := result.Database.MessageByName("Lights")
+	assert.Assert(t, ok)
+	assert.Equal(t, 0, lights.RepetitionCount)
+}
+
+func TestSendTypes_OnChangeWithRepetitions(t *testing.T) {
+	ecu := sendtypescan.NewECU("can", "vcan0")
+	tx := runTransmitter(t, ecu, sendtypescan.Messages().Lights.ID)
+	// when writing a new value
+	ecu.Lock()
+	ecu.Tx().Lights().SetHeadLights(1)
+	ecu.Unlock()
+	// then the message should be transmitted
+	assert.Equal(t, uint64(1), sendtypescan.Messages().Lights.HeadLights.UnmarshalUnsigned(tx.next(t).Data))
+	// and repeated twice with the fast cycle time
+	for i := 0; i < 2; i++ {
+		tx.clock.blockUntil(t, 1)
+		tx.clock.advance(sendtypescan.Messages().Lights.CycleTimeFast)
+		assert.Equal(t, uint64(1), sendtypescan.Messages().Lights.HeadLights.UnmarshalUnsigned(tx.next(t).Data))
+	}
+	tx.clock.advance(sendtypescan.Messages().Lights.CycleTimeFast)
+	tx.none(t)
+	// when writing the same value
+	ecu.Lock()
+	ecu.Tx().Lights().SetHeadLights(1)
+	ecu.Unlock()
+	// then the message should not be transmitted
+	tx.none(t)
+	// when writing a signal inheriting the send type of the message
+	ecu.Lock()
+	ecu.Tx().Lights().SetCounter(2)
+	ecu.Unlock()
+	// then the message should be transmitted
+	assert.Equal(t, uint64(2), sendtypescan.Messages().Lights.Counter.UnmarshalUnsigned(tx.next(t).Data))
+}
+
+func TestSendTypes_OnChangeComparesWrittenSignal(t *testing.T) {
+	ecu := sendtypescan.NewECU("can", "vcan0")
+	tx := runTransmitter(t, ecu, sendtypescan.Messages().Lights.ID)
+	ecu.Lock()
+	ecu.Tx().Lights().SetHeadLights(1)
+	ecu.Unlock()
+	_ = tx.next(t)
+	// when changing a cyclic signal, and writing the same value to an on-change signal
+	ecu.Lock()
+	ecu.Tx().Lights().SetBrightness(5).SetHeadLights(1)
+	ecu.Unlock()
+	// then the message should not be transmitted, since the on-change signal did not change
+	tx.none(t)
+}
+
+func TestSendTypes_ChainedWrites(t *testing.T) {
+	ecu := sendtypescan.NewECU("can", "vcan0")
+	tx := runTransmitter(t, ecu, sendtypescan.Messages().Lights.ID)
+	// when chaining a write of an unchanged value and a write of a new value
+	ecu.Lock()
+	ecu.Tx().Lights().SetHeadLights(0).SetCounter(5)
+	ecu.Unlock()
+	// then the chained write should trigger a transmission
+	assert.Equal(t, uint64(5), sendtypescan.Messages().Lights.Counter.UnmarshalUnsigned(tx.next(t).Data))
+}
+
+func TestSendTypes_OnWriteSignalWithDelayTime(t *testing.T) {
+	ecu := sendtypescan.NewECU("can", "vcan0")
+	tx := runTransmitter(t, ecu, sendtypescan.Messages().Command.ID)
+	delayTime := sendtypescan.Messages().Command.DelayTime
+	// when writing a value
+	ecu.Lock()
+	ecu.Tx().Command().SetRequest(3)
+	ecu.Unlock()
+	// then the message should be transmitted immediately
+	_ = tx.next(t)
+	// when writing the same value again
+	ecu.Lock()
+	ecu.Tx().Command().SetRequest(3)
+	ecu.Unlock()
+	// then the message should be transmitted after the delay time
+	tx.clock.blockUntil(t, 1)
+	tx.clock.advance(delayTime - time.Millisecond)
+	tx.none(t)
+	tx.clock.advance(time.Millisecond)
+	_ = tx.next(t)
+	assert.Equal(t, delayTime, tx.times[1].Sub(tx.times[0]))
+}
+
+func TestSendTypes_CyclicIfActiveFast(t *testing.T) {
+	ecu := sendtypescan.NewECU("can", "vcan0")
+	ecu.Lock()
+	ecu.Tx().Status().SetLevel(1)
+	ecu.Tx().Status().SetCyclicTransmissionEnabled(true)
+	ecu.Unlock()
+	tx := runTransmitter(t, ecu, sendtypescan.Messages().Status.ID)
+	// when the message is inactive
+	tx.clock.blockUntil(t, 1)
+	tx.clock.advance(sendtypescan.Messages().Status.CycleTimeFast)
+	tx.none(t)
+	// and the message becomes active
+	ecu.Lock()
+	ecu.Tx().Status().SetWarning(true)
+	ecu.Unlock()
+	// then the message should be transmitted immediately
+	assert.Assert(t, sendtypescan.Messages().Status.Warning.UnmarshalBool(tx.next(t).Data))
+	// and with the fast cycle time
+	for i := 0; i < 2; i++ {
+		tx.clock.advance(sendtypescan.Messages().Status.CycleTimeFast)
+		assert.Assert(t, sendtypescan.Messages().Status.Warning.UnmarshalBool(tx.next(t).Data))
+	}
+}
+
+// channelFrameTransmitter is a canrunner.FrameTransmitter transmitting frames to a channel.
+type channelFrameTransmitter struct {
+	clock  *fakeClock
+	frames chan can.Frame
+	times  []time.Time
+}
+
+func (c *channelFrameTransmitter) TransmitFrame(ctx context.Context, f can.Frame) error {
+	select {
+	case c.frames <- f:
+		return nil
+	case <-ctx.Done():
+		return nil // drop frames transmitted after the test has finished
+	}
+}
+
+func (c *channelFrameTransmitter) next(t *testing.T) can.Frame {
+	t.Helper()
+	select {
+	case f := <-c.frames:
+		c.times = append(c.times, c.clock.Now())
+		return f
+	case <-time.After(time.Second):
+		t.Fatal("expected frame not transmitted")
+		return can.Frame{}
+	}
+}
+
+func (c *channelFrameTransmitter) none(t *testing.T) {
+	t.Helper()
+	select {
+	case f := <-c.frames:
+		t.Fatalf("unexpected frame transmitted: %v", f)
+	case <-time.After(10 * time.Millisecond):
+	}
+}
+
+func runTransmitter(t *testing.T, n any, id uint32) *channelFrameTransmitter {
+	t.Helper()
+	node, ok := n.(canrunner.Node)
+	assert.Assert(t, ok)
+	var m canrunner.TransmittedMessage
+	for _, tm := range node.TransmittedMessages() {
+		if tm.Descriptor().ID == id {
+			m = tm
+		}
+	}
+	assert.Assert(t, m != nil)
+	tx := &channelFrameTransmitter{clock: newFakeClock(), frames: make(chan can.Frame)}
+	ctx, cancel := context.WithCancel(context.Background())
+	var g errgroup.Group
+	g.Go(func() error {
+		return canrunner.RunMessageTransmitter(ctx, tx, node, m, tx.clock)
+	})
+	t.Cleanup(func() {
+		cancel()
+		assert.NilError(t, g.Wait())
+	})
+	return tx
+}
+
+// fakeClock is a clock.Clock advanced manually by tests.
+type fakeClock struct {
+	mu      sync.Mutex
+	now     time.Time
+	waiters []*fakeWaiter
+}
+
+// fakeWaiter is a pending timer or ticker of a fakeClock.
+type fakeWaiter struct {
+	deadline time.Time
+	period   time.Duration
+	c        chan time.Time
+}
+
+var _ clock.Clock = &fakeClock{}
+
+func newFakeClock() *fakeClock {
+	return &fakeClock{now: time.Unix(0, 0)}
+}
+
+func (c *fakeClock) After(d time.Duration) <-chan time.Time {
+	return c.addWaiter(d, 0).c
+}
+
+func (c *fakeClock) NewTicker(d time.Duration) clock.Ticker {
+	return &fakeTicker{clock: c, waiter: c.addWaiter(d, d)}
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// advance the clock, firing the timers and tickers that are due.
+func (c *fakeClock) advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+	waiters := c.waiters[:0]
+	for _, w := range c.waiters {
+		if w.deadline.After(c.now) {
+			waiters = append(waiters, w)
+			continue
+		}
+		select {
+		case w.c <- c.now:
+		default:
+		}
+		if w.period > 0 {
+			for !w.deadline.After(c.now) {
+				w.deadline = w.deadline.Add(w.period)
+			}
+			waiters = append(waiters, w)
+		}
+	}
+	c.waiters = waiters
+}
+
+// blockUntil blocks until at least n timers and tickers are pending.
+func (c *fakeClock) blockUntil(t *testing.T, n int) {
+	t.Helper()
+	for deadline := time.Now().Add(time.Second); ; time.Sleep(time.Millisecond) {
+		c.mu.Lock()
+		pending := len(c.waiters)
+		c.mu.Unlock()
+		if pending >= n {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("expected %d pending timers, got %d", n, pending)
+		}
+	}
+}
+
+func (c *fakeClock) addWaiter(d, period time.Duration) *fakeWaiter {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	w := &fakeWaiter{deadline: c.now.Add(d), period: period, c: make(chan time.Time, 1)}
+	if d <= 0 && period == 0 {
+		w.c <- c.now
+		return w
+	}
+	c.waiters = append(c.waiters, w)
+	return w
+}
+
+func (c *fakeClock) removeWaiter(w *fakeWaiter) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for i, cw := range c.waiters {
+		if cw == w {
+			c.waiters = append(c.waiters[:i], c.waiters[i+1:]...)
+			return
+		}
+	}
+}
+
+type fakeTicker struct {
+	clock  *fakeClock
+	waiter *fakeWaiter
+}
+
+func (t *fakeTicker) C() <-chan time.Time {
+	return t.waiter.c
+}
+
+func (t *fakeTicker) Stop() {
+	t.clock.removeWaiter(t.waiter)
+}
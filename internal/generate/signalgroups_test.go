@@ -0,0 +1,116 @@
+package generate
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"go.einride.tech/can/pkg/descriptor"
+	signalgroupscan "go.einride.tech/can/testdata/gen/go/signalgroups"
+	"gotest.tools/v3/assert"
+)
+
+func TestCompile_SignalGroupsDBC(t *testing.T) {
+	finish := runTestInDir(t, "../..")
+	defer finish()
+	const signalGroupsDBCFile = "testdata/dbc/signalgroups/signalgroups.dbc"
+	input, err := os.ReadFile(signalGroupsDBCFile)
+	assert.NilError(t, err)
+	result, err := Compile(signalGroupsDBCFile, input)
+	assert.NilError(t, err)
+	assert.Equal(t, 0, len(result.Warnings))
+	drivetrain, ok := result.Database.MessageByName("Drivetrain")
+	assert.Assert(t, ok)
+	assert.DeepEqual(t, []string{"ECU", "GATEWAY"}, drivetrain.TransmitterNodes)
+	assert.DeepEqual(t, []*descriptor.SignalGroup{
+		{Name: "Motion", Repetitions: 1, SignalNames: []string{"Gear", "Speed"}},
+	}, drivetrain.SignalGroups)
+	// signals referencing a signal type get its default value and value table
+	gear, ok := drivetrain.Signal("Gear")
+	assert.Assert(t, ok)
+	assert.Equal(t, "GearType", gear.SignalType)
+	assert.Equal(t, 2, gear.DefaultValue)
+	assert.Equal(t, "GearTable", gear.ValueTable)
+	assert.Equal(t, 4, len(gear.ValueDescriptions))
+}
+
+func TestCompile_SignalTypeMismatch(t *testing.T) {
+	const input = `VERSION ""
+
+NS_ :
+
+BS_:
+
+BU_: ECU
+
+BO_ 100 Message: 1 ECU
+ SG_ Signal : 0|8@1+ (1,0) [0|255] "" Vector__XXX
+
+SGTYPE_ Type : 4@1+ (1,0) [0|15] "" 0, ;
+
+SIG_TYPE_REF_ 100 Signal : Type;
+`
+	result, err := Compile("test.dbc", []byte(input))
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(result.Warnings))
+	assert.ErrorContains(t, result.Warnings[0], "signal type mismatch")
+}
+
+func TestSignalGroups_GroupAccessors(t *testing.T) {
+	msg := signalgroupscan.NewDrivetrain().SetMotionGroup(signalgroupscan.Drivetrain_MotionGroup{
+		Gear:  signalgroupscan.Drivetrain_Gear_Drive,
+		Speed: 1234,
+	})
+	assert.Equal(t, signalgroupscan.Drivetrain_Gear_Drive, msg.Gear())
+	assert.Equal(t, 12.34, msg.Speed())
+	var actual signalgroupscan.Drivetrain
+	assert.NilError(t, actual.UnmarshalFrame(msg.Frame()))
+	assert.Equal(t, msg.MotionGroup(), actual.MotionGroup())
+}
+
+func TestSignalGroups_WriteGroupTransmitsOnce(t *testing.T) {
+	ecu := signalgroupscan.NewECU("can", "vcan0")
+	tx := runTransmitter(t, ecu, signalgroupscan.Messages().Drivetrain.ID)
+	// when writing a signal group
+	ecu.Lock()
+	ecu.Tx().Drivetrain().SetMotionGroup(signalgroupscan.Drivetrain_MotionGroup{
+		Gear:  signalgroupscan.Drivetrain_Gear_Reverse,
+		Speed: 500,
+	})
+	ecu.Unlock()
+	// then the message should be transmitted once, with all signals of the group
+	f := tx.next(t)
+	md := signalgroupscan.Messages().Drivetrain
+	assert.Equal(t, uint64(1), md.Gear.UnmarshalUnsigned(f.Data))
+	assert.Equal(t, uint64(500), md.Speed.UnmarshalUnsigned(f.Data))
+	tx.none(t)
+}
+
+func TestDatabase_SignalGroupNames(t *testing.T) {
+	values := []*descriptor.ValueDescription{{Value: 0, Description: "Off"}, {Value: 1, Description: "On"}}
+	d := &descriptor.Database{
+		SourceFile: "test.dbc",
+		ValueTables: []*descriptor.ValueTable{
+			{Name: "Status_modesGroup", ValueDescriptions: values},
+		},
+		Messages: []*descriptor.Message{
+			{
+				Name:   "Status",
+				ID:     100,
+				Length: 1,
+				Signals: []*descriptor.Signal{
+					{Name: "Left", Length: 2, Scale: 1, ValueTable: "Status_modesGroup", ValueDescriptions: values},
+					{Name: "Right", Start: 2, Length: 2, Scale: 1, ValueTable: "Status_modesGroup", ValueDescriptions: values},
+				},
+				SignalGroups: []*descriptor.SignalGroup{{Name: "modes", SignalNames: []string{"Left", "Right"}}},
+			},
+		},
+	}
+	output, err := Database(d)
+	assert.NilError(t, err)
+	// accessors of signal groups with lowercase names are exported
+	assert.Assert(t, strings.Contains(string(output), "ModesGroup() Status_modesGroup"))
+	assert.Assert(t, strings.Contains(string(output), "SetModesGroup(Status_modesGroup) *Status"))
+	// value tables colliding with signal group types are not shared
+	assert.Assert(t, !strings.Contains(string(output), "type Status_modesGroup uint8"))
+}
@@ -0,0 +1,65 @@
+package generate
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"go.einride.tech/can/internal/clock"
+	"go.einride.tech/can/pkg/canrunner"
+	"go.einride.tech/can/pkg/descriptor"
+	streamscan "go.einride.tech/can/testdata/gen/go/streams"
+	"gotest.tools/v3/assert"
+)
+
+func TestReceiveStreams_ReceiveChan(t *testing.T) {
+	// given a DRIVER node with a channel of received speed reports
+	driver := streamscan.NewDRIVER("can", "vcan0")
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	statuses := driver.Rx().MotorStatus().ReceiveChan(ctx, 10, canrunner.OverflowPolicyDropOldest)
+	// when receiving speed reports
+	expected := []float64{1, 2, 3}
+	runReceiver(t, driver, expected)
+	// then every speed report should be available on the channel
+	for _, speed := range expected {
+		assert.Equal(t, speed, (<-statuses).SpeedKph())
+	}
+}
+
+func TestDatabase_WithoutReceiveStreams(t *testing.T) {
+	d := &descriptor.Database{
+		SourceFile: "test.dbc",
+		Nodes:      []*descriptor.Node{{Name: "ECU"}},
+		Messages: []*descriptor.Message{
+			{
+				Name:     "Status",
+				ID:       100,
+				Length:   1,
+				SendType: descriptor.SendTypeCyclic,
+				Signals: []*descriptor.Signal{
+					{Name: "Level", Length: 8, Scale: 1, ReceiverNodes: []string{"ECU"}},
+				},
+			},
+		},
+	}
+	output, err := Database(d)
+	assert.NilError(t, err)
+	assert.Assert(t, !strings.Contains(string(output), "ReceiveChan"))
+	assert.Assert(t, !strings.Contains(string(output), `"iter"`))
+	output, err = Database(d, WithReceiveStreams())
+	assert.NilError(t, err)
+	assert.Assert(t, strings.Contains(string(output), "ReceiveChan"))
+	assert.Assert(t, strings.Contains(string(output), `"iter"`))
+}
+
+func runReceiver(t *testing.T, driver streamscan.DRIVER, speeds []float64) {
+	t.Helper()
+	rx := &sliceFrameReceiver{}
+	for _, speed := range speeds {
+		rx.frames = append(rx.frames, streamscan.NewMotorStatus().SetSpeedKph(speed).Frame())
+	}
+	n, ok := driver.(canrunner.Node)
+	assert.Assert(t, ok)
+	assert.NilError(t, canrunner.RunMessageReceiver(context.Background(), rx, n, clock.System()))
+}
@@ -0,0 +1,72 @@
+package generate
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"go.einride.tech/can/pkg/canunit"
+	"go.einride.tech/can/pkg/descriptor"
+	unitscan "go.einride.tech/can/testdata/gen/go/units"
+	"gotest.tools/v3/assert"
+)
+
+func TestTypedUnits_SignalTypes(t *testing.T) {
+	msg := unitscan.NewVehicleStatus()
+	for _, tt := range []struct {
+		setter   any
+		expected reflect.Type
+	}{
+		{setter: msg.SetSpeed, expected: reflect.TypeOf(canunit.KilometersPerHour(0))},
+		{setter: msg.SetWheelSpeed, expected: reflect.TypeOf(canunit.MetersPerSecond(0))},
+		{setter: msg.SetEngineSpeed, expected: reflect.TypeOf(canunit.RevolutionsPerMinute(0))},
+		{setter: msg.SetCoolantTemperature, expected: reflect.TypeOf(canunit.DegreesCelsius(0))},
+		{setter: msg.SetFuelLevel, expected: reflect.TypeOf(canunit.Percent(0))},
+		// signals with unknown units keep float64 physical values
+		{setter: unitscan.NewLightStatus().SetIlluminance, expected: reflect.TypeOf(float64(0))},
+	} {
+		actual := reflect.TypeOf(tt.setter).In(0)
+		assert.Equal(t, tt.expected, actual)
+	}
+}
+
+func TestTypedUnits_PhysicalValues(t *testing.T) {
+	msg := unitscan.NewVehicleStatus().
+		SetSpeed(canunit.MetersPerSecond(25).KilometersPerHour()).
+		SetCoolantTemperature(90)
+	assert.Equal(t, canunit.KilometersPerHour(90), msg.Speed())
+	assert.Equal(t, uint16(9000), msg.RawSpeed())
+	assert.Equal(t, canunit.DegreesCelsius(90), msg.CoolantTemperature())
+	assert.Equal(t, "90 km/h", msg.Speed().String())
+}
+
+func TestTypedUnits_TxWriteTracking(t *testing.T) {
+	ecu := unitscan.NewECU("can", "vcan0")
+	ecu.Lock()
+	ecu.Tx().VehicleStatus().SetSpeed(canunit.KilometersPerHour(50))
+	ecu.Unlock()
+	assert.Equal(t, canunit.KilometersPerHour(50), ecu.Tx().VehicleStatus().Speed())
+}
+
+func TestDatabase_WithoutTypedUnits(t *testing.T) {
+	d := &descriptor.Database{
+		SourceFile: "test.dbc",
+		Messages: []*descriptor.Message{
+			{
+				Name:   "Status",
+				ID:     100,
+				Length: 2,
+				Signals: []*descriptor.Signal{
+					{Name: "Speed", Length: 16, Scale: 0.01, Max: 655.35, Unit: "km/h"},
+				},
+			},
+		},
+	}
+	output, err := Database(d)
+	assert.NilError(t, err)
+	assert.Assert(t, strings.Contains(string(output), "SetSpeed(float64) *Status"))
+	assert.Assert(t, !strings.Contains(string(output), "canunit"))
+	output, err = Database(d, WithTypedUnits())
+	assert.NilError(t, err)
+	assert.Assert(t, strings.Contains(string(output), "SetSpeed(canunit.KilometersPerHour) *Status"))
+}
@@ -0,0 +1,106 @@
+package generate
+
+import (
+	"os"
+	"testing"
+
+	"go.einride.tech/can/pkg/descriptor"
+	valuetablescan "go.einride.tech/can/testdata/gen/go/valuetables"
+	"gotest.tools/v3/assert"
+)
+
+func TestCompile_ValueTablesDBC(t *testing.T) {
+	finish := runTestInDir(t, "../..")
+	defer finish()
+	const valueTablesDBCFile = "testdata/dbc/valuetables/valuetables.dbc"
+	input, err := os.ReadFile(valueTablesDBCFile)
+	assert.NilError(t, err)
+	result, err := Compile(valueTablesDBCFile, input)
+	assert.NilError(t, err)
+	assert.Equal(t, 0, len(result.Warnings))
+	db := result.Database
+	onOff := []*descriptor.ValueDescription{
+		{Value: 0, Description: "Off"},
+		{Value: 1, Description: "On"},
+	}
+	assert.DeepEqual(t, []*descriptor.ValueTable{
+		{
+			Name: "LightState",
+			ValueDescriptions: []*descriptor.ValueDescription{
+				{Value: 0, Description: "Off"},
+				{Value: 1, Description: "Low"},
+				{Value: 2, Description: "High"},
+				{Value: 3, Description: "Error"},
+			},
+		},
+		{Name: "OnOff", ValueDescriptions: onOff},
+	}, db.ValueTables)
+	// signals referencing a value table get its descriptions
+	left, ok := db.Signal(100, "Left")
+	assert.Assert(t, ok)
+	assert.Equal(t, "LightState", left.ValueTable)
+	assert.Equal(t, 4, len(left.ValueDescriptions))
+	// signals with the descriptions of a value table are linked to it
+	brake, ok := db.Signal(200, "Brake")
+	assert.Assert(t, ok)
+	assert.Equal(t, "LightState", brake.ValueTable)
+	assert.DeepEqual(t, []*descriptor.EnvironmentVariable{
+		{
+			Name:        "Calibration",
+			ID:          2,
+			Type:        descriptor.EnvironmentVariableTypeData,
+			DataSize:    8,
+			AccessType:  descriptor.AccessTypeReadWrite,
+			AccessNodes: []string{"ECU", "DASH"},
+			Attributes: []*descriptor.Attribute{
+				{Name: "Persistent", Type: descriptor.AttributeTypeInt, IsDefault: true},
+			},
+		},
+		{
+			Name:              "Ignition",
+			ID:                1,
+			Type:              descriptor.EnvironmentVariableTypeInteger,
+			Max:               1,
+			AccessType:        descriptor.AccessTypeRead,
+			AccessNodes:       []string{"ECU"},
+			Description:       "Ignition switch position",
+			ValueTable:        "OnOff",
+			ValueDescriptions: onOff,
+			Attributes: []*descriptor.Attribute{
+				{Name: "Persistent", Type: descriptor.AttributeTypeInt, IntValue: 1},
+			},
+		},
+	}, db.EnvironmentVariables)
+}
+
+func TestCompile_UndeclaredValueTable(t *testing.T) {
+	const input = `VERSION ""
+
+NS_ :
+
+BS_:
+
+BU_: ECU
+
+BO_ 100 Message: 1 ECU
+ SG_ Signal : 0|8@1+ (1,0) [0|255] "" Vector__XXX
+
+VAL_ 100 Signal Missing ;
+`
+	result, err := Compile("test.dbc", []byte(input))
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(result.Warnings))
+	assert.ErrorContains(t, result.Warnings[0], "no declared value table")
+}
+
+func TestValueTables_SharedEnumType(t *testing.T) {
+	// signals sharing a value table share its named enum type
+	var state valuetablescan.LightState = valuetablescan.NewFrontLights().
+		SetLeft(valuetablescan.LightState_High).
+		Left()
+	assert.Equal(t, "High", state.String())
+	rear := valuetablescan.NewRearLights().SetBrake(state)
+	assert.Equal(t, valuetablescan.RearLights_Brake_High, rear.Brake())
+	// signals not sharing a value table keep their own type
+	assert.Equal(t, "On", valuetablescan.RearLights_Fog_On.String())
+}
@@ -0,0 +1,184 @@
+package arxml
+
+import (
+	"encoding/xml"
+	"strconv"
+	"strings"
+)
+
+// element is a generic ARXML element.
+//
+// ARXML is decoded into generic elements rather than typed structs, since the element structure differs between
+// AUTOSAR schema versions.
+type element struct {
+	XMLName  xml.Name
+	Attrs    []xml.Attr `xml:",any,attr"`
+	Content  string     `xml:",chardata"`
+	Children []*element `xml:",any"`
+}
+
+// name returns the tag name of the element.
+func (e *element) name() string {
+	return e.XMLName.Local
+}
+
+// text returns the trimmed text content of the element.
+func (e *element) text() string {
+	if e == nil {
+		return ""
+	}
+	return strings.TrimSpace(e.Content)
+}
+
+// uint returns the unsigned integer content of the element.
+func (e *element) uint() (uint64, bool) {
+	v, err := strconv.ParseUint(e.text(), 0, 64)
+	if err != nil {
+		return 0, false
+	}
+	return v, true
+}
+
+// float returns the floating point content of the element.
+func (e *element) float() (float64, bool) {
+	v, err := parseFloat(e.text())
+	if err != nil {
+		return 0, false
+	}
+	return v, true
+}
+
+// shortName returns the SHORT-NAME of the element.
+func (e *element) shortName() string {
+	if c := e.child("SHORT-NAME"); c != nil {
+		return c.text()
+	}
+	return ""
+}
+
+// child returns the first child element with the provided name.
+func (e *element) child(name string) *element {
+	if e == nil {
+		return nil
+	}
+	for _, c := range e.Children {
+		if c.name() == name {
+			return c
+		}
+	}
+	return nil
+}
+
+// find returns the first descendant element with the provided name, in depth-first order.
+func (e *element) find(name string) *element {
+	if e == nil {
+		return nil
+	}
+	for _, c := range e.Children {
+		if c.name() == name {
+			return c
+		}
+		if d := c.find(name); d != nil {
+			return d
+		}
+	}
+	return nil
+}
+
+// findAll returns all descendant elements with the provided name, in depth-first order.
+//
+// Matching elements are not searched for nested matches.
+func (e *element) findAll(name string) []*element {
+	if e == nil {
+		return nil
+	}
+	var result []*element
+	for _, c := range e.Children {
+		if c.name() == name {
+			result = append(result, c)
+			continue
+		}
+		result = append(result, c.findAll(name)...)
+	}
+	return result
+}
+
+// findText returns the text content of the first descendant element with the provided name.
+func (e *element) findText(name string) string {
+	return e.find(name).text()
+}
+
+// findUint returns the unsigned integer content of the first descendant element with the provided name.
+func (e *element) findUint(name string) (uint64, bool) {
+	return e.find(name).uint()
+}
+
+// findFloat returns the floating point content of the first descendant element with the provided name.
+func (e *element) findFloat(name string) (float64, bool) {
+	return e.find(name).float()
+}
+
+// description returns the first description of the element.
+func (e *element) description() string {
+	desc := e.child("DESC")
+	if desc == nil {
+		return ""
+	}
+	return desc.findText("L-2")
+}
+
+func parseFloat(s string) (float64, error) {
+	s = strings.TrimSpace(s)
+	if strings.HasPrefix(s, "0x") || strings.HasPrefix(s, "0X") {
+		v, err := strconv.ParseInt(s, 0, 64)
+		return float64(v), err
+	}
+	return strconv.ParseFloat(s, 64)
+}
+
+// index is an index of ARXML elements by reference path.
+type index struct {
+	elements map[string]*element
+	paths    map[*element]string
+}
+
+// newIndex indexes the elements with a SHORT-NAME by their reference paths.
+func newIndex(root *element) *index {
+	idx := &index{
+		elements: map[string]*element{},
+		paths:    map[*element]string{},
+	}
+	idx.add("", root)
+	return idx
+}
+
+func (idx *index) add(parentPath string, e *element) {
+	p := parentPath
+	if shortName := e.shortName(); shortName != "" {
+		p = parentPath + "/" + shortName
+		idx.elements[p] = e
+		idx.paths[e] = p
+	}
+	for _, c := range e.Children {
+		idx.add(p, c)
+	}
+}
+
+// path returns the reference path of the provided element.
+func (idx *index) path(e *element) string {
+	return idx.paths[e]
+}
+
+// resolve returns the element referenced by the text of the provided reference element.
+func (idx *index) resolve(ref *element) (*element, bool) {
+	if ref == nil {
+		return nil, false
+	}
+	e, ok := idx.elements[ref.text()]
+	return e, ok
+}
+
+// resolveChild returns the element referenced by the first descendant reference element with the provided name.
+func (idx *index) resolveChild(e *element, refName string) (*element, bool) {
+	return idx.resolve(e.find(refName))
+}
@@ -0,0 +1,370 @@
+// Package arxml provides an importer of CAN communication matrices from AUTOSAR System Template (ARXML) files.
+package arxml
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"go.einride.tech/can/pkg/descriptor"
+)
+
+// ImportResult is the result of importing an ARXML file.
+type ImportResult struct {
+	// Database of the imported CAN cluster.
+	Database *descriptor.Database
+	// Warnings for elements that could not be imported.
+	Warnings []error
+}
+
+// Import imports the CAN cluster of an ARXML file.
+//
+// Files with multiple CAN clusters are imported with ImportCluster.
+func Import(sourceFile string, data []byte) (*ImportResult, error) {
+	return ImportCluster(sourceFile, data, "")
+}
+
+// ImportCluster imports the CAN cluster with the provided name from an ARXML file.
+//
+// The frames triggered on the physical channels of the cluster are imported as messages, with the signals of their
+// I-PDUs, and the ECU instances with ports on the channels are imported as nodes.
+func ImportCluster(sourceFile string, data []byte, clusterName string) (*ImportResult, error) {
+	var root element
+	if err := xml.NewDecoder(bytes.NewReader(data)).Decode(&root); err != nil {
+		return nil, fmt.Errorf("import ARXML %s: %w", sourceFile, err)
+	}
+	clusters := root.findAll("CAN-CLUSTER")
+	var cluster *element
+	switch {
+	case clusterName != "":
+		for _, c := range clusters {
+			if c.shortName() == clusterName {
+				cluster = c
+			}
+		}
+		if cluster == nil {
+			return nil, fmt.Errorf("import ARXML %s: no CAN cluster: %s", sourceFile, clusterName)
+		}
+	case len(clusters) == 0:
+		return nil, fmt.Errorf("import ARXML %s: no CAN cluster", sourceFile)
+	case len(clusters) > 1:
+		names := make([]string, 0, len(clusters))
+		for _, c := range clusters {
+			names = append(names, c.shortName())
+		}
+		return nil, fmt.Errorf("import ARXML %s: multiple CAN clusters: %s", sourceFile, strings.Join(names, ", "))
+	default:
+		cluster = clusters[0]
+	}
+	im := &importer{
+		idx: newIndex(&root),
+		db:  &descriptor.Database{SourceFile: sourceFile},
+	}
+	im.importNodes(&root)
+	for _, triggering := range cluster.findAll("CAN-FRAME-TRIGGERING") {
+		im.importFrameTriggering(triggering)
+	}
+	im.sortDescriptors()
+	return &ImportResult{Database: im.db, Warnings: im.warnings}, nil
+}
+
+type importError struct {
+	element *element
+	reason  string
+}
+
+func (e *importError) Error() string {
+	return fmt.Sprintf("failed to import: %v (%v %v)", e.reason, e.element.name(), e.element.shortName())
+}
+
+type importer struct {
+	idx      *index
+	db       *descriptor.Database
+	ecus     []*ecuInstance
+	warnings []error
+}
+
+// ecuInstance is an ECU instance and the reference path of its frame ports.
+type ecuInstance struct {
+	node *descriptor.Node
+	path string
+}
+
+func (im *importer) addWarning(warning error) {
+	im.warnings = append(im.warnings, warning)
+}
+
+func (im *importer) importNodes(root *element) {
+	for _, ecu := range root.findAll("ECU-INSTANCE") {
+		node := &descriptor.Node{Name: ecu.shortName(), Description: ecu.description()}
+		im.ecus = append(im.ecus, &ecuInstance{node: node, path: im.idx.path(ecu)})
+		im.db.Nodes = append(im.db.Nodes, node)
+	}
+}
+
+func (im *importer) importFrameTriggering(triggering *element) {
+	frame, ok := im.idx.resolveChild(triggering, "FRAME-REF")
+	if !ok {
+		im.addWarning(&importError{element: triggering, reason: "no referenced frame"})
+		return
+	}
+	id, ok := triggering.findUint("IDENTIFIER")
+	if !ok {
+		im.addWarning(&importError{element: triggering, reason: "no identifier"})
+		return
+	}
+	length, _ := frame.findUint("FRAME-LENGTH")
+	message := &descriptor.Message{
+		Name:        frame.shortName(),
+		ID:          uint32(id),
+		IsExtended:  triggering.findText("CAN-ADDRESSING-MODE") == "EXTENDED",
+		Length:      uint8(length),
+		Description: frame.description(),
+	}
+	var receiverNodes []string
+	for _, portRef := range triggering.findAll("FRAME-PORT-REF") {
+		port, ok := im.idx.resolve(portRef)
+		if !ok {
+			continue
+		}
+		ecu, ok := im.ecuOfPort(portRef.text())
+		if !ok {
+			continue
+		}
+		switch port.findText("COMMUNICATION-DIRECTION") {
+		case "OUT":
+			if message.SenderNode == "" {
+				message.SenderNode = ecu.Name
+			}
+			message.TransmitterNodes = append(message.TransmitterNodes, ecu.Name)
+		case "IN":
+			receiverNodes = append(receiverNodes, ecu.Name)
+		}
+	}
+	if len(message.TransmitterNodes) < 2 {
+		message.TransmitterNodes = nil
+	}
+	for _, mapping := range frame.findAll("PDU-TO-FRAME-MAPPING") {
+		pdu, ok := im.idx.resolveChild(mapping, "PDU-REF")
+		if !ok {
+			im.addWarning(&importError{element: frame, reason: "no referenced PDU"})
+			continue
+		}
+		if pdu.name() != "I-SIGNAL-I-PDU" {
+			im.addWarning(&importError{element: pdu, reason: "unsupported PDU type: " + pdu.name()})
+			continue
+		}
+		pduStart, _ := mapping.findUint("START-POSITION")
+		im.importPDU(message, pdu, uint8(pduStart), receiverNodes)
+	}
+	im.db.Messages = append(im.db.Messages, message)
+}
+
+func (im *importer) ecuOfPort(portPath string) (*descriptor.Node, bool) {
+	for _, ecu := range im.ecus {
+		if strings.HasPrefix(portPath, ecu.path+"/") {
+			return ecu.node, true
+		}
+	}
+	return nil, false
+}
+
+func (im *importer) importPDU(message *descriptor.Message, pdu *element, pduStart uint8, receiverNodes []string) {
+	if message.Description == "" {
+		message.Description = pdu.description()
+	}
+	if timing := pdu.find("CYCLIC-TIMING"); timing != nil {
+		if period, ok := timing.find("TIME-PERIOD").findFloat("VALUE"); ok {
+			message.CycleTime = time.Duration(period * float64(time.Second))
+			message.SendType = descriptor.SendTypeCyclic
+		}
+	}
+	for _, mapping := range pdu.findAll("I-SIGNAL-TO-I-PDU-MAPPING") {
+		iSignal, ok := im.idx.resolveChild(mapping, "I-SIGNAL-REF")
+		if !ok {
+			// signal groups are mapped without a signal reference
+			continue
+		}
+		start, ok := mapping.findUint("START-POSITION")
+		if !ok {
+			im.addWarning(&importError{element: mapping, reason: "no start position"})
+			continue
+		}
+		length, ok := iSignal.child("LENGTH").uint()
+		if !ok {
+			im.addWarning(&importError{element: iSignal, reason: "no length"})
+			continue
+		}
+		if _, ok := message.Signal(iSignal.shortName()); ok {
+			im.addWarning(&importError{element: iSignal, reason: "duplicate signal in frame " + message.Name})
+			continue
+		}
+		// start positions are the least significant bit of little-endian signals and the most significant bit of
+		// big-endian signals, as in DBC files
+		signal := &descriptor.Signal{
+			Name:          iSignal.shortName(),
+			Start:         pduStart + uint8(start),
+			Length:        uint8(length),
+			IsBigEndian:   mapping.findText("PACKING-BYTE-ORDER") == "MOST-SIGNIFICANT-BYTE-FIRST",
+			Scale:         1,
+			Description:   iSignal.description(),
+			ReceiverNodes: receiverNodes,
+		}
+		if initValue, ok := iSignal.child("INIT-VALUE").findFloat("VALUE"); ok {
+			signal.DefaultValue = int(initValue)
+		}
+		im.importBaseType(signal, iSignal)
+		im.importCompuMethod(signal, iSignal)
+		message.Signals = append(message.Signals, signal)
+	}
+}
+
+// importBaseType imports the encoding of a signal from the base type of its network representation.
+func (im *importer) importBaseType(signal *descriptor.Signal, iSignal *element) {
+	baseType, ok := im.idx.resolveChild(iSignal, "BASE-TYPE-REF")
+	if !ok {
+		return
+	}
+	switch baseType.findText("BASE-TYPE-ENCODING") {
+	case "2C":
+		signal.IsSigned = true
+	case "IEEE754":
+		if signal.Length != 32 {
+			im.addWarning(&importError{element: baseType, reason: "unsupported floating point length"})
+			return
+		}
+		signal.IsFloat = true
+	}
+}
+
+// importCompuMethod imports the scaling, range, unit and value descriptions of a signal from its compu-method.
+//
+// The compu-method is taken from the network representation of the signal, or from its system signal.
+func (im *importer) importCompuMethod(signal *descriptor.Signal, iSignal *element) {
+	compuMethod, ok := im.idx.resolveChild(iSignal, "COMPU-METHOD-REF")
+	if !ok {
+		if systemSignal, ok := im.idx.resolveChild(iSignal, "SYSTEM-SIGNAL-REF"); ok {
+			compuMethod, _ = im.idx.resolveChild(systemSignal, "COMPU-METHOD-REF")
+		}
+	}
+	if unit, ok := im.idx.resolveChild(iSignal, "UNIT-REF"); ok {
+		signal.Unit = unitName(unit)
+	}
+	minRaw, maxRaw := rawRange(signal)
+	signal.Min, signal.Max = minRaw, maxRaw
+	if compuMethod == nil {
+		return
+	}
+	if unit, ok := im.idx.resolveChild(compuMethod, "UNIT-REF"); ok {
+		signal.Unit = unitName(unit)
+	}
+	category := compuMethod.findText("CATEGORY")
+	switch category {
+	case "IDENTICAL", "LINEAR", "SCALE_LINEAR", "TEXTTABLE", "SCALE_LINEAR_AND_TEXTTABLE":
+	default:
+		im.addWarning(&importError{element: compuMethod, reason: "unsupported compu-method category: " + category})
+		return
+	}
+	internalToPhys := compuMethod.child("COMPU-INTERNAL-TO-PHYS")
+	if internalToPhys == nil {
+		return
+	}
+	for _, scale := range internalToPhys.findAll("COMPU-SCALE") {
+		lower, hasLower := scale.findFloat("LOWER-LIMIT")
+		upper, hasUpper := scale.findFloat("UPPER-LIMIT")
+		if vt := scale.find("VT"); vt != nil {
+			signal.ValueDescriptions = append(signal.ValueDescriptions, &descriptor.ValueDescription{
+				Value:       int64(lower),
+				Description: vt.text(),
+			})
+			continue
+		}
+		coeffs := scale.child("COMPU-RATIONAL-COEFFS")
+		if coeffs == nil {
+			continue
+		}
+		numerator := coefficients(coeffs.child("COMPU-NUMERATOR"))
+		denominator := coefficients(coeffs.child("COMPU-DENOMINATOR"))
+		if len(numerator) != 2 || len(denominator) > 1 || (len(denominator) == 1 && denominator[0] == 0) {
+			im.addWarning(&importError{element: compuMethod, reason: "unsupported rational coefficients"})
+			continue
+		}
+		divisor := 1.0
+		if len(denominator) == 1 {
+			divisor = denominator[0]
+		}
+		signal.Offset = numerator[0] / divisor
+		signal.Scale = numerator[1] / divisor
+		if !hasLower {
+			lower = minRaw
+		}
+		if !hasUpper {
+			upper = maxRaw
+		}
+		signal.Min = signal.Offset + signal.Scale*lower
+		signal.Max = signal.Offset + signal.Scale*upper
+		if signal.Min > signal.Max {
+			signal.Min, signal.Max = signal.Max, signal.Min
+		}
+	}
+}
+
+func (im *importer) sortDescriptors() {
+	sort.Slice(im.db.Nodes, func(i, j int) bool {
+		return im.db.Nodes[i].Name < im.db.Nodes[j].Name
+	})
+	sort.Slice(im.db.Messages, func(i, j int) bool {
+		return im.db.Messages[i].ID < im.db.Messages[j].ID
+	})
+	for _, m := range im.db.Messages {
+		sort.Slice(m.Signals, func(i, j int) bool {
+			return m.Signals[i].Start < m.Signals[j].Start
+		})
+		for _, s := range m.Signals {
+			sort.Slice(s.ValueDescriptions, func(i, j int) bool {
+				return s.ValueDescriptions[i].Value < s.ValueDescriptions[j].Value
+			})
+		}
+	}
+}
+
+func unitName(unit *element) string {
+	if displayName := unit.findText("DISPLAY-NAME"); displayName != "" {
+		return displayName
+	}
+	return unit.shortName()
+}
+
+func coefficients(e *element) []float64 {
+	if e == nil {
+		return nil
+	}
+	var result []float64
+	for _, v := range e.Children {
+		if v.name() != "V" {
+			continue
+		}
+		f, err := parseFloat(v.text())
+		if err != nil {
+			return nil
+		}
+		result = append(result, f)
+	}
+	return result
+}
+
+// rawRange returns the range of raw values of a signal.
+func rawRange(signal *descriptor.Signal) (float64, float64) {
+	switch {
+	case signal.IsFloat:
+		return -math.MaxFloat32, math.MaxFloat32
+	case signal.IsSigned:
+		return -math.Pow(2, float64(signal.Length-1)), math.Pow(2, float64(signal.Length-1)) - 1
+	default:
+		return 0, math.Pow(2, float64(signal.Length)) - 1
+	}
+}
@@ -0,0 +1,127 @@
+package arxml
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"go.einride.tech/can/pkg/descriptor"
+	"gotest.tools/v3/assert"
+)
+
+func TestImport(t *testing.T) {
+	const sourceFile = "../../testdata/arxml/powertrain.arxml"
+	data, err := os.ReadFile(sourceFile)
+	assert.NilError(t, err)
+	result, err := Import(sourceFile, data)
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(result.Warnings))
+	assert.ErrorContains(t, result.Warnings[0], "unsupported compu-method category: RAT_FUNC")
+	expected := &descriptor.Database{
+		SourceFile: sourceFile,
+		Nodes: []*descriptor.Node{
+			{Name: "DASH"},
+			{Name: "ECM", Description: "Engine control module"},
+		},
+		Messages: []*descriptor.Message{
+			{
+				Name:        "EngineStatus",
+				ID:          100,
+				Length:      8,
+				Description: "Engine status",
+				SenderNode:  "ECM",
+				SendType:    descriptor.SendTypeCyclic,
+				CycleTime:   100 * time.Millisecond,
+				Signals: []*descriptor.Signal{
+					{
+						Name:          "Speed",
+						Start:         0,
+						Length:        16,
+						Scale:         0.25,
+						Max:           8000,
+						Unit:          "rpm",
+						Description:   "Engine speed",
+						ReceiverNodes: []string{"DASH"},
+					},
+					{
+						Name:          "Temperature",
+						Start:         16,
+						Length:        8,
+						IsSigned:      true,
+						Scale:         0.5,
+						Offset:        -40,
+						Min:           -104,
+						Max:           23.5,
+						Unit:          "°C",
+						ReceiverNodes: []string{"DASH"},
+					},
+					{
+						Name:   "Gear",
+						Start:  24,
+						Length: 2,
+						Scale:  1,
+						Max:    3,
+						ValueDescriptions: []*descriptor.ValueDescription{
+							{Value: 0, Description: "Park"},
+							{Value: 1, Description: "Neutral"},
+							{Value: 2, Description: "Drive"},
+						},
+						ReceiverNodes: []string{"DASH"},
+						DefaultValue:  1,
+					},
+				},
+			},
+			{
+				Name:       "BrakeStatus",
+				ID:         0x18ff0001,
+				IsExtended: true,
+				Length:     2,
+				SenderNode: "DASH",
+				Signals: []*descriptor.Signal{
+					{
+						Name:        "Pressure",
+						Start:       7,
+						Length:      12,
+						IsBigEndian: true,
+						Scale:       1,
+						Max:         4095,
+					},
+				},
+			},
+		},
+	}
+	assert.DeepEqual(t, expected, result.Database)
+}
+
+func TestImport_Clusters(t *testing.T) {
+	const input = `<?xml version="1.0" encoding="UTF-8"?>
+<AUTOSAR xmlns="http://autosar.org/schema/r4.0">
+  <AR-PACKAGES>
+    <AR-PACKAGE>
+      <SHORT-NAME>Clusters</SHORT-NAME>
+      <ELEMENTS>
+        <CAN-CLUSTER>
+          <SHORT-NAME>Body</SHORT-NAME>
+        </CAN-CLUSTER>
+        <CAN-CLUSTER>
+          <SHORT-NAME>Chassis</SHORT-NAME>
+        </CAN-CLUSTER>
+      </ELEMENTS>
+    </AR-PACKAGE>
+  </AR-PACKAGES>
+</AUTOSAR>
+`
+	t.Run("multiple clusters", func(t *testing.T) {
+		_, err := Import("test.arxml", []byte(input))
+		assert.ErrorContains(t, err, "multiple CAN clusters: Body, Chassis")
+	})
+	t.Run("cluster by name", func(t *testing.T) {
+		result, err := ImportCluster("test.arxml", []byte(input), "Chassis")
+		assert.NilError(t, err)
+		assert.Equal(t, 0, len(result.Database.Messages))
+	})
+	t.Run("no cluster", func(t *testing.T) {
+		_, err := ImportCluster("test.arxml", []byte(input), "Powertrain")
+		assert.ErrorContains(t, err, "no CAN cluster: Powertrain")
+	})
+}
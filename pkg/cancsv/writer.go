@@ -0,0 +1,170 @@
+// Package cancsv writes the signals of CAN frames decoded with a database to CSV files.
+//
+// In the wide format, each row is a frame, with a column for the time and a column per signal of the database, named
+// "<message>.<signal>" followed by the unit of the signal in brackets. Signals not in the frame are left empty.
+//
+// In the long format, each row is a signal of a frame, with the columns time, message, signal, value, unit and label,
+// where the label is the value description of the value.
+//
+// Times are formatted as RFC 3339 timestamps, and values as physical values.
+package cancsv
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"go.einride.tech/can"
+	"go.einride.tech/can/pkg/descriptor"
+)
+
+// Format is the format of a CSV file.
+type Format int
+
+const (
+	// FormatWide writes a row per frame, with a column per signal.
+	FormatWide Format = iota
+	// FormatLong writes a row per signal of a frame.
+	FormatLong
+)
+
+// WriterOption configures a Writer.
+type WriterOption func(*writerOpts)
+
+type writerOpts struct {
+	format Format
+	comma  rune
+}
+
+// WithFormat sets the format of the file, which defaults to FormatWide.
+func WithFormat(format Format) WriterOption {
+	return func(opts *writerOpts) {
+		opts.format = format
+	}
+}
+
+// WithComma sets the field delimiter of the file, which defaults to ','.
+func WithComma(comma rune) WriterOption {
+	return func(opts *writerOpts) {
+		opts.comma = comma
+	}
+}
+
+// Writer writes timestamped CAN frames to a CSV file, as decoded signals.
+type Writer struct {
+	opts     writerOpts
+	csv      *csv.Writer
+	messages map[descriptor.MessageKey]*message
+	record   []string
+}
+
+type message struct {
+	descriptor *descriptor.Message
+	// column of the first signal of the message in the wide format.
+	column int
+}
+
+// NewWriter returns a new writer of CSV files with the messages of a database, and writes the header of the file.
+func NewWriter(w io.Writer, d *descriptor.Database, opt ...WriterOption) (*Writer, error) {
+	opts := writerOpts{format: FormatWide, comma: ','}
+	for _, f := range opt {
+		f(&opts)
+	}
+	cw := &Writer{
+		opts:     opts,
+		csv:      csv.NewWriter(w),
+		messages: make(map[descriptor.MessageKey]*message, len(d.Messages)),
+	}
+	cw.csv.Comma = opts.comma
+	header := []string{"time"}
+	for _, m := range d.Messages {
+		key := m.Key()
+		if _, ok := cw.messages[key]; ok {
+			return nil, fmt.Errorf("new csv writer: duplicate message ID: %d", m.ID)
+		}
+		cw.messages[key] = &message{descriptor: m, column: len(header)}
+		for _, s := range m.Signals {
+			column := m.Name + "." + s.Name
+			if s.Unit != "" {
+				column += " [" + s.Unit + "]"
+			}
+			header = append(header, column)
+		}
+	}
+	switch opts.format {
+	case FormatWide:
+	case FormatLong:
+		header = []string{"time", "message", "signal", "value", "unit", "label"}
+	default:
+		return nil, fmt.Errorf("new csv writer: invalid format: %d", opts.format)
+	}
+	cw.record = make([]string, len(header))
+	if err := cw.csv.Write(header); err != nil {
+		return nil, fmt.Errorf("new csv writer: %w", err)
+	}
+	return cw, nil
+}
+
+// WriteFrame writes the signals of a frame received at the provided time.
+//
+// Remote frames and frames of messages not in the database are skipped.
+func (w *Writer) WriteFrame(t time.Time, f can.Frame) error {
+	if f.IsRemote {
+		return nil
+	}
+	m, ok := w.messages[descriptor.MessageKeyOf(f)]
+	if !ok {
+		return nil
+	}
+	if f.Length != m.descriptor.Length {
+		return fmt.Errorf(
+			"write csv frame: %s: expects length %d (got %s with length %d)",
+			m.descriptor.Name, m.descriptor.Length, f.String(), f.Length,
+		)
+	}
+	timestamp := t.UTC().Format(time.RFC3339Nano)
+	if w.opts.format == FormatLong {
+		for _, s := range m.descriptor.Signals {
+			if !m.descriptor.IsSignalPresent(s, f.Data) {
+				continue
+			}
+			label, _ := s.UnmarshalValueDescription(f.Data)
+			w.record[0] = timestamp
+			w.record[1] = m.descriptor.Name
+			w.record[2] = s.Name
+			w.record[3] = formatValue(s.UnmarshalPhysical(f.Data))
+			w.record[4] = s.Unit
+			w.record[5] = label
+			if err := w.csv.Write(w.record); err != nil {
+				return fmt.Errorf("write csv frame: %w", err)
+			}
+		}
+		return nil
+	}
+	clear(w.record)
+	w.record[0] = timestamp
+	for i, s := range m.descriptor.Signals {
+		if m.descriptor.IsSignalPresent(s, f.Data) {
+			w.record[m.column+i] = formatValue(s.UnmarshalPhysical(f.Data))
+		}
+	}
+	if err := w.csv.Write(w.record); err != nil {
+		return fmt.Errorf("write csv frame: %w", err)
+	}
+	return nil
+}
+
+// Flush writes any buffered rows to the underlying writer.
+func (w *Writer) Flush() error {
+	w.csv.Flush()
+	if err := w.csv.Error(); err != nil {
+		return fmt.Errorf("flush csv writer: %w", err)
+	}
+	return nil
+}
+
+func formatValue(value float64) string {
+	return strconv.FormatFloat(value, 'g', -1, 64)
+}
@@ -0,0 +1,67 @@
+package cancsv
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"go.einride.tech/can"
+	loggingcan "go.einride.tech/can/testdata/gen/go/logging"
+	"gotest.tools/v3/assert"
+)
+
+func writeTestFrames(t *testing.T, opt ...WriterOption) string {
+	t.Helper()
+	start := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, loggingcan.Messages().Database(), opt...)
+	assert.NilError(t, err)
+	assert.NilError(t, w.WriteFrame(start, can.Frame{ID: 100, Length: 3, Data: can.Data{0x64, 0x00, 0x01}}))
+	diagnostics := can.Frame{ID: 0x18daf110, IsExtended: true, Length: 2, Data: can.Data{1, 0xfe}}
+	assert.NilError(t, w.WriteFrame(start.Add(time.Millisecond), diagnostics))
+	// unknown message
+	assert.NilError(t, w.WriteFrame(start.Add(2*time.Millisecond), can.Frame{ID: 400, Length: 8}))
+	assert.NilError(t, w.WriteFrame(start.Add(3*time.Millisecond), can.Frame{ID: 100, Length: 3, IsRemote: true}))
+	assert.NilError(t, w.Flush())
+	return buf.String()
+}
+
+func TestWriter_Wide(t *testing.T) {
+	const expected = "time,MotorStatus.Speed [km/h],MotorStatus.State,Unused.Value," +
+		"Diagnostics.Mux,Diagnostics.Counter,Diagnostics.Error\n" +
+		"2024-01-01T12:00:00Z,40,1,,,,\n" +
+		"2024-01-01T12:00:00.001Z,,,,1,,-2\n"
+	assert.Equal(t, expected, writeTestFrames(t))
+}
+
+func TestWriter_Long(t *testing.T) {
+	const expected = `time,message,signal,value,unit,label
+2024-01-01T12:00:00Z,MotorStatus,Speed,40,km/h,
+2024-01-01T12:00:00Z,MotorStatus,State,1,,"On, running"
+2024-01-01T12:00:00.001Z,Diagnostics,Mux,1,,
+2024-01-01T12:00:00.001Z,Diagnostics,Error,-2,,
+`
+	assert.Equal(t, expected, writeTestFrames(t, WithFormat(FormatLong)))
+}
+
+func TestWriter_Comma(t *testing.T) {
+	const expected = `time;message;signal;value;unit;label
+2024-01-01T12:00:00Z;MotorStatus;Speed;40;km/h;
+2024-01-01T12:00:00Z;MotorStatus;State;1;;On, running
+2024-01-01T12:00:00.001Z;Diagnostics;Mux;1;;
+2024-01-01T12:00:00.001Z;Diagnostics;Error;-2;;
+`
+	assert.Equal(t, expected, writeTestFrames(t, WithFormat(FormatLong), WithComma(';')))
+}
+
+func TestWriter_InvalidLength(t *testing.T) {
+	w, err := NewWriter(&bytes.Buffer{}, loggingcan.Messages().Database())
+	assert.NilError(t, err)
+	err = w.WriteFrame(time.Now(), can.Frame{ID: 100, Length: 8})
+	assert.ErrorContains(t, err, "MotorStatus: expects length 3")
+}
+
+func TestNewWriter_InvalidFormat(t *testing.T) {
+	_, err := NewWriter(&bytes.Buffer{}, loggingcan.Messages().Database(), WithFormat(Format(3)))
+	assert.ErrorContains(t, err, "invalid format: 3")
+}
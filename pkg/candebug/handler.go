@@ -0,0 +1,504 @@
+package candebug
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"mime"
+	"net/http"
+	"net/url"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.einride.tech/can"
+	"go.einride.tech/can/pkg/canjson"
+	"go.einride.tech/can/pkg/canrunner"
+	"go.einride.tech/can/pkg/cantext"
+	"go.einride.tech/can/pkg/descriptor"
+	"go.einride.tech/can/pkg/generated"
+)
+
+const (
+	// defaultHistorySize is the default number of values in the history of each signal.
+	defaultHistorySize = 100
+	// defaultUpdateInterval is the default interval of live updates.
+	defaultUpdateInterval = 250 * time.Millisecond
+	// transmitTimeout is the time to wait for a transmitted message to be picked up by the node.
+	transmitTimeout = 5 * time.Second
+)
+
+// HandlerOption configures a Handler.
+type HandlerOption func(*handlerOpts)
+
+type handlerOpts struct {
+	historySize    int
+	updateInterval time.Duration
+}
+
+// WithHistorySize sets the number of values in the history of each signal, which defaults to 100.
+func WithHistorySize(size int) HandlerOption {
+	return func(opts *handlerOpts) {
+		opts.historySize = size
+	}
+}
+
+// WithUpdateInterval sets the interval of live updates, which defaults to 250ms.
+func WithUpdateInterval(interval time.Duration) HandlerOption {
+	return func(opts *handlerOpts) {
+		opts.updateInterval = interval
+	}
+}
+
+// Handler is an HTTP handler for debugging messages from a browser.
+//
+// The messages are served as plain text, HTML or JSON, depending on the Accept header of the request, and as live
+// updates with Server-Sent Events when the request accepts text/event-stream. As for ServeMessagesHTTP, a path ending
+// with a message name serves only that message.
+//
+// The HTML page shows the history of each signal as a sparkline, sampled when the messages are served, and updates
+// live. Transmitted messages can be written with POST requests to their paths: forms set signals in the notation of
+// cantext.Unmarshal, and JSON bodies set signals in the notation of canjson.Unmarshal. Both can also toggle cyclic
+// transmission and trigger a transmission of the message. Writes sent by browsers from pages of other origins are
+// rejected.
+type Handler struct {
+	opts   handlerOpts
+	locker sync.Locker
+	// messages and their histories, protected by locker.
+	messages []*debugMessage
+}
+
+type debugMessage struct {
+	generated.Message
+	// frame is the frame of the last sample.
+	frame can.Frame
+	// time is the receive or transmit time of the last sample.
+	time time.Time
+	// isSampled is true if the message has been sampled.
+	isSampled bool
+	// history of the physical values of each signal, where NaN is a multiplexed signal not in the frame.
+	history [][]float64
+}
+
+// NewHandler returns a new handler for debugging messages, which are only accessed while holding the locker.
+func NewHandler(l sync.Locker, msgs []generated.Message, opt ...HandlerOption) *Handler {
+	opts := handlerOpts{historySize: defaultHistorySize, updateInterval: defaultUpdateInterval}
+	for _, f := range opt {
+		f(&opts)
+	}
+	h := &Handler{opts: opts, locker: l}
+	for _, m := range msgs {
+		h.messages = append(h.messages, &debugMessage{
+			Message: m,
+			history: make([][]float64, len(m.Descriptor().Signals)),
+		})
+	}
+	return h
+}
+
+// ServeHTTP implements http.Handler.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	msgs := h.messages
+	base := path.Base(r.URL.Path)
+	isMessagePath := false
+	for _, m := range h.messages {
+		if m.Descriptor().Name == base {
+			msgs = []*debugMessage{m}
+			isMessagePath = true
+			break
+		}
+	}
+	switch r.Method {
+	case http.MethodGet, http.MethodHead:
+	case http.MethodPost:
+		if !isMessagePath {
+			http.Error(w, "POST requires the path of a message", http.StatusNotFound)
+			return
+		}
+		h.serveWrite(w, r, msgs[0])
+		return
+	default:
+		w.Header().Set("Allow", "GET, HEAD, POST")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	switch negotiateContentType(r.Header.Get("Accept")) {
+	case contentTypeEventStream:
+		h.serveEvents(w, r, msgs)
+	case contentTypeJSON:
+		h.serveJSON(w, msgs)
+	case contentTypeHTML:
+		h.serveHTML(w, r, msgs)
+	default:
+		h.locker.Lock()
+		defer h.locker.Unlock()
+		h.sample(msgs)
+		generatedMsgs := make([]generated.Message, 0, len(msgs))
+		for _, m := range msgs {
+			generatedMsgs = append(generatedMsgs, m.Message)
+		}
+		serveMessagesHTTP(w, r, generatedMsgs)
+	}
+}
+
+// sample appends the values of the signals of updated messages to their histories.
+func (h *Handler) sample(msgs []*debugMessage) {
+	for _, m := range msgs {
+		f := m.Frame()
+		t := messageTime(m.Message)
+		if m.isSampled && f == m.frame && t.Equal(m.time) {
+			continue
+		}
+		m.frame, m.time, m.isSampled = f, t, true
+		for i, s := range m.Descriptor().Signals {
+			value := math.NaN()
+			if m.Descriptor().IsSignalPresent(s, f.Data) {
+				value = s.UnmarshalPhysical(f.Data)
+			}
+			m.history[i] = append(m.history[i], value)
+			if n := len(m.history[i]); n > h.opts.historySize {
+				m.history[i] = slices.Delete(m.history[i], 0, n-h.opts.historySize)
+			}
+		}
+	}
+}
+
+// messageTime returns the receive or transmit time of a message.
+func messageTime(m generated.Message) time.Time {
+	if timer, ok := m.(interface{ ReceiveTime() time.Time }); ok {
+		return timer.ReceiveTime()
+	}
+	if timer, ok := m.(interface{ TransmitTime() time.Time }); ok {
+		return timer.TransmitTime()
+	}
+	return time.Time{}
+}
+
+// messageJSON is the JSON representation of a message.
+type messageJSON struct {
+	Name                      string
+	ID                        uint32
+	IsExtended                bool   `json:",omitempty"`
+	Sender                    string `json:",omitempty"`
+	SendType                  string
+	CycleTime                 string     `json:",omitempty"`
+	IsWritable                bool       `json:",omitempty"`
+	CyclicTransmissionEnabled *bool      `json:",omitempty"`
+	ReceiveTime               *time.Time `json:",omitempty"`
+	TransmitTime              *time.Time `json:",omitempty"`
+	Frame                     string
+	// Signals in the notation of canjson.Marshal.
+	Signals json.RawMessage
+	// History of the physical values of each signal, where null is a multiplexed signal not in the frame.
+	History map[string][]*float64
+}
+
+func (m *debugMessage) json() (*messageJSON, error) {
+	md := m.Descriptor()
+	signals, err := canjson.Marshal(m.Message)
+	if err != nil {
+		return nil, err
+	}
+	result := &messageJSON{
+		Name:       md.Name,
+		ID:         md.ID,
+		IsExtended: md.IsExtended,
+		Sender:     md.SenderNode,
+		SendType:   md.SendType.String(),
+		IsWritable: isWritable(m.Message),
+		Frame:      m.Frame().String(),
+		Signals:    signals,
+		History:    make(map[string][]*float64, len(md.Signals)),
+	}
+	if md.SendType.IsCyclic() {
+		result.CycleTime = md.CycleTime.String()
+	}
+	if enabler, ok := m.Message.(interface{ IsCyclicTransmissionEnabled() bool }); ok {
+		isEnabled := enabler.IsCyclicTransmissionEnabled()
+		result.CyclicTransmissionEnabled = &isEnabled
+	}
+	if timer, ok := m.Message.(interface{ ReceiveTime() time.Time }); ok && !timer.ReceiveTime().IsZero() {
+		t := timer.ReceiveTime()
+		result.ReceiveTime = &t
+	}
+	if timer, ok := m.Message.(interface{ TransmitTime() time.Time }); ok && !timer.TransmitTime().IsZero() {
+		t := timer.TransmitTime()
+		result.TransmitTime = &t
+	}
+	for i, s := range md.Signals {
+		history := make([]*float64, len(m.history[i]))
+		for j, value := range m.history[i] {
+			if !math.IsNaN(value) {
+				history[j] = &m.history[i][j]
+			}
+		}
+		result.History[s.Name] = history
+	}
+	return result, nil
+}
+
+// marshalMessages samples the messages and marshals them to JSON, while holding the locker.
+func (h *Handler) marshalMessages(msgs []*debugMessage) ([]byte, error) {
+	h.locker.Lock()
+	defer h.locker.Unlock()
+	h.sample(msgs)
+	result := make([]*messageJSON, 0, len(msgs))
+	for _, m := range msgs {
+		mj, err := m.json()
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, mj)
+	}
+	return json.Marshal(result)
+}
+
+func (h *Handler) serveJSON(w http.ResponseWriter, msgs []*debugMessage) {
+	data, err := h.marshalMessages(msgs)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", contentTypeJSON)
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(data)
+}
+
+// serveEvents serves live updates of the messages as Server-Sent Events, with the JSON of the messages as data.
+func (h *Handler) serveEvents(w http.ResponseWriter, r *http.Request, msgs []*debugMessage) {
+	rc := http.NewResponseController(w)
+	w.Header().Set("Content-Type", contentTypeEventStream)
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	ticker := time.NewTicker(h.opts.updateInterval)
+	defer ticker.Stop()
+	var prev []byte
+	for {
+		data, err := h.marshalMessages(msgs)
+		if err != nil {
+			return
+		}
+		if !bytes.Equal(data, prev) {
+			if _, err := fmt.Fprintf(w, "data: %s\n\n", data); err != nil {
+				return
+			}
+			if err := rc.Flush(); err != nil {
+				return
+			}
+			prev = data
+		}
+		select {
+		case <-r.Context().Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// writeRequest is a request to write a message, in the JSON notation of messageJSON.
+type writeRequest struct {
+	// Signals in the notation of canjson.Unmarshal.
+	Signals                   json.RawMessage
+	CyclicTransmissionEnabled *bool
+	// Transmit triggers a transmission of the message after it is written.
+	Transmit bool
+	// signalNames are the names of the signals set by a form.
+	signalNames []string
+}
+
+// serveWrite writes a transmitted message from a form or a JSON body.
+func (h *Handler) serveWrite(w http.ResponseWriter, r *http.Request, m *debugMessage) {
+	// forms can be posted by pages of any site, so writes are only accepted from pages of the same origin
+	if isCrossOrigin(r) {
+		http.Error(w, "cross-origin writes are not allowed", http.StatusForbidden)
+		return
+	}
+	transmitter, ok := m.Message.(interface{ Transmit(context.Context) error })
+	if !ok {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, fmt.Sprintf("%s is not a transmitted message", m.Descriptor().Name), http.StatusMethodNotAllowed)
+		return
+	}
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	isJSON := mediaType == contentTypeJSON
+	var req writeRequest
+	var err error
+	if isJSON {
+		err = json.NewDecoder(io.LimitReader(r.Body, 1<<20)).Decode(&req)
+	} else {
+		req, err = parseWriteForm(r, m.Descriptor())
+	}
+	if err == nil {
+		err = h.write(m, &req, isJSON)
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if req.Transmit {
+		ctx, cancel := context.WithTimeout(r.Context(), transmitTimeout)
+		defer cancel()
+		// the node is not locked, since it is locked when transmitting
+		if err := transmitter.Transmit(ctx); err != nil {
+			http.Error(w, err.Error(), http.StatusServiceUnavailable)
+			return
+		}
+	}
+	if isJSON {
+		h.serveJSON(w, []*debugMessage{m})
+		return
+	}
+	http.Redirect(w, r, r.URL.Path, http.StatusSeeOther)
+}
+
+// isCrossOrigin returns true if a request is sent by a browser from a page of another origin.
+//
+// Requests without the Sec-Fetch-Site and Origin headers are not sent by browsers, and are not cross-origin.
+func isCrossOrigin(r *http.Request) bool {
+	if site := r.Header.Get("Sec-Fetch-Site"); site != "" {
+		return site != "same-origin" && site != "none"
+	}
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return false
+	}
+	u, err := url.Parse(origin)
+	return err != nil || u.Host != r.Host
+}
+
+func (h *Handler) write(m *debugMessage, req *writeRequest, isJSON bool) error {
+	h.locker.Lock()
+	defer h.locker.Unlock()
+	if len(req.Signals) > 0 {
+		var err error
+		if isJSON {
+			err = canjson.Unmarshal(req.Signals, m.Message)
+		} else {
+			err = cantext.Unmarshal(req.Signals, m.Message)
+		}
+		if err != nil {
+			return err
+		}
+		names := req.signalNames
+		if isJSON {
+			var fields map[string]json.RawMessage
+			if err := json.Unmarshal(req.Signals, &fields); err != nil {
+				return err
+			}
+			for name := range fields {
+				names = append(names, name)
+			}
+		}
+		trackWrites(m.Message, names)
+	}
+	if req.CyclicTransmissionEnabled != nil {
+		enabler, ok := m.Message.(interface{ SetCyclicTransmissionEnabled(bool) })
+		if !ok {
+			return fmt.Errorf("%s is not a cyclic message", m.Descriptor().Name)
+		}
+		enabler.SetCyclicTransmissionEnabled(*req.CyclicTransmissionEnabled)
+	}
+	return nil
+}
+
+// trackWrites records writes to the named signals of a message tracking writes to its signals, as its setters do, to
+// trigger transmissions according to the send types of the signals. Multiplexed signals not selected by their
+// multiplexers are not written.
+func trackWrites(m generated.Message, names []string) {
+	tracked, ok := m.(canrunner.WriteTracked)
+	if !ok {
+		return
+	}
+	md := m.Descriptor()
+	d := m.Frame().Data
+	for _, name := range names {
+		if s, ok := md.Signal(name); ok && md.IsSignalPresent(s, d) {
+			tracked.WriteTracker().Written(s)
+		}
+	}
+}
+
+// parseWriteForm parses a form with a field per signal, and the optional fields cyclic and transmit, into a request
+// with the signals in the multi-line notation of cantext.Unmarshal.
+func parseWriteForm(r *http.Request, md *descriptor.Message) (writeRequest, error) {
+	var req writeRequest
+	if err := r.ParseForm(); err != nil {
+		return req, err
+	}
+	text := []byte(md.Name)
+	for _, s := range md.Signals {
+		value := strings.TrimSpace(r.PostForm.Get(s.Name))
+		if value == "" {
+			continue
+		}
+		if strings.ContainsAny(value, "\r\n") {
+			return req, fmt.Errorf("%s: invalid value: %q", s.Name, value)
+		}
+		req.signalNames = append(req.signalNames, s.Name)
+		text = append(text, "\n\t"...)
+		text = append(text, s.Name...)
+		text = append(text, ": "...)
+		text = append(text, value...)
+	}
+	if len(text) > len(md.Name) {
+		req.Signals = text
+	}
+	if value := r.PostForm.Get("cyclic"); value != "" {
+		isEnabled, err := strconv.ParseBool(value)
+		if err != nil {
+			return req, fmt.Errorf("cyclic: invalid value: %q", value)
+		}
+		req.CyclicTransmissionEnabled = &isEnabled
+	}
+	if value := r.PostForm.Get("transmit"); value != "" {
+		isTransmit, err := strconv.ParseBool(value)
+		if err != nil {
+			return req, fmt.Errorf("transmit: invalid value: %q", value)
+		}
+		req.Transmit = isTransmit
+	}
+	return req, nil
+}
+
+func isWritable(m generated.Message) bool {
+	_, ok := m.(interface{ Transmit(context.Context) error })
+	return ok
+}
+
+const (
+	contentTypeText        = "text/plain"
+	contentTypeHTML        = "text/html"
+	contentTypeJSON        = "application/json"
+	contentTypeEventStream = "text/event-stream"
+)
+
+// negotiateContentType returns the served content type with the highest quality in an Accept header, and plain text
+// when no served content type is accepted.
+func negotiateContentType(accept string) string {
+	best, bestQuality := contentTypeText, 0.0
+	for _, mediaRange := range strings.Split(accept, ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(mediaRange))
+		if err != nil {
+			continue
+		}
+		quality := 1.0
+		if q, ok := params["q"]; ok {
+			if quality, err = strconv.ParseFloat(q, 64); err != nil {
+				continue
+			}
+		}
+		switch mediaType {
+		case contentTypeText, contentTypeHTML, contentTypeJSON, contentTypeEventStream:
+			if quality > bestQuality {
+				best, bestQuality = mediaType, quality
+			}
+		}
+	}
+	return best
+}
@@ -0,0 +1,391 @@
+package candebug
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"go.einride.tech/can"
+	"go.einride.tech/can/pkg/canrunner"
+	"go.einride.tech/can/pkg/descriptor"
+	"go.einride.tech/can/pkg/generated"
+	"gotest.tools/v3/assert"
+)
+
+func TestHandler_Text(t *testing.T) {
+	ts := newTestHandlerServer(t)
+	res := doRequest(t, ts.URL+"/DriverHeartbeat", http.MethodGet, "", "", nil)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	assert.Equal(t, "text/plain; charset=utf-8", res.Header.Get("Content-Type"))
+	assert.Assert(t, strings.Contains(readBody(t, res), "Command: 0 (0x0) None"))
+}
+
+func TestHandler_JSON(t *testing.T) {
+	ts := newTestHandlerServer(t, withFrame(can.Frame{ID: 100, Length: 1, Data: can.Data{1}}))
+	res := doRequest(t, ts.URL, http.MethodGet, "application/json", "", nil)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	var msgs []messageJSON
+	assert.NilError(t, json.NewDecoder(res.Body).Decode(&msgs))
+	assert.NilError(t, res.Body.Close())
+	assert.Equal(t, 2, len(msgs))
+	assert.Equal(t, "DriverHeartbeat", msgs[0].Name)
+	assert.Equal(t, "Cyclic", msgs[0].SendType)
+	assert.Equal(t, "100ms", msgs[0].CycleTime)
+	assert.Assert(t, msgs[0].IsWritable)
+	assert.Equal(t, false, *msgs[0].CyclicTransmissionEnabled)
+	assert.Equal(t, `{"Command":{"Raw":1,"Physical":1,"Description":"Sync"}}`, string(msgs[0].Signals))
+	assert.Equal(t, 1, len(msgs[0].History["Command"]))
+	assert.Equal(t, 1.0, *msgs[0].History["Command"][0])
+	assert.Assert(t, !msgs[1].IsWritable)
+}
+
+func TestHandler_HTML(t *testing.T) {
+	ts := newTestHandlerServer(t)
+	res := doRequest(t, ts.URL+"/", http.MethodGet, "text/html,application/xhtml+xml,*/*;q=0.8", "", nil)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	body := readBody(t, res)
+	assert.Assert(t, strings.Contains(body, `<form method="post" action="DriverHeartbeat">`))
+	assert.Assert(t, strings.Contains(body, `<input name="Command" placeholder="None">`))
+	assert.Assert(t, strings.Contains(body, `<option value="true">Enable cyclic transmission</option>`))
+	assert.Assert(t, strings.Contains(body, `id="DriverHeartbeat.Command.sparkline" d="M0.0 12.0"`))
+	assert.Assert(t, strings.Contains(body, "new EventSource"))
+}
+
+func TestHandler_Events(t *testing.T) {
+	msg := newTestTxMessage()
+	var mu sync.Mutex
+	h := NewHandler(&mu, []generated.Message{msg}, WithUpdateInterval(time.Millisecond))
+	ts := httptest.NewServer(h)
+	t.Cleanup(ts.Close)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, ts.URL, nil)
+	assert.NilError(t, err)
+	req.Header.Set("Accept", "text/event-stream")
+	res, err := http.DefaultClient.Do(req)
+	assert.NilError(t, err)
+	defer res.Body.Close()
+	assert.Equal(t, "text/event-stream", res.Header.Get("Content-Type"))
+	events := bufio.NewScanner(res.Body)
+	nextEvent := func() []messageJSON {
+		t.Helper()
+		for events.Scan() {
+			if data, ok := strings.CutPrefix(events.Text(), "data: "); ok {
+				var msgs []messageJSON
+				assert.NilError(t, json.Unmarshal([]byte(data), &msgs))
+				return msgs
+			}
+		}
+		t.Fatal("no event")
+		return nil
+	}
+	assert.Equal(t, 1, len(nextEvent()[0].History["Command"]))
+	mu.Lock()
+	msg.frame.Data[0] = 2
+	mu.Unlock()
+	msgs := nextEvent()
+	assert.Equal(t, `{"Command":{"Raw":2,"Physical":2,"Description":"Reboot"}}`, string(msgs[0].Signals))
+	assert.Equal(t, 2, len(msgs[0].History["Command"]))
+}
+
+func TestHandler_WriteForm(t *testing.T) {
+	msg := newTestTxMessage()
+	ts := httptest.NewServer(NewHandler(&sync.Mutex{}, []generated.Message{msg}))
+	t.Cleanup(ts.Close)
+	transmitted := make(chan struct{})
+	msg.transmit = func(context.Context) error {
+		close(transmitted)
+		return nil
+	}
+	form := url.Values{"Command": {"Reboot"}, "cyclic": {"true"}, "transmit": {"true"}}
+	client := &http.Client{
+		CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse },
+	}
+	req, err := http.NewRequestWithContext(
+		context.Background(), http.MethodPost, ts.URL+"/DriverHeartbeat", strings.NewReader(form.Encode()),
+	)
+	assert.NilError(t, err)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Sec-Fetch-Site", "same-origin")
+	req.Header.Set("Origin", ts.URL)
+	req.Header.Set("Referer", "http://example.com/")
+	res, err := client.Do(req)
+	assert.NilError(t, err)
+	assert.NilError(t, res.Body.Close())
+	assert.Equal(t, http.StatusSeeOther, res.StatusCode)
+	assert.Equal(t, "/DriverHeartbeat", res.Header.Get("Location"))
+	assert.Equal(t, uint8(2), msg.frame.Data[0])
+	assert.Assert(t, msg.isCyclicEnabled)
+	<-transmitted
+}
+
+func TestHandler_WriteJSON(t *testing.T) {
+	msg := newTestTxMessage()
+	ts := httptest.NewServer(NewHandler(&sync.Mutex{}, []generated.Message{msg}))
+	t.Cleanup(ts.Close)
+	res := doRequest(
+		t, ts.URL+"/DriverHeartbeat", http.MethodPost, "", "application/json",
+		strings.NewReader(`{"Signals": {"Command": "Sync"}, "CyclicTransmissionEnabled": true}`),
+	)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	var msgs []messageJSON
+	assert.NilError(t, json.NewDecoder(res.Body).Decode(&msgs))
+	assert.NilError(t, res.Body.Close())
+	assert.Equal(t, `{"Command":{"Raw":1,"Physical":1,"Description":"Sync"}}`, string(msgs[0].Signals))
+	assert.Equal(t, true, *msgs[0].CyclicTransmissionEnabled)
+}
+
+func TestHandler_WriteTracked(t *testing.T) {
+	msg := &testTrackedTxMessage{testTxMessage: newTestTxMessage()}
+	ts := httptest.NewServer(NewHandler(&sync.Mutex{}, []generated.Message{msg}))
+	t.Cleanup(ts.Close)
+	command := msg.Descriptor().Signals[0]
+	t.Run("form", func(t *testing.T) {
+		res := doRequest(
+			t, ts.URL+"/DriverHeartbeat", http.MethodPost, "", "application/x-www-form-urlencoded",
+			strings.NewReader("Command=Reboot"),
+		)
+		assert.NilError(t, res.Body.Close())
+		assert.DeepEqual(t, []*descriptor.Signal{command}, msg.tracker.Take())
+	})
+	t.Run("json", func(t *testing.T) {
+		res := doRequest(
+			t, ts.URL+"/DriverHeartbeat", http.MethodPost, "", "application/json",
+			strings.NewReader(`{"Signals": {"Command": "Sync"}}`),
+		)
+		assert.NilError(t, res.Body.Close())
+		assert.DeepEqual(t, []*descriptor.Signal{command}, msg.tracker.Take())
+	})
+	t.Run("cyclic only", func(t *testing.T) {
+		res := doRequest(
+			t, ts.URL+"/DriverHeartbeat", http.MethodPost, "", "application/json",
+			strings.NewReader(`{"CyclicTransmissionEnabled": true}`),
+		)
+		assert.NilError(t, res.Body.Close())
+		assert.Equal(t, 0, len(msg.tracker.Take()))
+	})
+}
+
+func TestHandler_WriteErrors(t *testing.T) {
+	ts := newTestHandlerServer(t)
+	for _, tt := range []struct {
+		name           string
+		path           string
+		contentType    string
+		secFetchSite   string
+		origin         string
+		body           string
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:           "invalid value",
+			path:           "/DriverHeartbeat",
+			contentType:    "application/x-www-form-urlencoded",
+			body:           "Command=Shutdown",
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   `unknown value description: "Shutdown"`,
+		},
+		{
+			name:           "invalid JSON",
+			path:           "/DriverHeartbeat",
+			contentType:    "application/json",
+			body:           `{"Signals": {"Command": {"Raw": 256}}}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   "raw value out of bounds [0, 256): 256",
+		},
+		{
+			name:           "not transmitted",
+			path:           "/MotorStatus",
+			contentType:    "application/json",
+			body:           `{}`,
+			expectedStatus: http.StatusMethodNotAllowed,
+			expectedBody:   "MotorStatus is not a transmitted message",
+		},
+		{
+			name:           "cross-site",
+			path:           "/DriverHeartbeat",
+			contentType:    "application/x-www-form-urlencoded",
+			secFetchSite:   "cross-site",
+			body:           "Command=Reboot",
+			expectedStatus: http.StatusForbidden,
+			expectedBody:   "cross-origin writes are not allowed",
+		},
+		{
+			name:           "cross-origin",
+			path:           "/DriverHeartbeat",
+			contentType:    "text/plain",
+			origin:         "http://example.com",
+			body:           "Command=Reboot",
+			expectedStatus: http.StatusForbidden,
+			expectedBody:   "cross-origin writes are not allowed",
+		},
+		{
+			name:           "no message",
+			path:           "/",
+			contentType:    "application/json",
+			body:           `{}`,
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   "POST requires the path of a message",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := http.NewRequestWithContext(
+				context.Background(), http.MethodPost, ts.URL+tt.path, strings.NewReader(tt.body),
+			)
+			assert.NilError(t, err)
+			req.Header.Set("Content-Type", tt.contentType)
+			if tt.secFetchSite != "" {
+				req.Header.Set("Sec-Fetch-Site", tt.secFetchSite)
+			}
+			if tt.origin != "" {
+				req.Header.Set("Origin", tt.origin)
+			}
+			res, err := http.DefaultClient.Do(req)
+			assert.NilError(t, err)
+			assert.Equal(t, tt.expectedStatus, res.StatusCode)
+			assert.Assert(t, strings.Contains(readBody(t, res), tt.expectedBody))
+		})
+	}
+}
+
+func TestNegotiateContentType(t *testing.T) {
+	for _, tt := range []struct {
+		accept   string
+		expected string
+	}{
+		{accept: "", expected: "text/plain"},
+		{accept: "*/*", expected: "text/plain"},
+		{accept: "application/json", expected: "application/json"},
+		{accept: "text/event-stream", expected: "text/event-stream"},
+		{accept: "text/html,application/xhtml+xml,application/xml;q=0.9,*/*;q=0.8", expected: "text/html"},
+		{accept: "text/plain;q=0.5, application/json;q=0.9", expected: "application/json"},
+	} {
+		t.Run(tt.accept, func(t *testing.T) {
+			assert.Equal(t, tt.expected, negotiateContentType(tt.accept))
+		})
+	}
+}
+
+func TestSparklinePath(t *testing.T) {
+	assert.Equal(t, "", sparklinePath(nil))
+	assert.Equal(t, "M0.0 12.0", sparklinePath([]float64{1}))
+	assert.Equal(t, "M0.0 24.0L40.0 0.0M120.0 12.0", sparklinePath([]float64{0, 2, math.NaN(), 1}))
+}
+
+func TestMessageURL(t *testing.T) {
+	assert.Equal(t, "Foo", messageURL("", "Foo"))
+	assert.Equal(t, "Foo", messageURL("/debug/tx/", "Foo"))
+	assert.Equal(t, "Foo", messageURL("/debug/tx/Foo", "Foo"))
+	assert.Equal(t, "tx/Foo", messageURL("/debug/tx", "Foo"))
+}
+
+type testHandlerOption func(*testTxMessage)
+
+func withFrame(f can.Frame) testHandlerOption {
+	return func(m *testTxMessage) {
+		m.frame = f
+	}
+}
+
+func newTestHandlerServer(t *testing.T, opts ...testHandlerOption) *httptest.Server {
+	t.Helper()
+	tx := newTestTxMessage()
+	for _, opt := range opts {
+		opt(tx)
+	}
+	rx := &testMessage{frame: can.Frame{ID: 400, Length: 3}, descriptor: newMotorStatusDescriptor()}
+	ts := httptest.NewServer(NewHandler(&sync.Mutex{}, []generated.Message{tx, rx}))
+	t.Cleanup(ts.Close)
+	return ts
+}
+
+func doRequest(t *testing.T, target, method, accept, contentType string, body io.Reader) *http.Response {
+	t.Helper()
+	req, err := http.NewRequestWithContext(context.Background(), method, target, body)
+	assert.NilError(t, err)
+	if accept != "" {
+		req.Header.Set("Accept", accept)
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	res, err := http.DefaultClient.Do(req)
+	assert.NilError(t, err)
+	return res
+}
+
+func readBody(t *testing.T, res *http.Response) string {
+	t.Helper()
+	data, err := io.ReadAll(res.Body)
+	assert.NilError(t, err)
+	assert.NilError(t, res.Body.Close())
+	return string(data)
+}
+
+// testTxMessage is a transmitted message with cyclic transmission.
+type testTxMessage struct {
+	testMessage
+	isCyclicEnabled bool
+	transmit        func(context.Context) error
+}
+
+func newTestTxMessage() *testTxMessage {
+	return &testTxMessage{
+		testMessage: testMessage{frame: can.Frame{ID: 100, Length: 1}, descriptor: newDriverHeartbeatDescriptor()},
+		transmit:    func(context.Context) error { return nil },
+	}
+}
+
+func (m *testTxMessage) UnmarshalFrame(f can.Frame) error {
+	m.frame = f
+	return nil
+}
+
+func (m *testTxMessage) TransmitTime() time.Time {
+	return time.Time{}
+}
+
+func (m *testTxMessage) Transmit(ctx context.Context) error {
+	return m.transmit(ctx)
+}
+
+func (m *testTxMessage) IsCyclicTransmissionEnabled() bool {
+	return m.isCyclicEnabled
+}
+
+func (m *testTxMessage) SetCyclicTransmissionEnabled(b bool) {
+	m.isCyclicEnabled = b
+}
+
+// testTrackedTxMessage is a transmitted message tracking writes to its signals.
+type testTrackedTxMessage struct {
+	*testTxMessage
+	tracker canrunner.WriteTracker
+}
+
+func (m *testTrackedTxMessage) WriteTracker() *canrunner.WriteTracker {
+	return &m.tracker
+}
+
+func newMotorStatusDescriptor() *descriptor.Message {
+	return &descriptor.Message{
+		Name:       "MotorStatus",
+		SenderNode: "MOTOR",
+		ID:         400,
+		Length:     3,
+		Signals: []*descriptor.Signal{
+			{Name: "WheelError", Length: 1, Scale: 1},
+			{Name: "SpeedKph", Start: 8, Length: 16, Scale: 0.001, Unit: "km/h"},
+		},
+	}
+}
@@ -0,0 +1,251 @@
+package candebug
+
+import (
+	"encoding/json"
+	"html/template"
+	"math"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.einride.tech/can/pkg/canjson"
+	"go.einride.tech/can/pkg/cantext"
+)
+
+const (
+	sparklineWidth  = 120
+	sparklineHeight = 24
+)
+
+// htmlMessage is the data of a message on the HTML page.
+type htmlMessage struct {
+	Name string
+	// Info lines of the message, as in the plain text.
+	Info          []string
+	Time          string
+	Action        string
+	IsWritable    bool
+	IsCyclic      bool
+	CyclicEnabled bool
+	Signals       []htmlSignal
+}
+
+type htmlSignal struct {
+	Name      string
+	Value     string
+	Compact   string
+	Sparkline string
+}
+
+// signalJSON is a signal in the notation of canjson.Marshal.
+type signalJSON struct {
+	Raw         json.Number
+	Physical    json.Number
+	Unit        string
+	Description string
+}
+
+// signalValueText returns the text of a signal value on the HTML page, formatted as by the page script.
+func signalValueText(s signalJSON) string {
+	text := s.Physical.String() + s.Unit + " (" + s.Raw.String() + ")"
+	if s.Description != "" {
+		text += " " + s.Description
+	}
+	return text
+}
+
+func (h *Handler) serveHTML(w http.ResponseWriter, r *http.Request, msgs []*debugMessage) {
+	page, err := h.htmlMessages(r, msgs)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	_ = htmlTemplate.Execute(w, page)
+}
+
+func (h *Handler) htmlMessages(r *http.Request, msgs []*debugMessage) ([]htmlMessage, error) {
+	h.locker.Lock()
+	defer h.locker.Unlock()
+	h.sample(msgs)
+	result := make([]htmlMessage, 0, len(msgs))
+	for _, m := range msgs {
+		md := m.Descriptor()
+		data, err := canjson.Marshal(m.Message)
+		if err != nil {
+			return nil, err
+		}
+		var signals map[string]signalJSON
+		if err := json.Unmarshal(data, &signals); err != nil {
+			return nil, err
+		}
+		hm := htmlMessage{
+			Name:       md.Name,
+			Action:     messageURL(r.URL.Path, md.Name),
+			IsWritable: isWritable(m.Message),
+		}
+		hm.Info = append(hm.Info, string(cantext.AppendID(nil, md)))
+		hm.Info = append(hm.Info, string(cantext.AppendSender(nil, md)))
+		hm.Info = append(hm.Info, string(cantext.AppendSendType(nil, md)))
+		if md.SendType.IsCyclic() {
+			hm.Info = append(hm.Info, string(cantext.AppendCycleTime(nil, md)))
+		}
+		if md.DelayTime != 0 {
+			hm.Info = append(hm.Info, string(cantext.AppendDelayTime(nil, md)))
+		}
+		if enabler, ok := m.Message.(interface{ IsCyclicTransmissionEnabled() bool }); ok {
+			hm.IsCyclic = true
+			hm.CyclicEnabled = enabler.IsCyclicTransmissionEnabled()
+		}
+		if timer, ok := m.Message.(interface{ ReceiveTime() time.Time }); ok {
+			hm.Time = "Received: " + string(appendTime(nil, timer.ReceiveTime()))
+		} else if timer, ok := m.Message.(interface{ TransmitTime() time.Time }); ok {
+			hm.Time = "Transmitted: " + string(appendTime(nil, timer.TransmitTime()))
+		}
+		f := m.Frame()
+		for i, s := range md.Signals {
+			compact := string(cantext.AppendSignalCompact(nil, s, f.Data))
+			hm.Signals = append(hm.Signals, htmlSignal{
+				Name:      s.Name,
+				Value:     signalValueText(signals[s.Name]),
+				Compact:   strings.TrimPrefix(compact, s.Name+": "),
+				Sparkline: sparklinePath(m.history[i]),
+			})
+		}
+		result = append(result, hm)
+	}
+	return result, nil
+}
+
+// messageURL returns the URL of a message relative to the page at the provided path.
+func messageURL(pagePath, name string) string {
+	if pagePath == "" || strings.HasSuffix(pagePath, "/") || path.Base(pagePath) == name {
+		return name
+	}
+	return path.Base(pagePath) + "/" + name
+}
+
+// sparklinePath returns the SVG path of a sparkline of values, with gaps for NaN values.
+func sparklinePath(values []float64) string {
+	minValue, maxValue := math.Inf(1), math.Inf(-1)
+	for _, value := range values {
+		if !math.IsNaN(value) {
+			minValue, maxValue = math.Min(minValue, value), math.Max(maxValue, value)
+		}
+	}
+	var buf []byte
+	isGap := true
+	for i, value := range values {
+		if math.IsNaN(value) {
+			isGap = true
+			continue
+		}
+		x := 0.0
+		if len(values) > 1 {
+			x = float64(i) * sparklineWidth / float64(len(values)-1)
+		}
+		y := sparklineHeight / 2.0
+		if maxValue > minValue {
+			y = sparklineHeight - (value-minValue)*sparklineHeight/(maxValue-minValue)
+		}
+		if isGap {
+			buf = append(buf, 'M')
+		} else {
+			buf = append(buf, 'L')
+		}
+		buf = strconv.AppendFloat(buf, x, 'f', 1, 64)
+		buf = append(buf, ' ')
+		buf = strconv.AppendFloat(buf, y, 'f', 1, 64)
+		isGap = false
+	}
+	return string(buf)
+}
+
+var htmlTemplate = template.Must(template.New("candebug").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>CAN debug</title>
+<style>
+body { font-family: monospace; margin: 1em; }
+section { margin-bottom: 2em; }
+table { border-collapse: collapse; }
+td, th { padding: 0.2em 0.8em; text-align: left; border-bottom: 1px solid #ddd; }
+path { fill: none; stroke: #36c; stroke-width: 1.5; }
+</style>
+</head>
+<body>
+{{range .}}
+<section id="{{.Name}}">
+<h2>{{.Name}}</h2>
+<p>{{range .Info}}{{.}}<br>{{end}}
+{{if .IsCyclic}}Enabled: <span id="{{.Name}}.cyclic">{{.CyclicEnabled}}</span><br>{{end}}
+{{if .Time}}<span id="{{.Name}}.time">{{.Time}}</span>{{end}}</p>
+{{$message := .}}
+<form method="post" action="{{.Action}}">
+<table>
+<tr><th>Signal</th><th>Value</th><th>History</th>{{if .IsWritable}}<th>Set</th>{{end}}</tr>
+{{range .Signals}}
+<tr>
+<td>{{.Name}}</td>
+<td id="{{$message.Name}}.{{.Name}}.value">{{.Value}}</td>
+<td><svg width="120" height="24"><path id="{{$message.Name}}.{{.Name}}.sparkline" d="{{.Sparkline}}"/></svg></td>
+{{if $message.IsWritable}}<td><input name="{{.Name}}" placeholder="{{.Compact}}"></td>{{end}}
+</tr>
+{{end}}
+</table>
+{{if .IsWritable}}
+<p>
+{{if .IsCyclic}}
+<select name="cyclic">
+<option value="">Cyclic transmission unchanged</option>
+<option value="true">Enable cyclic transmission</option>
+<option value="false">Disable cyclic transmission</option>
+</select>
+{{end}}
+<button type="submit" name="transmit" value="false">Set</button>
+<button type="submit" name="transmit" value="true">Set and transmit</button>
+</p>
+{{end}}
+</form>
+</section>
+{{end}}
+<script>
+function sparkline(values) {
+  const defined = values.filter((v) => v !== null);
+  const min = Math.min(...defined), max = Math.max(...defined);
+  let d = "", isGap = true;
+  values.forEach((v, i) => {
+    if (v === null) { isGap = true; return; }
+    const x = values.length > 1 ? i * 120 / (values.length - 1) : 0;
+    const y = max > min ? 24 - (v - min) * 24 / (max - min) : 12;
+    d += (isGap ? "M" : "L") + x.toFixed(1) + " " + y.toFixed(1);
+    isGap = false;
+  });
+  return d;
+}
+function setText(id, text) {
+  const element = document.getElementById(id);
+  if (element) { element.textContent = text; }
+}
+const events = new EventSource(location.href);
+events.onmessage = (event) => {
+  for (const m of JSON.parse(event.data)) {
+    for (const [name, s] of Object.entries(m.Signals)) {
+      setText(m.Name + "." + name + ".value",
+        s.Physical + (s.Unit || "") + " (" + s.Raw + ")" + (s.Description ? " " + s.Description : ""));
+      const path = document.getElementById(m.Name + "." + name + ".sparkline");
+      if (path) { path.setAttribute("d", sparkline(m.History[name])); }
+    }
+    if (m.CyclicTransmissionEnabled !== undefined) { setText(m.Name + ".cyclic", m.CyclicTransmissionEnabled); }
+    if (m.ReceiveTime) { setText(m.Name + ".time", "Received: " + m.ReceiveTime); }
+    if (m.TransmitTime) { setText(m.Name + ".time", "Transmitted: " + m.TransmitTime); }
+  }
+};
+</script>
+</body>
+</html>
+`))
@@ -0,0 +1,266 @@
+// Package candiff provides primitives for semantic comparison of CAN databases.
+package candiff
+
+import (
+	"strconv"
+	"strings"
+
+	"go.einride.tech/can/pkg/descriptor"
+)
+
+// ChangeType is the type of a change between two databases.
+type ChangeType string
+
+const (
+	// ChangeTypeAdded means the object was added.
+	ChangeTypeAdded ChangeType = "added"
+	// ChangeTypeRemoved means the object was removed.
+	ChangeTypeRemoved ChangeType = "removed"
+	// ChangeTypeChanged means a property of the object was changed.
+	ChangeTypeChanged ChangeType = "changed"
+)
+
+// Change describes a single difference between two databases.
+type Change struct {
+	// Type of the change.
+	Type ChangeType
+	// Node is the name of the changed node.
+	Node string `json:",omitempty"`
+	// Message is the name of the changed message, or the message of the changed signal.
+	Message string `json:",omitempty"`
+	// Signal is the name of the changed signal.
+	Signal string `json:",omitempty"`
+	// Property is the name of the changed property, for changes of type ChangeTypeChanged.
+	Property string `json:",omitempty"`
+	// Old value of the property.
+	Old string `json:",omitempty"`
+	// New value of the property.
+	New string `json:",omitempty"`
+	// IsBreaking is true if the change breaks wire compatibility.
+	IsBreaking bool
+}
+
+// String returns a human-readable representation of the change.
+func (c *Change) String() string {
+	var b strings.Builder
+	switch c.Type {
+	case ChangeTypeAdded:
+		b.WriteString("+ ")
+	case ChangeTypeRemoved:
+		b.WriteString("- ")
+	default:
+		b.WriteString("~ ")
+	}
+	switch {
+	case c.Node != "":
+		b.WriteString("node ")
+		b.WriteString(c.Node)
+	case c.Signal != "":
+		b.WriteString("signal ")
+		b.WriteString(c.Message)
+		b.WriteByte('.')
+		b.WriteString(c.Signal)
+	default:
+		b.WriteString("message ")
+		b.WriteString(c.Message)
+	}
+	if c.Type == ChangeTypeChanged {
+		b.WriteString(": ")
+		b.WriteString(c.Property)
+		b.WriteString(" ")
+		b.WriteString(strconv.Quote(c.Old))
+		b.WriteString(" -> ")
+		b.WriteString(strconv.Quote(c.New))
+	}
+	if c.IsBreaking {
+		b.WriteString(" (breaking)")
+	}
+	return b.String()
+}
+
+// Diff returns the semantic differences between the old and the new database.
+//
+// Nodes, messages and signals are matched by name.
+func Diff(oldDB, newDB *descriptor.Database) []*Change {
+	var changes []*Change
+	changes = append(changes, diffNodes(oldDB, newDB)...)
+	changes = append(changes, diffMessages(oldDB, newDB)...)
+	return changes
+}
+
+// HasBreakingChanges returns true if any of the changes breaks wire compatibility.
+func HasBreakingChanges(changes []*Change) bool {
+	for _, c := range changes {
+		if c.IsBreaking {
+			return true
+		}
+	}
+	return false
+}
+
+func diffNodes(oldDB, newDB *descriptor.Database) []*Change {
+	var changes []*Change
+	for _, n := range oldDB.Nodes {
+		if _, ok := newDB.Node(n.Name); !ok {
+			changes = append(changes, &Change{Type: ChangeTypeRemoved, Node: n.Name})
+		}
+	}
+	for _, n := range newDB.Nodes {
+		if _, ok := oldDB.Node(n.Name); !ok {
+			changes = append(changes, &Change{Type: ChangeTypeAdded, Node: n.Name})
+		}
+	}
+	return changes
+}
+
+func diffMessages(oldDB, newDB *descriptor.Database) []*Change {
+	var changes []*Change
+	for _, oldMsg := range oldDB.Messages {
+		newMsg, ok := newDB.MessageByName(oldMsg.Name)
+		if !ok {
+			changes = append(changes, &Change{Type: ChangeTypeRemoved, Message: oldMsg.Name, IsBreaking: true})
+			continue
+		}
+		changes = append(changes, diffMessage(oldMsg, newMsg)...)
+	}
+	for _, newMsg := range newDB.Messages {
+		if _, ok := oldDB.MessageByName(newMsg.Name); !ok {
+			changes = append(changes, &Change{Type: ChangeTypeAdded, Message: newMsg.Name})
+		}
+	}
+	return changes
+}
+
+func diffMessage(oldMsg, newMsg *descriptor.Message) []*Change {
+	var changes []*Change
+	property := func(name, oldValue, newValue string, isBreaking bool) {
+		if oldValue == newValue {
+			return
+		}
+		changes = append(changes, &Change{
+			Type:       ChangeTypeChanged,
+			Message:    oldMsg.Name,
+			Property:   name,
+			Old:        oldValue,
+			New:        newValue,
+			IsBreaking: isBreaking,
+		})
+	}
+	property("ID", formatUint(uint64(oldMsg.ID)), formatUint(uint64(newMsg.ID)), true)
+	property("IsExtended", strconv.FormatBool(oldMsg.IsExtended), strconv.FormatBool(newMsg.IsExtended), true)
+	property("Length", formatUint(uint64(oldMsg.Length)), formatUint(uint64(newMsg.Length)), true)
+	property("SenderNode", oldMsg.SenderNode, newMsg.SenderNode, false)
+	property("SendType", oldMsg.SendType.String(), newMsg.SendType.String(), false)
+	property("CycleTime", oldMsg.CycleTime.String(), newMsg.CycleTime.String(), false)
+	property("CycleTimeFast", oldMsg.CycleTimeFast.String(), newMsg.CycleTimeFast.String(), false)
+	property("DelayTime", oldMsg.DelayTime.String(), newMsg.DelayTime.String(), false)
+	property(
+		"RepetitionCount", strconv.Itoa(oldMsg.RepetitionCount), strconv.Itoa(newMsg.RepetitionCount), false,
+	)
+	for _, oldSig := range oldMsg.Signals {
+		newSig, ok := newMsg.Signal(oldSig.Name)
+		if !ok {
+			changes = append(changes, &Change{
+				Type:       ChangeTypeRemoved,
+				Message:    oldMsg.Name,
+				Signal:     oldSig.Name,
+				IsBreaking: true,
+			})
+			continue
+		}
+		changes = append(changes, diffSignal(oldMsg, oldSig, newSig)...)
+	}
+	for _, newSig := range newMsg.Signals {
+		if _, ok := oldMsg.Signal(newSig.Name); !ok {
+			changes = append(changes, &Change{Type: ChangeTypeAdded, Message: oldMsg.Name, Signal: newSig.Name})
+		}
+	}
+	return changes
+}
+
+func diffSignal(m *descriptor.Message, oldSig, newSig *descriptor.Signal) []*Change {
+	var changes []*Change
+	property := func(name, oldValue, newValue string, isBreaking bool) {
+		if oldValue == newValue {
+			return
+		}
+		changes = append(changes, &Change{
+			Type:       ChangeTypeChanged,
+			Message:    m.Name,
+			Signal:     oldSig.Name,
+			Property:   name,
+			Old:        oldValue,
+			New:        newValue,
+			IsBreaking: isBreaking,
+		})
+	}
+	property("Start", formatUint(uint64(oldSig.Start)), formatUint(uint64(newSig.Start)), true)
+	property("Length", formatUint(uint64(oldSig.Length)), formatUint(uint64(newSig.Length)), true)
+	property("IsBigEndian", strconv.FormatBool(oldSig.IsBigEndian), strconv.FormatBool(newSig.IsBigEndian), true)
+	property("IsSigned", strconv.FormatBool(oldSig.IsSigned), strconv.FormatBool(newSig.IsSigned), true)
+	property("IsFloat", strconv.FormatBool(oldSig.IsFloat), strconv.FormatBool(newSig.IsFloat), true)
+	property(
+		"IsMultiplexer", strconv.FormatBool(oldSig.IsMultiplexer), strconv.FormatBool(newSig.IsMultiplexer), true,
+	)
+	property(
+		"IsMultiplexed", strconv.FormatBool(oldSig.IsMultiplexed), strconv.FormatBool(newSig.IsMultiplexed), true,
+	)
+	property(
+		"MultiplexerValue",
+		formatUint(uint64(oldSig.MultiplexerValue)),
+		formatUint(uint64(newSig.MultiplexerValue)),
+		true,
+	)
+	property("MultiplexerName", oldSig.MultiplexerName, newSig.MultiplexerName, true)
+	property(
+		"MultiplexerRanges",
+		formatMultiplexerRanges(oldSig.MultiplexerRanges),
+		formatMultiplexerRanges(newSig.MultiplexerRanges),
+		true,
+	)
+	property("Scale", formatFloat(oldSig.Scale), formatFloat(newSig.Scale), true)
+	property("Offset", formatFloat(oldSig.Offset), formatFloat(newSig.Offset), true)
+	property("Min", formatFloat(oldSig.Min), formatFloat(newSig.Min), false)
+	property("Max", formatFloat(oldSig.Max), formatFloat(newSig.Max), false)
+	property("Unit", oldSig.Unit, newSig.Unit, false)
+	property("DefaultValue", strconv.Itoa(oldSig.DefaultValue), strconv.Itoa(newSig.DefaultValue), false)
+	property("SendType", oldSig.SendType.String(), newSig.SendType.String(), false)
+	property("InactiveValue", strconv.Itoa(oldSig.InactiveValue), strconv.Itoa(newSig.InactiveValue), false)
+	property(
+		"ValueDescriptions",
+		formatValueDescriptions(oldSig.ValueDescriptions),
+		formatValueDescriptions(newSig.ValueDescriptions),
+		false,
+	)
+	property("ReceiverNodes", strings.Join(oldSig.ReceiverNodes, ","), strings.Join(newSig.ReceiverNodes, ","), false)
+	return changes
+}
+
+func formatUint(i uint64) string {
+	return strconv.FormatUint(i, 10)
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
+
+func formatValueDescriptions(vds []*descriptor.ValueDescription) string {
+	var b strings.Builder
+	for i, vd := range vds {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		b.WriteString(strconv.FormatInt(vd.Value, 10))
+		b.WriteString("=")
+		b.WriteString(vd.Description)
+	}
+	return b.String()
+}
+
+func formatMultiplexerRanges(ranges []*descriptor.MultiplexerRange) string {
+	values := make([]string, 0, len(ranges))
+	for _, r := range ranges {
+		values = append(values, r.String())
+	}
+	return strings.Join(values, ",")
+}
@@ -0,0 +1,163 @@
+package candiff
+
+import (
+	"testing"
+	"time"
+
+	"go.einride.tech/can/pkg/descriptor"
+	"gotest.tools/v3/assert"
+)
+
+func TestDiff(t *testing.T) {
+	oldDB := &descriptor.Database{
+		Nodes: []*descriptor.Node{{Name: "ECU1"}, {Name: "ECU2"}},
+		Messages: []*descriptor.Message{
+			{
+				Name:      "Status",
+				ID:        100,
+				Length:    8,
+				SendType:  descriptor.SendTypeCyclic,
+				CycleTime: 100 * time.Millisecond,
+				Signals: []*descriptor.Signal{
+					{Name: "Speed", Start: 0, Length: 16, Scale: 0.1, Unit: "km/h"},
+					{Name: "Mode", Start: 16, Length: 2, Scale: 1},
+				},
+			},
+			{Name: "Removed", ID: 200, Length: 1},
+		},
+	}
+	newDB := &descriptor.Database{
+		Nodes: []*descriptor.Node{{Name: "ECU1"}, {Name: "ECU3"}},
+		Messages: []*descriptor.Message{
+			{
+				Name:      "Status",
+				ID:        100,
+				Length:    8,
+				SendType:  descriptor.SendTypeCyclic,
+				CycleTime: 50 * time.Millisecond,
+				Signals: []*descriptor.Signal{
+					{Name: "Speed", Start: 0, Length: 16, Scale: 0.01, Unit: "km/h"},
+					{Name: "Mode", Start: 16, Length: 2, Scale: 1, ValueDescriptions: []*descriptor.ValueDescription{
+						{Value: 0, Description: "Off"},
+					}},
+					{Name: "Added", Start: 32, Length: 8, Scale: 1},
+				},
+			},
+			{Name: "Added", ID: 300, Length: 1},
+		},
+	}
+	expected := []*Change{
+		{Type: ChangeTypeRemoved, Node: "ECU2"},
+		{Type: ChangeTypeAdded, Node: "ECU3"},
+		{Type: ChangeTypeChanged, Message: "Status", Property: "CycleTime", Old: "100ms", New: "50ms"},
+		{
+			Type:       ChangeTypeChanged,
+			Message:    "Status",
+			Signal:     "Speed",
+			Property:   "Scale",
+			Old:        "0.1",
+			New:        "0.01",
+			IsBreaking: true,
+		},
+		{Type: ChangeTypeChanged, Message: "Status", Signal: "Mode", Property: "ValueDescriptions", New: "0=Off"},
+		{Type: ChangeTypeAdded, Message: "Status", Signal: "Added"},
+		{Type: ChangeTypeRemoved, Message: "Removed", IsBreaking: true},
+		{Type: ChangeTypeAdded, Message: "Added"},
+	}
+	actual := Diff(oldDB, newDB)
+	assert.DeepEqual(t, expected, actual)
+	assert.Assert(t, HasBreakingChanges(actual))
+}
+
+func TestDiff_ExtendedMultiplexing(t *testing.T) {
+	newDatabase := func(multiplexerName string, ranges ...*descriptor.MultiplexerRange) *descriptor.Database {
+		return &descriptor.Database{
+			Messages: []*descriptor.Message{
+				{
+					Name:   "Status",
+					ID:     100,
+					Length: 8,
+					Signals: []*descriptor.Signal{
+						{Name: "Mux1", Length: 4, IsMultiplexer: true},
+						{Name: "Mux2", Start: 4, Length: 4, IsMultiplexer: true},
+						{
+							Name:              "Value",
+							Start:             8,
+							Length:            8,
+							IsMultiplexed:     true,
+							MultiplexerName:   multiplexerName,
+							MultiplexerRanges: ranges,
+						},
+					},
+				},
+			},
+		}
+	}
+	oldDB := newDatabase("Mux1", &descriptor.MultiplexerRange{Min: 1, Max: 3})
+	newDB := newDatabase(
+		"Mux2", &descriptor.MultiplexerRange{Min: 1, Max: 2}, &descriptor.MultiplexerRange{Min: 5, Max: 5},
+	)
+	expected := []*Change{
+		{
+			Type:       ChangeTypeChanged,
+			Message:    "Status",
+			Signal:     "Value",
+			Property:   "MultiplexerName",
+			Old:        "Mux1",
+			New:        "Mux2",
+			IsBreaking: true,
+		},
+		{
+			Type:       ChangeTypeChanged,
+			Message:    "Status",
+			Signal:     "Value",
+			Property:   "MultiplexerRanges",
+			Old:        "1-3",
+			New:        "1-2,5",
+			IsBreaking: true,
+		},
+	}
+	assert.DeepEqual(t, expected, Diff(oldDB, newDB))
+}
+
+func TestDiff_Equal(t *testing.T) {
+	db := &descriptor.Database{
+		Nodes: []*descriptor.Node{{Name: "ECU1"}},
+		Messages: []*descriptor.Message{
+			{Name: "Status", ID: 100, Length: 8, Signals: []*descriptor.Signal{{Name: "Speed", Length: 16}}},
+		},
+	}
+	assert.Equal(t, 0, len(Diff(db, db)))
+}
+
+func TestChange_String(t *testing.T) {
+	for _, tt := range []struct {
+		change   *Change
+		expected string
+	}{
+		{
+			change:   &Change{Type: ChangeTypeAdded, Node: "ECU1"},
+			expected: "+ node ECU1",
+		},
+		{
+			change:   &Change{Type: ChangeTypeRemoved, Message: "Status", IsBreaking: true},
+			expected: "- message Status (breaking)",
+		},
+		{
+			change: &Change{
+				Type:       ChangeTypeChanged,
+				Message:    "Status",
+				Signal:     "Speed",
+				Property:   "Start",
+				Old:        "0",
+				New:        "8",
+				IsBreaking: true,
+			},
+			expected: `~ signal Status.Speed: Start "0" -> "8" (breaking)`,
+		},
+	} {
+		t.Run(tt.expected, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.change.String())
+		})
+	}
+}
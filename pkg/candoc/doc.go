@@ -0,0 +1,290 @@
+// Package candoc provides primitives for generating documentation of CAN databases.
+package candoc
+
+import (
+	"bufio"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+
+	"go.einride.tech/can/pkg/descriptor"
+)
+
+// WriteMarkdown writes Markdown documentation of the database to the writer.
+func WriteMarkdown(w io.Writer, d *descriptor.Database) error {
+	bw := bufio.NewWriter(w)
+	writeDatabase(&markdownWriter{w: bw}, d)
+	return bw.Flush()
+}
+
+// WriteHTML writes HTML documentation of the database to the writer.
+func WriteHTML(w io.Writer, d *descriptor.Database) error {
+	bw := bufio.NewWriter(w)
+	hw := &htmlWriter{w: bw}
+	hw.begin(d.Name())
+	writeDatabase(hw, d)
+	hw.end()
+	return bw.Flush()
+}
+
+// docWriter is the interface for the output formats of the documentation.
+type docWriter interface {
+	heading(level int, text, anchor string)
+	paragraph(text string)
+	table(header []string, rows [][]cell)
+}
+
+// cell is a table cell, optionally linking to an anchor in the documentation.
+type cell struct {
+	text string
+	link string
+}
+
+func writeDatabase(dw docWriter, d *descriptor.Database) {
+	dw.heading(1, d.Name(), "")
+	dw.paragraph("Source: " + d.SourceFile)
+	if d.Version != "" {
+		dw.paragraph("Version: " + d.Version)
+	}
+	a := newAnchors(d)
+	dw.heading(2, "Nodes", "nodes")
+	nodeRows := make([][]cell, 0, len(d.Nodes))
+	for _, n := range d.Nodes {
+		nodeRows = append(nodeRows, []cell{
+			{text: n.Name, link: a.nodes[n]},
+			{text: n.Description},
+		})
+	}
+	dw.table([]string{"Node", "Description"}, nodeRows)
+	for _, n := range d.Nodes {
+		writeNode(dw, a, d, n)
+	}
+	dw.heading(2, "Messages", "messages")
+	messageRows := make([][]cell, 0, len(d.Messages))
+	for _, m := range d.Messages {
+		messageRows = append(messageRows, messageRow(a, m))
+	}
+	dw.table(messageHeader, messageRows)
+	for _, m := range d.Messages {
+		writeMessage(dw, a, m)
+	}
+}
+
+func writeNode(dw docWriter, a *anchors, d *descriptor.Database, n *descriptor.Node) {
+	dw.heading(3, n.Name, a.nodes[n])
+	if n.Description != "" {
+		dw.paragraph(n.Description)
+	}
+	var txRows, rxRows [][]cell
+	for _, m := range d.Messages {
+		if isSender(m, n) {
+			txRows = append(txRows, messageRow(a, m))
+		}
+		if isReceiver(m, n) {
+			rxRows = append(rxRows, messageRow(a, m))
+		}
+	}
+	dw.paragraph("Transmitted messages:")
+	dw.table(messageHeader, txRows)
+	dw.paragraph("Received messages:")
+	dw.table(messageHeader, rxRows)
+}
+
+var messageHeader = []string{"Message", "ID", "Length", "Sender", "Send type", "Cycle time", "Description"}
+
+func messageRow(a *anchors, m *descriptor.Message) []cell {
+	var cycleTime string
+	if m.CycleTime != 0 {
+		cycleTime = m.CycleTime.String()
+	}
+	return []cell{
+		{text: m.Name, link: a.messages[m]},
+		{text: formatID(m)},
+		{text: strconv.Itoa(int(m.Length))},
+		{text: strings.Join(senders(m), ", ")},
+		{text: m.SendType.String()},
+		{text: cycleTime},
+		{text: m.Description},
+	}
+}
+
+func writeMessage(dw docWriter, a *anchors, m *descriptor.Message) {
+	dw.heading(3, m.Name, a.messages[m])
+	if m.Description != "" {
+		dw.paragraph(m.Description)
+	}
+	properties := [][]cell{
+		{{text: "ID"}, {text: formatID(m)}},
+		{{text: "Length"}, {text: strconv.Itoa(int(m.Length)) + " bytes"}},
+		{{text: "Sender"}, {text: strings.Join(senders(m), ", ")}},
+		{{text: "Send type"}, {text: m.SendType.String()}},
+	}
+	if m.CycleTime != 0 {
+		properties = append(properties, []cell{{text: "Cycle time"}, {text: m.CycleTime.String()}})
+	}
+	if m.CycleTimeFast != 0 {
+		properties = append(properties, []cell{{text: "Fast cycle time"}, {text: m.CycleTimeFast.String()}})
+	}
+	if m.DelayTime != 0 {
+		properties = append(properties, []cell{{text: "Delay time"}, {text: m.DelayTime.String()}})
+	}
+	if m.RepetitionCount != 0 {
+		properties = append(properties, []cell{{text: "Repetitions"}, {text: strconv.Itoa(m.RepetitionCount)}})
+	}
+	dw.table([]string{"Property", "Value"}, properties)
+	signalRows := make([][]cell, 0, len(m.Signals))
+	for _, s := range m.Signals {
+		signalRows = append(signalRows, signalRow(s))
+	}
+	dw.table(
+		[]string{
+			"Signal", "Start", "Length", "Byte order", "Type", "Scale", "Offset", "Range", "Unit",
+			"Multiplexing", "Receivers", "Values", "Description",
+		},
+		signalRows,
+	)
+}
+
+func signalRow(s *descriptor.Signal) []cell {
+	byteOrder := "little-endian"
+	if s.IsBigEndian {
+		byteOrder = "big-endian"
+	}
+	var valueType string
+	switch {
+	case s.IsFloat:
+		valueType = "float"
+	case s.Length == 1:
+		valueType = "bool"
+	case s.IsSigned:
+		valueType = "signed"
+	default:
+		valueType = "unsigned"
+	}
+	var signalRange string
+	if s.Min != 0 || s.Max != 0 {
+		signalRange = "[" + formatFloat(s.Min) + ", " + formatFloat(s.Max) + "]"
+	}
+	var multiplexing []string
+	if s.IsMultiplexer {
+		multiplexing = append(multiplexing, "multiplexer")
+	}
+	if s.IsMultiplexed {
+		multiplexing = append(multiplexing, multiplexingCondition(s))
+	}
+	values := make([]string, 0, len(s.ValueDescriptions))
+	for _, vd := range s.ValueDescriptions {
+		values = append(values, strconv.Itoa(int(vd.Value))+": "+vd.Description)
+	}
+	return []cell{
+		{text: s.Name},
+		{text: strconv.Itoa(int(s.Start))},
+		{text: strconv.Itoa(int(s.Length))},
+		{text: byteOrder},
+		{text: valueType},
+		{text: formatFloat(s.Scale)},
+		{text: formatFloat(s.Offset)},
+		{text: signalRange},
+		{text: s.Unit},
+		{text: strings.Join(multiplexing, ", ")},
+		{text: strings.Join(s.ReceiverNodes, ", ")},
+		{text: strings.Join(values, ", ")},
+		{text: s.Description},
+	}
+}
+
+// multiplexingCondition formats the multiplexer values selecting a multiplexed signal as m<values>, followed by the
+// name of the multiplexer for extended multiplexing.
+func multiplexingCondition(s *descriptor.Signal) string {
+	values := strconv.Itoa(int(s.MultiplexerValue))
+	if len(s.MultiplexerRanges) > 0 {
+		ranges := make([]string, 0, len(s.MultiplexerRanges))
+		for _, r := range s.MultiplexerRanges {
+			ranges = append(ranges, r.String())
+		}
+		values = strings.Join(ranges, ",")
+	}
+	if s.MultiplexerName != "" {
+		return "m" + values + " of " + s.MultiplexerName
+	}
+	return "m" + values
+}
+
+// isSender returns true if the node is the sender of the message, or one of its additional transmitters.
+func isSender(m *descriptor.Message, n *descriptor.Node) bool {
+	return m.SenderNode == n.Name || slices.Contains(m.TransmitterNodes, n.Name)
+}
+
+// senders returns the sender of the message followed by its additional transmitters.
+func senders(m *descriptor.Message) []string {
+	var result []string
+	if m.SenderNode != "" {
+		result = append(result, m.SenderNode)
+	}
+	for _, transmitter := range m.TransmitterNodes {
+		if !slices.Contains(result, transmitter) {
+			result = append(result, transmitter)
+		}
+	}
+	return result
+}
+
+func isReceiver(m *descriptor.Message, n *descriptor.Node) bool {
+	for _, s := range m.Signals {
+		for _, receiver := range s.ReceiverNodes {
+			if receiver == n.Name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func formatID(m *descriptor.Message) string {
+	id := strconv.Itoa(int(m.ID)) + " (0x" + strconv.FormatUint(uint64(m.ID), 16) + ")"
+	if m.IsExtended {
+		id += " extended"
+	}
+	return id
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
+
+// anchors are the anchors of the sections of the nodes and messages of a database.
+type anchors struct {
+	nodes    map[*descriptor.Node]string
+	messages map[*descriptor.Message]string
+	used     map[string]struct{}
+}
+
+func newAnchors(d *descriptor.Database) *anchors {
+	a := &anchors{
+		nodes:    make(map[*descriptor.Node]string, len(d.Nodes)),
+		messages: make(map[*descriptor.Message]string, len(d.Messages)),
+		used:     map[string]struct{}{"nodes": {}, "messages": {}},
+	}
+	for _, n := range d.Nodes {
+		a.nodes[n] = a.add("node-" + strings.ToLower(n.Name))
+	}
+	for _, m := range d.Messages {
+		a.messages[m] = a.add("message-" + strings.ToLower(m.Name))
+	}
+	return a
+}
+
+// add returns a unique anchor, suffixed with a number when the anchor is already used, as by names that differ only
+// by case.
+func (a *anchors) add(anchor string) string {
+	unique := anchor
+	for i := 2; ; i++ {
+		if _, ok := a.used[unique]; !ok {
+			break
+		}
+		unique = anchor + "-" + strconv.Itoa(i)
+	}
+	a.used[unique] = struct{}{}
+	return unique
+}
@@ -0,0 +1,206 @@
+package candoc
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"go.einride.tech/can/pkg/descriptor"
+	"gotest.tools/v3/assert"
+)
+
+func testDatabase() *descriptor.Database {
+	return &descriptor.Database{
+		SourceFile: "test.dbc",
+		Nodes: []*descriptor.Node{
+			{Name: "ECU1", Description: "First ECU"},
+			{Name: "ECU2"},
+		},
+		Messages: []*descriptor.Message{
+			{
+				Name:       "Status",
+				ID:         100,
+				Length:     2,
+				SenderNode: "ECU1",
+				SendType:   descriptor.SendTypeCyclic,
+				CycleTime:  100 * time.Millisecond,
+				Signals: []*descriptor.Signal{
+					{
+						Name:          "Mode",
+						Start:         0,
+						Length:        2,
+						Scale:         1,
+						ReceiverNodes: []string{"ECU2"},
+						ValueDescriptions: []*descriptor.ValueDescription{
+							{Value: 0, Description: "Off"},
+							{Value: 1, Description: "On"},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestWriteMarkdown(t *testing.T) {
+	var buf bytes.Buffer
+	assert.NilError(t, WriteMarkdown(&buf, testDatabase()))
+	expected := strings.Join([]string{
+		"# test",
+		"",
+		"Source: test.dbc",
+		"",
+		`<a id="nodes"></a>`,
+		"",
+		"## Nodes",
+		"",
+		"| Node | Description |",
+		"| --- | --- |",
+		"| [ECU1](#node-ecu1) | First ECU |",
+		"| [ECU2](#node-ecu2) |  |",
+		"",
+		`<a id="node-ecu1"></a>`,
+		"",
+		"### ECU1",
+		"",
+		"First ECU",
+		"",
+		"Transmitted messages:",
+		"",
+		"| Message | ID | Length | Sender | Send type | Cycle time | Description |",
+		"| --- | --- | --- | --- | --- | --- | --- |",
+		"| [Status](#message-status) | 100 (0x64) | 2 | ECU1 | Cyclic | 100ms |  |",
+		"",
+		"Received messages:",
+		"",
+		"None.",
+		"",
+		`<a id="node-ecu2"></a>`,
+		"",
+		"### ECU2",
+		"",
+		"Transmitted messages:",
+		"",
+		"None.",
+		"",
+		"Received messages:",
+		"",
+		"| Message | ID | Length | Sender | Send type | Cycle time | Description |",
+		"| --- | --- | --- | --- | --- | --- | --- |",
+		"| [Status](#message-status) | 100 (0x64) | 2 | ECU1 | Cyclic | 100ms |  |",
+		"",
+		`<a id="messages"></a>`,
+		"",
+		"## Messages",
+		"",
+		"| Message | ID | Length | Sender | Send type | Cycle time | Description |",
+		"| --- | --- | --- | --- | --- | --- | --- |",
+		"| [Status](#message-status) | 100 (0x64) | 2 | ECU1 | Cyclic | 100ms |  |",
+		"",
+		`<a id="message-status"></a>`,
+		"",
+		"### Status",
+		"",
+		"| Property | Value |",
+		"| --- | --- |",
+		"| ID | 100 (0x64) |",
+		"| Length | 2 bytes |",
+		"| Sender | ECU1 |",
+		"| Send type | Cyclic |",
+		"| Cycle time | 100ms |",
+		"",
+		"| Signal | Start | Length | Byte order | Type | Scale | Offset | Range | Unit | Multiplexing | Receivers " +
+			"| Values | Description |",
+		"| --- | --- | --- | --- | --- | --- | --- | --- | --- | --- | --- | --- | --- |",
+		"| Mode | 0 | 2 | little-endian | unsigned | 1 | 0 |  |  |  | ECU2 | 0: Off, 1: On |  |",
+		"",
+		"",
+	}, "\n")
+	assert.Equal(t, expected, buf.String())
+}
+
+func TestWriteMarkdown_EscapeTableCells(t *testing.T) {
+	d := testDatabase()
+	d.Nodes[0].Description = "First line\nSecond | line"
+	var buf bytes.Buffer
+	assert.NilError(t, WriteMarkdown(&buf, d))
+	assert.Assert(t, strings.Contains(buf.String(), "| [ECU1](#node-ecu1) | First line<br>Second \\| line |\n"))
+}
+
+func TestWriteMarkdown_TransmitterNodes(t *testing.T) {
+	d := testDatabase()
+	// BO_TX_BU_ 100 : ECU1,ECU2;
+	d.Messages[0].TransmitterNodes = []string{"ECU1", "ECU2"}
+	var buf bytes.Buffer
+	assert.NilError(t, WriteMarkdown(&buf, d))
+	assert.Assert(t, strings.Contains(buf.String(), strings.Join([]string{
+		"### ECU2",
+		"",
+		"Transmitted messages:",
+		"",
+		"| Message | ID | Length | Sender | Send type | Cycle time | Description |",
+		"| --- | --- | --- | --- | --- | --- | --- |",
+		"| [Status](#message-status) | 100 (0x64) | 2 | ECU1, ECU2 | Cyclic | 100ms |  |",
+		"",
+	}, "\n")))
+	assert.Assert(t, strings.Contains(buf.String(), "| Sender | ECU1, ECU2 |\n"))
+}
+
+func TestWriteMarkdown_CaseInsensitiveAnchors(t *testing.T) {
+	d := testDatabase()
+	d.Nodes = append(d.Nodes, &descriptor.Node{Name: "ecu1"})
+	status := *d.Messages[0]
+	status.Name = "STATUS"
+	status.ID = 101
+	d.Messages = append(d.Messages, &status)
+	var buf bytes.Buffer
+	assert.NilError(t, WriteMarkdown(&buf, d))
+	for _, expected := range []string{
+		"| [ECU1](#node-ecu1) | First ECU |\n",
+		"| [ecu1](#node-ecu1-2) |  |\n",
+		`<a id="node-ecu1"></a>` + "\n\n### ECU1\n",
+		`<a id="node-ecu1-2"></a>` + "\n\n### ecu1\n",
+		"| [Status](#message-status) | 100 (0x64) |",
+		"| [STATUS](#message-status-2) | 101 (0x65) |",
+		`<a id="message-status"></a>` + "\n\n### Status\n",
+		`<a id="message-status-2"></a>` + "\n\n### STATUS\n",
+	} {
+		assert.Assert(t, strings.Contains(buf.String(), expected), expected)
+	}
+}
+
+func TestSignalRow_Multiplexing(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		s        *descriptor.Signal
+		expected string
+	}{
+		{name: "multiplexer", s: &descriptor.Signal{IsMultiplexer: true}, expected: "multiplexer"},
+		{name: "multiplexed", s: &descriptor.Signal{IsMultiplexed: true, MultiplexerValue: 2}, expected: "m2"},
+		{
+			name: "extended",
+			s: &descriptor.Signal{
+				IsMultiplexer:     true,
+				IsMultiplexed:     true,
+				MultiplexerName:   "Mux",
+				MultiplexerRanges: []*descriptor.MultiplexerRange{{Min: 1, Max: 3}, {Min: 5, Max: 5}},
+			},
+			expected: "multiplexer, m1-3,5 of Mux",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			// the multiplexing column follows the unit column
+			assert.Equal(t, tt.expected, signalRow(tt.s)[9].text)
+		})
+	}
+}
+
+func TestWriteHTML(t *testing.T) {
+	var buf bytes.Buffer
+	assert.NilError(t, WriteHTML(&buf, testDatabase()))
+	assert.Assert(t, strings.HasPrefix(buf.String(), "<!DOCTYPE html>"))
+	assert.Assert(t, strings.Contains(buf.String(), `<h3 id="message-status">Status</h3>`))
+	assert.Assert(t, strings.Contains(buf.String(), `<td><a href="#node-ecu1">ECU1</a></td><td>First ECU</td>`))
+	assert.Assert(t, strings.Contains(buf.String(), "<td>0: Off, 1: On</td>"))
+}
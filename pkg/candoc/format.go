@@ -0,0 +1,108 @@
+package candoc
+
+import (
+	"bufio"
+	"html"
+	"strconv"
+	"strings"
+)
+
+// markdownWriter writes documentation in GitHub-flavored Markdown.
+type markdownWriter struct {
+	w *bufio.Writer
+}
+
+var _ docWriter = &markdownWriter{}
+
+func (m *markdownWriter) heading(level int, text, anchor string) {
+	if anchor != "" {
+		_, _ = m.w.WriteString(`<a id="` + anchor + `"></a>` + "\n\n")
+	}
+	_, _ = m.w.WriteString(strings.Repeat("#", level) + " " + text + "\n\n")
+}
+
+func (m *markdownWriter) paragraph(text string) {
+	_, _ = m.w.WriteString(text + "\n\n")
+}
+
+func (m *markdownWriter) table(header []string, rows [][]cell) {
+	if len(rows) == 0 {
+		_, _ = m.w.WriteString("None.\n\n")
+		return
+	}
+	_, _ = m.w.WriteString("| " + strings.Join(header, " | ") + " |\n")
+	_, _ = m.w.WriteString("|" + strings.Repeat(" --- |", len(header)) + "\n")
+	for _, row := range rows {
+		_, _ = m.w.WriteString("|")
+		for _, c := range row {
+			text := markdownCellReplacer.Replace(c.text)
+			if c.link != "" {
+				text = "[" + text + "](#" + c.link + ")"
+			}
+			_, _ = m.w.WriteString(" " + text + " |")
+		}
+		_, _ = m.w.WriteString("\n")
+	}
+	_, _ = m.w.WriteString("\n")
+}
+
+// markdownCellReplacer escapes text in Markdown table cells, where pipes end cells and newlines end rows.
+var markdownCellReplacer = strings.NewReplacer("|", `\|`, "\r\n", "<br>", "\n", "<br>", "\r", "<br>")
+
+// htmlWriter writes documentation as a standalone HTML document.
+type htmlWriter struct {
+	w *bufio.Writer
+}
+
+var _ docWriter = &htmlWriter{}
+
+func (h *htmlWriter) begin(title string) {
+	_, _ = h.w.WriteString("<!DOCTYPE html>\n<html>\n<head>\n<meta charset=\"utf-8\">\n")
+	_, _ = h.w.WriteString("<title>" + html.EscapeString(title) + "</title>\n")
+	_, _ = h.w.WriteString("<style>\n")
+	_, _ = h.w.WriteString("body { font-family: sans-serif; }\n")
+	_, _ = h.w.WriteString("table { border-collapse: collapse; margin-bottom: 1em; }\n")
+	_, _ = h.w.WriteString("th, td { border: 1px solid #ccc; padding: 4px 8px; text-align: left; }\n")
+	_, _ = h.w.WriteString("</style>\n</head>\n<body>\n")
+}
+
+func (h *htmlWriter) end() {
+	_, _ = h.w.WriteString("</body>\n</html>\n")
+}
+
+func (h *htmlWriter) heading(level int, text, anchor string) {
+	tag := "h" + strconv.Itoa(level)
+	_, _ = h.w.WriteString("<" + tag)
+	if anchor != "" {
+		_, _ = h.w.WriteString(` id="` + html.EscapeString(anchor) + `"`)
+	}
+	_, _ = h.w.WriteString(">" + html.EscapeString(text) + "</" + tag + ">\n")
+}
+
+func (h *htmlWriter) paragraph(text string) {
+	_, _ = h.w.WriteString("<p>" + html.EscapeString(text) + "</p>\n")
+}
+
+func (h *htmlWriter) table(header []string, rows [][]cell) {
+	if len(rows) == 0 {
+		_, _ = h.w.WriteString("<p>None.</p>\n")
+		return
+	}
+	_, _ = h.w.WriteString("<table>\n<tr>")
+	for _, text := range header {
+		_, _ = h.w.WriteString("<th>" + html.EscapeString(text) + "</th>")
+	}
+	_, _ = h.w.WriteString("</tr>\n")
+	for _, row := range rows {
+		_, _ = h.w.WriteString("<tr>")
+		for _, c := range row {
+			text := html.EscapeString(c.text)
+			if c.link != "" {
+				text = `<a href="#` + html.EscapeString(c.link) + `">` + text + "</a>"
+			}
+			_, _ = h.w.WriteString("<td>" + text + "</td>")
+		}
+		_, _ = h.w.WriteString("</tr>\n")
+	}
+	_, _ = h.w.WriteString("</table>\n")
+}
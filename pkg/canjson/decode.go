@@ -0,0 +1,161 @@
+package canjson
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"go.einride.tech/can"
+	"go.einride.tech/can/pkg/descriptor"
+	"go.einride.tech/can/pkg/generated"
+)
+
+// Unmarshal a CAN message from JSON.
+//
+// The JSON is an object with a field per signal, with the value of the signal in any of these forms:
+//
+//   - an object with the Raw, Physical, Unit and Description fields of the JSON written by Marshal, where the raw value
+//     takes precedence over the physical value, which takes precedence over the description
+//   - a number with the physical value
+//   - a string with the value description
+//   - a bool, for 1-bit signals
+//
+// Signals missing from the JSON keep their values, and multiplexed signals not selected by their multiplexers are
+// ignored. Values out of the range of a signal are errors.
+func Unmarshal(data []byte, m generated.Message) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return fmt.Errorf("unmarshal json: %w", err)
+	}
+	md := m.Descriptor()
+	for name := range fields {
+		if _, ok := md.Signal(name); !ok {
+			return fmt.Errorf("unmarshal json: %s: unknown signal: %s", md.Name, name)
+		}
+	}
+	f := m.Frame()
+	// multiplexers are set first, to know which multiplexed signals are selected
+	for _, isMultiplexer := range []bool{true, false} {
+		for _, s := range md.Signals {
+			value, ok := fields[s.Name]
+			if !ok || s.IsMultiplexer != isMultiplexer || !md.IsSignalPresent(s, f.Data) {
+				continue
+			}
+			if err := unmarshalSignal(s, &f.Data, value); err != nil {
+				return fmt.Errorf("unmarshal json: %s.%s: %w", md.Name, s.Name, err)
+			}
+		}
+	}
+	if err := m.UnmarshalFrame(f); err != nil {
+		return fmt.Errorf("unmarshal json: %w", err)
+	}
+	return nil
+}
+
+func unmarshalSignal(s *descriptor.Signal, d *can.Data, value json.RawMessage) error {
+	value = bytes.TrimSpace(value)
+	if len(value) == 0 {
+		return errors.New("missing value")
+	}
+	switch value[0] {
+	case '{':
+		var sig struct {
+			Raw         *json.Number
+			Physical    *json.Number
+			Unit        string
+			Description string
+		}
+		dec := json.NewDecoder(bytes.NewReader(value))
+		dec.UseNumber()
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(&sig); err != nil {
+			return err
+		}
+		if sig.Unit != "" && sig.Unit != s.Unit {
+			return fmt.Errorf("unit %q does not match %q", sig.Unit, s.Unit)
+		}
+		switch {
+		case sig.Raw != nil:
+			return setRaw(s, d, *sig.Raw)
+		case sig.Physical != nil:
+			physical, err := sig.Physical.Float64()
+			if err != nil {
+				return fmt.Errorf("invalid physical value: %s", *sig.Physical)
+			}
+			return s.CheckedMarshalPhysical(d, physical)
+		case sig.Description != "":
+			return setDescription(s, d, sig.Description)
+		default:
+			return errors.New("missing Raw, Physical or Description value")
+		}
+	case '"':
+		var description string
+		if err := json.Unmarshal(value, &description); err != nil {
+			return err
+		}
+		return setDescription(s, d, description)
+	case 't', 'f':
+		var b bool
+		if err := json.Unmarshal(value, &b); err != nil {
+			return err
+		}
+		if s.Length != 1 {
+			return fmt.Errorf("bool value for signal of length %d", s.Length)
+		}
+		s.MarshalBool(d, b)
+		return nil
+	default:
+		var physical float64
+		if err := json.Unmarshal(value, &physical); err != nil {
+			return fmt.Errorf("invalid value: %s", value)
+		}
+		return s.CheckedMarshalPhysical(d, physical)
+	}
+}
+
+func setDescription(s *descriptor.Signal, d *can.Data, description string) error {
+	value, ok := s.ValueDescriptionValue(description)
+	if !ok {
+		return fmt.Errorf("unknown value description: %q", description)
+	}
+	return setRaw(s, d, intToJSON(value))
+}
+
+func setRaw(s *descriptor.Signal, d *can.Data, raw json.Number) error {
+	switch {
+	case s.IsFloat:
+		value, err := raw.Float64()
+		if err != nil {
+			return fmt.Errorf("invalid raw value: %s", raw)
+		}
+		if s.SaturatedCastFloat(value) != value {
+			return fmt.Errorf("raw value out of bounds [%v, %v]: %v", s.MinFloat(), s.MaxFloat(), value)
+		}
+		s.MarshalFloat(d, value)
+		return nil
+	case s.IsSigned:
+		value, err := strconv.ParseInt(raw.String(), 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid raw value: %s", raw)
+		}
+		if s.SaturatedCastSigned(value) != value {
+			return fmt.Errorf("raw value out of bounds [%d, %d]: %d", s.MinSigned(), s.MaxSigned(), value)
+		}
+		s.MarshalSigned(d, value)
+		return nil
+	default:
+		value, err := strconv.ParseUint(raw.String(), 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid raw value: %s", raw)
+		}
+		if s.Length < 64 {
+			if err := can.CheckValue(value, s.Length); err != nil {
+				return fmt.Errorf("raw %w", err)
+			}
+		}
+		s.MarshalUnsigned(d, value)
+		return nil
+	}
+}
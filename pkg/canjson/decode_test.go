@@ -0,0 +1,118 @@
+package canjson_test
+
+import (
+	"testing"
+
+	"go.einride.tech/can/pkg/canjson"
+	examplecan "go.einride.tech/can/testdata/gen/go/example"
+	"gotest.tools/v3/assert"
+)
+
+func TestUnmarshal_Marshaled(t *testing.T) {
+	sonars := examplecan.NewSensorSonars().SetMux(1).SetErrCount(3).SetNoFiltLeft(1.5).SetNoFiltRear(40.9)
+	js, err := canjson.Marshal(sonars)
+	assert.NilError(t, err)
+	actual := examplecan.NewSensorSonars()
+	assert.NilError(t, canjson.Unmarshal(js, actual))
+	assert.Equal(t, sonars.String(), actual.String())
+}
+
+func TestUnmarshal_MarshaledFloat(t *testing.T) {
+	m := examplecan.NewIOFloat32().SetFloat32ValueNoRange(1.5).SetFloat32WithRange(-12.25)
+	js, err := canjson.Marshal(m)
+	assert.NilError(t, err)
+	assert.Equal(
+		t,
+		`{"Float32ValueNoRange":{"Raw":1.5,"Physical":1.5},"Float32WithRange":{"Raw":-12.25,"Physical":-12.25}}`,
+		string(js),
+	)
+	actual := examplecan.NewIOFloat32()
+	assert.NilError(t, canjson.Unmarshal(js, actual))
+	assert.Equal(t, float32(1.5), actual.Float32ValueNoRange())
+	assert.Equal(t, -12.25, actual.Float32WithRange())
+}
+
+func TestUnmarshal_Relaxed(t *testing.T) {
+	m := examplecan.NewIODebug()
+	assert.NilError(t, canjson.Unmarshal([]byte(`{
+		"TestUnsigned": {"Raw": 200},
+		"TestEnum": "Two",
+		"TestSigned": -12,
+		"TestFloat": {"Physical": 12.5},
+		"TestBoolEnum": true,
+		"TestScaledEnum": {"Description": "Six"}
+	}`), m))
+	assert.Equal(t, uint8(200), m.TestUnsigned())
+	assert.Equal(t, examplecan.IODebug_TestEnum_Two, m.TestEnum())
+	assert.Equal(t, int8(-12), m.TestSigned())
+	assert.Equal(t, 12.5, m.TestFloat())
+	assert.Equal(t, examplecan.IODebug_TestBoolEnum_One, m.TestBoolEnum())
+	assert.Equal(t, 6.0, m.TestScaledEnum())
+}
+
+func TestUnmarshal_KeepsMissingSignals(t *testing.T) {
+	m := examplecan.NewMotorCommand().SetSteer(1).SetDrive(7)
+	assert.NilError(t, canjson.Unmarshal([]byte(`{"Drive": 2}`), m))
+	assert.Equal(t, 1.0, m.Steer())
+	assert.Equal(t, 2.0, m.Drive())
+}
+
+func TestUnmarshal_Errors(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		json     string
+		expected string
+	}{
+		{name: "invalid json", json: `{`, expected: "unmarshal json: unexpected end of JSON input"},
+		{name: "unknown signal", json: `{"Foo": 1}`, expected: "unmarshal json: IODebug: unknown signal: Foo"},
+		{
+			name:     "raw unsigned out of bounds",
+			json:     `{"TestEnum": {"Raw": 64}}`,
+			expected: "unmarshal json: IODebug.TestEnum: raw value out of bounds [0, 64): 64",
+		},
+		{
+			name:     "raw signed out of bounds",
+			json:     `{"TestSigned": {"Raw": -129}}`,
+			expected: "unmarshal json: IODebug.TestSigned: raw value out of bounds [-128, 127]: -129",
+		},
+		{
+			name:     "negative raw unsigned",
+			json:     `{"TestUnsigned": {"Raw": -1}}`,
+			expected: "unmarshal json: IODebug.TestUnsigned: invalid raw value: -1",
+		},
+		{
+			name:     "physical out of range",
+			json:     `{"TestScaledEnum": 8}`,
+			expected: "unmarshal json: IODebug.TestScaledEnum: physical value out of range [0, 6]: 8",
+		},
+		{
+			name:     "physical out of bounds",
+			json:     `{"TestFloat": 128}`,
+			expected: "unmarshal json: IODebug.TestFloat: physical value 128: raw value out of bounds [0, 255]: 256",
+		},
+		{
+			name:     "unknown description",
+			json:     `{"TestEnum": "Three"}`,
+			expected: `unmarshal json: IODebug.TestEnum: unknown value description: "Three"`,
+		},
+		{
+			name:     "bool for non-bool signal",
+			json:     `{"TestEnum": true}`,
+			expected: "unmarshal json: IODebug.TestEnum: bool value for signal of length 6",
+		},
+		{
+			name:     "missing value",
+			json:     `{"TestEnum": {"Unit": ""}}`,
+			expected: "unmarshal json: IODebug.TestEnum: missing Raw, Physical or Description value",
+		},
+		{
+			name:     "unit mismatch",
+			json:     `{"TestEnum": {"Raw": 1, "Unit": "km/h"}}`,
+			expected: `unmarshal json: IODebug.TestEnum: unit "km/h" does not match ""`,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Error(t, canjson.Unmarshal([]byte(tt.json), examplecan.NewIODebug()), tt.expected)
+		})
+	}
+}
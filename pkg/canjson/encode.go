@@ -46,6 +46,8 @@ type signal struct {
 
 func (s *signal) set(desc *descriptor.Signal, f can.Frame) {
 	switch {
+	case desc.IsFloat: // float
+		s.setFloatValue(desc.UnmarshalFloat(f.Data), desc)
 	case desc.Length == 1: // bool
 		s.setBoolValue(desc.UnmarshalBool(f.Data), desc)
 	case desc.IsSigned: // signed
@@ -91,6 +93,12 @@ func (s *signal) setBoolValue(value bool, desc *descriptor.Signal) {
 	}
 }
 
+func (s *signal) setFloatValue(value float64, desc *descriptor.Signal) {
+	s.Raw = floatToJSON(value)
+	s.Physical = floatToJSON(desc.ToPhysical(value))
+	s.Unit = desc.Unit
+}
+
 func floatToJSON(f float64) json.Number {
 	return json.Number(strconv.FormatFloat(f, 'f', -1, 64))
 }
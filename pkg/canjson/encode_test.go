@@ -1,16 +1,17 @@
-package canjson
+package canjson_test
 
 import (
 	"strings"
 	"testing"
 
+	"go.einride.tech/can/pkg/canjson"
 	examplecan "go.einride.tech/can/testdata/gen/go/example"
 	"gotest.tools/v3/assert"
 )
 
 func TestMarshal(t *testing.T) {
 	driverHeartbeat := examplecan.NewDriverHeartbeat().SetCommand(examplecan.DriverHeartbeat_Command_Reboot)
-	js, err := Marshal(driverHeartbeat)
+	js, err := canjson.Marshal(driverHeartbeat)
 	assert.NilError(t, err)
 	expected := strings.TrimSpace(`
 		{"Command":{"Raw":2,"Physical":2,"Description":"Reboot"}}
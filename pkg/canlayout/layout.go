@@ -0,0 +1,225 @@
+// Package canlayout provides primitives for rendering the bit layouts of CAN messages.
+package canlayout
+
+import (
+	"go.einride.tech/can"
+	"go.einride.tech/can/pkg/descriptor"
+)
+
+// Layout is the bit layout of a CAN message.
+type Layout struct {
+	// Message of the layout.
+	Message *descriptor.Message
+	// Groups of signals that are present in the message payload at the same time.
+	//
+	// Messages without a multiplexer have a single group.
+	Groups []*Group
+}
+
+// Group is a group of signals that are present in the message payload at the same time.
+type Group struct {
+	// IsMultiplexed is true if the group contains multiplexed signals.
+	IsMultiplexed bool
+	// Conditions selecting the group, from the outermost to the innermost multiplexer.
+	//
+	// The group is present when all conditions hold.
+	Conditions []*Condition
+	// Signals in the group.
+	Signals []*descriptor.Signal
+	// Bits contains the signals occupying each bit of the message payload.
+	//
+	// Bits are indexed in the same way as can.Data.Bit.
+	Bits [][]*descriptor.Signal
+}
+
+// Condition is a condition on the value of a multiplexer.
+type Condition struct {
+	// Multiplexer signal of the condition.
+	Multiplexer *descriptor.Signal
+	// Ranges of multiplexer values satisfying the condition.
+	Ranges []*descriptor.MultiplexerRange
+}
+
+// Overlap is a pair of signals occupying the same bits of a message payload.
+type Overlap struct {
+	Signal1 *descriptor.Signal
+	Signal2 *descriptor.Signal
+	// Bits occupied by both signals.
+	Bits []uint8
+}
+
+// New returns the bit layout of the message.
+//
+// Multiplexed messages have one group per selection condition of their multiplexed signals, containing the signals
+// present when the condition holds.
+func New(m *descriptor.Message) *Layout {
+	l := &Layout{Message: m}
+	var base []*descriptor.Signal
+	var selections [][]*Condition
+	for _, s := range m.Signals {
+		if !s.IsMultiplexed {
+			base = append(base, s)
+			continue
+		}
+		conditions := signalConditions(m, s)
+		if !containsConditions(selections, conditions) {
+			selections = append(selections, conditions)
+		}
+	}
+	if len(selections) == 0 {
+		l.Groups = append(l.Groups, newGroup(m, base))
+		return l
+	}
+	for _, conditions := range selections {
+		signals := append([]*descriptor.Signal{}, base...)
+		for _, s := range m.Signals {
+			if s.IsMultiplexed && impliesConditions(conditions, signalConditions(m, s)) {
+				signals = append(signals, s)
+			}
+		}
+		g := newGroup(m, signals)
+		g.IsMultiplexed = true
+		g.Conditions = conditions
+		l.Groups = append(l.Groups, g)
+	}
+	return l
+}
+
+// signalConditions returns the conditions selecting the signal, from the outermost to the innermost multiplexer.
+func signalConditions(m *descriptor.Message, s *descriptor.Signal) []*Condition {
+	var conditions []*Condition
+	// the number of multiplexer levels is bounded by the number of signals, to guard against cycles
+	for i := 0; i < len(m.Signals) && s.IsMultiplexed; i++ {
+		mux, ok := m.Multiplexer(s)
+		if !ok {
+			break
+		}
+		ranges := s.MultiplexerRanges
+		if len(ranges) == 0 {
+			ranges = []*descriptor.MultiplexerRange{{Min: s.MultiplexerValue, Max: s.MultiplexerValue}}
+		}
+		conditions = append([]*Condition{{Multiplexer: mux, Ranges: ranges}}, conditions...)
+		s = mux
+	}
+	return conditions
+}
+
+// impliesConditions returns true if the conditions a holding implies that the conditions b hold.
+func impliesConditions(a, b []*Condition) bool {
+	if len(b) > len(a) {
+		return false
+	}
+	for i, cb := range b {
+		ca := a[i]
+		if ca.Multiplexer != cb.Multiplexer {
+			return false
+		}
+		for _, ra := range ca.Ranges {
+			if !containsRange(cb.Ranges, ra) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func containsConditions(selections [][]*Condition, conditions []*Condition) bool {
+	for _, c := range selections {
+		if len(c) == len(conditions) && impliesConditions(c, conditions) && impliesConditions(conditions, c) {
+			return true
+		}
+	}
+	return false
+}
+
+// containsRange returns true if all values of the range r are covered by the ranges.
+func containsRange(ranges []*descriptor.MultiplexerRange, r *descriptor.MultiplexerRange) bool {
+	value := r.Min
+	for {
+		var covering *descriptor.MultiplexerRange
+		for _, rr := range ranges {
+			if value >= rr.Min && value <= rr.Max && (covering == nil || rr.Max > covering.Max) {
+				covering = rr
+			}
+		}
+		if covering == nil {
+			return false
+		}
+		if covering.Max >= r.Max {
+			return true
+		}
+		value = covering.Max + 1
+	}
+}
+
+func newGroup(m *descriptor.Message, signals []*descriptor.Signal) *Group {
+	g := &Group{
+		Signals: signals,
+		Bits:    make([][]*descriptor.Signal, int(m.Length)*8),
+	}
+	for _, s := range signals {
+		for _, i := range SignalBits(s) {
+			if int(i) < len(g.Bits) {
+				g.Bits[i] = append(g.Bits[i], s)
+			}
+		}
+	}
+	return g
+}
+
+// Overlaps returns the pairs of signals in the layout that occupy the same bits.
+//
+// Multiplexed signals that are never present at the same time are not considered to overlap.
+func (l *Layout) Overlaps() []*Overlap {
+	type signalPair struct {
+		s1, s2 *descriptor.Signal
+	}
+	overlaps := map[signalPair]*Overlap{}
+	var result []*Overlap
+	for _, g := range l.Groups {
+		for i, signals := range g.Bits {
+			for j := 0; j < len(signals); j++ {
+				for k := j + 1; k < len(signals); k++ {
+					p := signalPair{s1: signals[j], s2: signals[k]}
+					o, ok := overlaps[p]
+					if !ok {
+						o = &Overlap{Signal1: p.s1, Signal2: p.s2}
+						overlaps[p] = o
+						result = append(result, o)
+					}
+					if !containsBit(o.Bits, uint8(i)) {
+						o.Bits = append(o.Bits, uint8(i))
+					}
+				}
+			}
+		}
+	}
+	return result
+}
+
+// SignalBits returns the bits occupied by the signal, from the least significant to the most significant bit.
+//
+// Bits are indexed in the same way as can.Data.Bit.
+func SignalBits(s *descriptor.Signal) []uint8 {
+	bits := make([]uint8, 0, s.Length)
+	for i := uint8(0); i < s.Length; i++ {
+		var d can.Data
+		s.MarshalUnsigned(&d, 1<<i)
+		for j := uint8(0); j < 8*can.MaxDataLength; j++ {
+			if d.Bit(j) {
+				bits = append(bits, j)
+				break
+			}
+		}
+	}
+	return bits
+}
+
+func containsBit(bits []uint8, bit uint8) bool {
+	for _, b := range bits {
+		if b == bit {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,140 @@
+package canlayout
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"go.einride.tech/can/pkg/descriptor"
+	"gotest.tools/v3/assert"
+)
+
+func TestSignalBits(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		s        *descriptor.Signal
+		expected []uint8
+	}{
+		{
+			name:     "little-endian",
+			s:        &descriptor.Signal{Start: 6, Length: 4},
+			expected: []uint8{6, 7, 8, 9},
+		},
+		{
+			name:     "big-endian",
+			s:        &descriptor.Signal{Start: 1, Length: 4, IsBigEndian: true},
+			expected: []uint8{14, 15, 0, 1},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.DeepEqual(t, tt.expected, SignalBits(tt.s))
+		})
+	}
+}
+
+func TestLayout_Overlaps(t *testing.T) {
+	speed := &descriptor.Signal{Name: "Speed", Start: 7, Length: 12, IsBigEndian: true}
+	mode := &descriptor.Signal{Name: "Mode", Start: 3, Length: 4, IsBigEndian: true}
+	flag := &descriptor.Signal{Name: "Flag", Start: 16, Length: 1}
+	l := New(&descriptor.Message{Name: "Message", Length: 3, Signals: []*descriptor.Signal{speed, mode, flag}})
+	assert.Equal(t, 1, len(l.Groups))
+	assert.DeepEqual(t, []*Overlap{{Signal1: speed, Signal2: mode, Bits: []uint8{0, 1, 2, 3}}}, l.Overlaps())
+}
+
+func TestLayout_Multiplexed(t *testing.T) {
+	mux := &descriptor.Signal{Name: "Mux", Start: 0, Length: 4, IsMultiplexer: true}
+	a := &descriptor.Signal{Name: "A", Start: 8, Length: 8, IsMultiplexed: true, MultiplexerValue: 0}
+	b := &descriptor.Signal{Name: "B", Start: 8, Length: 8, IsMultiplexed: true, MultiplexerValue: 1}
+	l := New(&descriptor.Message{Name: "Message", Length: 2, Signals: []*descriptor.Signal{mux, a, b}})
+	assert.Equal(t, 2, len(l.Groups))
+	assert.DeepEqual(t, []*descriptor.Signal{mux, a}, l.Groups[0].Signals)
+	assert.DeepEqual(t, []*descriptor.Signal{mux, b}, l.Groups[1].Signals)
+	assert.Equal(t, 0, len(l.Overlaps()))
+}
+
+func TestLayout_ExtendedMultiplexing(t *testing.T) {
+	mux := &descriptor.Signal{Name: "Mux", Start: 0, Length: 4, IsMultiplexer: true}
+	other := &descriptor.Signal{Name: "Other", Start: 4, Length: 4, IsMultiplexer: true}
+	nested := &descriptor.Signal{
+		Name:              "Nested",
+		Start:             8,
+		Length:            4,
+		IsMultiplexer:     true,
+		IsMultiplexed:     true,
+		MultiplexerName:   "Mux",
+		MultiplexerRanges: []*descriptor.MultiplexerRange{{Min: 1, Max: 3}},
+	}
+	a := &descriptor.Signal{
+		Name:              "A",
+		Start:             12,
+		Length:            4,
+		IsMultiplexed:     true,
+		MultiplexerName:   "Nested",
+		MultiplexerRanges: []*descriptor.MultiplexerRange{{Min: 0, Max: 0}},
+	}
+	// B has the same multiplexer value as A, but another multiplexer
+	b := &descriptor.Signal{
+		Name:              "B",
+		Start:             12,
+		Length:            4,
+		IsMultiplexed:     true,
+		MultiplexerName:   "Other",
+		MultiplexerRanges: []*descriptor.MultiplexerRange{{Min: 0, Max: 0}},
+	}
+	l := New(&descriptor.Message{Name: "Message", Length: 2, Signals: []*descriptor.Signal{mux, other, nested, a, b}})
+	assert.Equal(t, 3, len(l.Groups))
+	assert.DeepEqual(t, []*descriptor.Signal{mux, other, nested}, l.Groups[0].Signals)
+	assert.DeepEqual(t, []*descriptor.Signal{mux, other, nested, a}, l.Groups[1].Signals)
+	assert.Equal(t, "Mux = 1-3 and Nested = 0", conditionsText(l.Groups[1].Conditions))
+	assert.DeepEqual(t, []*descriptor.Signal{mux, other, b}, l.Groups[2].Signals)
+	assert.Equal(t, "Other = 0", conditionsText(l.Groups[2].Conditions))
+	assert.Equal(t, 0, len(l.Overlaps()))
+}
+
+func TestLayout_MultiplexerRangeOverlaps(t *testing.T) {
+	mux := &descriptor.Signal{Name: "Mux", Start: 0, Length: 4, IsMultiplexer: true}
+	a := &descriptor.Signal{
+		Name:              "A",
+		Start:             8,
+		Length:            8,
+		IsMultiplexed:     true,
+		MultiplexerName:   "Mux",
+		MultiplexerRanges: []*descriptor.MultiplexerRange{{Min: 1, Max: 2}, {Min: 3, Max: 4}},
+	}
+	b := &descriptor.Signal{Name: "B", Start: 12, Length: 4, IsMultiplexed: true, MultiplexerValue: 3}
+	l := New(&descriptor.Message{Name: "Message", Length: 2, Signals: []*descriptor.Signal{mux, a, b}})
+	assert.Equal(t, 2, len(l.Groups))
+	assert.DeepEqual(t, []*descriptor.Signal{mux, a, b}, l.Groups[1].Signals)
+	assert.DeepEqual(t, []*Overlap{{Signal1: a, Signal2: b, Bits: []uint8{12, 13, 14, 15}}}, l.Overlaps())
+}
+
+func TestSignalKey(t *testing.T) {
+	for i, expected := range map[int]string{0: "A", 25: "Z", 26: "AA", 701: "ZZ", 702: "AAA", 18277: "ZZZ"} {
+		assert.Equal(t, expected, signalKeyOf(i))
+	}
+}
+
+func TestWriteText(t *testing.T) {
+	l := New(&descriptor.Message{
+		Name:   "Message",
+		ID:     10,
+		Length: 1,
+		Signals: []*descriptor.Signal{
+			{Name: "Low", Start: 0, Length: 4},
+			{Name: "High", Start: 4, Length: 4},
+		},
+	})
+	var buf bytes.Buffer
+	assert.NilError(t, WriteText(&buf, l))
+	expected := strings.Join([]string{
+		"Message (ID 10 0xa, 1 bytes)",
+		"      7    6    5    4    3    2    1    0",
+		"    +----+----+----+----+----+----+----+----+",
+		"  0 | B  | B  | B  | B  | A  | A  | A  | A  |",
+		"    +----+----+----+----+----+----+----+----+",
+		"A   Low: start 0, length 4, little-endian, lsb 0.0, msb 0.3",
+		"B   High: start 4, length 4, little-endian, lsb 0.4, msb 0.7",
+		"",
+	}, "\n")
+	assert.Equal(t, expected, buf.String())
+}
@@ -0,0 +1,280 @@
+package canlayout
+
+import (
+	"bufio"
+	"fmt"
+	"html"
+	"io"
+	"strings"
+
+	"go.einride.tech/can/pkg/descriptor"
+)
+
+// overlapKey is the cell text of bits occupied by more than one signal.
+const overlapKey = "!!"
+
+// WriteText writes the layout as an ASCII grid to the writer.
+func WriteText(w io.Writer, l *Layout) error {
+	bw := bufio.NewWriter(w)
+	keyWidth := len(overlapKey)
+	if n := len(l.Message.Signals); n > 0 {
+		keyWidth = max(keyWidth, len(signalKeyOf(n-1)))
+	}
+	for i, g := range l.Groups {
+		if i > 0 {
+			_, _ = bw.WriteString("\n")
+		}
+		_, _ = bw.WriteString(title(l))
+		_, _ = bw.WriteString("\n")
+		if g.IsMultiplexed {
+			_, _ = bw.WriteString(conditionsText(g.Conditions))
+			_, _ = bw.WriteString("\n")
+		}
+		border := "    +" + strings.Repeat(strings.Repeat("-", keyWidth+2)+"+", 8) + "\n"
+		header := "    "
+		for bit := 7; bit >= 0; bit-- {
+			padding := (keyWidth + 2) / 2
+			header += fmt.Sprintf("%*s%d%*s", padding, "", bit, keyWidth+2-padding, "")
+		}
+		_, _ = bw.WriteString(strings.TrimRight(header, " ") + "\n")
+		_, _ = bw.WriteString(border)
+		for row := 0; row < len(g.Bits)/8; row++ {
+			_, _ = fmt.Fprintf(bw, "%3d |", row)
+			for bit := 7; bit >= 0; bit-- {
+				_, _ = fmt.Fprintf(bw, " %-*s |", keyWidth, cellKey(l, g.Bits[row*8+bit]))
+			}
+			_, _ = bw.WriteString("\n")
+			_, _ = bw.WriteString(border)
+		}
+		for _, s := range g.Signals {
+			_, _ = fmt.Fprintf(bw, "%-*s  %s\n", keyWidth, signalKey(l, s), signalSummary(l.Message, s))
+		}
+	}
+	for _, o := range l.Overlaps() {
+		_, _ = fmt.Fprintf(
+			bw, "%-*s  %s and %s overlap at %s\n", keyWidth, overlapKey, o.Signal1.Name, o.Signal2.Name, bitList(o.Bits),
+		)
+	}
+	return bw.Flush()
+}
+
+// SVG layout constants.
+const (
+	svgCellWidth  = 40
+	svgCellHeight = 24
+	svgMargin     = 32
+	svgLineHeight = 18
+)
+
+// svgPalette is the palette used for signal fill colors.
+var svgPalette = []string{
+	"#8dd3c7", "#ffffb3", "#bebada", "#80b1d3", "#fdb462", "#b3de69", "#fccde5", "#d9d9d9", "#bc80bd", "#ccebc5",
+}
+
+// svgOverlapColor is the fill color of bits occupied by more than one signal.
+const svgOverlapColor = "#fb8072"
+
+// WriteSVG writes the layout as an SVG image to the writer.
+func WriteSVG(w io.Writer, l *Layout) error {
+	bw := bufio.NewWriter(w)
+	groupHeight := func(g *Group) int {
+		return svgLineHeight*2 + svgCellHeight*(len(g.Bits)/8+1) + svgLineHeight*len(g.Signals) + svgMargin
+	}
+	width := svgMargin*2 + svgCellWidth*8 + 320
+	height := svgMargin
+	for _, g := range l.Groups {
+		height += groupHeight(g)
+	}
+	overlaps := l.Overlaps()
+	height += svgLineHeight * len(overlaps)
+	_, _ = fmt.Fprintf(
+		bw,
+		`<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" font-family="monospace" font-size="12">`+"\n",
+		width,
+		height,
+	)
+	y := svgMargin
+	for _, g := range l.Groups {
+		heading := title(l)
+		if g.IsMultiplexed {
+			heading += ", " + conditionsText(g.Conditions)
+		}
+		_, _ = fmt.Fprintf(
+			bw, `<text x="%d" y="%d" font-weight="bold">%s</text>`+"\n", svgMargin, y, html.EscapeString(heading),
+		)
+		y += svgLineHeight
+		for bit := 7; bit >= 0; bit-- {
+			x := svgMargin + svgCellWidth + (7-bit)*svgCellWidth + svgCellWidth/2
+			_, _ = fmt.Fprintf(bw, `<text x="%d" y="%d" text-anchor="middle">%d</text>`+"\n", x, y+svgCellHeight/2, bit)
+		}
+		y += svgCellHeight
+		for row := 0; row < len(g.Bits)/8; row++ {
+			_, _ = fmt.Fprintf(
+				bw,
+				`<text x="%d" y="%d" text-anchor="middle">%d</text>`+"\n",
+				svgMargin+svgCellWidth/2, y+svgCellHeight*2/3, row,
+			)
+			for bit := 7; bit >= 0; bit-- {
+				signals := g.Bits[row*8+bit]
+				x := svgMargin + svgCellWidth + (7-bit)*svgCellWidth
+				fill := "#ffffff"
+				var dash string
+				switch {
+				case len(signals) > 1:
+					fill = svgOverlapColor
+				case len(signals) == 1:
+					fill = signalColor(l, signals[0])
+					if signals[0].IsMultiplexed {
+						dash = ` stroke-dasharray="4 2"`
+					}
+				}
+				_, _ = fmt.Fprintf(
+					bw,
+					`<rect x="%d" y="%d" width="%d" height="%d" fill="%s" stroke="#333333"%s/>`+"\n",
+					x, y, svgCellWidth, svgCellHeight, fill, dash,
+				)
+				if key := cellKey(l, signals); key != "" {
+					_, _ = fmt.Fprintf(
+						bw,
+						`<text x="%d" y="%d" text-anchor="middle">%s</text>`+"\n",
+						x+svgCellWidth/2, y+svgCellHeight*2/3, key,
+					)
+				}
+			}
+			y += svgCellHeight
+		}
+		y += svgLineHeight
+		for _, s := range g.Signals {
+			_, _ = fmt.Fprintf(
+				bw,
+				`<rect x="%d" y="%d" width="12" height="12" fill="%s" stroke="#333333"/>`+"\n",
+				svgMargin, y-10, signalColor(l, s),
+			)
+			_, _ = fmt.Fprintf(
+				bw,
+				`<text x="%d" y="%d">%-2s  %s</text>`+"\n",
+				svgMargin+svgCellWidth/2, y, signalKey(l, s), html.EscapeString(signalSummary(l.Message, s)),
+			)
+			y += svgLineHeight
+		}
+		y += svgMargin - svgLineHeight
+	}
+	for _, o := range overlaps {
+		_, _ = fmt.Fprintf(
+			bw,
+			`<text x="%d" y="%d" fill="#c0392b">%s</text>`+"\n",
+			svgMargin,
+			y,
+			html.EscapeString(fmt.Sprintf("%s and %s overlap at %s", o.Signal1.Name, o.Signal2.Name, bitList(o.Bits))),
+		)
+		y += svgLineHeight
+	}
+	_, _ = bw.WriteString("</svg>\n")
+	return bw.Flush()
+}
+
+// WriteHTML writes the layouts as an HTML document with embedded SVG images to the writer.
+func WriteHTML(w io.Writer, name string, layouts []*Layout) error {
+	bw := bufio.NewWriter(w)
+	_, _ = bw.WriteString("<!DOCTYPE html>\n<html>\n<head>\n<meta charset=\"utf-8\">\n")
+	_, _ = fmt.Fprintf(bw, "<title>%s</title>\n", html.EscapeString(name))
+	_, _ = bw.WriteString("</head>\n<body>\n")
+	_, _ = fmt.Fprintf(bw, "<h1>%s</h1>\n", html.EscapeString(name))
+	for _, l := range layouts {
+		name := html.EscapeString(l.Message.Name)
+		_, _ = fmt.Fprintf(bw, "<h2 id=\"%s\">%s</h2>\n", name, name)
+		if err := WriteSVG(bw, l); err != nil {
+			return err
+		}
+	}
+	_, _ = bw.WriteString("</body>\n</html>\n")
+	return bw.Flush()
+}
+
+func title(l *Layout) string {
+	return fmt.Sprintf("%s (ID %d 0x%x, %d bytes)", l.Message.Name, l.Message.ID, l.Message.ID, l.Message.Length)
+}
+
+func cellKey(l *Layout, signals []*descriptor.Signal) string {
+	switch len(signals) {
+	case 0:
+		return ""
+	case 1:
+		return signalKey(l, signals[0])
+	default:
+		return overlapKey
+	}
+}
+
+// signalKey returns a short key identifying the signal in the layout: A, B, ..., Z, AA, AB, ..., ZZ, AAA, ...
+func signalKey(l *Layout, s *descriptor.Signal) string {
+	for i, ms := range l.Message.Signals {
+		if ms == s {
+			return signalKeyOf(i)
+		}
+	}
+	return "?"
+}
+
+// signalKeyOf returns the key of the i:th signal, in bijective base-26 with the digits A to Z.
+func signalKeyOf(i int) string {
+	var key []byte
+	for n := i + 1; n > 0; n = (n - 1) / 26 {
+		key = append([]byte{byte('A' + (n-1)%26)}, key...)
+	}
+	return string(key)
+}
+
+func signalColor(l *Layout, s *descriptor.Signal) string {
+	for i, ms := range l.Message.Signals {
+		if ms == s {
+			return svgPalette[i%len(svgPalette)]
+		}
+	}
+	return svgPalette[0]
+}
+
+func signalSummary(m *descriptor.Message, s *descriptor.Signal) string {
+	byteOrder := "little-endian"
+	if s.IsBigEndian {
+		byteOrder = "big-endian"
+	}
+	var b strings.Builder
+	_, _ = fmt.Fprintf(&b, "%s: start %d, length %d, %s", s.Name, s.Start, s.Length, byteOrder)
+	if bits := SignalBits(s); len(bits) > 0 {
+		_, _ = fmt.Fprintf(&b, ", lsb %s, msb %s", bitPosition(bits[0]), bitPosition(bits[len(bits)-1]))
+	}
+	switch {
+	case s.IsMultiplexer:
+		b.WriteString(", multiplexer")
+	case s.IsMultiplexed:
+		_, _ = fmt.Fprintf(&b, ", multiplexed (%s)", conditionsText(signalConditions(m, s)))
+	}
+	return b.String()
+}
+
+// conditionsText formats conditions as <multiplexer> = <values> and ...
+func conditionsText(conditions []*Condition) string {
+	texts := make([]string, 0, len(conditions))
+	for _, c := range conditions {
+		values := make([]string, 0, len(c.Ranges))
+		for _, r := range c.Ranges {
+			values = append(values, r.String())
+		}
+		texts = append(texts, fmt.Sprintf("%s = %s", c.Multiplexer.Name, strings.Join(values, ", ")))
+	}
+	return strings.Join(texts, " and ")
+}
+
+// bitPosition formats a bit index as <byte>.<bit>.
+func bitPosition(i uint8) string {
+	return fmt.Sprintf("%d.%d", i/8, i%8)
+}
+
+func bitList(bits []uint8) string {
+	positions := make([]string, 0, len(bits))
+	for _, i := range bits {
+		positions = append(positions, bitPosition(i))
+	}
+	return strings.Join(positions, ", ")
+}
@@ -0,0 +1,118 @@
+package canmdf
+
+import (
+	"encoding/binary"
+	"math"
+)
+
+// MDF4 block IDs.
+const (
+	blockIDHeader      = "##HD"
+	blockIDFileHistory = "##FH"
+	blockIDDataGroup   = "##DG"
+	blockIDChannelGrp  = "##CG"
+	blockIDChannel     = "##CN"
+	blockIDConversion  = "##CC"
+	blockIDText        = "##TX"
+	blockIDMetadata    = "##MD"
+	blockIDData        = "##DT"
+	blockIDDataList    = "##DL"
+)
+
+const (
+	// idBlockSize is the size of the identification block at the start of the file.
+	idBlockSize = 64
+	// blockHeaderSize is the size of the header of a block: its ID, reserved bytes, length and link count.
+	blockHeaderSize = 24
+	// headerBlockSize is the size of the header block, which directly follows the identification block.
+	headerBlockSize = blockHeaderSize + 6*8 + 32
+	// version of the written MDF format.
+	version = 410
+)
+
+// Channel types.
+const (
+	channelTypeFixedLength = 0
+	channelTypeMaster      = 2
+)
+
+// Channel synchronization types.
+const (
+	syncTypeNone = 0
+	syncTypeTime = 1
+)
+
+// Channel data types.
+const (
+	dataTypeUnsignedLE = 0
+	dataTypeSignedLE   = 2
+	dataTypeFloatLE    = 4
+)
+
+// Channel flags.
+const (
+	channelFlagInvalidationBitValid = 1 << 1
+	channelFlagLimitRangeValid      = 1 << 4
+)
+
+// Conversion types.
+const (
+	conversionTypeLinear      = 1
+	conversionTypeValueToText = 7
+)
+
+// block is an MDF4 block, with the file offsets of the blocks it links to.
+type block struct {
+	id    string
+	links []int64
+	data  []byte
+}
+
+// size returns the size of the block.
+func (b *block) size() int {
+	return blockHeaderSize + 8*len(b.links) + len(b.data)
+}
+
+func (b *block) appendTo(buf []byte) []byte {
+	buf = append(buf, b.id...)
+	buf = append(buf, 0, 0, 0, 0) // reserved
+	buf = binary.LittleEndian.AppendUint64(buf, uint64(b.size()))
+	buf = binary.LittleEndian.AppendUint64(buf, uint64(len(b.links)))
+	for _, link := range b.links {
+		buf = binary.LittleEndian.AppendUint64(buf, uint64(link))
+	}
+	return append(buf, b.data...)
+}
+
+// textData returns the data of a text or metadata block, zero-terminated and padded to a multiple of 8 bytes.
+func textData(text string) []byte {
+	data := make([]byte, (len(text)+8)/8*8)
+	copy(data, text)
+	return data
+}
+
+// identificationBlock returns the identification block at the start of the file.
+func identificationBlock(isFinalized bool) []byte {
+	buf := make([]byte, 0, idBlockSize)
+	if isFinalized {
+		buf = append(buf, "MDF     "...)
+	} else {
+		buf = append(buf, "UnFinMF "...)
+	}
+	buf = append(buf, "4.10    "...)
+	buf = append(buf, "can-go  "...)
+	buf = append(buf, make([]byte, 4)...)
+	buf = binary.LittleEndian.AppendUint16(buf, version)
+	buf = append(buf, make([]byte, 30)...)
+	var unfinalizedFlags uint16
+	if !isFinalized {
+		// cycle counters of channel groups and the length of the last data block are not updated
+		unfinalizedFlags = 1<<0 | 1<<2
+	}
+	buf = binary.LittleEndian.AppendUint16(buf, unfinalizedFlags)
+	return binary.LittleEndian.AppendUint16(buf, 0)
+}
+
+func appendFloat64(buf []byte, value float64) []byte {
+	return binary.LittleEndian.AppendUint64(buf, math.Float64bits(value))
+}
@@ -0,0 +1,494 @@
+// Package canmdf writes the signals of CAN frames decoded with a database to ASAM MDF 4 measurement files.
+//
+// Each message of the database is written to a data group with a single channel group, with a time master channel in
+// seconds since the first frame, and a channel per signal. Channels hold the raw values of signals, and have
+// conversions to their physical values and value descriptions, so that MDF tools show physical values. Multiplexed
+// signals not in a frame are marked invalid.
+//
+// Besides the unit tests, which decode files with a minimal reader of their own, the output is validated against
+// asammdf by a test behind the reference build tag, run with asammdf installed by:
+//
+//	go test -tags reference ./pkg/canmdf
+package canmdf
+
+import (
+	"cmp"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"slices"
+	"time"
+
+	"go.einride.tech/can"
+	"go.einride.tech/can/pkg/descriptor"
+)
+
+// defaultDataBlockSize is the size of buffered records of a message written as a data block.
+const defaultDataBlockSize = 64 * 1024
+
+// WriterOption configures a Writer.
+type WriterOption func(*writerOpts)
+
+type writerOpts struct {
+	dataBlockSize int
+}
+
+// WithDataBlockSize sets the size of the buffered records of a message written as a data block, which defaults to
+// 64 KiB.
+func WithDataBlockSize(size int) WriterOption {
+	return func(opts *writerOpts) {
+		opts.dataBlockSize = size
+	}
+}
+
+// Writer writes timestamped CAN frames to an MDF 4 file, as decoded signals.
+//
+// The file is finalized when the writer is closed.
+type Writer struct {
+	opts     writerOpts
+	w        io.WriteSeeker
+	offset   int64
+	start    time.Time
+	groups   []*channelGroup
+	messages map[descriptor.MessageKey]*channelGroup
+	buf      []byte
+	err      error
+	isClosed bool
+}
+
+// channelGroup is the channel group of the records of a message.
+type channelGroup struct {
+	message *descriptor.Message
+	// invalidationBytes is the number of invalidation bytes of a record.
+	invalidationBytes int
+	// records are the buffered records not yet written to a data block.
+	records []byte
+	// cycleCount is the number of records of the group.
+	cycleCount uint64
+	// dataBlocks are the offsets of the written data blocks of the group.
+	dataBlocks []int64
+	// dataOffsets are the offsets of the data of the data blocks in the records of the group.
+	dataOffsets []uint64
+	// dataLength is the total length of the written records of the group.
+	dataLength uint64
+}
+
+// dataBytes returns the number of data bytes of a record: the time, followed by the raw value of each signal.
+func (g *channelGroup) dataBytes() int {
+	return 8 + 8*len(g.message.Signals)
+}
+
+// NewWriter returns a new writer of MDF 4 files with the messages of a database.
+func NewWriter(w io.WriteSeeker, d *descriptor.Database, opt ...WriterOption) (*Writer, error) {
+	opts := writerOpts{dataBlockSize: defaultDataBlockSize}
+	for _, f := range opt {
+		f(&opts)
+	}
+	mw := &Writer{
+		opts:     opts,
+		w:        w,
+		messages: make(map[descriptor.MessageKey]*channelGroup, len(d.Messages)),
+	}
+	for _, m := range d.Messages {
+		key := m.Key()
+		if _, ok := mw.messages[key]; ok {
+			return nil, fmt.Errorf("new mdf writer: duplicate message ID: %d", m.ID)
+		}
+		g := &channelGroup{message: m}
+		for _, s := range m.Signals {
+			if s.IsMultiplexed {
+				g.invalidationBytes = (len(m.Signals) + 7) / 8
+				break
+			}
+		}
+		mw.groups = append(mw.groups, g)
+		mw.messages[key] = g
+	}
+	// the header block is rewritten with its links when the file is finalized
+	mw.buf = append(mw.buf, identificationBlock(false)...)
+	mw.buf = append(mw.buf, make([]byte, headerBlockSize)...)
+	if err := mw.flush(); err != nil {
+		return nil, fmt.Errorf("new mdf writer: %w", err)
+	}
+	return mw, nil
+}
+
+// WriteFrame writes the signals of a frame received at the provided time.
+//
+// Remote frames and frames of messages not in the database are skipped. Times are relative to the first written frame.
+func (w *Writer) WriteFrame(t time.Time, f can.Frame) error {
+	if w.isClosed {
+		return errors.New("write mdf frame: writer is closed")
+	}
+	if w.err != nil {
+		return fmt.Errorf("write mdf frame: %w", w.err)
+	}
+	if f.IsRemote {
+		return nil
+	}
+	g, ok := w.messages[descriptor.MessageKeyOf(f)]
+	if !ok {
+		return nil
+	}
+	if f.Length != g.message.Length {
+		return fmt.Errorf(
+			"write mdf frame: %s: expects length %d (got %s with length %d)",
+			g.message.Name, g.message.Length, f.String(), f.Length,
+		)
+	}
+	if w.start.IsZero() {
+		w.start = t
+	}
+	g.records = appendFloat64(g.records, t.Sub(w.start).Seconds())
+	invalidation := make([]byte, g.invalidationBytes)
+	for i, s := range g.message.Signals {
+		if !g.message.IsSignalPresent(s, f.Data) {
+			g.records = binary.LittleEndian.AppendUint64(g.records, 0)
+			invalidation[i/8] |= 1 << (i % 8)
+			continue
+		}
+		g.records = binary.LittleEndian.AppendUint64(g.records, rawValue(s, f.Data))
+	}
+	g.records = append(g.records, invalidation...)
+	g.cycleCount++
+	if len(g.records) >= w.opts.dataBlockSize {
+		if err := w.writeData(g); err != nil {
+			w.err = err
+			return fmt.Errorf("write mdf frame: %w", err)
+		}
+	}
+	return nil
+}
+
+// Close writes the buffered records and the channel descriptions, and finalizes the file.
+//
+// Close does not close the underlying writer.
+func (w *Writer) Close() error {
+	if w.isClosed {
+		return errors.New("close mdf writer: writer is closed")
+	}
+	w.isClosed = true
+	if w.err != nil {
+		return fmt.Errorf("close mdf writer: %w", w.err)
+	}
+	if err := w.finalize(); err != nil {
+		return fmt.Errorf("close mdf writer: %w", err)
+	}
+	return nil
+}
+
+func (w *Writer) finalize() error {
+	// blocks are written last to first, so that the links to the next blocks are known
+	var nextDataGroup int64
+	for _, g := range slices.Backward(w.groups) {
+		if len(g.records) > 0 {
+			if err := w.writeData(g); err != nil {
+				return err
+			}
+		}
+		dataGroup, err := w.writeDataGroup(g, nextDataGroup)
+		if err != nil {
+			return err
+		}
+		nextDataGroup = dataGroup
+	}
+	fileHistory, err := w.writeFileHistory()
+	if err != nil {
+		return err
+	}
+	if err := w.flush(); err != nil {
+		return err
+	}
+	if _, err := w.w.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	w.buf = append(w.buf[:0], identificationBlock(true)...)
+	w.buf = w.headerBlock(nextDataGroup, fileHistory).appendTo(w.buf)
+	if err := w.flush(); err != nil {
+		return err
+	}
+	_, err = w.w.Seek(0, io.SeekEnd)
+	return err
+}
+
+func (w *Writer) headerBlock(firstDataGroup, fileHistory int64) *block {
+	var startTime uint64
+	if !w.start.IsZero() {
+		startTime = uint64(w.start.UnixNano())
+	}
+	data := binary.LittleEndian.AppendUint64(nil, startTime)
+	data = append(data, make([]byte, 8)...) // time zone, DST offset, time flags, time class, flags and reserved
+	data = appendFloat64(data, 0)           // start angle
+	data = appendFloat64(data, 0)           // start distance
+	return &block{id: blockIDHeader, links: []int64{firstDataGroup, fileHistory, 0, 0, 0, 0}, data: data}
+}
+
+func (w *Writer) writeFileHistory() (int64, error) {
+	comment, err := w.writeBlock(&block{
+		id: blockIDMetadata,
+		data: textData(`<FHcomment xmlns="http://www.asam.net/mdf/v4">` +
+			`<TX>Decoded CAN signals</TX>` +
+			`<tool_id>canmdf</tool_id>` +
+			`<tool_vendor>go.einride.tech/can</tool_vendor>` +
+			`<tool_version>1</tool_version>` +
+			`</FHcomment>`),
+	})
+	if err != nil {
+		return 0, err
+	}
+	var startTime uint64
+	if !w.start.IsZero() {
+		startTime = uint64(w.start.UnixNano())
+	}
+	data := binary.LittleEndian.AppendUint64(nil, startTime)
+	data = append(data, make([]byte, 8)...) // time zone, DST offset, time flags and reserved
+	return w.writeBlock(&block{id: blockIDFileHistory, links: []int64{0, comment}, data: data})
+}
+
+func (w *Writer) writeDataGroup(g *channelGroup, next int64) (int64, error) {
+	var dataList int64
+	if len(g.dataBlocks) > 0 {
+		data := []byte{0, 0, 0, 0} // flags and reserved
+		data = binary.LittleEndian.AppendUint32(data, uint32(len(g.dataBlocks)))
+		for _, offset := range g.dataOffsets {
+			data = binary.LittleEndian.AppendUint64(data, offset)
+		}
+		links := append([]int64{0}, g.dataBlocks...)
+		var err error
+		if dataList, err = w.writeBlock(&block{id: blockIDDataList, links: links, data: data}); err != nil {
+			return 0, err
+		}
+	}
+	var nextChannel int64
+	for i, s := range slices.Backward(g.message.Signals) {
+		channel, err := w.writeSignalChannel(g, i, s, nextChannel)
+		if err != nil {
+			return 0, err
+		}
+		nextChannel = channel
+	}
+	timeChannel, err := w.writeTimeChannel(nextChannel)
+	if err != nil {
+		return 0, err
+	}
+	name, err := w.writeText(g.message.Name)
+	if err != nil {
+		return 0, err
+	}
+	comment, err := w.writeText(g.message.Description)
+	if err != nil {
+		return 0, err
+	}
+	data := binary.LittleEndian.AppendUint64(nil, 0) // record ID
+	data = binary.LittleEndian.AppendUint64(data, g.cycleCount)
+	data = append(data, make([]byte, 8)...) // flags, path separator and reserved
+	data = binary.LittleEndian.AppendUint32(data, uint32(g.dataBytes()))
+	data = binary.LittleEndian.AppendUint32(data, uint32(g.invalidationBytes))
+	group, err := w.writeBlock(&block{
+		id:    blockIDChannelGrp,
+		links: []int64{0, timeChannel, name, 0, 0, comment},
+		data:  data,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return w.writeBlock(&block{
+		id:    blockIDDataGroup,
+		links: []int64{next, group, dataList, 0},
+		data:  make([]byte, 8), // record ID size and reserved
+	})
+}
+
+func (w *Writer) writeTimeChannel(next int64) (int64, error) {
+	name, err := w.writeText("time")
+	if err != nil {
+		return 0, err
+	}
+	unit, err := w.writeText("s")
+	if err != nil {
+		return 0, err
+	}
+	return w.writeBlock(&block{
+		id:    blockIDChannel,
+		links: []int64{next, 0, name, 0, 0, 0, unit, 0},
+		data: channelData(channel{
+			channelType: channelTypeMaster,
+			syncType:    syncTypeTime,
+			dataType:    dataTypeFloatLE,
+		}),
+	})
+}
+
+func (w *Writer) writeSignalChannel(g *channelGroup, i int, s *descriptor.Signal, next int64) (int64, error) {
+	name, err := w.writeText(s.Name)
+	if err != nil {
+		return 0, err
+	}
+	conversion, err := w.writeConversion(s)
+	if err != nil {
+		return 0, err
+	}
+	unit, err := w.writeText(s.Unit)
+	if err != nil {
+		return 0, err
+	}
+	comment, err := w.writeText(s.Description)
+	if err != nil {
+		return 0, err
+	}
+	c := channel{
+		channelType: channelTypeFixedLength,
+		syncType:    syncTypeNone,
+		dataType:    dataTypeUnsignedLE,
+		byteOffset:  uint32(8 + 8*i),
+		min:         s.Min,
+		max:         s.Max,
+	}
+	switch {
+	case s.IsFloat:
+		c.dataType = dataTypeFloatLE
+	case s.IsSigned:
+		c.dataType = dataTypeSignedLE
+	}
+	if s.IsMultiplexed {
+		c.flags |= channelFlagInvalidationBitValid
+		c.invalidationBit = uint32(i)
+	}
+	if s.Min != 0 || s.Max != 0 {
+		c.flags |= channelFlagLimitRangeValid
+	}
+	return w.writeBlock(&block{
+		id:    blockIDChannel,
+		links: []int64{next, 0, name, 0, conversion, 0, unit, comment},
+		data:  channelData(c),
+	})
+}
+
+// channel is the data of a channel block.
+type channel struct {
+	channelType     uint8
+	syncType        uint8
+	dataType        uint8
+	byteOffset      uint32
+	flags           uint32
+	invalidationBit uint32
+	min, max        float64
+}
+
+func channelData(c channel) []byte {
+	data := []byte{c.channelType, c.syncType, c.dataType, 0}
+	data = binary.LittleEndian.AppendUint32(data, c.byteOffset)
+	data = binary.LittleEndian.AppendUint32(data, 64) // bit count
+	data = binary.LittleEndian.AppendUint32(data, c.flags)
+	data = binary.LittleEndian.AppendUint32(data, c.invalidationBit)
+	data = append(data, 0, 0, 0, 0) // precision, reserved and attachment count
+	data = appendFloat64(data, 0)   // value range min
+	data = appendFloat64(data, 0)   // value range max
+	data = appendFloat64(data, c.min)
+	data = appendFloat64(data, c.max)
+	data = appendFloat64(data, 0) // extended limit min
+	return appendFloat64(data, 0) // extended limit max
+}
+
+// writeConversion writes the conversion of the raw values of a signal to its physical values, and returns 0 when the
+// raw values are the physical values.
+func (w *Writer) writeConversion(s *descriptor.Signal) (int64, error) {
+	var linear int64
+	if s.Scale != 1 || s.Offset != 0 {
+		var err error
+		if linear, err = w.writeBlock(conversionBlock(conversionTypeLinear, nil, s.Offset, s.Scale)); err != nil {
+			return 0, err
+		}
+	}
+	if len(s.ValueDescriptions) == 0 {
+		return linear, nil
+	}
+	valueDescriptions := slices.SortedFunc(
+		slices.Values(s.ValueDescriptions),
+		func(a, b *descriptor.ValueDescription) int { return cmp.Compare(a.Value, b.Value) },
+	)
+	refs := make([]int64, 0, len(valueDescriptions)+1)
+	values := make([]float64, 0, len(valueDescriptions))
+	for _, vd := range valueDescriptions {
+		text, err := w.writeText(vd.Description)
+		if err != nil {
+			return 0, err
+		}
+		refs = append(refs, text)
+		values = append(values, float64(vd.Value))
+	}
+	// values without a description are converted by the default conversion
+	refs = append(refs, linear)
+	return w.writeBlock(conversionBlock(conversionTypeValueToText, refs, values...))
+}
+
+func conversionBlock(conversionType uint8, refs []int64, values ...float64) *block {
+	data := []byte{conversionType, 0}                // type and precision
+	data = binary.LittleEndian.AppendUint16(data, 0) // flags
+	data = binary.LittleEndian.AppendUint16(data, uint16(len(refs)))
+	data = binary.LittleEndian.AppendUint16(data, uint16(len(values)))
+	data = appendFloat64(data, 0) // physical range min
+	data = appendFloat64(data, 0) // physical range max
+	for _, value := range values {
+		data = appendFloat64(data, value)
+	}
+	return &block{id: blockIDConversion, links: append([]int64{0, 0, 0, 0}, refs...), data: data}
+}
+
+// writeText writes a text block, and returns 0 for empty texts.
+func (w *Writer) writeText(text string) (int64, error) {
+	if text == "" {
+		return 0, nil
+	}
+	return w.writeBlock(&block{id: blockIDText, data: textData(text)})
+}
+
+// writeData writes the buffered records of a channel group as a data block.
+func (w *Writer) writeData(g *channelGroup) error {
+	offset, err := w.writeBlock(&block{id: blockIDData, data: g.records})
+	if err != nil {
+		return err
+	}
+	g.dataBlocks = append(g.dataBlocks, offset)
+	g.dataOffsets = append(g.dataOffsets, g.dataLength)
+	g.dataLength += uint64(len(g.records))
+	g.records = g.records[:0]
+	return nil
+}
+
+// writeBlock writes a block aligned to 8 bytes, and returns its offset.
+func (w *Writer) writeBlock(b *block) (int64, error) {
+	w.buf = w.buf[:0]
+	if padding := w.offset % 8; padding != 0 {
+		w.buf = append(w.buf, make([]byte, 8-padding)...)
+	}
+	offset := w.offset + int64(len(w.buf))
+	w.buf = b.appendTo(w.buf)
+	if err := w.flush(); err != nil {
+		return 0, err
+	}
+	return offset, nil
+}
+
+func (w *Writer) flush() error {
+	n, err := w.w.Write(w.buf)
+	w.offset += int64(n)
+	w.buf = w.buf[:0]
+	return err
+}
+
+// rawValue returns the raw value of a signal in the provided payload, as the bits of a channel value.
+func rawValue(s *descriptor.Signal, d can.Data) uint64 {
+	switch {
+	case s.IsFloat && s.Length == 64:
+		return s.UnmarshalUnsigned(d)
+	case s.IsFloat:
+		return math.Float64bits(s.UnmarshalFloat(d))
+	case s.IsSigned:
+		return uint64(s.UnmarshalSigned(d))
+	default:
+		return s.UnmarshalUnsigned(d)
+	}
+}
@@ -0,0 +1,115 @@
+//go:build reference
+
+package canmdf
+
+import (
+	"encoding/json"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+// readScript reads an MDF file with asammdf, and prints the physical values of the channels of its groups as JSON.
+const readScript = `
+import json, sys
+from asammdf import MDF
+
+mdf = MDF(sys.argv[1])
+groups = []
+for i, group in enumerate(mdf.groups):
+    channels = []
+    # the first channel is the time master channel
+    for j in range(1, len(group.channels)):
+        signal = mdf.get(group=i, index=j)
+        channels.append({
+            "name": signal.name,
+            "unit": signal.unit,
+            "timestamps": signal.timestamps.tolist(),
+            "samples": [s.decode() if isinstance(s, bytes) else s for s in signal.samples.tolist()],
+            "invalid": None if signal.invalidation_bits is None else signal.invalidation_bits.tolist(),
+        })
+    groups.append({
+        "name": group.channel_group.acq_name,
+        "comment": group.channel_group.comment,
+        "channels": channels,
+    })
+json.dump({"start": mdf.header.start_time.timestamp(), "groups": groups}, sys.stdout)
+`
+
+type referenceFile struct {
+	Start  float64          `json:"start"`
+	Groups []referenceGroup `json:"groups"`
+}
+
+type referenceGroup struct {
+	Name     string             `json:"name"`
+	Comment  string             `json:"comment"`
+	Channels []referenceChannel `json:"channels"`
+}
+
+type referenceChannel struct {
+	Name       string    `json:"name"`
+	Unit       string    `json:"unit"`
+	Timestamps []float64 `json:"timestamps"`
+	Samples    []any     `json:"samples"`
+	Invalid    []bool    `json:"invalid"`
+}
+
+// TestWriter_Reference checks that files written by Writer are read as expected by asammdf.
+func TestWriter_Reference(t *testing.T) {
+	if err := exec.Command("python3", "-c", "import asammdf").Run(); err != nil {
+		t.Skip("asammdf is not available:", err)
+	}
+	for _, tt := range []struct {
+		name string
+		opts []WriterOption
+	}{
+		{name: "single data block"},
+		{name: "multiple data blocks", opts: []WriterOption{WithDataBlockSize(1)}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "test.mf4")
+			assert.NilError(t, os.WriteFile(path, writeTestFile(t, tt.opts...), 0o600))
+			output, err := exec.Command("python3", "-c", readScript, path).Output()
+			assert.NilError(t, err)
+			var file referenceFile
+			assert.NilError(t, json.Unmarshal(output, &file))
+			assert.Equal(t, float64(testStart.Unix()), file.Start)
+			assert.DeepEqual(t, []referenceGroup{
+				{
+					Name:    "MotorStatus",
+					Comment: "Status of the motor",
+					Channels: []referenceChannel{
+						{Name: "Speed", Unit: "km/h", Timestamps: []float64{0, 0.004}, Samples: []any{40.0, 45.0}},
+						{Name: "State", Timestamps: []float64{0, 0.004}, Samples: []any{"On, running", "Off"}},
+					},
+				},
+				{
+					Name:     "Unused",
+					Channels: []referenceChannel{{Name: "Value", Timestamps: []float64{}, Samples: []any{}}},
+				},
+				{
+					Name: "Diagnostics",
+					Channels: []referenceChannel{
+						{Name: "Mux", Timestamps: []float64{0.001, 0.005}, Samples: []any{1.0, 0.0}},
+						{
+							Name:       "Counter",
+							Timestamps: []float64{0.001, 0.005},
+							Samples:    []any{0.0, 7.0},
+							Invalid:    []bool{true, false},
+						},
+						{
+							Name:       "Error",
+							Timestamps: []float64{0.001, 0.005},
+							Samples:    []any{-2.0, 0.0},
+							Invalid:    []bool{false, true},
+						},
+					},
+				},
+			}, file.Groups)
+		})
+	}
+}
@@ -0,0 +1,269 @@
+package canmdf
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"go.einride.tech/can"
+	"go.einride.tech/can/pkg/descriptor"
+	loggingcan "go.einride.tech/can/testdata/gen/go/logging"
+	"gotest.tools/v3/assert"
+)
+
+var testStart = time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+func writeTestFile(t *testing.T, opt ...WriterOption) []byte {
+	t.Helper()
+	f, err := os.Create(filepath.Join(t.TempDir(), "test.mf4"))
+	assert.NilError(t, err)
+	defer f.Close()
+	w, err := NewWriter(f, loggingcan.Messages().Database(), opt...)
+	assert.NilError(t, err)
+	frames := []can.Frame{
+		{ID: 100, Length: 3, Data: can.Data{0x64, 0x00, 0x01}},
+		{ID: 0x18daf110, IsExtended: true, Length: 2, Data: can.Data{1, 0xfe}},
+		{ID: 400, Length: 8},
+		{ID: 100, Length: 3, IsRemote: true},
+		{ID: 100, Length: 3, Data: can.Data{0x6e, 0x00, 0x00}},
+		{ID: 0x18daf110, IsExtended: true, Length: 2, Data: can.Data{0, 0x07}},
+	}
+	for i, frame := range frames {
+		assert.NilError(t, w.WriteFrame(testStart.Add(time.Duration(i)*time.Millisecond), frame))
+	}
+	assert.NilError(t, w.Close())
+	data, err := os.ReadFile(f.Name())
+	assert.NilError(t, err)
+	return data
+}
+
+func TestWriter(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		opts []WriterOption
+	}{
+		{name: "single data block"},
+		{name: "multiple data blocks", opts: []WriterOption{WithDataBlockSize(1)}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &testReader{t: t, data: writeTestFile(t, tt.opts...)}
+			assert.Equal(t, "MDF     4.10    ", string(r.data[:16]))
+			assert.Equal(t, uint16(410), binary.LittleEndian.Uint16(r.data[28:]))
+			assert.Equal(t, uint16(0), binary.LittleEndian.Uint16(r.data[60:]))
+			header := r.block(idBlockSize, blockIDHeader)
+			assert.Equal(t, uint64(testStart.UnixNano()), binary.LittleEndian.Uint64(header.data))
+			fileHistory := r.block(header.links[1], blockIDFileHistory)
+			assert.Assert(t, bytes.Contains(r.block(fileHistory.links[1], blockIDMetadata).data, []byte("<FHcomment")))
+			groups := r.dataGroups(header.links[0])
+			assert.Equal(t, 3, len(groups))
+
+			motorStatus := groups[0]
+			assert.Equal(t, "MotorStatus", motorStatus.name)
+			assert.Equal(t, "Status of the motor", motorStatus.comment)
+			assert.DeepEqual(t, []string{"time", "Speed", "State"}, motorStatus.channelNames())
+			assert.Equal(t, "s", motorStatus.channels[0].unit)
+			assert.Equal(t, uint8(channelTypeMaster), motorStatus.channels[0].data[0])
+			speed := motorStatus.channels[1]
+			assert.Equal(t, "km/h", speed.unit)
+			assert.DeepEqual(t, []float64{-10, 0.5}, speed.conversion.values)
+			assert.Equal(t, uint32(channelFlagLimitRangeValid), binary.LittleEndian.Uint32(speed.data[12:]))
+			assert.Equal(t, 100.0, math.Float64frombits(binary.LittleEndian.Uint64(speed.data[48:])))
+			state := motorStatus.channels[2]
+			assert.Equal(t, uint8(conversionTypeValueToText), state.conversion.conversionType)
+			assert.DeepEqual(t, []float64{0, 1}, state.conversion.values)
+			assert.DeepEqual(t, []string{"Off", "On, running"}, state.conversion.texts)
+			assert.Equal(t, uint64(2), motorStatus.cycleCount)
+			assert.DeepEqual(t, [][]uint64{
+				{math.Float64bits(0), 100, 1},
+				{math.Float64bits(0.004), 110, 0},
+			}, motorStatus.records)
+
+			unused := groups[1]
+			assert.Equal(t, uint64(0), unused.cycleCount)
+			assert.Equal(t, 0, len(unused.records))
+
+			diagnostics := groups[2]
+			assert.DeepEqual(t, []string{"time", "Mux", "Counter", "Error"}, diagnostics.channelNames())
+			assert.Equal(t, uint32(1), diagnostics.invalidationBytes)
+			errorChannel := diagnostics.channels[3]
+			assert.Equal(t, uint8(dataTypeSignedLE), errorChannel.data[2])
+			assert.Equal(t, uint32(channelFlagInvalidationBitValid), binary.LittleEndian.Uint32(errorChannel.data[12:]))
+			assert.Equal(t, uint32(2), binary.LittleEndian.Uint32(errorChannel.data[16:]))
+			assert.Assert(t, diagnostics.channels[1].conversion == nil)
+			assert.DeepEqual(t, [][]uint64{
+				{math.Float64bits(0.001), 1, 0, math.MaxUint64 - 1, 0b010},
+				{math.Float64bits(0.005), 0, 7, 0, 0b100},
+			}, diagnostics.records)
+		})
+	}
+}
+
+func TestWriter_InvalidLength(t *testing.T) {
+	f, err := os.Create(filepath.Join(t.TempDir(), "test.mf4"))
+	assert.NilError(t, err)
+	defer f.Close()
+	w, err := NewWriter(f, loggingcan.Messages().Database())
+	assert.NilError(t, err)
+	err = w.WriteFrame(testStart, can.Frame{ID: 100, Length: 2})
+	assert.ErrorContains(t, err, "MotorStatus: expects length 3")
+	assert.NilError(t, w.Close())
+	assert.ErrorContains(t, w.WriteFrame(testStart, can.Frame{ID: 100, Length: 3}), "writer is closed")
+}
+
+func TestWriter_DuplicateMessageID(t *testing.T) {
+	db := loggingcan.Messages().Database()
+	duplicate := *db.Messages[1]
+	duplicate.ID = 100
+	d := &descriptor.Database{Messages: []*descriptor.Message{db.Messages[0], &duplicate}}
+	f, err := os.Create(filepath.Join(t.TempDir(), "test.mf4"))
+	assert.NilError(t, err)
+	defer f.Close()
+	_, err = NewWriter(f, d)
+	assert.ErrorContains(t, err, "duplicate message ID: 100")
+}
+
+// testReader is a minimal reader of the blocks of MDF 4 files written by Writer.
+type testReader struct {
+	t    *testing.T
+	data []byte
+}
+
+type testBlock struct {
+	links []int64
+	data  []byte
+}
+
+type testDataGroup struct {
+	name              string
+	comment           string
+	cycleCount        uint64
+	invalidationBytes uint32
+	channels          []*testChannel
+	// records are the time, the channel values and the invalidation bits of each record.
+	records [][]uint64
+}
+
+func (g *testDataGroup) channelNames() []string {
+	names := make([]string, 0, len(g.channels))
+	for _, c := range g.channels {
+		names = append(names, c.name)
+	}
+	return names
+}
+
+type testChannel struct {
+	name       string
+	unit       string
+	data       []byte
+	conversion *testConversion
+}
+
+type testConversion struct {
+	conversionType uint8
+	values         []float64
+	texts          []string
+}
+
+func (r *testReader) block(offset int64, id string) *testBlock {
+	r.t.Helper()
+	assert.Equal(r.t, int64(0), offset%8)
+	assert.Equal(r.t, id, string(r.data[offset:offset+4]))
+	length := int64(binary.LittleEndian.Uint64(r.data[offset+8:]))
+	linkCount := int64(binary.LittleEndian.Uint64(r.data[offset+16:]))
+	b := &testBlock{data: r.data[offset+blockHeaderSize+8*linkCount : offset+length]}
+	for i := int64(0); i < linkCount; i++ {
+		b.links = append(b.links, int64(binary.LittleEndian.Uint64(r.data[offset+blockHeaderSize+8*i:])))
+	}
+	return b
+}
+
+func (r *testReader) text(offset int64) string {
+	r.t.Helper()
+	if offset == 0 {
+		return ""
+	}
+	data := r.block(offset, blockIDText).data
+	return string(data[:bytes.IndexByte(data, 0)])
+}
+
+func (r *testReader) dataGroups(offset int64) []*testDataGroup {
+	r.t.Helper()
+	var groups []*testDataGroup
+	for offset != 0 {
+		dataGroup := r.block(offset, blockIDDataGroup)
+		channelGroup := r.block(dataGroup.links[1], blockIDChannelGrp)
+		assert.Equal(r.t, int64(0), channelGroup.links[0])
+		g := &testDataGroup{
+			name:              r.text(channelGroup.links[2]),
+			comment:           r.text(channelGroup.links[5]),
+			cycleCount:        binary.LittleEndian.Uint64(channelGroup.data[8:]),
+			invalidationBytes: binary.LittleEndian.Uint32(channelGroup.data[28:]),
+		}
+		for channel := channelGroup.links[1]; channel != 0; {
+			c := r.block(channel, blockIDChannel)
+			g.channels = append(g.channels, &testChannel{
+				name:       r.text(c.links[2]),
+				unit:       r.text(c.links[6]),
+				data:       c.data,
+				conversion: r.conversion(c.links[4]),
+			})
+			channel = c.links[0]
+		}
+		records := r.records(dataGroup.links[2])
+		recordSize := 8*len(g.channels) + int(g.invalidationBytes)
+		assert.Equal(r.t, 0, len(records)%recordSize)
+		for len(records) > 0 {
+			var record []uint64
+			for i := range g.channels {
+				record = append(record, binary.LittleEndian.Uint64(records[8*i:]))
+			}
+			if g.invalidationBytes > 0 {
+				record = append(record, uint64(records[8*len(g.channels)]))
+			}
+			g.records = append(g.records, record)
+			records = records[recordSize:]
+		}
+		groups = append(groups, g)
+		offset = dataGroup.links[0]
+	}
+	return groups
+}
+
+func (r *testReader) conversion(offset int64) *testConversion {
+	r.t.Helper()
+	if offset == 0 {
+		return nil
+	}
+	b := r.block(offset, blockIDConversion)
+	c := &testConversion{conversionType: b.data[0]}
+	valueCount := int(binary.LittleEndian.Uint16(b.data[6:]))
+	for i := 0; i < valueCount; i++ {
+		c.values = append(c.values, math.Float64frombits(binary.LittleEndian.Uint64(b.data[24+8*i:])))
+	}
+	for _, ref := range b.links[4:] {
+		if ref != 0 && string(r.data[ref:ref+4]) == blockIDText {
+			c.texts = append(c.texts, r.text(ref))
+		}
+	}
+	return c
+}
+
+func (r *testReader) records(offset int64) []byte {
+	r.t.Helper()
+	if offset == 0 {
+		return nil
+	}
+	dataList := r.block(offset, blockIDDataList)
+	count := int(binary.LittleEndian.Uint32(dataList.data[4:]))
+	assert.Equal(r.t, count, len(dataList.links)-1)
+	var records []byte
+	for i, dataBlock := range dataList.links[1:] {
+		assert.Equal(r.t, uint64(len(records)), binary.LittleEndian.Uint64(dataList.data[8+8*i:]))
+		records = append(records, r.block(dataBlock, blockIDData).data...)
+	}
+	return records
+}
@@ -0,0 +1,95 @@
+package canparquet
+
+import (
+	"encoding/binary"
+	"math"
+)
+
+// column is a column of the current row group of a file.
+type column struct {
+	path               []string
+	physicalType       int32
+	maxDefinitionLevel uint8
+	// definitionLevels of the rows of the column, run-length encoded.
+	definitionLevels []levelRun
+	// rows is the number of rows of the column.
+	rows int
+	// values of the non-null rows of the column, PLAIN-encoded.
+	values []byte
+}
+
+// levelRun is a run of repeated definition levels.
+type levelRun struct {
+	level uint8
+	count int
+}
+
+// appendLevel appends the definition level of the row with the provided index, after the rows of other messages.
+func (c *column) appendLevel(row int, level uint8) {
+	if c.maxDefinitionLevel == 0 {
+		c.rows = row + 1
+		return
+	}
+	// rows of other messages are null at the level of the message group
+	c.appendRun(0, row-c.rows)
+	c.appendRun(level, 1)
+	c.rows = row + 1
+}
+
+func (c *column) appendRun(level uint8, count int) {
+	if count == 0 {
+		return
+	}
+	if n := len(c.definitionLevels); n > 0 && c.definitionLevels[n-1].level == level {
+		c.definitionLevels[n-1].count += count
+		return
+	}
+	c.definitionLevels = append(c.definitionLevels, levelRun{level: level, count: count})
+}
+
+// appendNull appends a null value at the provided definition level.
+func (c *column) appendNull(row int, level uint8) {
+	c.appendLevel(row, level)
+}
+
+func (c *column) appendInt64(row int, value int64) {
+	c.appendLevel(row, c.maxDefinitionLevel)
+	c.values = binary.LittleEndian.AppendUint64(c.values, uint64(value))
+}
+
+func (c *column) appendDouble(row int, value float64) {
+	c.appendLevel(row, c.maxDefinitionLevel)
+	c.values = binary.LittleEndian.AppendUint64(c.values, math.Float64bits(value))
+}
+
+func (c *column) appendByteArray(row int, value string) {
+	c.appendLevel(row, c.maxDefinitionLevel)
+	c.values = binary.LittleEndian.AppendUint32(c.values, uint32(len(value)))
+	c.values = append(c.values, value...)
+}
+
+// page returns the data of a data page with the provided number of rows of the column.
+//
+// The definition levels are encoded with the RLE/bit-packing hybrid encoding, using only RLE runs, and prefixed with
+// their length.
+func (c *column) page(rows int) []byte {
+	if c.maxDefinitionLevel == 0 {
+		return c.values
+	}
+	c.appendRun(0, rows-c.rows)
+	c.rows = rows
+	levels := make([]byte, 4, 4+3*len(c.definitionLevels)+len(c.values))
+	for _, run := range c.definitionLevels {
+		levels = binary.AppendUvarint(levels, uint64(run.count)<<1)
+		// the levels fit a single byte, with a bit width of at most 8
+		levels = append(levels, run.level)
+	}
+	binary.LittleEndian.PutUint32(levels, uint32(len(levels)-4))
+	return append(levels, c.values...)
+}
+
+func (c *column) reset() {
+	c.definitionLevels = c.definitionLevels[:0]
+	c.values = c.values[:0]
+	c.rows = 0
+}
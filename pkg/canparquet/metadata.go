@@ -0,0 +1,198 @@
+package canparquet
+
+// Parquet physical types.
+const (
+	typeInt64     = 2
+	typeDouble    = 5
+	typeByteArray = 6
+)
+
+// Parquet repetition types.
+const (
+	repetitionRequired = 0
+	repetitionOptional = 1
+)
+
+// Parquet converted types.
+const (
+	convertedUTF8            = 0
+	convertedTimestampMicros = 10
+)
+
+// Parquet encodings.
+const (
+	encodingPlain = 0
+	encodingRLE   = 3
+)
+
+const (
+	pageTypeData       = 0
+	codecUncompressed  = 0
+	fileMetadataFormat = 1
+)
+
+// logicalType is the logical type of a schema element.
+type logicalType int
+
+const (
+	logicalTypeNone logicalType = iota
+	logicalTypeString
+	logicalTypeTimestampMicros
+)
+
+// schemaElement is an element of the schema of a Parquet file, either a group or a column.
+type schemaElement struct {
+	name          string
+	physicalType  int32 // only for columns
+	repetition    int32
+	numChildren   int32 // only for groups
+	convertedType int32
+	logicalType   logicalType
+	isGroup       bool
+	isRoot        bool
+}
+
+func (e *schemaElement) write(w *compactWriter) {
+	w.structBegin()
+	if !e.isGroup {
+		w.i32Field(1, e.physicalType)
+	}
+	if !e.isRoot {
+		w.i32Field(3, e.repetition)
+	}
+	w.stringField(4, e.name)
+	if e.isGroup {
+		w.i32Field(5, e.numChildren)
+	}
+	switch e.logicalType {
+	case logicalTypeNone:
+	case logicalTypeString:
+		w.i32Field(6, convertedUTF8)
+		w.structField(10)
+		w.structField(1) // StringType
+		w.structEnd()
+		w.structEnd()
+	case logicalTypeTimestampMicros:
+		w.i32Field(6, convertedTimestampMicros)
+		w.structField(10)
+		w.structField(8) // TimestampType
+		w.boolField(1, true)
+		w.structField(2)
+		w.structField(2) // MICROS
+		w.structEnd()
+		w.structEnd()
+		w.structEnd()
+		w.structEnd()
+	}
+	w.structEnd()
+}
+
+// columnChunk is the metadata of a column chunk of a row group.
+type columnChunk struct {
+	physicalType          int32
+	path                  []string
+	numValues             int64
+	totalUncompressedSize int64
+	totalCompressedSize   int64
+	dataPageOffset        int64
+}
+
+func (c *columnChunk) write(w *compactWriter) {
+	w.structBegin()
+	w.i64Field(2, c.dataPageOffset)
+	w.structField(3)
+	w.i32Field(1, c.physicalType)
+	w.listField(2, compactI32, 2)
+	w.appendI32(encodingPlain)
+	w.appendI32(encodingRLE)
+	w.listField(3, compactBinary, len(c.path))
+	for _, p := range c.path {
+		w.appendString(p)
+	}
+	w.i32Field(4, codecUncompressed)
+	w.i64Field(5, c.numValues)
+	w.i64Field(6, c.totalUncompressedSize)
+	w.i64Field(7, c.totalCompressedSize)
+	w.i64Field(9, c.dataPageOffset)
+	w.structEnd()
+	w.structEnd()
+}
+
+// rowGroup is the metadata of a row group.
+type rowGroup struct {
+	columns       []columnChunk
+	totalByteSize int64
+	numRows       int64
+}
+
+func (g *rowGroup) write(w *compactWriter) {
+	w.structBegin()
+	w.listField(1, compactStruct, len(g.columns))
+	for i := range g.columns {
+		g.columns[i].write(w)
+	}
+	w.i64Field(2, g.totalByteSize)
+	w.i64Field(3, g.numRows)
+	w.structEnd()
+}
+
+// keyValue is an entry of the key-value metadata of a Parquet file.
+type keyValue struct {
+	key   string
+	value string
+}
+
+// fileMetadata is the footer of a Parquet file.
+type fileMetadata struct {
+	schema    []schemaElement
+	numRows   int64
+	rowGroups []rowGroup
+	keyValues []keyValue
+	createdBy string
+}
+
+func (m *fileMetadata) write(w *compactWriter) {
+	w.structBegin()
+	w.i32Field(1, fileMetadataFormat)
+	w.listField(2, compactStruct, len(m.schema))
+	for i := range m.schema {
+		m.schema[i].write(w)
+	}
+	w.i64Field(3, m.numRows)
+	w.listField(4, compactStruct, len(m.rowGroups))
+	for i := range m.rowGroups {
+		m.rowGroups[i].write(w)
+	}
+	if len(m.keyValues) > 0 {
+		w.listField(5, compactStruct, len(m.keyValues))
+		for _, kv := range m.keyValues {
+			w.structBegin()
+			w.stringField(1, kv.key)
+			w.stringField(2, kv.value)
+			w.structEnd()
+		}
+	}
+	w.stringField(6, m.createdBy)
+	w.structEnd()
+}
+
+// dataPageHeader is the header of a data page (v1).
+type dataPageHeader struct {
+	uncompressedSize int32
+	compressedSize   int32
+	numValues        int32
+}
+
+func (h *dataPageHeader) write(w *compactWriter) {
+	w.structBegin()
+	w.i32Field(1, pageTypeData)
+	w.i32Field(2, h.uncompressedSize)
+	w.i32Field(3, h.compressedSize)
+	w.structField(5)
+	w.i32Field(1, h.numValues)
+	w.i32Field(2, encodingPlain)
+	w.i32Field(3, encodingRLE)
+	w.i32Field(4, encodingRLE)
+	w.structEnd()
+	w.structEnd()
+}
@@ -0,0 +1,95 @@
+package canparquet
+
+import "encoding/binary"
+
+// Thrift compact protocol types.
+const (
+	compactBoolTrue  = 1
+	compactBoolFalse = 2
+	compactI32       = 5
+	compactI64       = 6
+	compactBinary    = 8
+	compactList      = 9
+	compactStruct    = 12
+)
+
+// compactWriter encodes structs with the Thrift compact protocol, which Parquet uses for page headers and file
+// metadata.
+type compactWriter struct {
+	buf []byte
+	// lastFieldID of the struct being written, for delta-encoding field IDs.
+	lastFieldID int16
+	// lastFieldIDs of the enclosing structs.
+	lastFieldIDs []int16
+}
+
+func (w *compactWriter) fieldHeader(id int16, fieldType byte) {
+	if delta := id - w.lastFieldID; delta > 0 && delta <= 15 {
+		w.buf = append(w.buf, byte(delta)<<4|fieldType)
+	} else {
+		w.buf = append(w.buf, fieldType)
+		w.buf = binary.AppendVarint(w.buf, int64(id))
+	}
+	w.lastFieldID = id
+}
+
+func (w *compactWriter) i32Field(id int16, value int32) {
+	w.fieldHeader(id, compactI32)
+	w.buf = binary.AppendVarint(w.buf, int64(value))
+}
+
+func (w *compactWriter) i64Field(id int16, value int64) {
+	w.fieldHeader(id, compactI64)
+	w.buf = binary.AppendVarint(w.buf, value)
+}
+
+func (w *compactWriter) stringField(id int16, value string) {
+	w.fieldHeader(id, compactBinary)
+	w.appendString(value)
+}
+
+func (w *compactWriter) boolField(id int16, value bool) {
+	if value {
+		w.fieldHeader(id, compactBoolTrue)
+	} else {
+		w.fieldHeader(id, compactBoolFalse)
+	}
+}
+
+// structField begins a struct field, which is ended by structEnd.
+func (w *compactWriter) structField(id int16) {
+	w.fieldHeader(id, compactStruct)
+	w.structBegin()
+}
+
+// structBegin begins a struct, which is ended by structEnd.
+func (w *compactWriter) structBegin() {
+	w.lastFieldIDs = append(w.lastFieldIDs, w.lastFieldID)
+	w.lastFieldID = 0
+}
+
+func (w *compactWriter) structEnd() {
+	w.buf = append(w.buf, 0) // stop field
+	w.lastFieldID = w.lastFieldIDs[len(w.lastFieldIDs)-1]
+	w.lastFieldIDs = w.lastFieldIDs[:len(w.lastFieldIDs)-1]
+}
+
+// listField begins a list field with the provided element type and size, followed by the elements of the list.
+func (w *compactWriter) listField(id int16, elementType byte, size int) {
+	w.fieldHeader(id, compactList)
+	if size < 15 {
+		w.buf = append(w.buf, byte(size)<<4|elementType)
+	} else {
+		w.buf = append(w.buf, 0xf0|elementType)
+		w.buf = binary.AppendUvarint(w.buf, uint64(size))
+	}
+}
+
+func (w *compactWriter) appendI32(value int32) {
+	w.buf = binary.AppendVarint(w.buf, int64(value))
+}
+
+func (w *compactWriter) appendString(value string) {
+	w.buf = binary.AppendUvarint(w.buf, uint64(len(value)))
+	w.buf = append(w.buf, value...)
+}
@@ -0,0 +1,302 @@
+// Package canparquet writes the signals of CAN frames decoded with a database to Apache Parquet files.
+//
+// Each row of a file is a frame, with a timestamp column and a group of columns per message, with a column for the
+// physical value of each signal and a column for the value description label of each signal with value descriptions.
+// The groups of the messages of other frames are null, as are signals not selected by the multiplexer of a frame.
+// The units of the signals are stored in the key-value metadata of the file, with keys of the form
+// "<message>.<signal>.unit".
+//
+// Files are uncompressed, with plain encoded values. Besides the unit tests, which decode files with a minimal reader
+// of their own, the output is validated against pyarrow by a test behind the reference build tag, run with pyarrow
+// installed by:
+//
+//	go test -tags reference ./pkg/canparquet
+package canparquet
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"go.einride.tech/can"
+	"go.einride.tech/can/pkg/descriptor"
+)
+
+const (
+	// magic number at the start and end of Parquet files.
+	magic = "PAR1"
+	// timeColumn is the name of the timestamp column.
+	timeColumn = "time"
+	// labelColumnSuffix is the suffix of the names of value description label columns.
+	labelColumnSuffix = "Label"
+	createdBy         = "go.einride.tech/can/pkg/canparquet"
+	// defaultRowGroupDuration is the default time window of row groups.
+	defaultRowGroupDuration = time.Minute
+)
+
+// WriterOption configures a Writer.
+type WriterOption func(*writerOpts)
+
+type writerOpts struct {
+	rowGroupDuration time.Duration
+}
+
+// WithRowGroupDuration sets the time window of the row groups of the file, which defaults to one minute.
+//
+// Frames are grouped by the window their timestamp is in, with windows aligned to the zero time.
+func WithRowGroupDuration(d time.Duration) WriterOption {
+	return func(opts *writerOpts) {
+		opts.rowGroupDuration = d
+	}
+}
+
+// Writer writes timestamped CAN frames to a Parquet file, as rows of decoded signals.
+type Writer struct {
+	opts     writerOpts
+	w        io.Writer
+	offset   int64
+	metadata fileMetadata
+	time     *column
+	columns  []*column
+	messages map[descriptor.MessageKey]*messageColumns
+	// window is the time window of the current row group.
+	window time.Time
+	// rows is the number of rows of the current row group.
+	rows int
+	err  error
+}
+
+// messageColumns are the columns of a message.
+type messageColumns struct {
+	message *descriptor.Message
+	// values are the columns of the physical values of the signals of the message.
+	values []*column
+	// labels are the columns of the value description labels of the signals, or nil for signals without value
+	// descriptions.
+	labels []*column
+}
+
+// NewWriter returns a new writer of Parquet files with the messages of a database, and writes the header of the file.
+//
+// Frames must be written in chronological order. The file is complete when the writer is closed.
+func NewWriter(w io.Writer, d *descriptor.Database, opt ...WriterOption) (*Writer, error) {
+	opts := writerOpts{rowGroupDuration: defaultRowGroupDuration}
+	for _, f := range opt {
+		f(&opts)
+	}
+	if opts.rowGroupDuration <= 0 {
+		return nil, fmt.Errorf("new parquet writer: invalid row group duration: %v", opts.rowGroupDuration)
+	}
+	pw := &Writer{
+		opts:     opts,
+		w:        w,
+		messages: make(map[descriptor.MessageKey]*messageColumns, len(d.Messages)),
+	}
+	if err := pw.initSchema(d); err != nil {
+		return nil, fmt.Errorf("new parquet writer: %w", err)
+	}
+	if err := pw.write([]byte(magic)); err != nil {
+		return nil, fmt.Errorf("new parquet writer: %w", err)
+	}
+	return pw, nil
+}
+
+func (w *Writer) initSchema(d *descriptor.Database) error {
+	w.metadata.createdBy = createdBy
+	w.metadata.schema = append(w.metadata.schema, schemaElement{
+		name:        "schema",
+		isRoot:      true,
+		isGroup:     true,
+		numChildren: int32(len(d.Messages) + 1),
+	})
+	w.metadata.schema = append(w.metadata.schema, schemaElement{
+		name:         timeColumn,
+		physicalType: typeInt64,
+		repetition:   repetitionRequired,
+		logicalType:  logicalTypeTimestampMicros,
+	})
+	w.time = &column{path: []string{timeColumn}, physicalType: typeInt64}
+	w.columns = append(w.columns, w.time)
+	names := map[string]struct{}{timeColumn: {}}
+	for _, m := range d.Messages {
+		if _, ok := names[m.Name]; ok {
+			return fmt.Errorf("duplicate column name: %s", m.Name)
+		}
+		names[m.Name] = struct{}{}
+		key := m.Key()
+		if _, ok := w.messages[key]; ok {
+			return fmt.Errorf("duplicate message ID: %d", m.ID)
+		}
+		mc := &messageColumns{
+			message: m,
+			values:  make([]*column, 0, len(m.Signals)),
+			labels:  make([]*column, 0, len(m.Signals)),
+		}
+		w.messages[key] = mc
+		group := len(w.metadata.schema)
+		w.metadata.schema = append(w.metadata.schema, schemaElement{
+			name:       m.Name,
+			isGroup:    true,
+			repetition: repetitionOptional,
+		})
+		signalNames := make(map[string]struct{}, len(m.Signals))
+		addColumn := func(name string, physicalType int32, logicalType logicalType) (*column, error) {
+			if _, ok := signalNames[name]; ok {
+				return nil, fmt.Errorf("%s: duplicate column name: %s", m.Name, name)
+			}
+			signalNames[name] = struct{}{}
+			w.metadata.schema = append(w.metadata.schema, schemaElement{
+				name:         name,
+				physicalType: physicalType,
+				repetition:   repetitionOptional,
+				logicalType:  logicalType,
+			})
+			w.metadata.schema[group].numChildren++
+			c := &column{path: []string{m.Name, name}, physicalType: physicalType, maxDefinitionLevel: 2}
+			w.columns = append(w.columns, c)
+			return c, nil
+		}
+		for _, s := range m.Signals {
+			value, err := addColumn(s.Name, typeDouble, logicalTypeNone)
+			if err != nil {
+				return err
+			}
+			mc.values = append(mc.values, value)
+			if s.Unit != "" {
+				w.metadata.keyValues = append(w.metadata.keyValues, keyValue{
+					key:   m.Name + "." + s.Name + ".unit",
+					value: s.Unit,
+				})
+			}
+			var label *column
+			if len(s.ValueDescriptions) > 0 {
+				if label, err = addColumn(s.Name+labelColumnSuffix, typeByteArray, logicalTypeString); err != nil {
+					return err
+				}
+			}
+			mc.labels = append(mc.labels, label)
+		}
+	}
+	return nil
+}
+
+// WriteFrame writes a frame received at the provided time as a row of the file.
+//
+// Remote frames and frames of messages not in the database are skipped.
+func (w *Writer) WriteFrame(t time.Time, f can.Frame) error {
+	if w.err != nil {
+		return w.err
+	}
+	if f.IsRemote {
+		return nil
+	}
+	mc, ok := w.messages[descriptor.MessageKeyOf(f)]
+	if !ok {
+		return nil
+	}
+	if f.Length != mc.message.Length {
+		return fmt.Errorf(
+			"write parquet frame: %s: expects length %d (got %s with length %d)",
+			mc.message.Name, mc.message.Length, f.String(), f.Length,
+		)
+	}
+	window := t.Truncate(w.opts.rowGroupDuration)
+	if w.rows > 0 && !window.Equal(w.window) {
+		if err := w.flushRowGroup(); err != nil {
+			return err
+		}
+	}
+	w.window = window
+	w.time.appendInt64(w.rows, t.UnixMicro())
+	for i, s := range mc.message.Signals {
+		if !mc.message.IsSignalPresent(s, f.Data) {
+			mc.values[i].appendNull(w.rows, 1)
+			if mc.labels[i] != nil {
+				mc.labels[i].appendNull(w.rows, 1)
+			}
+			continue
+		}
+		mc.values[i].appendDouble(w.rows, s.UnmarshalPhysical(f.Data))
+		if mc.labels[i] == nil {
+			continue
+		}
+		if label, ok := s.UnmarshalValueDescription(f.Data); ok {
+			mc.labels[i].appendByteArray(w.rows, label)
+		} else {
+			mc.labels[i].appendNull(w.rows, 1)
+		}
+	}
+	w.rows++
+	return nil
+}
+
+// Close writes the remaining rows and the footer of the file.
+//
+// Close does not close the underlying writer.
+func (w *Writer) Close() error {
+	if w.err != nil {
+		return w.err
+	}
+	if w.rows > 0 {
+		if err := w.flushRowGroup(); err != nil {
+			return err
+		}
+	}
+	var cw compactWriter
+	w.metadata.write(&cw)
+	cw.buf = binary.LittleEndian.AppendUint32(cw.buf, uint32(len(cw.buf)))
+	cw.buf = append(cw.buf, magic...)
+	if err := w.write(cw.buf); err != nil {
+		return fmt.Errorf("close parquet writer: %w", err)
+	}
+	w.err = errors.New("parquet writer is closed")
+	return nil
+}
+
+// flushRowGroup writes the rows of the current row group, with a single data page per column.
+func (w *Writer) flushRowGroup() error {
+	g := rowGroup{columns: make([]columnChunk, 0, len(w.columns)), numRows: int64(w.rows)}
+	var cw compactWriter
+	for _, c := range w.columns {
+		page := c.page(w.rows)
+		cw.buf = cw.buf[:0]
+		header := dataPageHeader{
+			uncompressedSize: int32(len(page)),
+			compressedSize:   int32(len(page)),
+			numValues:        int32(w.rows),
+		}
+		header.write(&cw)
+		chunk := columnChunk{
+			physicalType:          c.physicalType,
+			path:                  c.path,
+			numValues:             int64(w.rows),
+			totalUncompressedSize: int64(len(cw.buf) + len(page)),
+			totalCompressedSize:   int64(len(cw.buf) + len(page)),
+			dataPageOffset:        w.offset,
+		}
+		if err := w.write(cw.buf); err != nil {
+			return fmt.Errorf("write parquet row group: %w", err)
+		}
+		if err := w.write(page); err != nil {
+			return fmt.Errorf("write parquet row group: %w", err)
+		}
+		g.columns = append(g.columns, chunk)
+		g.totalByteSize += chunk.totalUncompressedSize
+		c.reset()
+	}
+	w.metadata.rowGroups = append(w.metadata.rowGroups, g)
+	w.metadata.numRows += int64(w.rows)
+	w.rows = 0
+	return nil
+}
+
+func (w *Writer) write(data []byte) error {
+	n, err := w.w.Write(data)
+	w.offset += int64(n)
+	if err != nil {
+		w.err = err
+	}
+	return err
+}
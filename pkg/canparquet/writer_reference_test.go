@@ -0,0 +1,66 @@
+//go:build reference
+
+package canparquet
+
+import (
+	"encoding/json"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+)
+
+// readScript reads a Parquet file with pyarrow, and prints its schema, metadata and columns as JSON.
+const readScript = `
+import json, sys
+import pyarrow.parquet as pq
+
+table = pq.read_table(sys.argv[1])
+time_type = str(table.schema.field("time").type)
+table = table.set_column(0, "time", table.column("time").cast("int64"))
+metadata = {k.decode(): v.decode() for k, v in pq.read_metadata(sys.argv[1]).metadata.items()}
+json.dump({"timeType": time_type, "metadata": metadata, "columns": table.to_pydict()}, sys.stdout)
+`
+
+// TestWriter_Reference checks that files written by Writer are read as expected by pyarrow.
+func TestWriter_Reference(t *testing.T) {
+	if err := exec.Command("python3", "-c", "import pyarrow").Run(); err != nil {
+		t.Skip("pyarrow is not available:", err)
+	}
+	path := filepath.Join(t.TempDir(), "test.parquet")
+	assert.NilError(t, os.WriteFile(path, writeTestFile(t), 0o600))
+	output, err := exec.Command("python3", "-c", readScript, path).Output()
+	assert.NilError(t, err)
+	var table struct {
+		TimeType string           `json:"timeType"`
+		Metadata map[string]any   `json:"metadata"`
+		Columns  map[string][]any `json:"columns"`
+	}
+	assert.NilError(t, json.Unmarshal(output, &table))
+	assert.Equal(t, "timestamp[us, tz=UTC]", table.TimeType)
+	assert.DeepEqual(t, map[string]any{"MotorStatus.Speed.unit": "km/h"}, table.Metadata)
+	assert.DeepEqual(t, map[string][]any{
+		"time": {
+			float64(testStart.UnixMicro()),
+			float64(testStart.Add(time.Second).UnixMicro()),
+			float64(testStart.Add(2 * time.Second).UnixMicro()),
+			float64(testStart.Add(time.Minute).UnixMicro()),
+		},
+		"MotorStatus": {
+			map[string]any{"Speed": 40.0, "State": 1.0, "StateLabel": "On, running"},
+			nil,
+			map[string]any{"Speed": -10.0, "State": 3.0, "StateLabel": nil},
+			nil,
+		},
+		"Unused": {nil, nil, nil, nil},
+		"Diagnostics": {
+			nil,
+			map[string]any{"Mux": 1.0, "Counter": nil, "Error": -2.0},
+			nil,
+			map[string]any{"Mux": 0.0, "Counter": 7.0, "Error": nil},
+		},
+	}, table.Columns)
+}
@@ -0,0 +1,274 @@
+package canparquet
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"math"
+	"slices"
+	"testing"
+	"time"
+
+	"go.einride.tech/can"
+	"go.einride.tech/can/pkg/descriptor"
+	loggingcan "go.einride.tech/can/testdata/gen/go/logging"
+	"gotest.tools/v3/assert"
+)
+
+var testStart = time.Date(2024, 1, 1, 12, 0, 30, 0, time.UTC)
+
+// writeTestFile writes frames of the logging test database in two row groups.
+func writeTestFile(t *testing.T) []byte {
+	t.Helper()
+	start := testStart
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, loggingcan.Messages().Database())
+	assert.NilError(t, err)
+	for _, tt := range []struct {
+		time  time.Time
+		frame can.Frame
+	}{
+		{time: start, frame: can.Frame{ID: 100, Length: 3, Data: can.Data{0x64, 0x00, 0x01}}},
+		{
+			time:  start.Add(time.Second),
+			frame: can.Frame{ID: 0x18daf110, IsExtended: true, Length: 2, Data: can.Data{1, 0xfe}},
+		},
+		{time: start.Add(2 * time.Second), frame: can.Frame{ID: 100, Length: 3, Data: can.Data{0x00, 0x00, 0x03}}},
+		{time: start.Add(3 * time.Second), frame: can.Frame{ID: 200, Length: 8}},                 // unknown message
+		{time: start.Add(4 * time.Second), frame: can.Frame{ID: 100, Length: 3, IsRemote: true}}, // remote frame
+		// next row group
+		{
+			time:  start.Add(time.Minute),
+			frame: can.Frame{ID: 0x18daf110, IsExtended: true, Length: 2, Data: can.Data{0, 7}},
+		},
+	} {
+		assert.NilError(t, w.WriteFrame(tt.time, tt.frame))
+	}
+	assert.NilError(t, w.Close())
+	return buf.Bytes()
+}
+
+func TestWriter(t *testing.T) {
+	start := testStart
+	file := readTestFile(t, writeTestFile(t))
+	assert.Equal(t, int64(4), file.metadata[3])
+	assert.Equal(t, 2, len(file.rowGroups()))
+	var schemaNames []any
+	for _, e := range file.metadata[2].([]any) {
+		schemaNames = append(schemaNames, e.(map[int16]any)[4])
+	}
+	assert.DeepEqual(t, []any{
+		"schema", "time", "MotorStatus", "Speed", "State", "StateLabel", "Unused", "Value",
+		"Diagnostics", "Mux", "Counter", "Error",
+	}, schemaNames)
+	assert.DeepEqual(t, []any{map[int16]any{1: "MotorStatus.Speed.unit", 2: "km/h"}}, file.metadata[5])
+	assert.DeepEqual(t, []any{
+		start.UnixMicro(),
+		start.Add(time.Second).UnixMicro(),
+		start.Add(2 * time.Second).UnixMicro(),
+		start.Add(time.Minute).UnixMicro(),
+	}, file.column(t, 0))
+	assert.DeepEqual(t, []any{40.0, nil, -10.0, nil}, file.column(t, 1))
+	assert.DeepEqual(t, []any{1.0, nil, 3.0, nil}, file.column(t, 2))
+	assert.DeepEqual(t, []any{"On, running", nil, nil, nil}, file.column(t, 3))
+	assert.DeepEqual(t, []any{nil, nil, nil, nil}, file.column(t, 4))
+	assert.DeepEqual(t, []any{nil, 1.0, nil, 0.0}, file.column(t, 5))
+	assert.DeepEqual(t, []any{nil, nil, nil, 7.0}, file.column(t, 6))
+	assert.DeepEqual(t, []any{nil, -2.0, nil, nil}, file.column(t, 7))
+}
+
+func TestWriter_Empty(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, loggingcan.Messages().Database())
+	assert.NilError(t, err)
+	assert.NilError(t, w.Close())
+	file := readTestFile(t, buf.Bytes())
+	assert.Equal(t, int64(0), file.metadata[3])
+	assert.Equal(t, 0, len(file.rowGroups()))
+	assert.ErrorContains(t, w.WriteFrame(time.Now(), can.Frame{ID: 100, Length: 3}), "closed")
+}
+
+func TestWriter_InvalidLength(t *testing.T) {
+	w, err := NewWriter(&bytes.Buffer{}, loggingcan.Messages().Database())
+	assert.NilError(t, err)
+	err = w.WriteFrame(time.Now(), can.Frame{ID: 100, Length: 8})
+	assert.ErrorContains(t, err, "MotorStatus: expects length 3")
+	// the writer is still usable
+	assert.NilError(t, w.WriteFrame(time.Now(), can.Frame{ID: 100, Length: 3}))
+	assert.NilError(t, w.Close())
+}
+
+func TestWriter_DuplicateColumnName(t *testing.T) {
+	motorStatus := *loggingcan.Messages().MotorStatus.Message
+	motorStatus.Signals = append(
+		slices.Clone(motorStatus.Signals), &descriptor.Signal{Name: "StateLabel", Start: 18, Length: 1, Scale: 1},
+	)
+	d := &descriptor.Database{Messages: []*descriptor.Message{&motorStatus}}
+	_, err := NewWriter(&bytes.Buffer{}, d)
+	assert.ErrorContains(t, err, "MotorStatus: duplicate column name: StateLabel")
+}
+
+func TestWriter_WriteError(t *testing.T) {
+	_, err := NewWriter(errWriter{}, loggingcan.Messages().Database())
+	assert.ErrorContains(t, err, "boom")
+}
+
+type errWriter struct{}
+
+func (errWriter) Write([]byte) (int, error) {
+	return 0, errors.New("boom")
+}
+
+// testFile is a Parquet file read by a minimal reader of the subset of the format written by Writer.
+type testFile struct {
+	data     []byte
+	metadata map[int16]any
+}
+
+func readTestFile(t *testing.T, data []byte) *testFile {
+	t.Helper()
+	assert.Equal(t, magic, string(data[:4]))
+	assert.Equal(t, magic, string(data[len(data)-4:]))
+	footerLength := int(binary.LittleEndian.Uint32(data[len(data)-8:]))
+	r := &compactReader{buf: data[len(data)-8-footerLength : len(data)-8]}
+	metadata := r.readStruct()
+	assert.Equal(t, 0, len(r.buf), "trailing footer data")
+	return &testFile{data: data, metadata: metadata}
+}
+
+func (f *testFile) rowGroups() []any {
+	rowGroups, _ := f.metadata[4].([]any)
+	return rowGroups
+}
+
+// column returns the values of the column with the provided index, with nil for null values.
+func (f *testFile) column(t *testing.T, index int) []any {
+	t.Helper()
+	var result []any
+	for _, g := range f.rowGroups() {
+		chunk := g.(map[int16]any)[1].([]any)[index].(map[int16]any)
+		meta := chunk[3].(map[int16]any)
+		path := meta[3].([]any)
+		physicalType := meta[1].(int64)
+		r := &compactReader{buf: f.data[meta[9].(int64):]}
+		header := r.readStruct()
+		assert.Equal(t, int64(pageTypeData), header[1])
+		numValues := int(header[5].(map[int16]any)[1].(int64))
+		page := r.buf[:header[3].(int64)]
+		// only the columns of signals, in message groups, are optional
+		maxDefinitionLevel := len(path)
+		if len(path) == 1 {
+			maxDefinitionLevel = 0
+		}
+		levels := make([]int, 0, numValues)
+		if maxDefinitionLevel == 0 {
+			for range numValues {
+				levels = append(levels, 0)
+			}
+		} else {
+			n := binary.LittleEndian.Uint32(page)
+			lr := &compactReader{buf: page[4 : 4+n]}
+			for len(lr.buf) > 0 {
+				runHeader := lr.readUvarint()
+				assert.Equal(t, uint64(0), runHeader&1, "bit-packed run")
+				level := int(lr.readByte())
+				for range runHeader >> 1 {
+					levels = append(levels, level)
+				}
+			}
+			page = page[4+n:]
+		}
+		assert.Equal(t, numValues, len(levels))
+		for _, level := range levels {
+			if level < maxDefinitionLevel {
+				result = append(result, nil)
+				continue
+			}
+			switch physicalType {
+			case typeInt64:
+				result = append(result, int64(binary.LittleEndian.Uint64(page)))
+				page = page[8:]
+			case typeDouble:
+				result = append(result, math.Float64frombits(binary.LittleEndian.Uint64(page)))
+				page = page[8:]
+			case typeByteArray:
+				n := binary.LittleEndian.Uint32(page)
+				result = append(result, string(page[4:4+n]))
+				page = page[4+n:]
+			}
+		}
+		assert.Equal(t, 0, len(page), "trailing page data")
+	}
+	return result
+}
+
+// compactReader decodes the Thrift compact protocol into maps of field IDs to values.
+type compactReader struct {
+	buf []byte
+}
+
+func (r *compactReader) readByte() byte {
+	b := r.buf[0]
+	r.buf = r.buf[1:]
+	return b
+}
+
+func (r *compactReader) readUvarint() uint64 {
+	value, n := binary.Uvarint(r.buf)
+	r.buf = r.buf[n:]
+	return value
+}
+
+func (r *compactReader) readVarint() int64 {
+	value, n := binary.Varint(r.buf)
+	r.buf = r.buf[n:]
+	return value
+}
+
+func (r *compactReader) readStruct() map[int16]any {
+	result := map[int16]any{}
+	var lastFieldID int16
+	for {
+		header := r.readByte()
+		if header == 0 {
+			return result
+		}
+		fieldType := header & 0x0f
+		fieldID := lastFieldID + int16(header>>4)
+		if header>>4 == 0 {
+			fieldID = int16(r.readVarint())
+		}
+		lastFieldID = fieldID
+		result[fieldID] = r.readValue(fieldType)
+	}
+}
+
+func (r *compactReader) readValue(valueType byte) any {
+	switch valueType {
+	case compactBoolTrue:
+		return true
+	case compactBoolFalse:
+		return false
+	case compactI32, compactI64:
+		return r.readVarint()
+	case compactBinary:
+		n := r.readUvarint()
+		value := string(r.buf[:n])
+		r.buf = r.buf[n:]
+		return value
+	case compactList:
+		header := r.readByte()
+		size := int(header >> 4)
+		if size == 15 {
+			size = int(r.readUvarint())
+		}
+		list := make([]any, 0, size)
+		for range size {
+			list = append(list, r.readValue(header&0x0f))
+		}
+		return list
+	case compactStruct:
+		return r.readStruct()
+	}
+	panic("unsupported compact type")
+}
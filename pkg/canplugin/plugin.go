@@ -0,0 +1,73 @@
+// Package canplugin provides the protocol of code generator plugins for cantool.
+//
+// A plugin is an executable named cantool-gen-<name>, found in the PATH or given by path, which is run by
+// "cantool generate --plugin <name>" once per compiled database. The plugin reads a Request as JSON from its standard
+// input, and writes a Response as JSON to its standard output.
+package canplugin
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"go.einride.tech/can/pkg/descriptor"
+)
+
+// ExecutablePrefix is the prefix of the executable names of plugins.
+const ExecutablePrefix = "cantool-gen-"
+
+// Request is a request to generate code for a database.
+type Request struct {
+	// Parameter of the plugin, given after the plugin name as in "--plugin <name>:<parameter>".
+	Parameter string
+	// GoFile is the path of the generated Go file of the database, relative to the output directory.
+	GoFile string
+	// GoPackage is the name of the package of the generated Go file.
+	GoPackage string
+	// Database to generate code for.
+	Database *descriptor.Database
+}
+
+// Response is the response of a plugin.
+type Response struct {
+	// Files generated by the plugin.
+	Files []*File
+	// Error is a non-empty error message when the plugin could not generate code for the request.
+	Error string
+}
+
+// File is a file generated by a plugin.
+type File struct {
+	// Name of the file, relative to the directory of the generated Go file.
+	//
+	// Go files are formatted before they are written.
+	Name string
+	// Content of the file.
+	Content string
+}
+
+// Run runs a plugin, with the request read from standard input and the response written to standard output.
+//
+// Errors returned by the generate function are written to the response, and make cantool fail.
+func Run(generate func(*Request) (*Response, error)) {
+	if err := run(os.Stdin, os.Stdout, generate); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(r io.Reader, w io.Writer, generate func(*Request) (*Response, error)) error {
+	var request Request
+	if err := json.NewDecoder(r).Decode(&request); err != nil {
+		return fmt.Errorf("run plugin: %w", err)
+	}
+	response, err := generate(&request)
+	if err != nil {
+		response = &Response{Error: err.Error()}
+	}
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		return fmt.Errorf("run plugin: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,30 @@
+package canplugin
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestRun(t *testing.T) {
+	input := strings.NewReader(`{"Parameter":"foo","GoFile":"example.dbc.go","Database":{"SourceFile":"example.dbc"}}`)
+	var output bytes.Buffer
+	err := run(input, &output, func(request *Request) (*Response, error) {
+		assert.Equal(t, "example.dbc", request.Database.SourceFile)
+		return &Response{Files: []*File{{Name: request.Parameter + ".txt", Content: request.GoFile}}}, nil
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, `{"Files":[{"Name":"foo.txt","Content":"example.dbc.go"}],"Error":""}`+"\n", output.String())
+}
+
+func TestRun_Error(t *testing.T) {
+	var output bytes.Buffer
+	err := run(strings.NewReader(`{}`), &output, func(*Request) (*Response, error) {
+		return nil, errors.New("boom")
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, `{"Files":null,"Error":"boom"}`+"\n", output.String())
+}
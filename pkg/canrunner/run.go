@@ -97,6 +97,22 @@ func Run(ctx context.Context, n Node) error {
 	return nil
 }
 
+// BusNode is an interface for a node on one of the buses of a network node.
+type BusNode interface {
+	Run(ctx context.Context) error
+}
+
+// RunNetwork runs a network node, with one runner per bus, until the context is canceled or a bus node fails.
+func RunNetwork(ctx context.Context, nodes ...BusNode) error {
+	g, ctx := errgroup.WithContext(ctx)
+	for _, n := range nodes {
+		g.Go(func() error {
+			return n.Run(ctx)
+		})
+	}
+	return g.Wait()
+}
+
 func RunMessageReceiver(ctx context.Context, rx FrameReceiver, n Node, c clock.Clock) error {
 	for rx.Receive() {
 		f := rx.Frame()
@@ -108,6 +124,10 @@ func RunMessageReceiver(ctx context.Context, rx FrameReceiver, n Node, c clock.C
 		hook := m.AfterReceiveHook()
 		m.SetReceiveTime(c.Now())
 		err := m.UnmarshalFrame(f)
+		var signalHooks []func(context.Context) error
+		if subscriber, ok := m.(SignalSubscriber); ok && err == nil {
+			signalHooks = subscriber.SignalSubscriptions().Triggered(m.Descriptor(), f)
+		}
 		n.Unlock()
 		if err != nil {
 			return fmt.Errorf("receiver: %w", err)
@@ -115,6 +135,14 @@ func RunMessageReceiver(ctx context.Context, rx FrameReceiver, n Node, c clock.C
 		if err := hook(ctx); err != nil {
 			return fmt.Errorf("receiver: %w", err)
 		}
+		for _, signalHook := range signalHooks {
+			if err := signalHook(ctx); err != nil {
+				return fmt.Errorf("receiver: %w", err)
+			}
+		}
+		if publisher, ok := m.(StreamPublisher); ok {
+			publisher.ReceiveStreams().Publish(ctx, f)
+		}
 	}
 	if err := rx.Err(); err != nil {
 		return fmt.Errorf("receiver: %w", err)
@@ -133,35 +161,65 @@ func RunMessageTransmitter(
 	if sendTimeout == 0 {
 		sendTimeout = defaultSendTimeout
 	}
-	var cyclicTransmissionTicker *time.Ticker
+	var cyclicTransmissionTicker clock.Ticker
 	var cyclicTransmissionTickChan <-chan time.Time
-	enableCyclicTransmission := func() {
-		isCyclic := m.Descriptor().SendType == descriptor.SendTypeCyclic
-		hasCycleTime := m.Descriptor().CycleTime > 0
-		if !isCyclic || !hasCycleTime || cyclicTransmissionTicker != nil {
+	var cyclicTransmissionPeriod time.Duration
+	setCyclicTransmissionPeriod := func(period time.Duration) {
+		if period == cyclicTransmissionPeriod {
 			return
 		}
-		cyclicTransmissionTicker = time.NewTicker(m.Descriptor().CycleTime)
-		cyclicTransmissionTickChan = cyclicTransmissionTicker.C
-	}
-	disableCyclicTransmission := func() {
-		if cyclicTransmissionTicker == nil {
-			return
+		cyclicTransmissionPeriod = period
+		if cyclicTransmissionTicker != nil {
+			cyclicTransmissionTicker.Stop()
+			cyclicTransmissionTicker = nil
+			cyclicTransmissionTickChan = nil
+		}
+		if period > 0 {
+			cyclicTransmissionTicker = c.NewTicker(period)
+			cyclicTransmissionTickChan = cyclicTransmissionTicker.C()
 		}
-		cyclicTransmissionTicker.Stop()
-		cyclicTransmissionTicker = nil
 	}
-	setCyclicTransmission := func() {
+	defer func() {
+		if cyclicTransmissionTicker != nil {
+			cyclicTransmissionTicker.Stop()
+		}
+	}()
+	var isActive bool
+	// setCyclicTransmission updates the cyclic transmission period and returns true if the message became active.
+	setCyclicTransmission := func() bool {
+		sendType := m.Descriptor().SendType
 		l.Lock()
 		isCyclicTransmissionEnabled := m.IsCyclicTransmissionEnabled()
+		wasActive := isActive
+		if sendType == descriptor.SendTypeIfActive || sendType == descriptor.SendTypeCyclicIfActiveFast {
+			isActive = m.Descriptor().IsActive(m.Frame().Data)
+		}
 		l.Unlock()
-		if isCyclicTransmissionEnabled {
-			enableCyclicTransmission()
-		} else {
-			disableCyclicTransmission()
+		switch {
+		case !sendType.IsCyclic() || !isCyclicTransmissionEnabled:
+			setCyclicTransmissionPeriod(0)
+		case sendType == descriptor.SendTypeIfActive && !isActive:
+			setCyclicTransmissionPeriod(0)
+		case sendType == descriptor.SendTypeCyclicIfActiveFast && isActive && m.Descriptor().CycleTimeFast > 0:
+			setCyclicTransmissionPeriod(m.Descriptor().CycleTimeFast)
+		default:
+			setCyclicTransmissionPeriod(m.Descriptor().CycleTime)
 		}
+		return isCyclicTransmissionEnabled && isActive && !wasActive
 	}
+	var lastTransmitTime time.Time
+	var lastTransmittedData can.Data
+	var hasTransmitted bool
 	transmit := func() error {
+		if delayTime := m.Descriptor().DelayTime; delayTime > 0 && hasTransmitted {
+			if wait := delayTime - c.Now().Sub(lastTransmitTime); wait > 0 {
+				select {
+				case <-ctx.Done():
+					return nil
+				case <-c.After(wait):
+				}
+			}
+		}
 		l.Lock()
 		hook := m.BeforeTransmitHook()
 		m.SetTransmitTime(c.Now())
@@ -178,8 +236,65 @@ func RunMessageTransmitter(
 		if err != nil {
 			return fmt.Errorf("%s transmitter: %w", m.Descriptor().Name, err)
 		}
+		lastTransmitTime = c.Now()
+		lastTransmittedData = f.Data
+		hasTransmitted = true
 		return nil
 	}
+	var repetitionTimerChan <-chan time.Time
+	var remainingRepetitions int
+	scheduleRepetition := func() {
+		repetitionTimerChan = nil
+		// repetitions are spaced by the fast cycle time, and are not sent without one
+		if remainingRepetitions <= 0 || m.Descriptor().CycleTimeFast <= 0 {
+			return
+		}
+		remainingRepetitions--
+		repetitionTimerChan = c.After(m.Descriptor().CycleTimeFast)
+	}
+	// transmitEvent transmits an event-triggered message and schedules its repetitions.
+	transmitEvent := func() error {
+		if err := transmit(); err != nil {
+			return err
+		}
+		remainingRepetitions = m.Descriptor().RepetitionCount
+		scheduleRepetition()
+		return nil
+	}
+	var writeTracker *WriteTracker
+	var writeChan <-chan struct{}
+	if wt, ok := m.(WriteTracked); ok {
+		writeTracker = wt.WriteTracker()
+		writeChan = writeTracker.C()
+	}
+	// isWriteTriggered returns true if the written signals trigger a transmission.
+	isWriteTriggered := func() bool {
+		l.Lock()
+		written := writeTracker.Take()
+		data := m.Frame().Data
+		l.Unlock()
+		for _, s := range written {
+			sendType := s.SendType
+			if sendType == descriptor.SendTypeNone {
+				sendType = m.Descriptor().SendType
+			}
+			switch sendType {
+			case descriptor.SendTypeOnWrite:
+				return true
+			case descriptor.SendTypeOnChange, descriptor.SendTypeCyclicAndOnChange:
+				// only the raw value of the written signal is compared to its last transmitted value
+				if !hasTransmitted || s.UnmarshalUnsigned(data) != s.UnmarshalUnsigned(lastTransmittedData) {
+					return true
+				}
+			case descriptor.SendTypeIfActive:
+				// messages sent if active are only write-triggered by signals sent if active
+				if s.SendType == descriptor.SendTypeIfActive && m.Descriptor().IsActive(data) {
+					return true
+				}
+			}
+		}
+		return false
+	}
 	ctxDone := ctx.Done()
 	transmitEventChan := m.TransmitEventChan()
 	setCyclicTransmission()
@@ -189,15 +304,31 @@ func RunMessageTransmitter(
 		case <-ctxDone:
 			return nil
 		case <-wakeUpChan:
-			setCyclicTransmission()
+			if setCyclicTransmission() {
+				if err := transmitEvent(); err != nil {
+					return err
+				}
+			}
+		case <-writeChan:
+			isTriggered := isWriteTriggered()
+			if becameActive := setCyclicTransmission(); isTriggered || becameActive {
+				if err := transmitEvent(); err != nil {
+					return err
+				}
+			}
 		case <-transmitEventChan:
-			if err := transmit(); err != nil {
+			if err := transmitEvent(); err != nil {
 				return err
 			}
 		case <-cyclicTransmissionTickChan:
 			if err := transmit(); err != nil {
 				return err
 			}
+		case <-repetitionTimerChan:
+			if err := transmit(); err != nil {
+				return err
+			}
+			scheduleRepetition()
 		}
 	}
 }
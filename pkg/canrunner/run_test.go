@@ -129,3 +129,26 @@ func TestRunMessageTransmitter_TransmitEventMessage(t *testing.T) {
 	cancel()
 	assert.NilError(t, g.Wait())
 }
+
+type busNodeFunc func(context.Context) error
+
+func (f busNodeFunc) Run(ctx context.Context) error {
+	return f(ctx)
+}
+
+func TestRunNetwork_BusNodeFails(t *testing.T) {
+	errBus := errors.New("bus failed")
+	// when one bus node fails
+	err := canrunner.RunNetwork(
+		context.Background(),
+		busNodeFunc(func(ctx context.Context) error {
+			<-ctx.Done()
+			return nil
+		}),
+		busNodeFunc(func(context.Context) error {
+			return errBus
+		}),
+	)
+	// then the other bus nodes should be stopped and the error returned
+	assert.Assert(t, errors.Is(err, errBus))
+}
@@ -0,0 +1,157 @@
+package canrunner
+
+import (
+	"context"
+	"iter"
+	"sync"
+
+	"go.einride.tech/can"
+)
+
+// StreamPublisher is an interface for a received message with streams of received snapshots.
+//
+// The runner publishes every received frame of a message implementing StreamPublisher to its streams, after the
+// after receive hook and the signal subscriptions have been called.
+type StreamPublisher interface {
+	// ReceiveStreams returns the receive streams of the message.
+	ReceiveStreams() *ReceiveStreams
+}
+
+// OverflowPolicy decides what happens when a receive stream is full.
+type OverflowPolicy uint8
+
+const (
+	// OverflowPolicyDropOldest drops the oldest buffered snapshot to make room for the received snapshot.
+	OverflowPolicyDropOldest OverflowPolicy = iota
+	// OverflowPolicyDropNewest drops the received snapshot.
+	OverflowPolicyDropNewest
+	// OverflowPolicyBlock blocks the receiver until there is room for the received snapshot.
+	//
+	// A blocked stream blocks the reception of all messages of the node.
+	OverflowPolicyBlock
+)
+
+// ReceiveStreams is a set of streams of received frames of a message.
+//
+// The zero value is an empty set of streams, ready to use.
+type ReceiveStreams struct {
+	mu      sync.Mutex
+	streams []*receiveStream
+}
+
+type receiveStream struct {
+	mu      sync.Mutex
+	closed  bool
+	publish func(ctx context.Context, f can.Frame)
+	close   func()
+}
+
+// Publish publishes a received frame to all open streams.
+//
+// Publish only blocks on streams with OverflowPolicyBlock, and stops blocking when the context is done.
+func (rs *ReceiveStreams) Publish(ctx context.Context, f can.Frame) {
+	rs.mu.Lock()
+	streams := append([]*receiveStream(nil), rs.streams...)
+	rs.mu.Unlock()
+	for _, s := range streams {
+		s.mu.Lock()
+		if !s.closed {
+			s.publish(ctx, f)
+		}
+		s.mu.Unlock()
+	}
+}
+
+func (rs *ReceiveStreams) add(s *receiveStream) {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	rs.streams = append(rs.streams, s)
+}
+
+func (rs *ReceiveStreams) remove(s *receiveStream) {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	for i, rss := range rs.streams {
+		if rss == s {
+			rs.streams = append(rs.streams[:i], rs.streams[i+1:]...)
+			return
+		}
+	}
+}
+
+// StreamChan opens a stream of received snapshots of a message, converted from frames by the convert function.
+//
+// The channel buffers up to size snapshots, and is closed when the context is done. A size less than one is treated
+// as one for the drop policies.
+func StreamChan[T any](
+	ctx context.Context,
+	rs *ReceiveStreams,
+	size int,
+	policy OverflowPolicy,
+	convert func(can.Frame) T,
+) <-chan T {
+	if policy != OverflowPolicyBlock && size < 1 {
+		size = 1
+	}
+	ch := make(chan T, size)
+	s := &receiveStream{close: func() { close(ch) }}
+	s.publish = func(runCtx context.Context, f can.Frame) {
+		v := convert(f)
+		switch policy {
+		case OverflowPolicyBlock:
+			select {
+			case ch <- v:
+			case <-ctx.Done():
+			case <-runCtx.Done():
+			}
+		case OverflowPolicyDropNewest:
+			select {
+			case ch <- v:
+			default:
+			}
+		default:
+			for {
+				select {
+				case ch <- v:
+					return
+				default:
+				}
+				select {
+				case <-ch:
+				default:
+				}
+			}
+		}
+	}
+	rs.add(s)
+	go func() {
+		<-ctx.Done()
+		rs.remove(s)
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.closed = true
+		s.close()
+	}()
+	return ch
+}
+
+// StreamSeq returns a sequence of received snapshots of a message, converted from frames by the convert function.
+//
+// Each iteration of the sequence opens a stream as by StreamChan, which is closed when the iteration stops.
+func StreamSeq[T any](
+	ctx context.Context,
+	rs *ReceiveStreams,
+	size int,
+	policy OverflowPolicy,
+	convert func(can.Frame) T,
+) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		for v := range StreamChan(ctx, rs, size, policy, convert) {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
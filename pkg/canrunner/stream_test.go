@@ -0,0 +1,103 @@
+package canrunner_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.einride.tech/can"
+	"go.einride.tech/can/pkg/canrunner"
+	"gotest.tools/v3/assert"
+)
+
+func TestStreamChan_OverflowPolicy(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		policy   canrunner.OverflowPolicy
+		expected []uint32
+	}{
+		{name: "drop oldest", policy: canrunner.OverflowPolicyDropOldest, expected: []uint32{3, 4}},
+		{name: "drop newest", policy: canrunner.OverflowPolicyDropNewest, expected: []uint32{1, 2}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			var rs canrunner.ReceiveStreams
+			ch := canrunner.StreamChan(ctx, &rs, 2, tt.policy, frameID)
+			for id := uint32(1); id <= 4; id++ {
+				rs.Publish(context.Background(), can.Frame{ID: id})
+			}
+			cancel()
+			actual := make([]uint32, 0, len(tt.expected))
+			for id := range ch {
+				actual = append(actual, id)
+			}
+			assert.DeepEqual(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestStreamChan_Block(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var rs canrunner.ReceiveStreams
+	ch := canrunner.StreamChan(ctx, &rs, 0, canrunner.OverflowPolicyBlock, frameID)
+	published := make(chan struct{})
+	go func() {
+		defer close(published)
+		rs.Publish(ctx, can.Frame{ID: 1})
+	}()
+	// the publisher should block until the snapshot is consumed
+	select {
+	case <-published:
+		t.Fatal("expected publish to block")
+	case <-time.After(10 * time.Millisecond):
+	}
+	assert.Equal(t, uint32(1), <-ch)
+	<-published
+}
+
+func TestStreamChan_Cancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	var rs canrunner.ReceiveStreams
+	ch := canrunner.StreamChan(ctx, &rs, 0, canrunner.OverflowPolicyBlock, frameID)
+	// when the context is canceled
+	cancel()
+	// then the channel should be closed
+	_, ok := <-ch
+	assert.Assert(t, !ok)
+	// and publishing should not block
+	rs.Publish(context.Background(), can.Frame{ID: 1})
+}
+
+func TestStreamSeq(t *testing.T) {
+	var rs canrunner.ReceiveStreams
+	done := make(chan struct{})
+	go func() {
+		for id := uint32(1); ; id++ {
+			select {
+			case <-done:
+				return
+			default:
+			}
+			rs.Publish(context.Background(), can.Frame{ID: id})
+		}
+	}()
+	// when iterating the sequence until two snapshots are received
+	var actual []uint32
+	for id := range canrunner.StreamSeq(context.Background(), &rs, 0, canrunner.OverflowPolicyBlock, frameID) {
+		actual = append(actual, id)
+		if len(actual) == 2 {
+			break
+		}
+	}
+	close(done)
+	// then the snapshots should be received in order
+	assert.Equal(t, 2, len(actual))
+	assert.Assert(t, actual[0] < actual[1])
+	// and publishing should not block after the iteration stopped
+	rs.Publish(context.Background(), can.Frame{ID: 0})
+}
+
+func frameID(f can.Frame) uint32 {
+	return f.ID
+}
@@ -0,0 +1,137 @@
+package canrunner
+
+import (
+	"context"
+	"sync"
+
+	"go.einride.tech/can"
+	"go.einride.tech/can/pkg/descriptor"
+)
+
+// SignalSubscriber is an interface for a received message with signal-level subscriptions.
+//
+// The runner evaluates the subscriptions of a received message implementing SignalSubscriber on every frame, and
+// calls the hooks of the triggered subscriptions after the after receive hook.
+type SignalSubscriber interface {
+	// SignalSubscriptions returns the signal subscriptions of the message.
+	SignalSubscriptions() *SignalSubscriptions
+}
+
+// SignalTrigger decides if a signal subscription should be triggered by a transition between two payloads.
+type SignalTrigger func(s *descriptor.Signal, prev, curr can.Data) bool
+
+// CrossingDirection is the direction of a threshold crossing.
+type CrossingDirection uint8
+
+const (
+	// CrossingDirectionBoth triggers on crossings in both directions.
+	CrossingDirectionBoth CrossingDirection = iota
+	// CrossingDirectionRising triggers when the value goes from below to at or above the threshold.
+	CrossingDirectionRising
+	// CrossingDirectionFalling triggers when the value goes from at or above to below the threshold.
+	CrossingDirectionFalling
+)
+
+// OnChange returns a trigger for when the raw value of the signal changes.
+func OnChange() SignalTrigger {
+	return func(s *descriptor.Signal, prev, curr can.Data) bool {
+		return s.UnmarshalUnsigned(prev) != s.UnmarshalUnsigned(curr)
+	}
+}
+
+// OnValueDescriptionChange returns a trigger for when the value description of the signal changes.
+//
+// Values without a value description are treated as having an empty description.
+func OnValueDescriptionChange() SignalTrigger {
+	return func(s *descriptor.Signal, prev, curr can.Data) bool {
+		prevDescription, _ := s.UnmarshalValueDescription(prev)
+		currDescription, _ := s.UnmarshalValueDescription(curr)
+		return prevDescription != currDescription
+	}
+}
+
+// OnTransition returns a trigger for when the value description of the signal transitions from one description to
+// another.
+//
+// An empty from or to description matches any other description.
+func OnTransition(from, to string) SignalTrigger {
+	return func(s *descriptor.Signal, prev, curr can.Data) bool {
+		prevDescription, _ := s.UnmarshalValueDescription(prev)
+		currDescription, _ := s.UnmarshalValueDescription(curr)
+		if prevDescription == currDescription {
+			return false
+		}
+		return (from == "" || prevDescription == from) && (to == "" || currDescription == to)
+	}
+}
+
+// OnThresholdCrossing returns a trigger for when the physical value of the signal crosses the threshold in the
+// provided direction.
+func OnThresholdCrossing(threshold float64, direction CrossingDirection) SignalTrigger {
+	return func(s *descriptor.Signal, prev, curr can.Data) bool {
+		prevAbove := s.UnmarshalPhysical(prev) >= threshold
+		currAbove := s.UnmarshalPhysical(curr) >= threshold
+		switch direction {
+		case CrossingDirectionRising:
+			return !prevAbove && currAbove
+		case CrossingDirectionFalling:
+			return prevAbove && !currAbove
+		default:
+			return prevAbove != currAbove
+		}
+	}
+}
+
+// SignalSubscriptions is a set of signal-level subscriptions on a received message.
+//
+// The zero value is an empty set of subscriptions, ready to use.
+type SignalSubscriptions struct {
+	mu            sync.Mutex
+	subscriptions []*signalSubscription
+}
+
+type signalSubscription struct {
+	signal  *descriptor.Signal
+	trigger SignalTrigger
+	hook    func(ctx context.Context, prev, curr can.Frame) error
+	prev    can.Frame
+	hasPrev bool
+}
+
+// Subscribe adds a subscription calling the hook when the trigger fires for the signal.
+//
+// The hook is called with the previous and current frames carrying the signal. The first frame carrying the signal
+// after subscribing never triggers the hook. Multiplexed signals are only evaluated on frames where the multiplexer
+// selects the signal.
+func (ss *SignalSubscriptions) Subscribe(
+	s *descriptor.Signal,
+	trigger SignalTrigger,
+	hook func(ctx context.Context, prev, curr can.Frame) error,
+) {
+	ss.mu.Lock()
+	defer ss.mu.Unlock()
+	ss.subscriptions = append(ss.subscriptions, &signalSubscription{signal: s, trigger: trigger, hook: hook})
+}
+
+// Triggered evaluates the subscriptions against a received frame of the message and returns the hooks of the
+// triggered subscriptions.
+func (ss *SignalSubscriptions) Triggered(m *descriptor.Message, f can.Frame) []func(context.Context) error {
+	ss.mu.Lock()
+	defer ss.mu.Unlock()
+	var hooks []func(context.Context) error
+	for _, sub := range ss.subscriptions {
+		if !m.IsSignalPresent(sub.signal, f.Data) {
+			continue
+		}
+		prev, hasPrev := sub.prev, sub.hasPrev
+		sub.prev, sub.hasPrev = f, true
+		if !hasPrev || !sub.trigger(sub.signal, prev.Data, f.Data) {
+			continue
+		}
+		hook, curr := sub.hook, f
+		hooks = append(hooks, func(ctx context.Context) error {
+			return hook(ctx, prev, curr)
+		})
+	}
+	return hooks
+}
@@ -0,0 +1,114 @@
+package canrunner_test
+
+import (
+	"context"
+	"testing"
+
+	"go.einride.tech/can"
+	"go.einride.tech/can/pkg/canrunner"
+	"go.einride.tech/can/pkg/descriptor"
+	"gotest.tools/v3/assert"
+)
+
+func TestSignalTrigger(t *testing.T) {
+	s := &descriptor.Signal{
+		Name:   "State",
+		Start:  0,
+		Length: 8,
+		Scale:  0.5,
+		ValueDescriptions: []*descriptor.ValueDescription{
+			{Value: 0, Description: "Off"},
+			{Value: 1, Description: "On"},
+			{Value: 2, Description: "Error"},
+		},
+	}
+	data := func(value uint64) can.Data {
+		var d can.Data
+		s.MarshalUnsigned(&d, value)
+		return d
+	}
+	for _, tt := range []struct {
+		name     string
+		trigger  canrunner.SignalTrigger
+		prev     uint64
+		curr     uint64
+		expected bool
+	}{
+		{name: "change", trigger: canrunner.OnChange(), prev: 1, curr: 2, expected: true},
+		{name: "no change", trigger: canrunner.OnChange(), prev: 1, curr: 1, expected: false},
+		{name: "description change", trigger: canrunner.OnValueDescriptionChange(), prev: 0, curr: 1, expected: true},
+		{name: "description no change", trigger: canrunner.OnValueDescriptionChange(), prev: 3, curr: 4},
+		{name: "transition", trigger: canrunner.OnTransition("Off", "On"), prev: 0, curr: 1, expected: true},
+		{name: "transition wrong from", trigger: canrunner.OnTransition("Off", "On"), prev: 2, curr: 1},
+		{name: "transition any from", trigger: canrunner.OnTransition("", "Error"), prev: 1, curr: 2, expected: true},
+		{name: "transition any to", trigger: canrunner.OnTransition("On", ""), prev: 1, curr: 0, expected: true},
+		{
+			name:     "rising",
+			trigger:  canrunner.OnThresholdCrossing(10, canrunner.CrossingDirectionRising),
+			prev:     19,
+			curr:     20,
+			expected: true,
+		},
+		{
+			name:    "rising falls",
+			trigger: canrunner.OnThresholdCrossing(10, canrunner.CrossingDirectionRising),
+			prev:    20,
+			curr:    19,
+		},
+		{
+			name:     "falling",
+			trigger:  canrunner.OnThresholdCrossing(10, canrunner.CrossingDirectionFalling),
+			prev:     20,
+			curr:     19,
+			expected: true,
+		},
+		{
+			name:     "both",
+			trigger:  canrunner.OnThresholdCrossing(10, canrunner.CrossingDirectionBoth),
+			prev:     40,
+			curr:     0,
+			expected: true,
+		},
+		{name: "both above", trigger: canrunner.OnThresholdCrossing(10, canrunner.CrossingDirectionBoth), prev: 40, curr: 30},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.trigger(s, data(tt.prev), data(tt.curr)))
+		})
+	}
+}
+
+func TestSignalSubscriptions_Triggered(t *testing.T) {
+	mux := &descriptor.Signal{Name: "Mux", Start: 0, Length: 4, IsMultiplexer: true}
+	value := &descriptor.Signal{Name: "Value", Start: 8, Length: 8, IsMultiplexed: true, MultiplexerValue: 1}
+	m := &descriptor.Message{Name: "Message", ID: 100, Length: 2, Signals: []*descriptor.Signal{mux, value}}
+	frame := func(muxValue, v uint64) can.Frame {
+		f := can.Frame{ID: m.ID, Length: m.Length}
+		mux.MarshalUnsigned(&f.Data, muxValue)
+		value.MarshalUnsigned(&f.Data, v)
+		return f
+	}
+	var ss canrunner.SignalSubscriptions
+	var calls [][2]can.Frame
+	ss.Subscribe(value, canrunner.OnChange(), func(_ context.Context, prev, curr can.Frame) error {
+		calls = append(calls, [2]can.Frame{prev, curr})
+		return nil
+	})
+	runHooks := func(f can.Frame) {
+		for _, hook := range ss.Triggered(m, f) {
+			assert.NilError(t, hook(context.Background()))
+		}
+	}
+	// the first frame never triggers
+	runHooks(frame(1, 10))
+	assert.Equal(t, 0, len(calls))
+	// frames with another multiplexer value are ignored
+	runHooks(frame(0, 20))
+	assert.Equal(t, 0, len(calls))
+	// unchanged value does not trigger
+	runHooks(frame(1, 10))
+	assert.Equal(t, 0, len(calls))
+	// changed value triggers with the previous frame carrying the signal
+	runHooks(frame(0, 30))
+	runHooks(frame(1, 11))
+	assert.DeepEqual(t, [][2]can.Frame{{frame(1, 10), frame(1, 11)}}, calls)
+}
@@ -0,0 +1,68 @@
+package canrunner
+
+import (
+	"sync"
+
+	"go.einride.tech/can/pkg/descriptor"
+)
+
+// WriteTracked is an interface for a transmitted message tracking writes to its signals.
+//
+// The runner uses the written signals of a message implementing WriteTracked to decide on write-triggered
+// transmissions, according to the send types of the message and its signals.
+type WriteTracked interface {
+	// WriteTracker returns the write tracker of the message.
+	WriteTracker() *WriteTracker
+}
+
+// WriteTracker tracks the signals written to a transmitted message.
+//
+// The zero value is an empty tracker, ready to use.
+type WriteTracker struct {
+	mu      sync.Mutex
+	c       chan struct{}
+	written []*descriptor.Signal
+}
+
+// Written records a write of the signal, and notifies the runner.
+func (w *WriteTracker) Written(s *descriptor.Signal) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	isWritten := false
+	for _, ws := range w.written {
+		if ws == s {
+			isWritten = true
+			break
+		}
+	}
+	if !isWritten {
+		w.written = append(w.written, s)
+	}
+	select {
+	case w.chanLocked() <- struct{}{}:
+	default:
+	}
+}
+
+// C returns a channel notified when signals have been written.
+func (w *WriteTracker) C() <-chan struct{} {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.chanLocked()
+}
+
+// Take returns the signals written since the last call to Take.
+func (w *WriteTracker) Take() []*descriptor.Signal {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	written := w.written
+	w.written = nil
+	return written
+}
+
+func (w *WriteTracker) chanLocked() chan struct{} {
+	if w.c == nil {
+		w.c = make(chan struct{}, 1)
+	}
+	return w.c
+}
@@ -0,0 +1,169 @@
+package cantext
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"go.einride.tech/can"
+	"go.einride.tech/can/pkg/descriptor"
+	"go.einride.tech/can/pkg/generated"
+)
+
+// Unmarshal a CAN message from text written by Marshal or MarshalCompact.
+//
+// The text is either the compact form, as in "{Command: Reboot, Speed: 12.5 km/h}", or the multi-line form with the
+// message name on the first line and a signal per line. Signal values are given as value descriptions, as bools for
+// 1-bit signals, or as physical values optionally followed by the unit of the signal and the raw value in
+// hexadecimal in parentheses, which takes precedence.
+//
+// Signals missing from the text keep their values, and multiplexed signals not selected by their multiplexers are
+// ignored. Values out of the range of a signal are errors.
+func Unmarshal(text []byte, m generated.Message) error {
+	md := m.Descriptor()
+	values, err := parseSignalValues(md, text)
+	if err != nil {
+		return fmt.Errorf("unmarshal text: %w", err)
+	}
+	f := m.Frame()
+	// multiplexers are set first, to know which multiplexed signals are selected
+	for _, isMultiplexer := range []bool{true, false} {
+		for _, s := range md.Signals {
+			value, ok := values[s.Name]
+			if !ok || s.IsMultiplexer != isMultiplexer || !md.IsSignalPresent(s, f.Data) {
+				continue
+			}
+			if err := unmarshalSignal(s, &f.Data, value); err != nil {
+				return fmt.Errorf("unmarshal text: %s.%s: %w", md.Name, s.Name, err)
+			}
+		}
+	}
+	if err := m.UnmarshalFrame(f); err != nil {
+		return fmt.Errorf("unmarshal text: %w", err)
+	}
+	return nil
+}
+
+// parseSignalValues returns the value text of each signal in the compact or multi-line text of a message.
+func parseSignalValues(md *descriptor.Message, text []byte) (map[string]string, error) {
+	text = bytes.TrimSpace(text)
+	var fields []string
+	if compact, ok := bytes.CutPrefix(text, []byte("{")); ok {
+		compact, ok = bytes.CutSuffix(compact, []byte("}"))
+		if !ok {
+			return nil, errors.New("missing closing brace")
+		}
+		// value descriptions can contain commas, so fields start at the next signal name
+		for _, field := range strings.Split(string(compact), ",") {
+			name, _, ok := strings.Cut(field, ":")
+			if len(fields) == 0 || ok && isSignal(md, strings.TrimSpace(name)) {
+				fields = append(fields, field)
+				continue
+			}
+			fields[len(fields)-1] += "," + field
+		}
+	} else {
+		lines := strings.Split(string(text), "\n")
+		if name := strings.TrimSpace(lines[0]); !strings.Contains(name, ":") {
+			if name != md.Name {
+				return nil, fmt.Errorf("expects message %s (got %s)", md.Name, name)
+			}
+			lines = lines[1:]
+		}
+		fields = lines
+	}
+	values := make(map[string]string, len(fields))
+	for _, field := range fields {
+		if strings.TrimSpace(field) == "" {
+			continue
+		}
+		name, value, ok := strings.Cut(field, ":")
+		if !ok {
+			return nil, fmt.Errorf("%s: invalid signal: %q", md.Name, strings.TrimSpace(field))
+		}
+		name = strings.TrimSpace(name)
+		if !isSignal(md, name) {
+			return nil, fmt.Errorf("%s: unknown signal: %s", md.Name, name)
+		}
+		if _, ok := values[name]; ok {
+			return nil, fmt.Errorf("%s: duplicate signal: %s", md.Name, name)
+		}
+		values[name] = strings.TrimSpace(value)
+	}
+	return values, nil
+}
+
+func isSignal(md *descriptor.Message, name string) bool {
+	_, ok := md.Signal(name)
+	return ok
+}
+
+func unmarshalSignal(s *descriptor.Signal, d *can.Data, value string) error {
+	if value == "" {
+		return errors.New("missing value")
+	}
+	if s.Length == 1 {
+		// bools can be followed by their value description
+		boolText, _, _ := strings.Cut(value, " ")
+		if b, err := strconv.ParseBool(boolText); err == nil {
+			s.MarshalBool(d, b)
+			return nil
+		}
+	}
+	if raw, ok := parseRawValue(value); ok {
+		return setRaw(s, d, raw)
+	}
+	if raw, ok := s.ValueDescriptionValue(value); ok {
+		return setRaw(s, d, uint64(raw))
+	}
+	physicalText := value
+	if s.Unit != "" {
+		physicalText = strings.TrimSpace(strings.TrimSuffix(physicalText, s.Unit))
+	}
+	physical, err := strconv.ParseFloat(physicalText, 64)
+	if err != nil {
+		if len(s.ValueDescriptions) > 0 {
+			return fmt.Errorf("invalid value or unknown value description: %q", value)
+		}
+		return fmt.Errorf("invalid value: %q", value)
+	}
+	return s.CheckedMarshalPhysical(d, physical)
+}
+
+// parseRawValue returns the raw value in hexadecimal in parentheses after the physical value, as written by Marshal.
+func parseRawValue(value string) (uint64, bool) {
+	_, rest, ok := strings.Cut(value, "(0x")
+	if !ok {
+		return 0, false
+	}
+	hex, _, ok := strings.Cut(rest, ")")
+	if !ok {
+		return 0, false
+	}
+	raw, err := strconv.ParseUint(hex, 16, 64)
+	if err != nil {
+		return 0, false
+	}
+	return raw, true
+}
+
+// setRaw sets the raw value of a signal, where the raw values of signed signals are in two's complement.
+func setRaw(s *descriptor.Signal, d *can.Data, raw uint64) error {
+	if s.IsSigned && !s.IsFloat {
+		value := int64(raw)
+		if s.SaturatedCastSigned(value) != value {
+			return fmt.Errorf("raw value out of bounds [%d, %d]: %d", s.MinSigned(), s.MaxSigned(), value)
+		}
+		s.MarshalSigned(d, value)
+		return nil
+	}
+	if s.Length < 64 {
+		if err := can.CheckValue(raw, s.Length); err != nil {
+			return fmt.Errorf("raw %w", err)
+		}
+	}
+	s.MarshalUnsigned(d, raw)
+	return nil
+}
@@ -0,0 +1,140 @@
+package cantext_test
+
+import (
+	"testing"
+
+	"go.einride.tech/can/pkg/cantext"
+	"go.einride.tech/can/pkg/generated"
+	examplecan "go.einride.tech/can/testdata/gen/go/example"
+	"gotest.tools/v3/assert"
+)
+
+func TestUnmarshal_Marshaled(t *testing.T) {
+	for _, tt := range []struct {
+		name  string
+		msg   generated.Message
+		empty generated.Message
+	}{
+		{
+			name:  "with enum",
+			msg:   examplecan.NewDriverHeartbeat().SetCommand(examplecan.DriverHeartbeat_Command_Reboot),
+			empty: examplecan.NewDriverHeartbeat(),
+		},
+		{
+			name:  "with unit and bool",
+			msg:   examplecan.NewMotorStatus().SetWheelError(true).SetSpeedKph(12.345),
+			empty: examplecan.NewMotorStatus(),
+		},
+		{
+			name:  "with signed",
+			msg:   examplecan.NewMotorCommand().SetSteer(-4).SetDrive(9),
+			empty: examplecan.NewMotorCommand(),
+		},
+		{
+			name:  "with multiplexing",
+			msg:   examplecan.NewSensorSonars().SetMux(1).SetErrCount(3).SetNoFiltLeft(1.5).SetNoFiltRear(40.9),
+			empty: examplecan.NewSensorSonars(),
+		},
+		{
+			name: "with value descriptions",
+			msg: examplecan.NewIODebug().
+				SetTestEnum(examplecan.IODebug_TestEnum_Two).
+				SetTestSigned(-12).
+				SetTestFloat(12.5).
+				SetTestBoolEnum(examplecan.IODebug_TestBoolEnum_One).
+				SetTestScaledEnum(4),
+			empty: examplecan.NewIODebug(),
+		},
+		{
+			name:  "with floats",
+			msg:   examplecan.NewIOFloat32().SetFloat32ValueNoRange(1.5).SetFloat32WithRange(-12.25),
+			empty: examplecan.NewIOFloat32(),
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Run("standard", func(t *testing.T) {
+				tt.empty.Reset()
+				assert.NilError(t, cantext.Unmarshal(cantext.Marshal(tt.msg), tt.empty))
+				assert.Equal(t, tt.msg.Frame(), tt.empty.Frame())
+			})
+			t.Run("compact", func(t *testing.T) {
+				tt.empty.Reset()
+				assert.NilError(t, cantext.Unmarshal(cantext.MarshalCompact(tt.msg), tt.empty))
+				assert.Equal(t, tt.msg.Frame(), tt.empty.Frame())
+			})
+		})
+	}
+}
+
+func TestUnmarshal_Written(t *testing.T) {
+	m := examplecan.NewIODebug().SetTestUnsigned(7)
+	assert.NilError(t, cantext.Unmarshal([]byte(`{TestEnum: One, TestSigned: -3, TestFloat: 4.5, TestScaledEnum: 4}`), m))
+	assert.Equal(t, uint8(7), m.TestUnsigned())
+	assert.Equal(t, examplecan.IODebug_TestEnum_One, m.TestEnum())
+	assert.Equal(t, int8(-3), m.TestSigned())
+	assert.Equal(t, 4.5, m.TestFloat())
+	assert.Equal(t, 4.0, m.TestScaledEnum())
+	status := examplecan.NewMotorStatus()
+	assert.NilError(t, cantext.Unmarshal([]byte("MotorStatus\n\tSpeedKph: 42.5 km/h\n"), status))
+	assert.Equal(t, 42.5, status.SpeedKph())
+}
+
+func TestUnmarshal_Errors(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		text     string
+		expected string
+	}{
+		{name: "missing brace", text: `{TestEnum: One`, expected: "unmarshal text: missing closing brace"},
+		{
+			name:     "wrong message",
+			text:     "MotorStatus\n\tSpeedKph: 1",
+			expected: "unmarshal text: expects message IODebug (got MotorStatus)",
+		},
+		{name: "unknown signal", text: `{Foo: 1}`, expected: "unmarshal text: IODebug: unknown signal: Foo"},
+		{
+			name:     "duplicate signal",
+			text:     `{TestEnum: One, TestEnum: Two}`,
+			expected: "unmarshal text: IODebug: duplicate signal: TestEnum",
+		},
+		{
+			name:     "invalid signal",
+			text:     "IODebug\n\tTestEnum One",
+			expected: `unmarshal text: IODebug: invalid signal: "TestEnum One"`,
+		},
+		{
+			name:     "missing value",
+			text:     `{TestEnum: }`,
+			expected: "unmarshal text: IODebug.TestEnum: missing value",
+		},
+		{
+			name:     "unknown value description",
+			text:     `{TestEnum: Three}`,
+			expected: `unmarshal text: IODebug.TestEnum: invalid value or unknown value description: "Three"`,
+		},
+		{
+			name:     "raw out of bounds",
+			text:     "IODebug\n\tTestEnum: 64 (0x40)",
+			expected: "unmarshal text: IODebug.TestEnum: raw value out of bounds [0, 64): 64",
+		},
+		{
+			name:     "physical out of range",
+			text:     `{TestScaledEnum: 8}`,
+			expected: "unmarshal text: IODebug.TestScaledEnum: physical value out of range [0, 6]: 8",
+		},
+		{
+			name:     "physical out of bounds",
+			text:     `{TestSigned: 200}`,
+			expected: "unmarshal text: IODebug.TestSigned: physical value 200: raw value out of bounds [-128, 127]: 200",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Error(t, cantext.Unmarshal([]byte(tt.text), examplecan.NewIODebug()), tt.expected)
+		})
+	}
+}
+
+func TestUnmarshal_UnitMismatch(t *testing.T) {
+	err := cantext.Unmarshal([]byte(`{SpeedKph: 12 mph}`), examplecan.NewMotorStatus())
+	assert.Error(t, err, `unmarshal text: MotorStatus.SpeedKph: invalid value: "12 mph"`)
+}
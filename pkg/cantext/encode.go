@@ -45,6 +45,13 @@ func AppendSignal(buf []byte, s *descriptor.Signal, d can.Data) []byte {
 	buf = append(buf, s.Name...)
 	buf = append(buf, ": "...)
 	switch {
+	case s.IsFloat: // float, with the bits of the float as raw value
+		buf = strconv.AppendFloat(buf, s.UnmarshalPhysical(d), 'g', -1, 64)
+		buf = append(buf, s.Unit...)
+		buf = append(buf, " ("...)
+		buf = append(buf, "0x"...)
+		buf = strconv.AppendUint(buf, s.UnmarshalUnsigned(d), 16)
+		buf = append(buf, ')')
 	case s.Length == 1: // bool
 		val := s.UnmarshalBool(d)
 		buf = strconv.AppendBool(buf, val)
@@ -77,6 +84,9 @@ func AppendSignalCompact(buf []byte, s *descriptor.Signal, d can.Data) []byte {
 	switch {
 	case hasValueDescription:
 		buf = append(buf, valueDescription...)
+	case s.IsFloat: // float
+		buf = strconv.AppendFloat(buf, s.UnmarshalPhysical(d), 'g', -1, 64)
+		buf = append(buf, s.Unit...)
 	case s.Length == 1: // bool
 		val := s.UnmarshalBool(d)
 		buf = strconv.AppendBool(buf, val)
@@ -0,0 +1,72 @@
+// Package canunit provides types for the physical units of CAN signals.
+//
+// Each type represents a value in a single unit, so that values in different units can't be mixed without an explicit
+// conversion, such as KilometersPerHour.MetersPerSecond.
+package canunit
+
+import "strconv"
+
+// Unit describes a physical unit of CAN signals.
+type Unit struct {
+	// Symbol of the unit in DBC files.
+	Symbol string
+	// Type is the name of the type of the unit in this package.
+	Type string
+}
+
+// Lookup returns the unit with the provided symbol.
+//
+// Symbols are the SI symbols expected by the siunits analyzer, and common symbols of units outside of the SI.
+func Lookup(symbol string) (Unit, bool) {
+	for _, u := range units {
+		if u.Symbol == symbol {
+			return u, true
+		}
+	}
+	return Unit{}, false
+}
+
+// Units returns all units with types in this package.
+func Units() []Unit {
+	return append([]Unit(nil), units...)
+}
+
+var units = []Unit{
+	{Symbol: "m/s", Type: "MetersPerSecond"},
+	{Symbol: "km/h", Type: "KilometersPerHour"},
+	{Symbol: "mph", Type: "MilesPerHour"},
+	{Symbol: "m/s²", Type: "MetersPerSecondSquared"},
+	{Symbol: "m/s^2", Type: "MetersPerSecondSquared"},
+	{Symbol: "m", Type: "Meters"},
+	{Symbol: "km", Type: "Kilometers"},
+	{Symbol: "cm", Type: "Centimeters"},
+	{Symbol: "mm", Type: "Millimeters"},
+	{Symbol: "rad", Type: "Radians"},
+	{Symbol: "°", Type: "Degrees"},
+	{Symbol: "rad/s", Type: "RadiansPerSecond"},
+	{Symbol: "°/s", Type: "DegreesPerSecond"},
+	{Symbol: "rpm", Type: "RevolutionsPerMinute"},
+	{Symbol: "°C", Type: "DegreesCelsius"},
+	{Symbol: "degC", Type: "DegreesCelsius"},
+	{Symbol: "K", Type: "Kelvin"},
+	{Symbol: "V", Type: "Volts"},
+	{Symbol: "mV", Type: "Millivolts"},
+	{Symbol: "A", Type: "Amperes"},
+	{Symbol: "mA", Type: "Milliamperes"},
+	{Symbol: "Pa", Type: "Pascals"},
+	{Symbol: "kPa", Type: "Kilopascals"},
+	{Symbol: "bar", Type: "Bars"},
+	{Symbol: "s", Type: "Seconds"},
+	{Symbol: "ms", Type: "Milliseconds"},
+	{Symbol: "Hz", Type: "Hertz"},
+	{Symbol: "W", Type: "Watts"},
+	{Symbol: "kW", Type: "Kilowatts"},
+	{Symbol: "N", Type: "Newtons"},
+	{Symbol: "Nm", Type: "NewtonMeters"},
+	{Symbol: "kg", Type: "Kilograms"},
+	{Symbol: "%", Type: "Percent"},
+}
+
+func format(v float64, symbol string) string {
+	return strconv.FormatFloat(v, 'g', -1, 64) + " " + symbol
+}
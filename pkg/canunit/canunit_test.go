@@ -0,0 +1,53 @@
+package canunit
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestLookup(t *testing.T) {
+	u, ok := Lookup("km/h")
+	assert.Assert(t, ok)
+	assert.Equal(t, Unit{Symbol: "km/h", Type: "KilometersPerHour"}, u)
+	_, ok = Lookup("kph") // non-SI symbols are reported by the siunits analyzer
+	assert.Assert(t, !ok)
+}
+
+func TestConversions(t *testing.T) {
+	const epsilon = 1e-9
+	for _, tt := range []struct {
+		name     string
+		actual   float64
+		expected float64
+	}{
+		{name: "km/h to m/s", actual: float64(KilometersPerHour(36).MetersPerSecond()), expected: 10},
+		{name: "m/s to km/h", actual: float64(MetersPerSecond(10).KilometersPerHour()), expected: 36},
+		{name: "mph to km/h", actual: float64(MilesPerHour(10).KilometersPerHour()), expected: 16.09344},
+		{name: "km to m", actual: float64(Kilometers(1.5).Meters()), expected: 1500},
+		{name: "° to rad", actual: float64(Degrees(180).Radians()), expected: math.Pi},
+		{name: "rpm to rad/s", actual: float64(RevolutionsPerMinute(60).RadiansPerSecond()), expected: 2 * math.Pi},
+		{name: "rpm to °/s", actual: float64(RevolutionsPerMinute(1).DegreesPerSecond()), expected: 6},
+		{name: "°C to K", actual: float64(DegreesCelsius(20).Kelvin()), expected: 293.15},
+		{name: "K to °C", actual: float64(Kelvin(0).DegreesCelsius()), expected: -273.15},
+		{name: "kPa to bar", actual: float64(Kilopascals(250).Bars()), expected: 2.5},
+		{name: "mV to V", actual: float64(Millivolts(12500).Volts()), expected: 12.5},
+		{name: "kW to W", actual: float64(Kilowatts(1.2).Watts()), expected: 1200},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Assert(t, math.Abs(tt.actual-tt.expected) < epsilon, "%v != %v", tt.actual, tt.expected)
+		})
+	}
+}
+
+func TestDuration(t *testing.T) {
+	assert.Equal(t, 1500*time.Millisecond, Seconds(1.5).Duration())
+	assert.Equal(t, -250*time.Microsecond, Milliseconds(-0.25).Duration())
+}
+
+func TestString(t *testing.T) {
+	assert.Equal(t, "12.5 km/h", KilometersPerHour(12.5).String())
+	assert.Equal(t, "-40 °C", DegreesCelsius(-40).String())
+}
@@ -0,0 +1,49 @@
+package canunit
+
+// Volts is a voltage in V.
+type Volts float64
+
+// Millivolts is a voltage in mV.
+type Millivolts float64
+
+// Amperes is a current in A.
+type Amperes float64
+
+// Milliamperes is a current in mA.
+type Milliamperes float64
+
+// Watts is a power in W.
+type Watts float64
+
+// Kilowatts is a power in kW.
+type Kilowatts float64
+
+func (v Volts) String() string { return format(float64(v), "V") }
+
+// Millivolts converts the voltage to mV.
+func (v Volts) Millivolts() Millivolts { return Millivolts(v * 1e3) }
+
+func (v Millivolts) String() string { return format(float64(v), "mV") }
+
+// Volts converts the voltage to V.
+func (v Millivolts) Volts() Volts { return Volts(v / 1e3) }
+
+func (v Amperes) String() string { return format(float64(v), "A") }
+
+// Milliamperes converts the current to mA.
+func (v Amperes) Milliamperes() Milliamperes { return Milliamperes(v * 1e3) }
+
+func (v Milliamperes) String() string { return format(float64(v), "mA") }
+
+// Amperes converts the current to A.
+func (v Milliamperes) Amperes() Amperes { return Amperes(v / 1e3) }
+
+func (v Watts) String() string { return format(float64(v), "W") }
+
+// Kilowatts converts the power to kW.
+func (v Watts) Kilowatts() Kilowatts { return Kilowatts(v / 1e3) }
+
+func (v Kilowatts) String() string { return format(float64(v), "kW") }
+
+// Watts converts the power to W.
+func (v Kilowatts) Watts() Watts { return Watts(v * 1e3) }
@@ -0,0 +1,94 @@
+package canunit
+
+// MetersPerSecond is a speed in m/s.
+type MetersPerSecond float64
+
+// KilometersPerHour is a speed in km/h.
+type KilometersPerHour float64
+
+// MilesPerHour is a speed in mph.
+type MilesPerHour float64
+
+// MetersPerSecondSquared is an acceleration in m/s².
+type MetersPerSecondSquared float64
+
+const (
+	kilometersPerHourPerMeterPerSecond = 3.6
+	milesPerHourPerMeterPerSecond      = 3600 / 1609.344
+)
+
+func (v MetersPerSecond) String() string { return format(float64(v), "m/s") }
+
+// KilometersPerHour converts the speed to km/h.
+func (v MetersPerSecond) KilometersPerHour() KilometersPerHour {
+	return KilometersPerHour(v * kilometersPerHourPerMeterPerSecond)
+}
+
+// MilesPerHour converts the speed to mph.
+func (v MetersPerSecond) MilesPerHour() MilesPerHour {
+	return MilesPerHour(v * milesPerHourPerMeterPerSecond)
+}
+
+func (v KilometersPerHour) String() string { return format(float64(v), "km/h") }
+
+// MetersPerSecond converts the speed to m/s.
+func (v KilometersPerHour) MetersPerSecond() MetersPerSecond {
+	return MetersPerSecond(v / kilometersPerHourPerMeterPerSecond)
+}
+
+// MilesPerHour converts the speed to mph.
+func (v KilometersPerHour) MilesPerHour() MilesPerHour {
+	return v.MetersPerSecond().MilesPerHour()
+}
+
+func (v MilesPerHour) String() string { return format(float64(v), "mph") }
+
+// MetersPerSecond converts the speed to m/s.
+func (v MilesPerHour) MetersPerSecond() MetersPerSecond {
+	return MetersPerSecond(v / milesPerHourPerMeterPerSecond)
+}
+
+// KilometersPerHour converts the speed to km/h.
+func (v MilesPerHour) KilometersPerHour() KilometersPerHour {
+	return v.MetersPerSecond().KilometersPerHour()
+}
+
+func (v MetersPerSecondSquared) String() string { return format(float64(v), "m/s²") }
+
+// Meters is a distance in m.
+type Meters float64
+
+// Kilometers is a distance in km.
+type Kilometers float64
+
+// Centimeters is a distance in cm.
+type Centimeters float64
+
+// Millimeters is a distance in mm.
+type Millimeters float64
+
+func (v Meters) String() string { return format(float64(v), "m") }
+
+// Kilometers converts the distance to km.
+func (v Meters) Kilometers() Kilometers { return Kilometers(v / 1e3) }
+
+// Centimeters converts the distance to cm.
+func (v Meters) Centimeters() Centimeters { return Centimeters(v * 1e2) }
+
+// Millimeters converts the distance to mm.
+func (v Meters) Millimeters() Millimeters { return Millimeters(v * 1e3) }
+
+func (v Kilometers) String() string { return format(float64(v), "km") }
+
+// Meters converts the distance to m.
+func (v Kilometers) Meters() Meters { return Meters(v * 1e3) }
+
+func (v Centimeters) String() string { return format(float64(v), "cm") }
+
+// Meters converts the distance to m.
+func (v Centimeters) Meters() Meters { return Meters(v / 1e2) }
+
+func (v Millimeters) String() string { return format(float64(v), "mm") }
+
+// Meters converts the distance to m.
+func (v Millimeters) Meters() Meters { return Meters(v / 1e3) }
@@ -0,0 +1,108 @@
+package canunit
+
+import (
+	"math"
+	"time"
+)
+
+// DegreesCelsius is a temperature in °C.
+type DegreesCelsius float64
+
+// Kelvin is a temperature in K.
+type Kelvin float64
+
+// Pascals is a pressure in Pa.
+type Pascals float64
+
+// Kilopascals is a pressure in kPa.
+type Kilopascals float64
+
+// Bars is a pressure in bar.
+type Bars float64
+
+// Seconds is a time in s.
+type Seconds float64
+
+// Milliseconds is a time in ms.
+type Milliseconds float64
+
+// Hertz is a frequency in Hz.
+type Hertz float64
+
+// Newtons is a force in N.
+type Newtons float64
+
+// NewtonMeters is a torque in Nm.
+type NewtonMeters float64
+
+// Kilograms is a mass in kg.
+type Kilograms float64
+
+// Percent is a ratio in %.
+type Percent float64
+
+const absoluteZeroCelsius = -273.15
+
+func (v DegreesCelsius) String() string { return format(float64(v), "°C") }
+
+// Kelvin converts the temperature to K.
+func (v DegreesCelsius) Kelvin() Kelvin { return Kelvin(v - absoluteZeroCelsius) }
+
+func (v Kelvin) String() string { return format(float64(v), "K") }
+
+// DegreesCelsius converts the temperature to °C.
+func (v Kelvin) DegreesCelsius() DegreesCelsius { return DegreesCelsius(v + absoluteZeroCelsius) }
+
+func (v Pascals) String() string { return format(float64(v), "Pa") }
+
+// Kilopascals converts the pressure to kPa.
+func (v Pascals) Kilopascals() Kilopascals { return Kilopascals(v / 1e3) }
+
+// Bars converts the pressure to bar.
+func (v Pascals) Bars() Bars { return Bars(v / 1e5) }
+
+func (v Kilopascals) String() string { return format(float64(v), "kPa") }
+
+// Pascals converts the pressure to Pa.
+func (v Kilopascals) Pascals() Pascals { return Pascals(v * 1e3) }
+
+// Bars converts the pressure to bar.
+func (v Kilopascals) Bars() Bars { return Bars(v / 1e2) }
+
+func (v Bars) String() string { return format(float64(v), "bar") }
+
+// Pascals converts the pressure to Pa.
+func (v Bars) Pascals() Pascals { return Pascals(v * 1e5) }
+
+// Kilopascals converts the pressure to kPa.
+func (v Bars) Kilopascals() Kilopascals { return Kilopascals(v * 1e2) }
+
+func (v Seconds) String() string { return format(float64(v), "s") }
+
+// Milliseconds converts the time to ms.
+func (v Seconds) Milliseconds() Milliseconds { return Milliseconds(v * 1e3) }
+
+// Duration converts the time to a duration, rounded to the nearest nanosecond.
+func (v Seconds) Duration() time.Duration {
+	return time.Duration(math.Round(float64(v) * float64(time.Second)))
+}
+
+func (v Milliseconds) String() string { return format(float64(v), "ms") }
+
+// Seconds converts the time to s.
+func (v Milliseconds) Seconds() Seconds { return Seconds(v / 1e3) }
+
+// Duration converts the time to a duration, rounded to the nearest nanosecond.
+func (v Milliseconds) Duration() time.Duration {
+	return time.Duration(math.Round(float64(v) * float64(time.Millisecond)))
+}
+
+func (v Hertz) String() string { return format(float64(v), "Hz") }
+
+func (v Newtons) String() string { return format(float64(v), "N") }
+
+func (v NewtonMeters) String() string { return format(float64(v), "Nm") }
+
+func (v Kilograms) String() string { return format(float64(v), "kg") }
+
+func (v Percent) String() string { return format(float64(v), "%") }
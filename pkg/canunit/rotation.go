@@ -0,0 +1,62 @@
+package canunit
+
+import "math"
+
+// Radians is an angle in rad.
+type Radians float64
+
+// Degrees is an angle in °.
+type Degrees float64
+
+// RadiansPerSecond is an angular speed in rad/s.
+type RadiansPerSecond float64
+
+// DegreesPerSecond is an angular speed in °/s.
+type DegreesPerSecond float64
+
+// RevolutionsPerMinute is an angular speed in rpm.
+type RevolutionsPerMinute float64
+
+func (v Radians) String() string { return format(float64(v), "rad") }
+
+// Degrees converts the angle to °.
+func (v Radians) Degrees() Degrees { return Degrees(v * 180 / math.Pi) }
+
+func (v Degrees) String() string { return format(float64(v), "°") }
+
+// Radians converts the angle to rad.
+func (v Degrees) Radians() Radians { return Radians(v * math.Pi / 180) }
+
+func (v RadiansPerSecond) String() string { return format(float64(v), "rad/s") }
+
+// DegreesPerSecond converts the angular speed to °/s.
+func (v RadiansPerSecond) DegreesPerSecond() DegreesPerSecond {
+	return DegreesPerSecond(v * 180 / math.Pi)
+}
+
+// RevolutionsPerMinute converts the angular speed to rpm.
+func (v RadiansPerSecond) RevolutionsPerMinute() RevolutionsPerMinute {
+	return RevolutionsPerMinute(v * 60 / (2 * math.Pi))
+}
+
+func (v DegreesPerSecond) String() string { return format(float64(v), "°/s") }
+
+// RadiansPerSecond converts the angular speed to rad/s.
+func (v DegreesPerSecond) RadiansPerSecond() RadiansPerSecond {
+	return RadiansPerSecond(v * math.Pi / 180)
+}
+
+// RevolutionsPerMinute converts the angular speed to rpm.
+func (v DegreesPerSecond) RevolutionsPerMinute() RevolutionsPerMinute {
+	return RevolutionsPerMinute(v / 6)
+}
+
+func (v RevolutionsPerMinute) String() string { return format(float64(v), "rpm") }
+
+// RadiansPerSecond converts the angular speed to rad/s.
+func (v RevolutionsPerMinute) RadiansPerSecond() RadiansPerSecond {
+	return RadiansPerSecond(v * 2 * math.Pi / 60)
+}
+
+// DegreesPerSecond converts the angular speed to °/s.
+func (v RevolutionsPerMinute) DegreesPerSecond() DegreesPerSecond { return DegreesPerSecond(v * 6) }
@@ -27,11 +27,15 @@ func orderOf(def dbc.Def) uint64 {
 		&dbc.MessageTransmittersDef{},
 		&dbc.EnvironmentVariableDef{},
 		&dbc.EnvironmentVariableDataDef{},
+		&dbc.SignalTypeDef{},
 		&dbc.CommentDef{},
 		&dbc.AttributeDef{},
 		&dbc.AttributeDefaultValueDef{},
 		&dbc.AttributeValueForObjectDef{},
 		&dbc.ValueDescriptionsDef{},
+		&dbc.SignalTypeRefDef{},
+		&dbc.SignalGroupDef{},
+		&dbc.SignalMultiplexValueDef{},
 	} {
 		if reflect.TypeOf(def) == reflect.TypeOf(orderDef) {
 			return uint64(i)
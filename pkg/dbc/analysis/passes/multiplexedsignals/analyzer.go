@@ -14,38 +14,76 @@ func Analyzer() *analysis.Analyzer {
 }
 
 func run(pass *analysis.Pass) error {
+	// collect extended multiplexing definitions
+	multiplexValueDefs := map[dbc.MessageID]map[dbc.Identifier]*dbc.SignalMultiplexValueDef{}
 	for _, def := range pass.File.Defs {
-		message, ok := def.(*dbc.MessageDef)
+		multiplexValueDef, ok := def.(*dbc.SignalMultiplexValueDef)
 		if !ok {
 			continue
 		}
-		// locate multiplexer switch
-		var multiplexerSwitch *dbc.SignalDef
-		for i := range message.Signals {
-			if !message.Signals[i].IsMultiplexerSwitch {
-				continue
-			}
-			if multiplexerSwitch != nil {
-				pass.Reportf(message.Signals[i].Pos, "more than one multiplexer switch")
-				continue
-			}
-			multiplexerSwitch = &message.Signals[i]
-			if multiplexerSwitch.IsSigned {
-				pass.Reportf(message.Signals[i].Pos, "signed multiplexer switch")
-				continue
-			}
-			if multiplexerSwitch.IsMultiplexed {
-				pass.Reportf(message.Signals[i].Pos, "can't be multiplexer and multiplexed")
-				continue
+		if multiplexValueDefs[multiplexValueDef.MessageID] == nil {
+			multiplexValueDefs[multiplexValueDef.MessageID] = map[dbc.Identifier]*dbc.SignalMultiplexValueDef{}
+		}
+		multiplexValueDefs[multiplexValueDef.MessageID][multiplexValueDef.Signal] = multiplexValueDef
+	}
+	for _, def := range pass.File.Defs {
+		switch def := def.(type) {
+		case *dbc.MessageDef:
+			runMessage(pass, def, multiplexValueDefs[def.MessageID])
+		case *dbc.SignalMultiplexValueDef:
+			runSignalMultiplexValue(pass, def)
+		}
+	}
+	return nil
+}
+
+func runMessage(
+	pass *analysis.Pass,
+	message *dbc.MessageDef,
+	multiplexValueDefs map[dbc.Identifier]*dbc.SignalMultiplexValueDef,
+) {
+	isExtended := len(multiplexValueDefs) > 0
+	// locate multiplexer switches
+	multiplexerSwitches := map[dbc.Identifier]*dbc.SignalDef{}
+	var multiplexerSwitch *dbc.SignalDef
+	for i := range message.Signals {
+		signal := &message.Signals[i]
+		if !signal.IsMultiplexerSwitch {
+			continue
+		}
+		if multiplexerSwitch != nil && !isExtended {
+			pass.Reportf(signal.Pos, "more than one multiplexer switch")
+			continue
+		}
+		multiplexerSwitches[signal.Name] = signal
+		if multiplexerSwitch == nil {
+			multiplexerSwitch = signal
+		}
+		if signal.IsSigned {
+			pass.Reportf(signal.Pos, "signed multiplexer switch")
+			continue
+		}
+		if signal.IsMultiplexed && !isExtended {
+			pass.Reportf(signal.Pos, "can't be multiplexer and multiplexed")
+			continue
+		}
+	}
+	for i := range message.Signals {
+		signal := &message.Signals[i]
+		multiplexValueDef, ok := multiplexValueDefs[signal.Name]
+		if !signal.IsMultiplexed {
+			if ok {
+				pass.Reportf(multiplexValueDef.Pos, "signal is not multiplexed: %v", signal.Name)
 			}
+			continue
 		}
-		for i := range message.Signals {
-			signal := &message.Signals[i]
-			if !signal.IsMultiplexed {
+		if !ok {
+			if multiplexerSwitch == nil {
+				pass.Reportf(signal.Pos, "no multiplexer switch for multiplexed signal")
 				continue
 			}
-			if multiplexerSwitch == nil {
-				pass.Reportf(message.Signals[i].Pos, "no multiplexer switch for multiplexed signal")
+			if len(multiplexerSwitches) > 1 {
+				pass.Reportf(signal.Pos, "ambiguous multiplexer switch for multiplexed signal")
 				continue
 			}
 			multiplexerSwitchMaxValue := uint64((1 << multiplexerSwitch.Size) - 1)
@@ -53,7 +91,47 @@ func run(pass *analysis.Pass) error {
 				pass.Reportf(signal.Pos, "multiplexer switch exceeds max value: %v", multiplexerSwitchMaxValue)
 				continue
 			}
+			continue
+		}
+		switchSignal, ok := multiplexerSwitches[multiplexValueDef.MultiplexerSwitch]
+		if !ok {
+			pass.Reportf(multiplexValueDef.Pos, "no multiplexer switch: %v", multiplexValueDef.MultiplexerSwitch)
+			continue
+		}
+		multiplexerSwitchMaxValue := uint64((1 << switchSignal.Size) - 1)
+		for _, r := range multiplexValueDef.Ranges {
+			if r.RangeEnd > multiplexerSwitchMaxValue {
+				pass.Reportf(multiplexValueDef.Pos, "multiplexer switch exceeds max value: %v", multiplexerSwitchMaxValue)
+				break
+			}
+		}
+	}
+	// report cycles of nested multiplexer switches
+	for i := range message.Signals {
+		signal := &message.Signals[i]
+		if multiplexerSwitches[signal.Name] != signal {
+			continue
+		}
+		visited := map[dbc.Identifier]bool{}
+		for curr := signal; curr != nil; {
+			if visited[curr.Name] {
+				pass.Reportf(signal.Pos, "cyclic multiplexer switches")
+				break
+			}
+			visited[curr.Name] = true
+			multiplexValueDef, ok := multiplexValueDefs[curr.Name]
+			if !ok {
+				break
+			}
+			curr = multiplexerSwitches[multiplexValueDef.MultiplexerSwitch]
+		}
+	}
+}
+
+func runSignalMultiplexValue(pass *analysis.Pass, def *dbc.SignalMultiplexValueDef) {
+	for _, r := range def.Ranges {
+		if r.RangeStart > r.RangeEnd {
+			pass.Reportf(def.Pos, "invalid multiplexer value range: %d-%d", r.RangeStart, r.RangeEnd)
 		}
 	}
-	return nil
 }
@@ -110,5 +110,103 @@ BO_ 200 SENSOR_SONARS: 8 SENSOR
 				},
 			},
 		},
+		{
+			Name: "valid extended multiplexing",
+			Data: `
+BO_ 300 EXTENDED: 8 SENSOR
+ SG_ EXTENDED_mux M : 0|4@1+ (1,0) [0|0] "" DRIVER
+ SG_ EXTENDED_sub_mux m1M : 4|4@1+ (1,0) [0|0] "" DRIVER
+ SG_ EXTENDED_value m2 : 8|8@1+ (1,0) [0|0] "" DRIVER
+ SG_ EXTENDED_other_mux M : 16|4@1+ (1,0) [0|0] "" DRIVER
+ SG_ EXTENDED_other_value m0 : 24|8@1+ (1,0) [0|0] "" DRIVER
+
+SG_MUL_VAL_ 300 EXTENDED_sub_mux EXTENDED_mux 1-1;
+SG_MUL_VAL_ 300 EXTENDED_value EXTENDED_sub_mux 2-2, 4-6;
+SG_MUL_VAL_ 300 EXTENDED_other_value EXTENDED_other_mux 0-3;
+			`,
+		},
+
+		{
+			Name: "ambiguous multiplexer switch",
+			Data: `
+BO_ 300 EXTENDED: 8 SENSOR
+ SG_ EXTENDED_mux M : 0|4@1+ (1,0) [0|0] "" DRIVER
+ SG_ EXTENDED_other_mux M : 4|4@1+ (1,0) [0|0] "" DRIVER
+ SG_ EXTENDED_value m1 : 8|8@1+ (1,0) [0|0] "" DRIVER
+ SG_ EXTENDED_other_value m1 : 16|8@1+ (1,0) [0|0] "" DRIVER
+
+SG_MUL_VAL_ 300 EXTENDED_value EXTENDED_mux 1-1;
+			`,
+			Diagnostics: []*analysis.Diagnostic{
+				{
+					Pos:     scanner.Position{Line: 5, Column: 2},
+					Message: "ambiguous multiplexer switch for multiplexed signal",
+				},
+			},
+		},
+
+		{
+			Name: "invalid extended multiplexing",
+			Data: `
+BO_ 300 EXTENDED: 8 SENSOR
+ SG_ EXTENDED_mux M : 0|4@1+ (1,0) [0|0] "" DRIVER
+ SG_ EXTENDED_value m1 : 8|8@1+ (1,0) [0|0] "" DRIVER
+ SG_ EXTENDED_other_value m1 : 16|8@1+ (1,0) [0|0] "" DRIVER
+ SG_ EXTENDED_plain : 24|8@1+ (1,0) [0|0] "" DRIVER
+
+SG_MUL_VAL_ 300 EXTENDED_value EXTENDED_missing 1-1;
+SG_MUL_VAL_ 300 EXTENDED_other_value EXTENDED_mux 16-16;
+SG_MUL_VAL_ 300 EXTENDED_plain EXTENDED_mux 1-1;
+			`,
+			Diagnostics: []*analysis.Diagnostic{
+				{
+					Pos:     scanner.Position{Line: 7, Column: 1},
+					Message: "no multiplexer switch: EXTENDED_missing",
+				},
+				{
+					Pos:     scanner.Position{Line: 8, Column: 1},
+					Message: "multiplexer switch exceeds max value: 15",
+				},
+				{
+					Pos:     scanner.Position{Line: 9, Column: 1},
+					Message: "signal is not multiplexed: EXTENDED_plain",
+				},
+			},
+		},
+
+		{
+			Name: "invalid multiplexer value range",
+			Data: `
+SG_MUL_VAL_ 300 EXTENDED_value EXTENDED_mux 3-1;
+			`,
+			Diagnostics: []*analysis.Diagnostic{
+				{
+					Pos:     scanner.Position{Line: 1, Column: 1},
+					Message: "invalid multiplexer value range: 3-1",
+				},
+			},
+		},
+
+		{
+			Name: "cyclic multiplexer switches",
+			Data: `
+BO_ 300 EXTENDED: 8 SENSOR
+ SG_ EXTENDED_mux m1M : 0|4@1+ (1,0) [0|0] "" DRIVER
+ SG_ EXTENDED_other_mux m1M : 4|4@1+ (1,0) [0|0] "" DRIVER
+
+SG_MUL_VAL_ 300 EXTENDED_mux EXTENDED_other_mux 1-1;
+SG_MUL_VAL_ 300 EXTENDED_other_mux EXTENDED_mux 1-1;
+			`,
+			Diagnostics: []*analysis.Diagnostic{
+				{
+					Pos:     scanner.Position{Line: 2, Column: 2},
+					Message: "cyclic multiplexer switches",
+				},
+				{
+					Pos:     scanner.Position{Line: 3, Column: 2},
+					Message: "cyclic multiplexer switches",
+				},
+			},
+		},
 	})
 }
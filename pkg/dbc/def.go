@@ -2,6 +2,7 @@ package dbc
 
 import (
 	"strconv"
+	"strings"
 	"text/scanner"
 )
 
@@ -256,10 +257,15 @@ type SignalDef struct {
 	// IsMultiplexerSwitch is true if the signal is a multiplexer switch.
 	//
 	// A multiplexer indicator of 'M' defines the signal as the multiplexer switch.
-	// Only one signal within a single message can be the multiplexer switch.
+	// Only one signal within a single message can be the multiplexer switch, unless extended multiplexing is used.
+	//
+	// With extended multiplexing, a multiplexer indicator of 'm' followed by a switch value and 'M' defines the signal
+	// as both a multiplexed signal and a multiplexer switch, for nested multiplexing.
 	IsMultiplexerSwitch bool
 
 	// IsMultiplexed is true if the signal is multiplexed by the message's multiplexer switch.
+	//
+	// With extended multiplexing, the multiplexer switch and switch values are defined by a SignalMultiplexValueDef.
 	IsMultiplexed bool
 
 	// MultiplexerSwitch is the multiplexer switch value of the signal.
@@ -313,7 +319,12 @@ func (d *SignalDef) parseFrom(p *Parser) {
 			d.IsMultiplexerSwitch = true
 		case tok.txt[0] == 'm' && len(tok.txt) > 1:
 			d.IsMultiplexed = true
-			i, err := strconv.Atoi(tok.txt[1:])
+			value := tok.txt[1:]
+			if strings.HasSuffix(value, "M") {
+				d.IsMultiplexerSwitch = true
+				value = strings.TrimSuffix(value, "M")
+			}
+			i, err := strconv.Atoi(value)
 			if err != nil || i < 0 {
 				p.failf(tok.pos, "invalid multiplexer value")
 			}
@@ -408,12 +419,15 @@ func (d *MessageTransmittersDef) Position() scanner.Position {
 }
 
 // ValueDescriptionsDef defines inline descriptions for specific raw signal values.
+//
+// Instead of inline descriptions, the descriptions of a value table can be referenced by name.
 type ValueDescriptionsDef struct {
 	Pos                     scanner.Position
 	ObjectType              ObjectType
 	MessageID               MessageID
 	SignalName              Identifier
 	EnvironmentVariableName Identifier
+	ValueTableName          Identifier
 	ValueDescriptions       []ValueDescriptionDef
 }
 
@@ -429,6 +443,9 @@ func (d *ValueDescriptionsDef) parseFrom(p *Parser) {
 		d.MessageID = p.messageID()
 		d.SignalName = p.identifier()
 	}
+	if p.peekToken().typ == scanner.Ident {
+		d.ValueTableName = p.identifier()
+	}
 	for p.peekToken().typ != ';' {
 		valueDescriptionDef := ValueDescriptionDef{}
 		valueDescriptionDef.parseFrom(p)
@@ -699,6 +716,160 @@ func (d *AttributeValueForObjectDef) Position() scanner.Position {
 	return d.Pos
 }
 
+// SignalGroupDef defines a group of signals within a message.
+//
+// Signal groups are used to define a group of signals within a message, for example to define that the signals of a
+// group have to be updated in common.
+type SignalGroupDef struct {
+	Pos         scanner.Position
+	MessageID   MessageID
+	GroupName   Identifier
+	Repetitions uint64
+	SignalNames []Identifier
+}
+
+var _ Def = &SignalGroupDef{}
+
+func (d *SignalGroupDef) parseFrom(p *Parser) {
+	d.Pos = p.keyword(KeywordSignalGroup).pos
+	d.MessageID = p.messageID()
+	d.GroupName = p.identifier()
+	d.Repetitions = p.uint()
+	p.token(':')
+	for p.peekToken().typ != ';' {
+		d.SignalNames = append(d.SignalNames, p.identifier())
+		// SPECIAL-CASE: Comma not included in spec, but encountered in the wild
+		p.optionalToken(',')
+	}
+	p.token(';')
+}
+
+// Position returns the position of the definition.
+func (d *SignalGroupDef) Position() scanner.Position {
+	return d.Pos
+}
+
+// SignalTypeDef defines a signal type.
+//
+// Signal types are used to define the common properties of several signals.
+type SignalTypeDef struct {
+	Pos            scanner.Position
+	TypeName       Identifier
+	Size           uint64
+	IsBigEndian    bool
+	IsSigned       bool
+	Factor         float64
+	Offset         float64
+	Minimum        float64
+	Maximum        float64
+	Unit           string
+	DefaultValue   float64
+	ValueTableName Identifier
+}
+
+var _ Def = &SignalTypeDef{}
+
+func (d *SignalTypeDef) parseFrom(p *Parser) {
+	d.Pos = p.keyword(KeywordSignalType).pos
+	d.TypeName = p.identifier()
+	p.token(':')
+	d.Size = p.uint()
+	p.token('@')
+	d.IsBigEndian = p.intInRange(0, 1) == 0
+	d.IsSigned = p.anyOf('-', '+') == '-'
+	p.token('(')
+	d.Factor = p.float()
+	p.token(',')
+	d.Offset = p.float()
+	p.token(')')
+	p.token('[')
+	d.Minimum = p.float()
+	p.token('|')
+	d.Maximum = p.float()
+	p.token(']')
+	d.Unit = p.string()
+	d.DefaultValue = p.float()
+	p.token(',')
+	if p.peekToken().typ == scanner.Ident {
+		d.ValueTableName = p.identifier()
+	}
+	p.token(';')
+}
+
+// Position returns the position of the definition.
+func (d *SignalTypeDef) Position() scanner.Position {
+	return d.Pos
+}
+
+// SignalTypeRefDef references the signal type of a signal.
+type SignalTypeRefDef struct {
+	Pos        scanner.Position
+	MessageID  MessageID
+	SignalName Identifier
+	TypeName   Identifier
+}
+
+var _ Def = &SignalTypeRefDef{}
+
+func (d *SignalTypeRefDef) parseFrom(p *Parser) {
+	d.Pos = p.keyword(KeywordSignalTypeRef).pos
+	d.MessageID = p.messageID()
+	d.SignalName = p.identifier()
+	p.token(':')
+	d.TypeName = p.identifier()
+	p.token(';')
+}
+
+// Position returns the position of the definition.
+func (d *SignalTypeRefDef) Position() scanner.Position {
+	return d.Pos
+}
+
+// SignalMultiplexValueDef defines the multiplexer switch and switch values of a multiplexed signal.
+//
+// Signal multiplexer value definitions are used for extended multiplexing, where a message can have multiple
+// multiplexer switches, multiplexer switches can be multiplexed themselves, and multiplexed signals can be present for
+// ranges of switch values.
+type SignalMultiplexValueDef struct {
+	Pos               scanner.Position
+	MessageID         MessageID
+	Signal            Identifier
+	MultiplexerSwitch Identifier
+	Ranges            []SignalMultiplexValueRange
+}
+
+// SignalMultiplexValueRange is an inclusive range of multiplexer switch values.
+type SignalMultiplexValueRange struct {
+	RangeStart uint64
+	RangeEnd   uint64
+}
+
+var _ Def = &SignalMultiplexValueDef{}
+
+func (d *SignalMultiplexValueDef) parseFrom(p *Parser) {
+	d.Pos = p.keyword(KeywordSignalMultiplexValue).pos
+	d.MessageID = p.messageID()
+	d.Signal = p.identifier()
+	d.MultiplexerSwitch = p.identifier()
+	for {
+		var r SignalMultiplexValueRange
+		r.RangeStart = p.uint()
+		p.token('-')
+		r.RangeEnd = p.uint()
+		d.Ranges = append(d.Ranges, r)
+		if p.peekToken().typ != ',' {
+			break
+		}
+		p.token(',')
+	}
+	p.token(';')
+}
+
+// Position returns the position of the definition.
+func (d *SignalMultiplexValueDef) Position() scanner.Position {
+	return d.Pos
+}
+
 // UnknownDef represents an unknown or unsupported DBC definition.
 type UnknownDef struct {
 	Pos     scanner.Position
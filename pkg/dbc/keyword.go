@@ -17,7 +17,9 @@ const (
 	KeywordNodes                   Keyword = "BU_"
 	KeywordSignal                  Keyword = "SG_"
 	KeywordSignalGroup             Keyword = "SIG_GROUP_"
+	KeywordSignalMultiplexValue    Keyword = "SG_MUL_VAL_"
 	KeywordSignalType              Keyword = "SGTYPE_"
+	KeywordSignalTypeRef           Keyword = "SIG_TYPE_REF_"
 	KeywordSignalValueType         Keyword = "SIG_VALTYPE_"
 	KeywordValueDescriptions       Keyword = "VAL_"
 	KeywordValueTable              Keyword = "VAL_TABLE_"
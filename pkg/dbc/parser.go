@@ -103,6 +103,14 @@ func (p *Parser) Parse() (err Error) {
 			def = &MessageTransmittersDef{}
 		case KeywordEnvironmentVariableData:
 			def = &EnvironmentVariableDataDef{}
+		case KeywordSignalGroup:
+			def = &SignalGroupDef{}
+		case KeywordSignalType:
+			def = &SignalTypeDef{}
+		case KeywordSignalTypeRef:
+			def = &SignalTypeRefDef{}
+		case KeywordSignalMultiplexValue:
+			def = &SignalMultiplexValueDef{}
 		default:
 			def = &UnknownDef{}
 		}
@@ -243,6 +243,127 @@ func TestParser_Parse(t *testing.T) {
 			},
 		},
 
+		{
+			name: "multiplexed_multiplexer_signal.dbc",
+			text: `SG_ TestSignal m3M : 8|8@1+ (1,0) [0|0] "" XXX`,
+			defs: []Def{
+				&SignalDef{
+					Pos: scanner.Position{
+						Filename: "multiplexed_multiplexer_signal.dbc",
+						Line:     1,
+						Column:   1,
+					},
+					Name:                "TestSignal",
+					StartBit:            8,
+					Size:                8,
+					Factor:              1,
+					Receivers:           []Identifier{"XXX"},
+					IsMultiplexed:       true,
+					IsMultiplexerSwitch: true,
+					MultiplexerSwitch:   3,
+				},
+			},
+		},
+
+		{
+			name: "signal_multiplex_value.dbc",
+			text: `SG_MUL_VAL_ 100 TestSignal TestMultiplexer 1-1, 3-5;`,
+			defs: []Def{
+				&SignalMultiplexValueDef{
+					Pos: scanner.Position{
+						Filename: "signal_multiplex_value.dbc",
+						Line:     1,
+						Column:   1,
+					},
+					MessageID:         100,
+					Signal:            "TestSignal",
+					MultiplexerSwitch: "TestMultiplexer",
+					Ranges: []SignalMultiplexValueRange{
+						{RangeStart: 1, RangeEnd: 1},
+						{RangeStart: 3, RangeEnd: 5},
+					},
+				},
+			},
+		},
+
+		{
+			name: "signal_group.dbc",
+			text: `SIG_GROUP_ 100 Lights 1 : HeadLights TailLights;`,
+			defs: []Def{
+				&SignalGroupDef{
+					Pos: scanner.Position{
+						Filename: "signal_group.dbc",
+						Line:     1,
+						Column:   1,
+					},
+					MessageID:   100,
+					GroupName:   "Lights",
+					Repetitions: 1,
+					SignalNames: []Identifier{"HeadLights", "TailLights"},
+				},
+			},
+		},
+
+		{
+			name: "signal_type.dbc",
+			text: `SGTYPE_ Speed : 16@0- (0.01,-10) [-10|645.35] "km/h" 1000, SpeedTable;`,
+			defs: []Def{
+				&SignalTypeDef{
+					Pos: scanner.Position{
+						Filename: "signal_type.dbc",
+						Line:     1,
+						Column:   1,
+					},
+					TypeName:       "Speed",
+					Size:           16,
+					IsBigEndian:    true,
+					IsSigned:       true,
+					Factor:         0.01,
+					Offset:         -10,
+					Minimum:        -10,
+					Maximum:        645.35,
+					Unit:           "km/h",
+					DefaultValue:   1000,
+					ValueTableName: "SpeedTable",
+				},
+			},
+		},
+
+		{
+			name: "signal_type_without_value_table.dbc",
+			text: `SGTYPE_ Counter : 8@1+ (1,0) [0|255] "" 0, ;`,
+			defs: []Def{
+				&SignalTypeDef{
+					Pos: scanner.Position{
+						Filename: "signal_type_without_value_table.dbc",
+						Line:     1,
+						Column:   1,
+					},
+					TypeName: "Counter",
+					Size:     8,
+					Factor:   1,
+					Maximum:  255,
+				},
+			},
+		},
+
+		{
+			name: "signal_type_ref.dbc",
+			text: `SIG_TYPE_REF_ 100 VehicleSpeed : Speed;`,
+			defs: []Def{
+				&SignalTypeRefDef{
+					Pos: scanner.Position{
+						Filename: "signal_type_ref.dbc",
+						Line:     1,
+						Column:   1,
+					},
+					MessageID:  100,
+					SignalName: "VehicleSpeed",
+					TypeName:   "Speed",
+				},
+			},
+		},
+
 		{
 			name: "comment.dbc",
 			text: `CM_ "comment";`,
@@ -957,6 +1078,24 @@ func TestParser_Parse(t *testing.T) {
 			},
 		},
 
+		{
+			name: "value_table_reference.dbc",
+			text: `VAL_ 100 Gear Gears;`,
+			defs: []Def{
+				&ValueDescriptionsDef{
+					Pos: scanner.Position{
+						Filename: "value_table_reference.dbc",
+						Line:     1,
+						Column:   1,
+					},
+					ObjectType:     ObjectTypeSignal,
+					MessageID:      100,
+					SignalName:     "Gear",
+					ValueTableName: "Gears",
+				},
+			},
+		},
+
 		{
 			name: "environment_variable_value_descriptions.dbc",
 			text: `VAL_ VariableName 2 "Value2" 1 "Value1" 0 "Value0";`,
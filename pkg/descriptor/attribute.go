@@ -0,0 +1,56 @@
+package descriptor
+
+// AttributeType represents the value type of an attribute.
+type AttributeType uint8
+
+//go:generate stringer -type AttributeType -trimprefix AttributeType
+
+const (
+	// AttributeTypeInt is an integer attribute.
+	AttributeTypeInt AttributeType = iota
+	// AttributeTypeHex is an integer attribute, in hexadecimal notation.
+	AttributeTypeHex
+	// AttributeTypeFloat is a floating point attribute.
+	AttributeTypeFloat
+	// AttributeTypeString is a string attribute.
+	AttributeTypeString
+	// AttributeTypeEnum is an enumerated attribute.
+	AttributeTypeEnum
+)
+
+// Attribute is the value of a user-defined attribute of a database, node, message or signal.
+type Attribute struct {
+	// Name of the attribute.
+	Name string
+	// Type of the attribute value.
+	Type AttributeType
+	// IntValue is the value of integer attributes, and the index of the value of enumerated attributes.
+	IntValue int64
+	// FloatValue is the value of floating point attributes.
+	FloatValue float64
+	// StringValue is the value of string attributes, and the value of enumerated attributes.
+	StringValue string
+	// IsDefault is true if the value is the default value of the attribute.
+	IsDefault bool
+}
+
+// Value returns the value of the attribute as an int64, float64 or string, depending on the attribute type.
+func (a *Attribute) Value() interface{} {
+	switch a.Type {
+	case AttributeTypeInt, AttributeTypeHex:
+		return a.IntValue
+	case AttributeTypeFloat:
+		return a.FloatValue
+	default:
+		return a.StringValue
+	}
+}
+
+func lookupAttribute(attributes []*Attribute, name string) (*Attribute, bool) {
+	for _, a := range attributes {
+		if a.Name == name {
+			return a, true
+		}
+	}
+	return nil, false
+}
@@ -18,6 +18,17 @@ type Database struct {
 	Messages []*Message
 	// Nodes in the database.
 	Nodes []*Node
+	// ValueTables in the database, sorted by name.
+	ValueTables []*ValueTable
+	// EnvironmentVariables in the database, sorted by name.
+	EnvironmentVariables []*EnvironmentVariable
+	// Attributes of the database, sorted by name.
+	Attributes []*Attribute
+}
+
+// Attribute returns the attribute with the provided name.
+func (d *Database) Attribute(name string) (*Attribute, bool) {
+	return lookupAttribute(d.Attributes, name)
 }
 
 func (d *Database) Node(nodeName string) (*Node, bool) {
@@ -29,6 +40,26 @@ func (d *Database) Node(nodeName string) (*Node, bool) {
 	return nil, false
 }
 
+// ValueTable returns the value table with the provided name.
+func (d *Database) ValueTable(name string) (*ValueTable, bool) {
+	for _, vt := range d.ValueTables {
+		if vt.Name == name {
+			return vt, true
+		}
+	}
+	return nil, false
+}
+
+// EnvironmentVariable returns the environment variable with the provided name.
+func (d *Database) EnvironmentVariable(name string) (*EnvironmentVariable, bool) {
+	for _, e := range d.EnvironmentVariables {
+		if e.Name == name {
+			return e, true
+		}
+	}
+	return nil, false
+}
+
 func (d *Database) Message(id uint32) (*Message, bool) {
 	for _, m := range d.Messages {
 		if m.ID == id {
@@ -38,6 +69,16 @@ func (d *Database) Message(id uint32) (*Message, bool) {
 	return nil, false
 }
 
+// MessageByName returns the message with the provided name.
+func (d *Database) MessageByName(name string) (*Message, bool) {
+	for _, m := range d.Messages {
+		if m.Name == name {
+			return m, true
+		}
+	}
+	return nil, false
+}
+
 func (d *Database) Signal(messageID uint32, signalName string) (*Signal, bool) {
 	message, ok := d.Message(messageID)
 	if !ok {
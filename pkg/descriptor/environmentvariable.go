@@ -0,0 +1,70 @@
+package descriptor
+
+// EnvironmentVariableType represents the type of an environment variable.
+type EnvironmentVariableType uint8
+
+//go:generate stringer -type EnvironmentVariableType -trimprefix EnvironmentVariableType
+
+const (
+	// EnvironmentVariableTypeInteger is an integer environment variable.
+	EnvironmentVariableTypeInteger EnvironmentVariableType = iota
+	// EnvironmentVariableTypeFloat is a floating point environment variable.
+	EnvironmentVariableTypeFloat
+	// EnvironmentVariableTypeString is a string environment variable.
+	EnvironmentVariableTypeString
+	// EnvironmentVariableTypeData is an environment variable of raw data bytes.
+	EnvironmentVariableTypeData
+)
+
+// AccessType represents the access type of an environment variable.
+type AccessType uint8
+
+//go:generate stringer -type AccessType -trimprefix AccessType
+
+const (
+	// AccessTypeUnrestricted is an environment variable without access restrictions.
+	AccessTypeUnrestricted AccessType = iota
+	// AccessTypeRead is a read-only environment variable.
+	AccessTypeRead
+	// AccessTypeWrite is a write-only environment variable.
+	AccessTypeWrite
+	// AccessTypeReadWrite is a readable and writable environment variable.
+	AccessTypeReadWrite
+)
+
+// EnvironmentVariable describes an environment variable of a CAN database.
+type EnvironmentVariable struct {
+	// Name of the environment variable.
+	Name string
+	// ID of the environment variable.
+	ID uint64
+	// Type of the environment variable.
+	Type EnvironmentVariableType
+	// Min value of the environment variable.
+	Min float64
+	// Max value of the environment variable.
+	Max float64
+	// Unit of the environment variable.
+	Unit string
+	// InitialValue of the environment variable.
+	InitialValue float64
+	// DataSize is the size in bytes of data environment variables.
+	DataSize uint64
+	// AccessType of the environment variable.
+	AccessType AccessType
+	// AccessNodes is the list of names of the nodes accessing the environment variable.
+	AccessNodes []string
+	// Description of the environment variable.
+	Description string
+	// ValueTable is the name of the value table providing the value descriptions, if any.
+	ValueTable string
+	// ValueDescriptions of the environment variable.
+	ValueDescriptions []*ValueDescription
+	// Attributes of the environment variable, sorted by name.
+	Attributes []*Attribute
+}
+
+// Attribute returns the attribute with the provided name.
+func (e *EnvironmentVariable) Attribute(name string) (*Attribute, bool) {
+	return lookupAttribute(e.Attributes, name)
+}
@@ -1,6 +1,10 @@
 package descriptor
 
-import "time"
+import (
+	"time"
+
+	"go.einride.tech/can"
+)
 
 // Message describes a CAN message.
 type Message struct {
@@ -20,16 +24,139 @@ type Message struct {
 	Signals []*Signal
 	// SenderNode is the name of the node sending the message.
 	SenderNode string
+	// TransmitterNodes is the list of names of the nodes transmitting the message, for messages with multiple
+	// transmitters.
+	TransmitterNodes []string
+	// SignalGroups of the message.
+	SignalGroups []*SignalGroup
 	// CycleTime is the cycle time of a cyclic message.
 	CycleTime time.Duration
-	// DelayTime is the allowed delay between cyclic message sends.
+	// CycleTimeFast is the cycle time of a cyclic message while it is active, and the interval between repetitions.
+	CycleTimeFast time.Duration
+	// DelayTime is the minimum delay between message sends.
 	DelayTime time.Duration
+	// RepetitionCount is the number of times an event-triggered message send is repeated.
+	RepetitionCount int
+	// Attributes of the message, sorted by name.
+	Attributes []*Attribute
+}
+
+// MessageKey identifies the message of a frame, by the ID and the ID format of the frame.
+type MessageKey struct {
+	ID         uint32
+	IsExtended bool
+}
+
+// MessageKeyOf returns the key of the message of the provided frame.
+func MessageKeyOf(f can.Frame) MessageKey {
+	return MessageKey{ID: f.ID, IsExtended: f.IsExtended}
+}
+
+// Key returns the key of the message, matching the keys of its frames.
+func (m *Message) Key() MessageKey {
+	return MessageKey{ID: m.ID, IsExtended: m.IsExtended}
+}
+
+// Attribute returns the attribute with the provided name.
+func (m *Message) Attribute(name string) (*Attribute, bool) {
+	return lookupAttribute(m.Attributes, name)
 }
 
 // MultiplexerSignal returns the message's multiplexer signal.
+//
+// With extended multiplexing, the first multiplexer signal that is not multiplexed is returned.
 func (m *Message) MultiplexerSignal() (*Signal, bool) {
 	for _, s := range m.Signals {
-		if s.IsMultiplexer {
+		if s.IsMultiplexer && !s.IsMultiplexed {
+			return s, true
+		}
+	}
+	return nil, false
+}
+
+// Multiplexer returns the multiplexer signal of the provided multiplexed signal.
+func (m *Message) Multiplexer(s *Signal) (*Signal, bool) {
+	if !s.IsMultiplexed {
+		return nil, false
+	}
+	if s.MultiplexerName == "" {
+		return m.MultiplexerSignal()
+	}
+	mux, ok := m.Signal(s.MultiplexerName)
+	if !ok || !mux.IsMultiplexer {
+		return nil, false
+	}
+	return mux, true
+}
+
+// MultiplexerDepth returns the number of multiplexer levels above the provided signal.
+//
+// Signals that are not multiplexed have depth 0, and signals multiplexed by a multiplexer that is not multiplexed have
+// depth 1.
+func (m *Message) MultiplexerDepth(s *Signal) int {
+	depth := 0
+	// the number of multiplexer levels is bounded by the number of signals, to guard against cycles
+	for ; depth < len(m.Signals); depth++ {
+		mux, ok := m.Multiplexer(s)
+		if !ok {
+			break
+		}
+		s = mux
+	}
+	return depth
+}
+
+// IsSignalPresent returns true if the signal is present in the provided payload.
+//
+// Multiplexed signals are present when their multiplexer is present, and has a value selecting them.
+func (m *Message) IsSignalPresent(s *Signal, d can.Data) bool {
+	// the number of multiplexer levels is bounded by the number of signals, to guard against cycles
+	for i := 0; i <= len(m.Signals); i++ {
+		if !s.IsMultiplexed {
+			return true
+		}
+		mux, ok := m.Multiplexer(s)
+		if !ok || !s.IsSelectedBy(mux.UnmarshalUnsigned(d)) {
+			return false
+		}
+		s = mux
+	}
+	return false
+}
+
+// IsActive returns true if any signal in the payload differs from its inactive value.
+//
+// Multiplexed signals are only considered when selected by the multiplexer.
+func (m *Message) IsActive(d can.Data) bool {
+	for _, s := range m.Signals {
+		if !m.IsSignalPresent(s, d) {
+			continue
+		}
+		if s.IsSigned {
+			if s.UnmarshalSigned(d) != int64(s.InactiveValue) {
+				return true
+			}
+		} else if s.UnmarshalUnsigned(d) != uint64(s.InactiveValue) {
+			return true
+		}
+	}
+	return false
+}
+
+// SignalGroup returns the signal group with the provided name.
+func (m *Message) SignalGroup(name string) (*SignalGroup, bool) {
+	for _, g := range m.SignalGroups {
+		if g.Name == name {
+			return g, true
+		}
+	}
+	return nil, false
+}
+
+// Signal returns the signal with the provided name.
+func (m *Message) Signal(name string) (*Signal, bool) {
+	for _, s := range m.Signals {
+		if s.Name == name {
 			return s, true
 		}
 	}
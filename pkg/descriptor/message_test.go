@@ -3,10 +3,17 @@ package descriptor
 import (
 	"testing"
 
+	"go.einride.tech/can"
 	"gotest.tools/v3/assert"
 	is "gotest.tools/v3/assert/cmp"
 )
 
+func TestMessage_Key(t *testing.T) {
+	m := &Message{ID: 100, IsExtended: true}
+	assert.Equal(t, MessageKeyOf(can.Frame{ID: 100, IsExtended: true}), m.Key())
+	assert.Assert(t, MessageKeyOf(can.Frame{ID: 100}) != m.Key())
+}
+
 func TestMessage_MultiplexerSignal(t *testing.T) {
 	mux := &Signal{
 		Name:          "Mux",
@@ -35,3 +42,78 @@ func TestMessage_MultiplexerSignal_NotFound(t *testing.T) {
 	assert.Assert(t, !ok)
 	assert.Assert(t, is.Nil(actualMux))
 }
+
+func TestMessage_IsActive(t *testing.T) {
+	mux := &Signal{Name: "Mux", Start: 0, Length: 4, IsMultiplexer: true, InactiveValue: 2}
+	signed := &Signal{Name: "Signed", Start: 8, Length: 8, IsSigned: true, InactiveValue: -1}
+	muxed := &Signal{Name: "Muxed", Start: 16, Length: 8, IsMultiplexed: true, MultiplexerValue: 2}
+	m := &Message{Signals: []*Signal{mux, signed, muxed}}
+	data := func(muxValue uint64, signedValue int64, muxedValue uint64) can.Data {
+		var d can.Data
+		mux.MarshalUnsigned(&d, muxValue)
+		signed.MarshalSigned(&d, signedValue)
+		muxed.MarshalUnsigned(&d, muxedValue)
+		return d
+	}
+	assert.Assert(t, !m.IsActive(data(2, -1, 0)))
+	assert.Assert(t, m.IsActive(data(1, -1, 0)))
+	assert.Assert(t, m.IsActive(data(2, 0, 0)))
+	assert.Assert(t, m.IsActive(data(2, -1, 5)))
+}
+
+func TestMessage_ExtendedMultiplexing(t *testing.T) {
+	mux := &Signal{Name: "Mux", Start: 0, Length: 4, IsMultiplexer: true}
+	subMux := &Signal{
+		Name:             "SubMux",
+		Start:            4,
+		Length:           4,
+		IsMultiplexer:    true,
+		IsMultiplexed:    true,
+		MultiplexerValue: 1,
+		MultiplexerName:  "Mux",
+	}
+	muxed := &Signal{
+		Name:              "Muxed",
+		Start:             8,
+		Length:            8,
+		IsMultiplexed:     true,
+		MultiplexerName:   "SubMux",
+		MultiplexerRanges: []*MultiplexerRange{{Min: 2, Max: 2}, {Min: 4, Max: 6}},
+	}
+	m := &Message{Signals: []*Signal{muxed, subMux, mux}}
+	actualMux, ok := m.MultiplexerSignal()
+	assert.Assert(t, ok)
+	assert.Equal(t, mux, actualMux)
+	actualMux, ok = m.Multiplexer(muxed)
+	assert.Assert(t, ok)
+	assert.Equal(t, subMux, actualMux)
+	assert.Equal(t, 0, m.MultiplexerDepth(mux))
+	assert.Equal(t, 1, m.MultiplexerDepth(subMux))
+	assert.Equal(t, 2, m.MultiplexerDepth(muxed))
+	data := func(muxValue, subMuxValue uint64) can.Data {
+		var d can.Data
+		mux.MarshalUnsigned(&d, muxValue)
+		subMux.MarshalUnsigned(&d, subMuxValue)
+		return d
+	}
+	for _, tt := range []struct {
+		muxValue    uint64
+		subMuxValue uint64
+		expected    bool
+	}{
+		{muxValue: 1, subMuxValue: 2, expected: true},
+		{muxValue: 1, subMuxValue: 3, expected: false},
+		{muxValue: 1, subMuxValue: 5, expected: true},
+		{muxValue: 0, subMuxValue: 5, expected: false},
+	} {
+		assert.Equal(t, tt.expected, m.IsSignalPresent(muxed, data(tt.muxValue, tt.subMuxValue)))
+	}
+	assert.Assert(t, m.IsSignalPresent(mux, data(0, 0)))
+}
+
+func TestMessage_IsSignalPresent_CyclicMultiplexers(t *testing.T) {
+	a := &Signal{Name: "A", Length: 1, IsMultiplexer: true, IsMultiplexed: true, MultiplexerName: "B"}
+	b := &Signal{Name: "B", Length: 1, IsMultiplexer: true, IsMultiplexed: true, MultiplexerName: "A"}
+	m := &Message{Signals: []*Signal{a, b}}
+	assert.Assert(t, !m.IsSignalPresent(a, can.Data{}))
+}
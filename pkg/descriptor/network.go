@@ -0,0 +1,87 @@
+package descriptor
+
+// Network describes a CAN network of multiple buses, compiled from one database per bus.
+type Network struct {
+	// Name of the network.
+	Name string
+	// Buses of the network, sorted by name.
+	Buses []*Bus
+	// Nodes of the network, merged across buses and sorted by name.
+	Nodes []*NetworkNode
+}
+
+// Bus describes a CAN bus of a network.
+type Bus struct {
+	// Name of the bus.
+	Name string
+	// Database of the bus.
+	Database *Database
+}
+
+// NetworkNode describes a CAN node of a network, present on one or more buses.
+type NetworkNode struct {
+	// Name of the node.
+	Name string
+	// Buses the node is present on, sorted by name.
+	Buses []string
+}
+
+// BusMessage is a message on a bus of a network.
+type BusMessage struct {
+	// Bus of the message.
+	Bus string
+	// Message on the bus, with the ID of the message on the bus.
+	Message *Message
+}
+
+// Bus returns the bus with the provided name.
+func (n *Network) Bus(name string) (*Bus, bool) {
+	for _, b := range n.Buses {
+		if b.Name == name {
+			return b, true
+		}
+	}
+	return nil, false
+}
+
+// Node returns the node with the provided name.
+func (n *Network) Node(name string) (*NetworkNode, bool) {
+	for _, nn := range n.Nodes {
+		if nn.Name == name {
+			return nn, true
+		}
+	}
+	return nil, false
+}
+
+// Message returns the message with the provided name on the provided bus.
+func (n *Network) Message(bus, name string) (*Message, bool) {
+	b, ok := n.Bus(bus)
+	if !ok {
+		return nil, false
+	}
+	return b.Database.MessageByName(name)
+}
+
+// MessagesByName returns the messages with the provided name on all buses, sorted by bus name.
+//
+// A message routed between buses may have a different ID on each bus.
+func (n *Network) MessagesByName(name string) []*BusMessage {
+	var result []*BusMessage
+	for _, b := range n.Buses {
+		if m, ok := b.Database.MessageByName(name); ok {
+			result = append(result, &BusMessage{Bus: b.Name, Message: m})
+		}
+	}
+	return result
+}
+
+// IsOnBus returns true if the node is present on the provided bus.
+func (n *NetworkNode) IsOnBus(bus string) bool {
+	for _, b := range n.Buses {
+		if b == bus {
+			return true
+		}
+	}
+	return false
+}
@@ -6,4 +6,11 @@ type Node struct {
 	Name string
 	// Description of the CAN node.
 	Description string
+	// Attributes of the CAN node, sorted by name.
+	Attributes []*Attribute
+}
+
+// Attribute returns the attribute with the provided name.
+func (n *Node) Attribute(name string) (*Attribute, bool) {
+	return lookupAttribute(n.Attributes, name)
 }
@@ -2,7 +2,7 @@ package descriptor
 
 import "strings"
 
-// SendType represents the send type of a message.
+// SendType represents the send type of a message or signal.
 type SendType uint8
 
 //go:generate stringer -type SendType -trimprefix SendType
@@ -14,16 +14,50 @@ const (
 	SendTypeCyclic
 	// SendTypeEvent means the message is only sent upon event or request.
 	SendTypeEvent
+	// SendTypeOnWrite means the message is sent every time a signal is written.
+	SendTypeOnWrite
+	// SendTypeOnChange means the message is sent when a written signal changes the message payload.
+	SendTypeOnChange
+	// SendTypeIfActive means the message is sent cyclically while it is active.
+	//
+	// For signals, it means the message is sent when the signal is written while the message is active.
+	// A message is active when any of its signals differs from its inactive value.
+	SendTypeIfActive
+	// SendTypeCyclicAndOnChange means the message is sent cyclically, and when a written signal changes the message
+	// payload.
+	SendTypeCyclicAndOnChange
+	// SendTypeCyclicIfActiveFast means the message is sent cyclically, with the fast cycle time while it is active.
+	SendTypeCyclicIfActiveFast
 )
 
+// IsCyclic returns true if the send type includes cyclic transmission.
+func (s SendType) IsCyclic() bool {
+	switch s {
+	case SendTypeCyclic, SendTypeIfActive, SendTypeCyclicAndOnChange, SendTypeCyclicIfActiveFast:
+		return true
+	default:
+		return false
+	}
+}
+
 // UnmarshalString sets the value of *s from the provided string.
 func (s *SendType) UnmarshalString(str string) error {
 	// TODO: Decide on conventions and make this more strict
 	switch strings.ToLower(str) {
 	case "cyclic", "cyclicifactive", "periodic", "fixedperiodic", "enabledperiodic", "eventperiodic":
 		*s = SendTypeCyclic
-	case "event", "onevent":
+	case "event", "onevent", "spontaneous", "spontaneouswithdelay", "spontaneouswithrepetition":
 		*s = SendTypeEvent
+	case "onwrite", "onwritewithrepetition":
+		*s = SendTypeOnWrite
+	case "onchange", "onchangewithrepetition":
+		*s = SendTypeOnChange
+	case "ifactive", "ifactivewithrepetition":
+		*s = SendTypeIfActive
+	case "cyclicandonchange", "cyclicandspontaneous", "cyclicandspontanwithdelay":
+		*s = SendTypeCyclicAndOnChange
+	case "cyclicifactivefast":
+		*s = SendTypeCyclicIfActiveFast
 	default:
 		*s = SendTypeNone
 	}
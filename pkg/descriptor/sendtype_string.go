@@ -11,11 +11,16 @@ func _() {
 	_ = x[SendTypeNone-0]
 	_ = x[SendTypeCyclic-1]
 	_ = x[SendTypeEvent-2]
+	_ = x[SendTypeOnWrite-3]
+	_ = x[SendTypeOnChange-4]
+	_ = x[SendTypeIfActive-5]
+	_ = x[SendTypeCyclicAndOnChange-6]
+	_ = x[SendTypeCyclicIfActiveFast-7]
 }
 
-const _SendType_name = "NoneCyclicEvent"
+const _SendType_name = "NoneCyclicEventOnWriteOnChangeIfActiveCyclicAndOnChangeCyclicIfActiveFast"
 
-var _SendType_index = [...]uint8{0, 4, 10, 15}
+var _SendType_index = [...]uint8{0, 4, 10, 15, 22, 30, 38, 55, 73}
 
 func (i SendType) String() string {
 	if i >= SendType(len(_SendType_index)-1) {
@@ -15,6 +15,14 @@ func TestSendType_UnmarshalString(t *testing.T) {
 		{str: "Periodic", expected: SendTypeCyclic},
 		{str: "OnEvent", expected: SendTypeEvent},
 		{str: "Event", expected: SendTypeEvent},
+		{str: "OnWrite", expected: SendTypeOnWrite},
+		{str: "OnChangeWithRepetition", expected: SendTypeOnChange},
+		{str: "IfActive", expected: SendTypeIfActive},
+		{str: "CyclicIfActive", expected: SendTypeCyclic},
+		{str: "EventPeriodic", expected: SendTypeCyclic},
+		{str: "CyclicAndSpontaneous", expected: SendTypeCyclicAndOnChange},
+		{str: "CyclicIfActiveFast", expected: SendTypeCyclicIfActiveFast},
+		{str: "NoMsgSendType", expected: SendTypeNone},
 	} {
 		t.Run(tt.str, func(t *testing.T) {
 			var actual SendType
@@ -23,3 +31,23 @@ func TestSendType_UnmarshalString(t *testing.T) {
 		})
 	}
 }
+
+func TestSendType_IsCyclic(t *testing.T) {
+	for _, tt := range []struct {
+		sendType SendType
+		expected bool
+	}{
+		{sendType: SendTypeNone, expected: false},
+		{sendType: SendTypeCyclic, expected: true},
+		{sendType: SendTypeEvent, expected: false},
+		{sendType: SendTypeOnWrite, expected: false},
+		{sendType: SendTypeOnChange, expected: false},
+		{sendType: SendTypeIfActive, expected: true},
+		{sendType: SendTypeCyclicAndOnChange, expected: true},
+		{sendType: SendTypeCyclicIfActiveFast, expected: true},
+	} {
+		t.Run(tt.sendType.String(), func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.sendType.IsCyclic())
+		})
+	}
+}
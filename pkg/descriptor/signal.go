@@ -1,7 +1,9 @@
 package descriptor
 
 import (
+	"fmt"
 	"math"
+	"strconv"
 	"unsafe"
 
 	"go.einride.tech/can"
@@ -19,14 +21,24 @@ type Signal struct {
 	IsBigEndian bool
 	// IsSigned is true if the signal uses raw signed values.
 	IsSigned bool
-	// IsFloat is true if the signal uses 32-bit floating point values
+	// IsFloat is true if the signal uses 32-bit floating point values, or 64-bit for signals of length 64.
 	IsFloat bool
-	// IsMultiplexer is true if the signal is the multiplexor of a multiplexed message.
+	// IsMultiplexer is true if the signal is a multiplexor of a multiplexed message.
+	//
+	// With extended multiplexing, a message can have multiple multiplexers, and multiplexers can be multiplexed.
 	IsMultiplexer bool
 	// IsMultiplexed is true if the signal is multiplexed.
 	IsMultiplexed bool
 	// MultiplexerValue is the value of the multiplexer when this signal is present.
 	MultiplexerValue uint
+	// MultiplexerName is the name of the multiplexer of the signal, for extended multiplexing.
+	//
+	// When empty, the signal is multiplexed by the multiplexer signal of the message.
+	MultiplexerName string
+	// MultiplexerRanges are the ranges of multiplexer values when this signal is present, for extended multiplexing.
+	//
+	// When empty, the signal is present when the multiplexer has the value MultiplexerValue.
+	MultiplexerRanges []*MultiplexerRange
 	// Offset for real-world transform.
 	Offset float64
 	// Scale for real-world transform.
@@ -39,12 +51,56 @@ type Signal struct {
 	Unit string
 	// Description of the signal.
 	Description string
+	// SignalType is the name of the signal type of the signal, if any.
+	SignalType string
+	// ValueTable is the name of the value table providing the value descriptions of the signal, if any.
+	ValueTable string
 	// ValueDescriptions of the signal.
 	ValueDescriptions []*ValueDescription
 	// ReceiverNodes is the list of names of the nodes receiving the signal.
 	ReceiverNodes []string
 	// DefaultValue of the signal.
 	DefaultValue int
+	// SendType is the signal's send type, deciding if writing the signal triggers a transmission of the message.
+	SendType SendType
+	// InactiveValue is the raw value of the signal when the message is inactive.
+	InactiveValue int
+	// Attributes of the signal, sorted by name.
+	Attributes []*Attribute
+}
+
+// MultiplexerRange is an inclusive range of multiplexer values.
+type MultiplexerRange struct {
+	// Min multiplexer value of the range.
+	Min uint
+	// Max multiplexer value of the range.
+	Max uint
+}
+
+// String returns the range formatted as <min>-<max>, or as <min> when the range has a single value.
+func (r *MultiplexerRange) String() string {
+	if r.Min == r.Max {
+		return strconv.FormatUint(uint64(r.Min), 10)
+	}
+	return strconv.FormatUint(uint64(r.Min), 10) + "-" + strconv.FormatUint(uint64(r.Max), 10)
+}
+
+// IsSelectedBy returns true if the multiplexed signal is present when its multiplexer has the provided value.
+func (s *Signal) IsSelectedBy(multiplexerValue uint64) bool {
+	if len(s.MultiplexerRanges) == 0 {
+		return multiplexerValue == uint64(s.MultiplexerValue)
+	}
+	for _, r := range s.MultiplexerRanges {
+		if multiplexerValue >= uint64(r.Min) && multiplexerValue <= uint64(r.Max) {
+			return true
+		}
+	}
+	return false
+}
+
+// Attribute returns the attribute with the provided name.
+func (s *Signal) Attribute(name string) (*Attribute, bool) {
+	return lookupAttribute(s.Attributes, name)
 }
 
 // ValueDescription returns the value description for the provided value.
@@ -57,6 +113,16 @@ func (s *Signal) ValueDescription(value int64) (string, bool) {
 	return "", false
 }
 
+// ValueDescriptionValue returns the value for the provided value description.
+func (s *Signal) ValueDescriptionValue(description string) (int64, bool) {
+	for _, vd := range s.ValueDescriptions {
+		if vd.Description == description {
+			return vd.Value, true
+		}
+	}
+	return 0, false
+}
+
 // ToPhysical converts a raw signal value to its physical value.
 func (s *Signal) ToPhysical(value float64) float64 {
 	result := value
@@ -88,6 +154,8 @@ func (s *Signal) FromPhysical(physical float64) float64 {
 // UnmarshalPhysical returns the physical value of the signal in the provided CAN frame.
 func (s *Signal) UnmarshalPhysical(d can.Data) float64 {
 	switch {
+	case s.IsFloat:
+		return s.ToPhysical(s.UnmarshalFloat(d))
 	case s.Length == 1:
 		if d.Bit(s.Start) {
 			return 1
@@ -149,6 +217,9 @@ func (s *Signal) UnmarshalBool(d can.Data) bool {
 
 // UnmarshalFloat returns the float64 value of the signam in the provided CAN frame.
 func (s *Signal) UnmarshalFloat(d can.Data) float64 {
+	if s.Length == 64 {
+		return math.Float64frombits(s.UnmarshalUnsigned(d))
+	}
 	var i uint64
 	if s.IsBigEndian {
 		i = d.UnsignedBitsBigEndian(s.Start, s.Length)
@@ -158,6 +229,58 @@ func (s *Signal) UnmarshalFloat(d can.Data) float64 {
 	return float64(*((*float32)(unsafe.Pointer(&i))))
 }
 
+// MarshalPhysical sets the physical value of the signal in the provided CAN frame.
+//
+// The raw value is rounded to the nearest value representable by the signal.
+func (s *Signal) MarshalPhysical(d *can.Data, physical float64) {
+	switch {
+	case s.IsFloat:
+		s.MarshalFloat(d, s.SaturatedCastFloat((physical-s.Offset)/s.Scale))
+	case s.Length == 1:
+		s.MarshalBool(d, math.Round(s.FromPhysical(physical)) != 0)
+	case s.IsSigned:
+		s.MarshalSigned(d, int64(math.Round(s.FromPhysical(physical))))
+	default:
+		s.MarshalUnsigned(d, uint64(math.Round(s.FromPhysical(physical))))
+	}
+}
+
+// CheckedMarshalPhysical sets the physical value of the signal in the provided CAN frame.
+//
+// Unlike MarshalPhysical, physical values out of the range of the signal, and raw values not representable by the
+// signal, are errors and leave the frame unchanged.
+func (s *Signal) CheckedMarshalPhysical(d *can.Data, physical float64) error {
+	if (s.Min != 0 || s.Max != 0) && (physical < s.Min || physical > s.Max) {
+		return fmt.Errorf("physical value out of range [%v, %v]: %v", s.Min, s.Max, physical)
+	}
+	raw := (physical - s.Offset) / s.Scale
+	if s.IsFloat {
+		if s.SaturatedCastFloat(raw) != raw {
+			return fmt.Errorf(
+				"physical value %v: raw value out of bounds [%v, %v]: %v", physical, s.MinFloat(), s.MaxFloat(), raw,
+			)
+		}
+		s.MarshalFloat(d, raw)
+		return nil
+	}
+	raw = math.Round(raw)
+	if s.IsSigned {
+		value := int64(raw)
+		if raw < math.MinInt64 || raw >= math.MaxInt64 || s.SaturatedCastSigned(value) != value {
+			return fmt.Errorf(
+				"physical value %v: raw value out of bounds [%d, %d]: %v", physical, s.MinSigned(), s.MaxSigned(), raw,
+			)
+		}
+		s.MarshalSigned(d, value)
+		return nil
+	}
+	if raw < 0 || raw >= math.MaxUint64 || s.SaturatedCastUnsigned(uint64(raw)) != uint64(raw) {
+		return fmt.Errorf("physical value %v: raw value out of bounds [0, %d]: %v", physical, s.MaxUnsigned(), raw)
+	}
+	s.MarshalUnsigned(d, uint64(raw))
+	return nil
+}
+
 // MarshalUnsigned sets the unsigned value of the signal in the provided CAN frame.
 func (s *Signal) MarshalUnsigned(d *can.Data, value uint64) {
 	if s.IsBigEndian {
@@ -183,6 +306,10 @@ func (s *Signal) MarshalBool(d *can.Data, value bool) {
 
 // Marshalfloat sets the float64 value of the signal in the provided CAN frame.
 func (s *Signal) MarshalFloat(d *can.Data, value float64) {
+	if s.Length == 64 {
+		s.MarshalUnsigned(d, math.Float64bits(value))
+		return
+	}
 	f := float32(value)
 	i := uint64(*((*uint32)(unsafe.Pointer(&f))))
 	s.MarshalUnsigned(d, i)
@@ -205,11 +332,17 @@ func (s *Signal) MaxSigned() int64 {
 
 // MinSigned returns the minimum signed value representable by the signal.
 func (s *Signal) MinFloat() float64 {
+	if s.Length == 64 {
+		return -math.MaxFloat64
+	}
 	return -math.MaxFloat32
 }
 
 // MaxSigned returns the maximum signed value representable by the signal.
 func (s *Signal) MaxFloat() float64 {
+	if s.Length == 64 {
+		return math.MaxFloat64
+	}
 	return math.MaxFloat32
 }
 
@@ -83,3 +83,120 @@ func TestSignal_MarshalSigned_BigEndian(t *testing.T) {
 	s.MarshalSigned(&actual, value)
 	assert.DeepEqual(t, expected, actual)
 }
+
+func TestSignal_MarshalPhysical(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		s        *Signal
+		physical float64
+	}{
+		{
+			name:     "unsigned scaled",
+			s:        &Signal{Name: "TestSignal", Start: 8, Length: 16, Scale: 0.1},
+			physical: 12.3,
+		},
+		{
+			name:     "signed offset",
+			s:        &Signal{Name: "TestSignal", Start: 0, Length: 4, IsSigned: true, Scale: 1, Offset: -5},
+			physical: -2,
+		},
+		{
+			name:     "big-endian",
+			s:        &Signal{Name: "TestSignal", Start: 7, Length: 12, IsBigEndian: true, Scale: 0.5},
+			physical: 100.5,
+		},
+		{
+			name:     "bool",
+			s:        &Signal{Name: "TestSignal", Start: 3, Length: 1, Scale: 1},
+			physical: 1,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			var data can.Data
+			tt.s.MarshalPhysical(&data, tt.physical)
+			assert.Equal(t, tt.physical, math.Round(tt.s.UnmarshalPhysical(data)*10)/10)
+		})
+	}
+}
+
+func TestSignal_CheckedMarshalPhysical(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		s        *Signal
+		physical float64
+		expected string
+	}{
+		{
+			name:     "unsigned scaled",
+			s:        &Signal{Name: "TestSignal", Start: 8, Length: 16, Scale: 0.1},
+			physical: 12.3,
+		},
+		{
+			name:     "signed offset",
+			s:        &Signal{Name: "TestSignal", Start: 0, Length: 4, IsSigned: true, Scale: 1, Offset: -5},
+			physical: -2,
+		},
+		{
+			name:     "float",
+			s:        &Signal{Name: "TestSignal", Start: 0, Length: 32, IsFloat: true, Scale: 1},
+			physical: -12.5,
+		},
+		{
+			name:     "double",
+			s:        &Signal{Name: "TestSignal", Start: 0, Length: 64, IsFloat: true, Scale: 1},
+			physical: 1e100,
+		},
+		{
+			name:     "out of range",
+			s:        &Signal{Name: "TestSignal", Start: 0, Length: 8, Scale: 1, Max: 100},
+			physical: 101,
+			expected: "physical value out of range [0, 100]: 101",
+		},
+		{
+			name:     "unsigned out of bounds",
+			s:        &Signal{Name: "TestSignal", Start: 0, Length: 8, Scale: 0.5},
+			physical: 128,
+			expected: "physical value 128: raw value out of bounds [0, 255]: 256",
+		},
+		{
+			name:     "signed out of bounds",
+			s:        &Signal{Name: "TestSignal", Start: 0, Length: 4, IsSigned: true, Scale: 1},
+			physical: -9,
+			expected: "physical value -9: raw value out of bounds [-8, 7]: -9",
+		},
+		{
+			name:     "float out of bounds",
+			s:        &Signal{Name: "TestSignal", Start: 0, Length: 32, IsFloat: true, Scale: 1},
+			physical: 1e100,
+			expected: "physical value 1e+100: raw value out of bounds [-3.4028234663852886e+38, " +
+				"3.4028234663852886e+38]: 1e+100",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			var data can.Data
+			err := tt.s.CheckedMarshalPhysical(&data, tt.physical)
+			if tt.expected != "" {
+				assert.Error(t, err, tt.expected)
+				assert.Equal(t, can.Data{}, data)
+				return
+			}
+			assert.NilError(t, err)
+			assert.Equal(t, tt.physical, math.Round(tt.s.UnmarshalPhysical(data)*10)/10)
+		})
+	}
+}
+
+func TestSignal_ValueDescriptionValue(t *testing.T) {
+	s := &Signal{
+		Name: "TestSignal",
+		ValueDescriptions: []*ValueDescription{
+			{Value: 0, Description: "Off"},
+			{Value: 1, Description: "On"},
+		},
+	}
+	value, ok := s.ValueDescriptionValue("On")
+	assert.Assert(t, ok)
+	assert.Equal(t, int64(1), value)
+	_, ok = s.ValueDescriptionValue("Unknown")
+	assert.Assert(t, !ok)
+}
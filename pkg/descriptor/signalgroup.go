@@ -0,0 +1,11 @@
+package descriptor
+
+// SignalGroup describes a group of signals within a message that are read and written together.
+type SignalGroup struct {
+	// Name of the signal group.
+	Name string
+	// Repetitions of the signal group.
+	Repetitions uint64
+	// SignalNames is the list of names of the signals in the group.
+	SignalNames []string
+}
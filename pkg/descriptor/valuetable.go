@@ -0,0 +1,9 @@
+package descriptor
+
+// ValueTable describes a named table of value descriptions, shared by signals and environment variables.
+type ValueTable struct {
+	// Name of the value table.
+	Name string
+	// ValueDescriptions of the value table, sorted by value.
+	ValueDescriptions []*ValueDescription
+}
@@ -0,0 +1,169 @@
+package kcd
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strconv"
+	"time"
+
+	"go.einride.tech/can/pkg/descriptor"
+)
+
+// Export exports a database as a KCD file, with a single bus named after the database.
+//
+// Multiplexed signals are exported with the multiplexer groups of their multiplexer, which must not be multiplexed.
+func Export(db *descriptor.Database) ([]byte, error) {
+	nd := &networkDefinition{
+		Xmlns:    namespace,
+		Document: &document{Name: db.Name(), Version: db.Version},
+	}
+	nodeIDs := map[string]string{}
+	for i, n := range db.Nodes {
+		id := strconv.Itoa(i + 1)
+		nodeIDs[n.Name] = id
+		nd.Nodes = append(nd.Nodes, &node{ID: id, Name: n.Name})
+	}
+	b := &bus{Name: db.Name()}
+	for _, m := range db.Messages {
+		msg, err := exportMessage(m, nodeIDs)
+		if err != nil {
+			return nil, fmt.Errorf("export KCD: %w", err)
+		}
+		b.Messages = append(b.Messages, msg)
+	}
+	nd.Buses = append(nd.Buses, b)
+	data, err := xml.MarshalIndent(nd, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("export KCD: %w", err)
+	}
+	return append([]byte(xml.Header), append(data, '\n')...), nil
+}
+
+func exportMessage(m *descriptor.Message, nodeIDs map[string]string) (*message, error) {
+	msg := &message{
+		ID:       fmt.Sprintf("0x%03X", m.ID),
+		Name:     m.Name,
+		Length:   strconv.Itoa(int(m.Length)),
+		Interval: int(m.CycleTime / time.Millisecond),
+		Notes:    m.Description,
+	}
+	if m.IsExtended {
+		msg.Format = "extended"
+	}
+	switch m.SendType {
+	case descriptor.SendTypeNone, descriptor.SendTypeCyclic:
+	default:
+		msg.Triggered = true
+	}
+	transmitterNodes := m.TransmitterNodes
+	if len(transmitterNodes) == 0 && m.SenderNode != "" {
+		transmitterNodes = []string{m.SenderNode}
+	}
+	for _, n := range transmitterNodes {
+		if id, ok := nodeIDs[n]; ok {
+			if msg.Producer == nil {
+				msg.Producer = &producer{}
+			}
+			msg.Producer.NodeRefs = append(msg.Producer.NodeRefs, &nodeRef{ID: id})
+		}
+	}
+	multiplexes := map[string]*multiplex{}
+	for _, s := range m.Signals {
+		if !s.IsMultiplexer {
+			continue
+		}
+		if s.IsMultiplexed {
+			return nil, fmt.Errorf("%s: unsupported multiplexed multiplexer: %s", m.Name, s.Name)
+		}
+		sig := exportSignal(s, nodeIDs)
+		mux := &multiplex{
+			Name:      sig.Name,
+			Offset:    sig.Offset,
+			Length:    sig.Length,
+			Endianess: sig.Endianess,
+			Notes:     sig.Notes,
+			Consumer:  sig.Consumer,
+			Value:     sig.Value,
+			LabelSet:  sig.LabelSet,
+		}
+		multiplexes[s.Name] = mux
+		msg.Multiplex = append(msg.Multiplex, mux)
+	}
+	for _, s := range m.Signals {
+		if s.IsMultiplexer {
+			continue
+		}
+		sig := exportSignal(s, nodeIDs)
+		if !s.IsMultiplexed {
+			msg.Signals = append(msg.Signals, sig)
+			continue
+		}
+		multiplexer, ok := m.Multiplexer(s)
+		if !ok {
+			return nil, fmt.Errorf("%s: no multiplexer for signal: %s", m.Name, s.Name)
+		}
+		value := uint64(s.MultiplexerValue)
+		if len(s.MultiplexerRanges) > 0 {
+			r := s.MultiplexerRanges[0]
+			if len(s.MultiplexerRanges) > 1 || r.Min != r.Max {
+				return nil, fmt.Errorf("%s: unsupported multiplexer ranges for signal: %s", m.Name, s.Name)
+			}
+			value = uint64(r.Min)
+		}
+		multiplexes[multiplexer.Name].addSignal(value, sig)
+	}
+	return msg, nil
+}
+
+func (mux *multiplex) addSignal(count uint64, sig *signal) {
+	for _, group := range mux.MuxGroups {
+		if group.Count == count {
+			group.Signals = append(group.Signals, sig)
+			return
+		}
+	}
+	mux.MuxGroups = append(mux.MuxGroups, &muxGroup{Count: count, Signals: []*signal{sig}})
+}
+
+func exportSignal(s *descriptor.Signal, nodeIDs map[string]string) *signal {
+	sig := &signal{
+		Name:   s.Name,
+		Offset: startBit(int(s.Start), s.IsBigEndian),
+		Length: int(s.Length),
+		Notes:  s.Description,
+		Value: &value{
+			Intercept: s.Offset,
+			Unit:      s.Unit,
+		},
+	}
+	if s.IsBigEndian {
+		sig.Endianess = "big"
+	}
+	switch {
+	case s.IsFloat:
+		sig.Value.Type = "single"
+	case s.IsSigned:
+		sig.Value.Type = "signed"
+	}
+	if s.Scale != 1 {
+		scale := s.Scale
+		sig.Value.Slope = &scale
+	}
+	minValue, maxValue := s.Min, s.Max
+	sig.Value.Min, sig.Value.Max = &minValue, &maxValue
+	for _, n := range s.ReceiverNodes {
+		if id, ok := nodeIDs[n]; ok {
+			if sig.Consumer == nil {
+				sig.Consumer = &consumer{}
+			}
+			sig.Consumer.NodeRefs = append(sig.Consumer.NodeRefs, &nodeRef{ID: id})
+		}
+	}
+	if len(s.ValueDescriptions) > 0 {
+		sig.LabelSet = &labelSet{}
+		for _, vd := range s.ValueDescriptions {
+			sig.LabelSet.Labels = append(sig.LabelSet.Labels, &label{Name: vd.Description, Value: vd.Value})
+		}
+	}
+	return sig
+}
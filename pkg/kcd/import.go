@@ -0,0 +1,280 @@
+package kcd
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.einride.tech/can/pkg/descriptor"
+)
+
+// ImportResult is the result of importing a KCD file.
+type ImportResult struct {
+	// Database of the imported bus.
+	Database *descriptor.Database
+	// Warnings for definitions that could not be imported.
+	Warnings []error
+}
+
+// Import imports the bus of a KCD file.
+//
+// Files with multiple buses are imported with ImportBus.
+func Import(sourceFile string, data []byte) (*ImportResult, error) {
+	return ImportBus(sourceFile, data, "")
+}
+
+// ImportBus imports the bus with the provided name from a KCD file.
+func ImportBus(sourceFile string, data []byte, busName string) (*ImportResult, error) {
+	var nd networkDefinition
+	if err := xml.NewDecoder(bytes.NewReader(data)).Decode(&nd); err != nil {
+		return nil, fmt.Errorf("import KCD %s: %w", sourceFile, err)
+	}
+	var b *bus
+	switch {
+	case busName != "":
+		for _, candidate := range nd.Buses {
+			if candidate.Name == busName {
+				b = candidate
+			}
+		}
+		if b == nil {
+			return nil, fmt.Errorf("import KCD %s: no bus: %s", sourceFile, busName)
+		}
+	case len(nd.Buses) == 0:
+		return nil, fmt.Errorf("import KCD %s: no bus", sourceFile)
+	case len(nd.Buses) > 1:
+		names := make([]string, 0, len(nd.Buses))
+		for _, candidate := range nd.Buses {
+			names = append(names, candidate.Name)
+		}
+		return nil, fmt.Errorf("import KCD %s: multiple buses: %s", sourceFile, strings.Join(names, ", "))
+	default:
+		b = nd.Buses[0]
+	}
+	im := &importer{
+		db:        &descriptor.Database{SourceFile: sourceFile},
+		nodeNames: map[string]string{},
+	}
+	if nd.Document != nil {
+		im.db.Version = nd.Document.Version
+	}
+	for _, n := range nd.Nodes {
+		im.nodeNames[n.ID] = n.Name
+		im.db.Nodes = append(im.db.Nodes, &descriptor.Node{Name: n.Name})
+	}
+	for _, m := range b.Messages {
+		im.importMessage(m)
+	}
+	im.sortDescriptors()
+	return &ImportResult{Database: im.db, Warnings: im.warnings}, nil
+}
+
+type importError struct {
+	name   string
+	reason string
+}
+
+func (e *importError) Error() string {
+	return fmt.Sprintf("failed to import: %v (%v)", e.reason, e.name)
+}
+
+type importer struct {
+	db        *descriptor.Database
+	nodeNames map[string]string
+	warnings  []error
+}
+
+func (im *importer) addWarning(warning error) {
+	im.warnings = append(im.warnings, warning)
+}
+
+func (im *importer) importMessage(m *message) {
+	id, err := strconv.ParseUint(m.ID, 0, 32)
+	if err != nil {
+		im.addWarning(&importError{name: m.Name, reason: "invalid message ID: " + m.ID})
+		return
+	}
+	msg := &descriptor.Message{
+		Name:        m.Name,
+		ID:          uint32(id),
+		IsExtended:  m.Format == "extended",
+		Description: strings.TrimSpace(m.Notes),
+		CycleTime:   time.Duration(m.Interval) * time.Millisecond,
+	}
+	switch {
+	case m.Interval > 0 && m.Triggered:
+		msg.SendType = descriptor.SendTypeCyclicAndOnChange
+	case m.Interval > 0:
+		msg.SendType = descriptor.SendTypeCyclic
+	case m.Triggered:
+		msg.SendType = descriptor.SendTypeEvent
+	}
+	if m.Producer != nil {
+		for _, ref := range m.Producer.NodeRefs {
+			msg.TransmitterNodes = append(msg.TransmitterNodes, im.nodeNames[ref.ID])
+		}
+		if len(msg.TransmitterNodes) > 0 {
+			msg.SenderNode = msg.TransmitterNodes[0]
+		}
+		if len(msg.TransmitterNodes) < 2 {
+			msg.TransmitterNodes = nil
+		}
+	}
+	// the last bit of a signal is at offset+length-1 for both byte orders
+	var lastBit int
+	addSignal := func(s *signal, sig *descriptor.Signal) {
+		if _, ok := msg.Signal(sig.Name); ok {
+			im.addWarning(&importError{name: m.Name + "." + sig.Name, reason: "duplicate signal"})
+			return
+		}
+		lastBit = max(lastBit, s.Offset+signalLength(s.Length)-1)
+		msg.Signals = append(msg.Signals, sig)
+	}
+	for _, s := range m.Signals {
+		addSignal(s, im.importSignal(msg, s))
+	}
+	for _, mux := range m.Multiplex {
+		muxSignal := &signal{
+			Name:      mux.Name,
+			Offset:    mux.Offset,
+			Length:    mux.Length,
+			Endianess: mux.Endianess,
+			Notes:     mux.Notes,
+			Consumer:  mux.Consumer,
+			Value:     mux.Value,
+			LabelSet:  mux.LabelSet,
+		}
+		muxSig := im.importSignal(msg, muxSignal)
+		muxSig.IsMultiplexer = true
+		addSignal(muxSignal, muxSig)
+		for _, group := range mux.MuxGroups {
+			for _, s := range group.Signals {
+				sig := im.importSignal(msg, s)
+				sig.IsMultiplexed = true
+				sig.MultiplexerValue = uint(group.Count)
+				if len(m.Multiplex) > 1 {
+					// signals of multiple multiplexers use extended multiplexing
+					sig.MultiplexerName = mux.Name
+					sig.MultiplexerRanges = []*descriptor.MultiplexerRange{{Min: uint(group.Count), Max: uint(group.Count)}}
+				}
+				addSignal(s, sig)
+			}
+		}
+	}
+	switch m.Length {
+	case "", "auto":
+		if len(msg.Signals) > 0 {
+			msg.Length = uint8(lastBit/8 + 1)
+		}
+	default:
+		length, err := strconv.ParseUint(m.Length, 0, 8)
+		if err != nil {
+			im.addWarning(&importError{name: m.Name, reason: "invalid message length: " + m.Length})
+			return
+		}
+		msg.Length = uint8(length)
+	}
+	im.db.Messages = append(im.db.Messages, msg)
+}
+
+func (im *importer) importSignal(msg *descriptor.Message, s *signal) *descriptor.Signal {
+	isBigEndian := s.Endianess == "big"
+	sig := &descriptor.Signal{
+		Name:        s.Name,
+		Start:       uint8(startBit(s.Offset, isBigEndian)),
+		Length:      uint8(signalLength(s.Length)),
+		IsBigEndian: isBigEndian,
+		Scale:       1,
+		Description: strings.TrimSpace(s.Notes),
+	}
+	if s.Consumer != nil {
+		for _, ref := range s.Consumer.NodeRefs {
+			sig.ReceiverNodes = append(sig.ReceiverNodes, im.nodeNames[ref.ID])
+		}
+	}
+	v := s.Value
+	if v == nil {
+		v = &value{}
+	}
+	switch v.Type {
+	case "", "unsigned":
+	case "signed":
+		sig.IsSigned = true
+	case "single":
+		sig.IsFloat = true
+	default:
+		im.addWarning(&importError{name: msg.Name + "." + s.Name, reason: "unsupported value type: " + v.Type})
+	}
+	if v.Slope != nil {
+		sig.Scale = *v.Slope
+	}
+	sig.Offset = v.Intercept
+	sig.Unit = v.Unit
+	minRaw, maxRaw := rawRange(sig)
+	sig.Min, sig.Max = sig.ToPhysical(minRaw), sig.ToPhysical(maxRaw)
+	if sig.Min > sig.Max {
+		sig.Min, sig.Max = sig.Max, sig.Min
+	}
+	if v.Min != nil {
+		sig.Min = *v.Min
+	}
+	if v.Max != nil {
+		sig.Max = *v.Max
+	}
+	if s.LabelSet != nil {
+		for _, l := range s.LabelSet.Labels {
+			sig.ValueDescriptions = append(sig.ValueDescriptions, &descriptor.ValueDescription{
+				Value:       l.Value,
+				Description: l.Name,
+			})
+		}
+		for _, g := range s.LabelSet.LabelGroups {
+			im.addWarning(&importError{name: msg.Name + "." + s.Name, reason: "unsupported label group: " + g.Name})
+		}
+	}
+	return sig
+}
+
+func (im *importer) sortDescriptors() {
+	sort.Slice(im.db.Nodes, func(i, j int) bool {
+		return im.db.Nodes[i].Name < im.db.Nodes[j].Name
+	})
+	sort.Slice(im.db.Messages, func(i, j int) bool {
+		return im.db.Messages[i].ID < im.db.Messages[j].ID
+	})
+	for _, m := range im.db.Messages {
+		sort.SliceStable(m.Signals, func(i, j int) bool {
+			return m.Signals[i].Start < m.Signals[j].Start
+		})
+		for _, s := range m.Signals {
+			sort.Slice(s.ValueDescriptions, func(i, j int) bool {
+				return s.ValueDescriptions[i].Value < s.ValueDescriptions[j].Value
+			})
+		}
+	}
+}
+
+// signalLength returns the length of a KCD signal, which defaults to a single bit.
+func signalLength(length int) int {
+	if length == 0 {
+		return 1
+	}
+	return length
+}
+
+// rawRange returns the range of raw values of a signal.
+func rawRange(sig *descriptor.Signal) (float64, float64) {
+	switch {
+	case sig.IsFloat:
+		return -math.MaxFloat32, math.MaxFloat32
+	case sig.IsSigned:
+		return -math.Pow(2, float64(sig.Length-1)), math.Pow(2, float64(sig.Length-1)) - 1
+	default:
+		return 0, math.Pow(2, float64(sig.Length)) - 1
+	}
+}
@@ -0,0 +1,123 @@
+// Package kcd provides a reader and a writer of CAN databases in the KCD (Kayak) XML format.
+package kcd
+
+import "encoding/xml"
+
+// namespace of KCD files.
+const namespace = "http://kayak.2codeornot2code.org/1.0"
+
+// networkDefinition is the root element of a KCD file.
+type networkDefinition struct {
+	XMLName  xml.Name  `xml:"NetworkDefinition"`
+	Xmlns    string    `xml:"xmlns,attr,omitempty"`
+	Document *document `xml:"Document"`
+	Nodes    []*node   `xml:"Node"`
+	Buses    []*bus    `xml:"Bus"`
+}
+
+type document struct {
+	Name    string `xml:"name,attr,omitempty"`
+	Version string `xml:"version,attr,omitempty"`
+	Content string `xml:",chardata"`
+}
+
+type node struct {
+	ID   string `xml:"id,attr"`
+	Name string `xml:"name,attr"`
+}
+
+type bus struct {
+	Name     string     `xml:"name,attr"`
+	Baudrate int        `xml:"baudrate,attr,omitempty"`
+	Messages []*message `xml:"Message"`
+}
+
+type message struct {
+	ID        string       `xml:"id,attr"`
+	Name      string       `xml:"name,attr"`
+	Length    string       `xml:"length,attr,omitempty"`
+	Interval  int          `xml:"interval,attr,omitempty"`
+	Triggered bool         `xml:"triggered,attr,omitempty"`
+	Format    string       `xml:"format,attr,omitempty"`
+	Notes     string       `xml:"Notes,omitempty"`
+	Producer  *producer    `xml:"Producer"`
+	Multiplex []*multiplex `xml:"Multiplex"`
+	Signals   []*signal    `xml:"Signal"`
+}
+
+type producer struct {
+	NodeRefs []*nodeRef `xml:"NodeRef"`
+}
+
+type consumer struct {
+	NodeRefs []*nodeRef `xml:"NodeRef"`
+}
+
+type nodeRef struct {
+	ID string `xml:"id,attr"`
+}
+
+type multiplex struct {
+	Name      string      `xml:"name,attr"`
+	Offset    int         `xml:"offset,attr"`
+	Length    int         `xml:"length,attr,omitempty"`
+	Endianess string      `xml:"endianess,attr,omitempty"`
+	Notes     string      `xml:"Notes,omitempty"`
+	Consumer  *consumer   `xml:"Consumer"`
+	Value     *value      `xml:"Value"`
+	LabelSet  *labelSet   `xml:"LabelSet"`
+	MuxGroups []*muxGroup `xml:"MuxGroup"`
+}
+
+type muxGroup struct {
+	Count   uint64    `xml:"count,attr"`
+	Signals []*signal `xml:"Signal"`
+}
+
+type signal struct {
+	Name      string    `xml:"name,attr"`
+	Offset    int       `xml:"offset,attr"`
+	Length    int       `xml:"length,attr,omitempty"`
+	Endianess string    `xml:"endianess,attr,omitempty"`
+	Notes     string    `xml:"Notes,omitempty"`
+	Consumer  *consumer `xml:"Consumer"`
+	Value     *value    `xml:"Value"`
+	LabelSet  *labelSet `xml:"LabelSet"`
+}
+
+type value struct {
+	Type      string   `xml:"type,attr,omitempty"`
+	Slope     *float64 `xml:"slope,attr"`
+	Intercept float64  `xml:"intercept,attr,omitempty"`
+	Unit      string   `xml:"unit,attr,omitempty"`
+	Min       *float64 `xml:"min,attr"`
+	Max       *float64 `xml:"max,attr"`
+}
+
+type labelSet struct {
+	Labels      []*label      `xml:"Label"`
+	LabelGroups []*labelGroup `xml:"LabelGroup"`
+}
+
+type label struct {
+	Name  string `xml:"name,attr"`
+	Value int64  `xml:"value,attr"`
+}
+
+type labelGroup struct {
+	Name string `xml:"name,attr"`
+	From int64  `xml:"from,attr"`
+	To   int64  `xml:"to,attr"`
+}
+
+// startBit converts between the offset of a KCD signal and the start bit of a descriptor signal.
+//
+// The offsets of big-endian KCD signals number the bits of each byte from the most significant bit, while the start
+// bits of big-endian descriptor signals number them from the least significant bit, as in DBC files. The conversion
+// is its own inverse.
+func startBit(offset int, isBigEndian bool) int {
+	if !isBigEndian {
+		return offset
+	}
+	return 8*(offset/8) + (7 - offset%8)
+}
@@ -0,0 +1,139 @@
+package kcd
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"go.einride.tech/can/pkg/descriptor"
+	"gotest.tools/v3/assert"
+)
+
+const powertrainKCDFile = "../../testdata/kcd/powertrain.kcd"
+
+func TestImport(t *testing.T) {
+	data, err := os.ReadFile(powertrainKCDFile)
+	assert.NilError(t, err)
+	result, err := Import(powertrainKCDFile, data)
+	assert.NilError(t, err)
+	assert.Equal(t, 0, len(result.Warnings))
+	expected := &descriptor.Database{
+		SourceFile: powertrainKCDFile,
+		Version:    "1.2",
+		Nodes: []*descriptor.Node{
+			{Name: "DASH"},
+			{Name: "ECM"},
+		},
+		Messages: []*descriptor.Message{
+			{
+				Name:        "EngineStatus",
+				ID:          100,
+				Length:      8,
+				Description: "Engine status",
+				SenderNode:  "ECM",
+				SendType:    descriptor.SendTypeCyclic,
+				CycleTime:   100 * time.Millisecond,
+				Signals: []*descriptor.Signal{
+					{
+						Name:          "Speed",
+						Length:        16,
+						Scale:         0.25,
+						Max:           8000,
+						Unit:          "rpm",
+						Description:   "Engine speed",
+						ReceiverNodes: []string{"DASH"},
+					},
+					{
+						Name:     "Temperature",
+						Start:    16,
+						Length:   8,
+						IsSigned: true,
+						Scale:    0.5,
+						Offset:   -40,
+						Min:      -104,
+						Max:      23.5,
+						Unit:     "degC",
+					},
+					{
+						Name:   "Gear",
+						Start:  24,
+						Length: 2,
+						Scale:  1,
+						Max:    3,
+						ValueDescriptions: []*descriptor.ValueDescription{
+							{Value: 0, Description: "Park"},
+							{Value: 1, Description: "Neutral"},
+							{Value: 2, Description: "Drive"},
+						},
+					},
+					{
+						Name:        "Pressure",
+						Start:       39,
+						Length:      12,
+						IsBigEndian: true,
+						Scale:       1,
+						Max:         4095,
+						Unit:        "kPa",
+					},
+				},
+			},
+			{
+				Name:       "Diagnostics",
+				ID:         0x18ff0001,
+				IsExtended: true,
+				Length:     4,
+				SenderNode: "DASH",
+				SendType:   descriptor.SendTypeEvent,
+				Signals: []*descriptor.Signal{
+					{Name: "Page", Length: 4, Scale: 1, Max: 15, IsMultiplexer: true},
+					{Name: "Counter", Start: 4, Length: 4, Scale: 1, Max: 15},
+					{
+						Name:          "Odometer",
+						Start:         8,
+						Length:        24,
+						Scale:         0.1,
+						Max:           1677721.5,
+						Unit:          "km",
+						IsMultiplexed: true,
+					},
+					{
+						Name:             "FuelLevel",
+						Start:            8,
+						Length:           8,
+						Scale:            1,
+						Max:              100,
+						Unit:             "%",
+						IsMultiplexed:    true,
+						MultiplexerValue: 1,
+					},
+				},
+			},
+		},
+	}
+	assert.DeepEqual(t, expected, result.Database)
+}
+
+func TestExport_RoundTrip(t *testing.T) {
+	data, err := os.ReadFile(powertrainKCDFile)
+	assert.NilError(t, err)
+	result, err := Import(powertrainKCDFile, data)
+	assert.NilError(t, err)
+	exported, err := Export(result.Database)
+	assert.NilError(t, err)
+	reimported, err := Import(powertrainKCDFile, exported)
+	assert.NilError(t, err)
+	assert.Equal(t, 0, len(reimported.Warnings))
+	assert.DeepEqual(t, result.Database, reimported.Database)
+}
+
+func TestImport_Buses(t *testing.T) {
+	const input = `<NetworkDefinition xmlns="http://kayak.2codeornot2code.org/1.0">
+  <Bus name="Body"/>
+  <Bus name="Chassis"/>
+</NetworkDefinition>`
+	_, err := Import("test.kcd", []byte(input))
+	assert.ErrorContains(t, err, "multiple buses: Body, Chassis")
+	result, err := ImportBus("test.kcd", []byte(input), "Chassis")
+	assert.NilError(t, err)
+	assert.Equal(t, 0, len(result.Database.Messages))
+}
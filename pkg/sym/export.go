@@ -0,0 +1,220 @@
+package sym
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.einride.tech/can/pkg/descriptor"
+)
+
+// Export exports a database as a SYM file.
+//
+// Value tables and the value descriptions of signals are exported as enums, and multiplexed messages are exported
+// with one section per multiplexer value. Messages with multiplexed multiplexers can't be exported.
+func Export(db *descriptor.Database) ([]byte, error) {
+	var buf bytes.Buffer
+	fmt.Fprintln(&buf, "FormatVersion=6.0 // Do not edit this line!")
+	fmt.Fprintf(&buf, "Title=%q\n", db.Name())
+	enums := exportEnums(db)
+	if len(enums.valueTables) > 0 {
+		fmt.Fprintln(&buf)
+		fmt.Fprintln(&buf, "{ENUMS}")
+		for _, vt := range enums.valueTables {
+			entries := make([]string, 0, len(vt.ValueDescriptions))
+			for _, vd := range vt.ValueDescriptions {
+				entries = append(entries, fmt.Sprintf("%d=%q", vd.Value, vd.Description))
+			}
+			fmt.Fprintf(&buf, "enum %s(%s)\n", vt.Name, strings.Join(entries, ", "))
+		}
+	}
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, "{SENDRECEIVE}")
+	for _, m := range db.Messages {
+		if err := exportMessage(&buf, m, enums); err != nil {
+			return nil, fmt.Errorf("export SYM: %w", err)
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+// enums are the enums of an exported SYM file.
+type enums struct {
+	valueTables []*descriptor.ValueTable
+	// signalEnums are the enum names of signals without a value table.
+	signalEnums map[*descriptor.Signal]string
+}
+
+func exportEnums(db *descriptor.Database) *enums {
+	result := &enums{
+		valueTables: append([]*descriptor.ValueTable(nil), db.ValueTables...),
+		signalEnums: map[*descriptor.Signal]string{},
+	}
+	names := map[string]bool{}
+	for _, vt := range db.ValueTables {
+		names[vt.Name] = true
+	}
+	for _, m := range db.Messages {
+		for _, s := range m.Signals {
+			if len(s.ValueDescriptions) == 0 || s.ValueTable != "" {
+				continue
+			}
+			name := s.Name
+			if names[name] {
+				name = m.Name + "_" + s.Name
+			}
+			names[name] = true
+			result.signalEnums[s] = name
+			result.valueTables = append(result.valueTables, &descriptor.ValueTable{
+				Name:              name,
+				ValueDescriptions: s.ValueDescriptions,
+			})
+		}
+	}
+	return result
+}
+
+func exportMessage(buf *bytes.Buffer, m *descriptor.Message, e *enums) error {
+	mux, ok := m.MultiplexerSignal()
+	if !ok {
+		exportSection(buf, m)
+		for _, s := range m.Signals {
+			exportVariable(buf, "Var", s, e)
+		}
+		return nil
+	}
+	// collect the multiplexer values of the message, in order of first occurrence
+	var muxValues []uint64
+	hasMuxValue := map[uint64]bool{}
+	for _, s := range m.Signals {
+		if s.IsMultiplexer && s != mux {
+			return fmt.Errorf("%s: unsupported multiplexer: %s", m.Name, s.Name)
+		}
+		if !s.IsMultiplexed {
+			continue
+		}
+		values, err := multiplexerValues(s)
+		if err != nil {
+			return fmt.Errorf("%s: %w", m.Name, err)
+		}
+		for _, v := range values {
+			if !hasMuxValue[v] {
+				hasMuxValue[v] = true
+				muxValues = append(muxValues, v)
+			}
+		}
+	}
+	for _, muxValue := range muxValues {
+		exportSection(buf, m)
+		fmt.Fprintf(buf, "Mux=%s %d,%d %d%s\n", mux.Name, startBit(int(mux.Start), mux.IsBigEndian), mux.Length,
+			muxValue, variableOptions(mux, e))
+		for _, s := range m.Signals {
+			if s == mux || (s.IsMultiplexed && !s.IsSelectedBy(muxValue)) {
+				continue
+			}
+			exportVariable(buf, "Var", s, e)
+		}
+	}
+	return nil
+}
+
+// multiplexerValues returns the multiplexer values selecting a multiplexed signal.
+func multiplexerValues(s *descriptor.Signal) ([]uint64, error) {
+	if s.MultiplexerName != "" {
+		return nil, fmt.Errorf("unsupported extended multiplexing: %s", s.Name)
+	}
+	if len(s.MultiplexerRanges) == 0 {
+		return []uint64{uint64(s.MultiplexerValue)}, nil
+	}
+	var values []uint64
+	for _, r := range s.MultiplexerRanges {
+		if r.Max-r.Min > math.MaxUint8 {
+			return nil, fmt.Errorf("unsupported multiplexer range: %s %d-%d", s.Name, r.Min, r.Max)
+		}
+		for v := r.Min; v <= r.Max; v++ {
+			values = append(values, uint64(v))
+		}
+	}
+	return values, nil
+}
+
+func exportSection(buf *bytes.Buffer, m *descriptor.Message) {
+	fmt.Fprintln(buf)
+	fmt.Fprintf(buf, "[%s]\n", m.Name)
+	if m.IsExtended {
+		fmt.Fprintf(buf, "ID=%08Xh\n", m.ID)
+		fmt.Fprintln(buf, "Type=Extended")
+	} else {
+		fmt.Fprintf(buf, "ID=%03Xh\n", m.ID)
+	}
+	fmt.Fprintf(buf, "Len=%d\n", m.Length)
+	if m.CycleTime > 0 {
+		fmt.Fprintf(buf, "CycleTime=%d\n", m.CycleTime/time.Millisecond)
+	}
+}
+
+func exportVariable(buf *bytes.Buffer, key string, s *descriptor.Signal, e *enums) {
+	typ := "unsigned"
+	switch {
+	case s.IsFloat:
+		typ = "float"
+	case s.IsSigned:
+		typ = "signed"
+	}
+	fmt.Fprintf(buf, "%s=%s %s %d,%d%s", key, s.Name, typ, startBit(int(s.Start), s.IsBigEndian), s.Length,
+		variableOptions(s, e))
+	if s.Description != "" {
+		fmt.Fprintf(buf, " // %s", s.Description)
+	}
+	fmt.Fprintln(buf)
+}
+
+func variableOptions(s *descriptor.Signal, e *enums) string {
+	var options strings.Builder
+	if s.IsBigEndian {
+		options.WriteString(" -m")
+	}
+	if s.Unit != "" {
+		if strings.ContainsAny(s.Unit, " \t") {
+			fmt.Fprintf(&options, ` /u:"%s"`, s.Unit)
+		} else {
+			fmt.Fprintf(&options, " /u:%s", s.Unit)
+		}
+	}
+	if s.Scale != 1 {
+		fmt.Fprintf(&options, " /f:%s", formatFloat(s.Scale))
+	}
+	if s.Offset != 0 {
+		fmt.Fprintf(&options, " /o:%s", formatFloat(s.Offset))
+	}
+	// the default range is computed without ToPhysical, which clamps to the range of the signal
+	minRaw, maxRaw := rawRange(s)
+	minPhysical, maxPhysical := minRaw*s.Scale+s.Offset, maxRaw*s.Scale+s.Offset
+	if minPhysical > maxPhysical {
+		minPhysical, maxPhysical = maxPhysical, minPhysical
+	}
+	// a zero range, as in DBC files, leaves the range of the signal unbounded
+	hasRange := s.Min != 0 || s.Max != 0
+	if hasRange && s.Min != minPhysical {
+		fmt.Fprintf(&options, " /min:%s", formatFloat(s.Min))
+	}
+	if hasRange && s.Max != maxPhysical {
+		fmt.Fprintf(&options, " /max:%s", formatFloat(s.Max))
+	}
+	if s.DefaultValue != 0 {
+		fmt.Fprintf(&options, " /d:%s", formatFloat(s.ToPhysical(float64(s.DefaultValue))))
+	}
+	if s.ValueTable != "" {
+		fmt.Fprintf(&options, " /e:%s", s.ValueTable)
+	} else if name, ok := e.signalEnums[s]; ok {
+		fmt.Fprintf(&options, " /e:%s", name)
+	}
+	return options.String()
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
@@ -0,0 +1,571 @@
+// Package sym provides a reader and a writer of CAN databases in the PCAN Symbol (SYM) format.
+package sym
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.einride.tech/can/pkg/descriptor"
+)
+
+// ImportResult is the result of importing a SYM file.
+type ImportResult struct {
+	// Database of the imported SYM file.
+	Database *descriptor.Database
+	// Warnings for definitions that could not be imported.
+	Warnings []error
+}
+
+// Import imports the messages and enums of a SYM file.
+//
+// Enums are imported as value tables. The sections of a multiplexed message are merged into a single message, where
+// the variables present in every section are not multiplexed.
+func Import(sourceFile string, data []byte) (*ImportResult, error) {
+	im := &importer{
+		db:      &descriptor.Database{SourceFile: sourceFile},
+		signals: map[string]*variable{},
+	}
+	if err := im.parse(data); err != nil {
+		return nil, fmt.Errorf("import SYM %s: %w", sourceFile, err)
+	}
+	for _, m := range im.messages {
+		im.importMessage(m)
+	}
+	im.sortDescriptors()
+	return &ImportResult{Database: im.db, Warnings: im.warnings}, nil
+}
+
+type importError struct {
+	line   int
+	reason string
+}
+
+func (e *importError) Error() string {
+	return fmt.Sprintf("failed to import: %v (line %d)", e.reason, e.line)
+}
+
+type importer struct {
+	db       *descriptor.Database
+	signals  map[string]*variable
+	messages []*message
+	warnings []error
+}
+
+// message is a message of a SYM file, with one section per multiplexer value.
+type message struct {
+	name     string
+	line     int
+	sections []*section
+}
+
+// section is a message section of a SYM file.
+type section struct {
+	line       int
+	id         uint32
+	isExtended bool
+	length     uint8
+	cycleTime  time.Duration
+	mux        *variable
+	muxValue   uint64
+	variables  []*variable
+}
+
+// variable is a Var, Sig or Mux definition of a SYM file.
+type variable struct {
+	line        int
+	name        string
+	typ         string
+	start       int
+	length      int
+	isBigEndian bool
+	unit        string
+	factor      float64
+	offset      float64
+	min         *float64
+	max         *float64
+	enum        string
+	defaultVal  *float64
+	description string
+}
+
+func (im *importer) addWarning(warning error) {
+	im.warnings = append(im.warnings, warning)
+}
+
+func (im *importer) parse(data []byte) error {
+	sc := bufio.NewScanner(bytes.NewReader(data))
+	var currentSection string
+	var current *section
+	var enumLine strings.Builder
+	var enumLineNumber int
+	for lineNumber := 1; sc.Scan(); lineNumber++ {
+		line, comment := splitComment(sc.Text())
+		line = strings.TrimSpace(line)
+		if enumLine.Len() > 0 {
+			// enums may span multiple lines
+			enumLine.WriteString(" " + line)
+			if strings.Contains(line, ")") {
+				im.parseEnum(enumLineNumber, enumLine.String())
+				enumLine.Reset()
+			}
+			continue
+		}
+		switch {
+		case line == "":
+		case strings.HasPrefix(line, "{") && strings.HasSuffix(line, "}"):
+			currentSection = line
+			current = nil
+		case currentSection == "{ENUMS}" && strings.HasPrefix(line, "enum "):
+			if strings.Contains(line, ")") {
+				im.parseEnum(lineNumber, line)
+				continue
+			}
+			enumLine.WriteString(line)
+			enumLineNumber = lineNumber
+		case currentSection == "{SIGNALS}" && strings.HasPrefix(line, "Sig="):
+			v, err := parseVariable(lineNumber, strings.TrimPrefix(line, "Sig="), comment, true)
+			if err != nil {
+				im.addWarning(err)
+				continue
+			}
+			im.signals[v.name] = v
+		case strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]"):
+			current = &section{line: lineNumber}
+			im.addSection(strings.TrimSuffix(strings.TrimPrefix(line, "["), "]"), current)
+		case current != nil:
+			im.parseSectionLine(lineNumber, current, line, comment)
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return err
+	}
+	if enumLine.Len() > 0 {
+		im.addWarning(&importError{line: enumLineNumber, reason: "unterminated enum"})
+	}
+	return nil
+}
+
+func (im *importer) addSection(name string, s *section) {
+	for _, m := range im.messages {
+		if m.name == name {
+			// a section with the same name as a previous section is another multiplexer value of the message
+			if len(m.sections) > 0 {
+				prev := m.sections[0]
+				s.id, s.isExtended, s.length, s.cycleTime = prev.id, prev.isExtended, prev.length, prev.cycleTime
+			}
+			m.sections = append(m.sections, s)
+			return
+		}
+	}
+	im.messages = append(im.messages, &message{name: name, line: s.line, sections: []*section{s}})
+}
+
+func (im *importer) parseSectionLine(lineNumber int, s *section, line, comment string) {
+	key, value, ok := strings.Cut(line, "=")
+	if !ok {
+		im.addWarning(&importError{line: lineNumber, reason: "invalid line: " + line})
+		return
+	}
+	value = strings.TrimSpace(value)
+	switch key {
+	case "ID":
+		id, err := parseUint(value)
+		if err != nil {
+			im.addWarning(&importError{line: lineNumber, reason: "invalid ID: " + value})
+			return
+		}
+		s.id = uint32(id)
+	case "Type":
+		s.isExtended = strings.EqualFold(value, "Extended")
+	case "Len", "DLC":
+		length, err := strconv.ParseUint(value, 10, 8)
+		if err != nil {
+			im.addWarning(&importError{line: lineNumber, reason: "invalid length: " + value})
+			return
+		}
+		s.length = uint8(length)
+	case "CycleTime":
+		fields := strings.Fields(value)
+		if len(fields) == 0 {
+			im.addWarning(&importError{line: lineNumber, reason: "invalid cycle time: " + value})
+			return
+		}
+		cycleTime, err := strconv.ParseUint(fields[0], 10, 32)
+		if err != nil {
+			im.addWarning(&importError{line: lineNumber, reason: "invalid cycle time: " + value})
+			return
+		}
+		s.cycleTime = time.Duration(cycleTime) * time.Millisecond
+	case "Mux":
+		mux, muxValue, err := parseMux(lineNumber, value, comment)
+		if err != nil {
+			im.addWarning(err)
+			return
+		}
+		s.mux, s.muxValue = mux, muxValue
+	case "Var":
+		v, err := parseVariable(lineNumber, value, comment, false)
+		if err != nil {
+			im.addWarning(err)
+			return
+		}
+		s.variables = append(s.variables, v)
+	case "Sig":
+		fields := strings.Fields(value)
+		if len(fields) != 2 {
+			im.addWarning(&importError{line: lineNumber, reason: "invalid signal reference: " + value})
+			return
+		}
+		sig, ok := im.signals[fields[0]]
+		if !ok {
+			im.addWarning(&importError{line: lineNumber, reason: "no declared signal: " + fields[0]})
+			return
+		}
+		start, err := strconv.Atoi(fields[1])
+		if err != nil {
+			im.addWarning(&importError{line: lineNumber, reason: "invalid start bit: " + fields[1]})
+			return
+		}
+		v := *sig
+		v.line, v.start = lineNumber, start
+		s.variables = append(s.variables, &v)
+	}
+}
+
+func (im *importer) parseEnum(lineNumber int, line string) {
+	open := strings.Index(line, "(")
+	closing := strings.LastIndex(line, ")")
+	if open < 0 || closing < open {
+		im.addWarning(&importError{line: lineNumber, reason: "invalid enum: " + line})
+		return
+	}
+	vt := &descriptor.ValueTable{Name: strings.TrimSpace(strings.TrimPrefix(line[:open], "enum "))}
+	for _, entry := range splitQuoted(line[open+1:closing], ',') {
+		value, description, ok := strings.Cut(strings.TrimSpace(entry), "=")
+		if !ok {
+			continue
+		}
+		v, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+		if err != nil {
+			im.addWarning(&importError{line: lineNumber, reason: "invalid enum value: " + value})
+			continue
+		}
+		vt.ValueDescriptions = append(vt.ValueDescriptions, &descriptor.ValueDescription{
+			Value:       v,
+			Description: strings.Trim(strings.TrimSpace(description), `"`),
+		})
+	}
+	im.db.ValueTables = append(im.db.ValueTables, vt)
+}
+
+func (im *importer) importMessage(m *message) {
+	first := m.sections[0]
+	msg := &descriptor.Message{
+		Name:       m.name,
+		ID:         first.id,
+		IsExtended: first.isExtended,
+		Length:     first.length,
+		CycleTime:  first.cycleTime,
+	}
+	if msg.CycleTime > 0 {
+		msg.SendType = descriptor.SendTypeCyclic
+	}
+	var mux *variable
+	for _, s := range m.sections {
+		if s.mux == nil {
+			continue
+		}
+		if mux == nil {
+			mux = s.mux
+			msg.Signals = append(msg.Signals, im.importVariable(msg, mux))
+			msg.Signals[0].IsMultiplexer = true
+			continue
+		}
+		if s.mux.start != mux.start || s.mux.length != mux.length || s.mux.isBigEndian != mux.isBigEndian {
+			im.addWarning(&importError{line: s.mux.line, reason: "inconsistent multiplexer: " + s.mux.name})
+		}
+	}
+	if mux == nil && len(m.sections) > 1 {
+		im.addWarning(&importError{line: m.sections[1].line, reason: "duplicate message: " + m.name})
+		m.sections = m.sections[:1]
+	}
+	// collect the multiplexer values of each variable
+	type variableValues struct {
+		variable *variable
+		values   []uint64
+	}
+	var variables []*variableValues
+	for _, s := range m.sections {
+	variableLoop:
+		for _, v := range s.variables {
+			for _, vv := range variables {
+				if vv.variable.name != v.name {
+					continue
+				}
+				if vv.variable.start != v.start || vv.variable.length != v.length {
+					im.addWarning(&importError{line: v.line, reason: "inconsistent variable: " + v.name})
+				}
+				vv.values = append(vv.values, s.muxValue)
+				continue variableLoop
+			}
+			variables = append(variables, &variableValues{variable: v, values: []uint64{s.muxValue}})
+		}
+	}
+	for _, vv := range variables {
+		sig := im.importVariable(msg, vv.variable)
+		if mux != nil && len(vv.values) < len(m.sections) {
+			sig.IsMultiplexed = true
+			sig.MultiplexerValue = uint(vv.values[0])
+			if len(vv.values) > 1 {
+				for _, value := range vv.values {
+					sig.MultiplexerRanges = append(sig.MultiplexerRanges, &descriptor.MultiplexerRange{
+						Min: uint(value),
+						Max: uint(value),
+					})
+				}
+			}
+		}
+		msg.Signals = append(msg.Signals, sig)
+	}
+	im.db.Messages = append(im.db.Messages, msg)
+}
+
+func (im *importer) importVariable(msg *descriptor.Message, v *variable) *descriptor.Signal {
+	sig := &descriptor.Signal{
+		Name:        v.name,
+		Start:       uint8(startBit(v.start, v.isBigEndian)),
+		Length:      uint8(v.length),
+		IsBigEndian: v.isBigEndian,
+		Scale:       v.factor,
+		Offset:      v.offset,
+		Unit:        v.unit,
+		Description: v.description,
+	}
+	switch v.typ {
+	case "unsigned", "bit":
+	case "signed":
+		sig.IsSigned = true
+	case "float":
+		sig.IsFloat = true
+	default:
+		im.addWarning(&importError{line: v.line, reason: "unsupported variable type: " + v.typ})
+	}
+	minRaw, maxRaw := rawRange(sig)
+	sig.Min, sig.Max = sig.ToPhysical(minRaw), sig.ToPhysical(maxRaw)
+	if sig.Min > sig.Max {
+		sig.Min, sig.Max = sig.Max, sig.Min
+	}
+	if v.min != nil {
+		sig.Min = *v.min
+	}
+	if v.max != nil {
+		sig.Max = *v.max
+	}
+	if v.defaultVal != nil {
+		sig.DefaultValue = int(math.Round(sig.FromPhysical(*v.defaultVal)))
+	}
+	if v.enum != "" {
+		vt, ok := im.db.ValueTable(v.enum)
+		if !ok {
+			im.addWarning(&importError{line: v.line, reason: "no declared enum: " + v.enum})
+		} else {
+			sig.ValueTable = vt.Name
+			sig.ValueDescriptions = vt.ValueDescriptions
+		}
+	}
+	return sig
+}
+
+func (im *importer) sortDescriptors() {
+	sort.Slice(im.db.Messages, func(i, j int) bool {
+		return im.db.Messages[i].ID < im.db.Messages[j].ID
+	})
+	for _, m := range im.db.Messages {
+		sort.SliceStable(m.Signals, func(i, j int) bool {
+			return m.Signals[i].Start < m.Signals[j].Start
+		})
+	}
+	sort.Slice(im.db.ValueTables, func(i, j int) bool {
+		return im.db.ValueTables[i].Name < im.db.ValueTables[j].Name
+	})
+	for _, vt := range im.db.ValueTables {
+		sort.Slice(vt.ValueDescriptions, func(i, j int) bool {
+			return vt.ValueDescriptions[i].Value < vt.ValueDescriptions[j].Value
+		})
+	}
+}
+
+// parseMux parses the value of a Mux line: a name, a start bit and length, a multiplexer value and flags.
+func parseMux(lineNumber int, value, comment string) (*variable, uint64, error) {
+	fields := splitQuoted(value, ' ')
+	if len(fields) < 3 {
+		return nil, 0, &importError{line: lineNumber, reason: "invalid multiplexer: " + value}
+	}
+	muxValue, err := parseUint(fields[2])
+	if err != nil {
+		return nil, 0, &importError{line: lineNumber, reason: "invalid multiplexer value: " + fields[2]}
+	}
+	// the multiplexer is parsed as an unsigned variable, without the multiplexer value
+	variableFields := append([]string{fields[0], "unsigned", fields[1]}, fields[3:]...)
+	v, err := parseVariable(lineNumber, strings.Join(variableFields, " "), comment, false)
+	if err != nil {
+		return nil, 0, err
+	}
+	return v, muxValue, nil
+}
+
+// parseVariable parses the value of a Var line, or of a Sig line when isSignal is true, where the start bit is
+// left out.
+func parseVariable(lineNumber int, value, comment string, isSignal bool) (*variable, error) {
+	fields := splitQuoted(value, ' ')
+	if len(fields) < 3 {
+		return nil, &importError{line: lineNumber, reason: "invalid variable: " + value}
+	}
+	v := &variable{
+		line:        lineNumber,
+		name:        fields[0],
+		typ:         fields[1],
+		factor:      1,
+		description: strings.TrimSpace(comment),
+	}
+	if isSignal {
+		length, err := strconv.Atoi(fields[2])
+		if err != nil {
+			return nil, &importError{line: lineNumber, reason: "invalid length: " + fields[2]}
+		}
+		v.length = length
+	} else {
+		start, length, ok := strings.Cut(fields[2], ",")
+		var err error
+		if v.start, err = strconv.Atoi(start); !ok || err != nil {
+			return nil, &importError{line: lineNumber, reason: "invalid start bit and length: " + fields[2]}
+		}
+		if v.length, err = strconv.Atoi(length); err != nil {
+			return nil, &importError{line: lineNumber, reason: "invalid start bit and length: " + fields[2]}
+		}
+	}
+	for _, field := range fields[3:] {
+		if field == "-m" {
+			v.isBigEndian = true
+			continue
+		}
+		key, arg, ok := strings.Cut(field, ":")
+		if !ok || !strings.HasPrefix(key, "/") {
+			continue // display flags, such as -h and -b
+		}
+		arg = strings.Trim(arg, `"`)
+		var err error
+		switch key {
+		case "/u":
+			v.unit = arg
+		case "/f":
+			v.factor, err = strconv.ParseFloat(arg, 64)
+		case "/o":
+			v.offset, err = strconv.ParseFloat(arg, 64)
+		case "/min":
+			v.min, err = parseFloatPtr(arg)
+		case "/max":
+			v.max, err = parseFloatPtr(arg)
+		case "/d":
+			v.defaultVal, err = parseFloatPtr(arg)
+		case "/e":
+			v.enum = arg
+		case "/ln":
+			if v.description == "" {
+				v.description = arg
+			}
+		}
+		if err != nil {
+			return nil, &importError{line: lineNumber, reason: "invalid variable option: " + field}
+		}
+	}
+	if v.typ == "bit" {
+		v.length = 1
+	}
+	return v, nil
+}
+
+func parseFloatPtr(s string) (*float64, error) {
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return nil, err
+	}
+	return &f, nil
+}
+
+// parseUint parses a decimal number, or a hexadecimal number with a h suffix.
+func parseUint(s string) (uint64, error) {
+	if hex, ok := strings.CutSuffix(strings.ToLower(s), "h"); ok {
+		return strconv.ParseUint(hex, 16, 64)
+	}
+	return strconv.ParseUint(s, 10, 64)
+}
+
+// splitComment splits a line into its content and its trailing // comment, outside of quotes.
+func splitComment(line string) (string, string) {
+	inQuotes := false
+	for i := 0; i < len(line); i++ {
+		switch {
+		case line[i] == '"':
+			inQuotes = !inQuotes
+		case !inQuotes && strings.HasPrefix(line[i:], "//"):
+			return line[:i], line[i+2:]
+		}
+	}
+	return line, ""
+}
+
+// splitQuoted splits a string at the separator, outside of quotes, dropping empty fields when splitting at spaces.
+func splitQuoted(s string, sep rune) []string {
+	var result []string
+	var field strings.Builder
+	inQuotes := false
+	flush := func() {
+		if sep != ' ' || field.Len() > 0 {
+			result = append(result, field.String())
+		}
+		field.Reset()
+	}
+	for _, r := range s {
+		switch {
+		case r == '"':
+			inQuotes = !inQuotes
+			field.WriteRune(r)
+		case !inQuotes && (r == sep || (sep == ' ' && r == '\t')):
+			flush()
+		default:
+			field.WriteRune(r)
+		}
+	}
+	flush()
+	return result
+}
+
+// startBit converts between the start bit of a SYM variable and the start bit of a descriptor signal.
+//
+// SYM numbers the bits of big-endian variables from the most significant bit of each byte, while descriptor signals
+// follow the DBC convention of numbering them from the least significant bit. The conversion is its own inverse.
+func startBit(start int, isBigEndian bool) int {
+	if !isBigEndian {
+		return start
+	}
+	return 8*(start/8) + (7 - start%8)
+}
+
+// rawRange returns the range of raw values of a signal.
+func rawRange(sig *descriptor.Signal) (float64, float64) {
+	switch {
+	case sig.IsFloat:
+		return -math.MaxFloat32, math.MaxFloat32
+	case sig.IsSigned:
+		return -math.Pow(2, float64(sig.Length-1)), math.Pow(2, float64(sig.Length-1)) - 1
+	default:
+		return 0, math.Pow(2, float64(sig.Length)) - 1
+	}
+}
@@ -0,0 +1,163 @@
+package sym
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"go.einride.tech/can/pkg/descriptor"
+	"gotest.tools/v3/assert"
+)
+
+const powertrainSYMFile = "../../testdata/sym/powertrain.sym"
+
+func TestImport(t *testing.T) {
+	data, err := os.ReadFile(powertrainSYMFile)
+	assert.NilError(t, err)
+	result, err := Import(powertrainSYMFile, data)
+	assert.NilError(t, err)
+	assert.Equal(t, 0, len(result.Warnings))
+	gearState := []*descriptor.ValueDescription{
+		{Value: 0, Description: "Park"},
+		{Value: 1, Description: "Neutral"},
+		{Value: 2, Description: "Drive"},
+	}
+	expected := &descriptor.Database{
+		SourceFile: powertrainSYMFile,
+		ValueTables: []*descriptor.ValueTable{
+			{Name: "GearState", ValueDescriptions: gearState},
+		},
+		Messages: []*descriptor.Message{
+			{
+				Name:      "EngineStatus",
+				ID:        100,
+				Length:    8,
+				SendType:  descriptor.SendTypeCyclic,
+				CycleTime: 100 * time.Millisecond,
+				Signals: []*descriptor.Signal{
+					{
+						Name:        "Speed",
+						Length:      16,
+						Scale:       0.25,
+						Max:         8000,
+						Unit:        "rpm",
+						Description: "Engine speed",
+					},
+					{
+						Name:     "Temperature",
+						Start:    16,
+						Length:   8,
+						IsSigned: true,
+						Scale:    0.5,
+						Offset:   -40,
+						Min:      -104,
+						Max:      23.5,
+						Unit:     "degC",
+					},
+					{
+						Name:              "Gear",
+						Start:             24,
+						Length:            2,
+						Scale:             1,
+						Max:               3,
+						DefaultValue:      2,
+						ValueTable:        "GearState",
+						ValueDescriptions: gearState,
+					},
+					{
+						Name:        "Pressure",
+						Start:       39,
+						Length:      12,
+						IsBigEndian: true,
+						Scale:       1,
+						Max:         4095,
+						Unit:        "kPa",
+					},
+				},
+			},
+			{
+				Name:       "Diagnostics",
+				ID:         0x18ff0001,
+				IsExtended: true,
+				Length:     4,
+				Signals: []*descriptor.Signal{
+					{Name: "Page", Length: 4, Scale: 1, Max: 15, IsMultiplexer: true},
+					{Name: "Counter", Start: 4, Length: 4, Scale: 1, Max: 15},
+					{
+						Name:          "Odometer",
+						Start:         8,
+						Length:        24,
+						Scale:         0.1,
+						Max:           1677721.5,
+						Unit:          "km",
+						IsMultiplexed: true,
+					},
+					{
+						Name:             "FuelLevel",
+						Start:            8,
+						Length:           8,
+						Scale:            1,
+						Max:              100,
+						Unit:             "%",
+						IsMultiplexed:    true,
+						MultiplexerValue: 1,
+						MultiplexerRanges: []*descriptor.MultiplexerRange{
+							{Min: 1, Max: 1},
+							{Min: 2, Max: 2},
+						},
+					},
+				},
+			},
+		},
+	}
+	assert.DeepEqual(t, expected, result.Database)
+}
+
+func TestExport_RoundTrip(t *testing.T) {
+	data, err := os.ReadFile(powertrainSYMFile)
+	assert.NilError(t, err)
+	result, err := Import(powertrainSYMFile, data)
+	assert.NilError(t, err)
+	exported, err := Export(result.Database)
+	assert.NilError(t, err)
+	reimported, err := Import(powertrainSYMFile, exported)
+	assert.NilError(t, err)
+	assert.Equal(t, 0, len(reimported.Warnings))
+	assert.DeepEqual(t, result.Database, reimported.Database)
+}
+
+func TestImport_Warnings(t *testing.T) {
+	const input = `FormatVersion=6.0 // Do not edit this line!
+
+{SENDRECEIVE}
+
+[Status]
+ID=100h
+Len=1
+Var=Mode unsigned 0,4 /e:Modes
+Var=Ratio double 4,4
+`
+	result, err := Import("test.sym", []byte(input))
+	assert.NilError(t, err)
+	assert.Equal(t, 2, len(result.Warnings))
+	assert.ErrorContains(t, result.Warnings[0], "no declared enum: Modes (line 8)")
+	assert.ErrorContains(t, result.Warnings[1], "unsupported variable type: double (line 9)")
+}
+
+func TestImport_MalformedCycleTime(t *testing.T) {
+	const input = `FormatVersion=6.0 // Do not edit this line!
+
+{SENDRECEIVE}
+
+[Status]
+ID=100h
+Len=1
+CycleTime=
+Var=Mode unsigned 0,4
+`
+	result, err := Import("test.sym", []byte(input))
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(result.Warnings))
+	assert.ErrorContains(t, result.Warnings[0], "invalid cycle time:  (line 8)")
+	assert.Equal(t, 1, len(result.Database.Messages))
+}
@@ -0,0 +1,1827 @@
+// Package decodingcan provides primitives for encoding and decoding decoding CAN messages.
+//
+// Source: testdata/dbc/decoding/decoding.dbc
+package decodingcan
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"go.einride.tech/can"
+	"go.einride.tech/can/pkg/candebug"
+	"go.einride.tech/can/pkg/canrunner"
+	"go.einride.tech/can/pkg/cantext"
+	"go.einride.tech/can/pkg/descriptor"
+	"go.einride.tech/can/pkg/generated"
+	"go.einride.tech/can/pkg/socketcan"
+)
+
+// prevent unused imports
+var (
+	_ = context.Background
+	_ = fmt.Print
+	_ = math.Float32frombits
+	_ = net.Dial
+	_ = http.Error
+	_ = sync.Mutex{}
+	_ = time.Now
+	_ = socketcan.Dial
+	_ = candebug.ServeMessagesHTTP
+	_ = canrunner.Run
+)
+
+// Generated code. DO NOT EDIT.
+// BigEndianReader provides read access to a BigEndian message.
+type BigEndianReader interface {
+	can.FrameMarshaler
+	// Unsigned12 returns the value of the Unsigned12 signal.
+	Unsigned12() uint16
+	// Signed10 returns the value of the Signed10 signal.
+	Signed10() int16
+	// Flag returns the value of the Flag signal.
+	Flag() bool
+	// Signed24 returns the physical value of the Signed24 signal.
+	Signed24() float64
+	// RawSigned24 returns the raw (encoded) value of the Signed24 signal.
+	RawSigned24() int32
+	// Unsigned7 returns the value of the Unsigned7 signal.
+	Unsigned7() uint8
+}
+
+// BigEndianWriter provides write access to a BigEndian message.
+type BigEndianWriter interface {
+	// CopyFrom copies all values from BigEndian.
+	CopyFrom(BigEndianReader) *BigEndian
+	// SetUnsigned12 sets the value of the Unsigned12 signal.
+	SetUnsigned12(uint16) *BigEndian
+	// SetSigned10 sets the value of the Signed10 signal.
+	SetSigned10(int16) *BigEndian
+	// SetFlag sets the value of the Flag signal.
+	SetFlag(bool) *BigEndian
+	// SetSigned24 sets the physical value of the Signed24 signal.
+	SetSigned24(float64) *BigEndian
+	// SetRawSigned24 sets the raw (encoded) value of the Signed24 signal.
+	SetRawSigned24(int32) *BigEndian
+	// SetUnsigned7 sets the value of the Unsigned7 signal.
+	SetUnsigned7(uint8) *BigEndian
+}
+
+type BigEndian struct {
+	xxx_Unsigned12 uint16
+	xxx_Signed10   int16
+	xxx_Flag       bool
+	xxx_Signed24   int32
+	xxx_Unsigned7  uint8
+}
+
+func NewBigEndian() *BigEndian {
+	m := &BigEndian{}
+	m.Reset()
+	return m
+}
+
+func (m *BigEndian) Reset() {
+	m.xxx_Unsigned12 = 0
+	m.xxx_Signed10 = 0
+	m.xxx_Flag = false
+	m.xxx_Signed24 = 0
+	m.xxx_Unsigned7 = 0
+}
+
+func (m *BigEndian) CopyFrom(o BigEndianReader) *BigEndian {
+	f, _ := o.MarshalFrame()
+	_ = m.UnmarshalFrame(f)
+	return m
+}
+
+// Descriptor returns the BigEndian descriptor.
+func (m *BigEndian) Descriptor() *descriptor.Message {
+	return Messages().BigEndian.Message
+}
+
+// String returns a compact string representation of the message.
+func (m *BigEndian) String() string {
+	return cantext.MessageString(m)
+}
+
+func (m *BigEndian) Unsigned12() uint16 {
+	return m.xxx_Unsigned12
+}
+
+func (m *BigEndian) SetUnsigned12(v uint16) *BigEndian {
+	m.xxx_Unsigned12 = uint16(Messages().BigEndian.Unsigned12.SaturatedCastUnsigned(uint64(v)))
+	return m
+}
+
+func (m *BigEndian) Signed10() int16 {
+	return m.xxx_Signed10
+}
+
+func (m *BigEndian) SetSigned10(v int16) *BigEndian {
+	m.xxx_Signed10 = int16(Messages().BigEndian.Signed10.SaturatedCastSigned(int64(v)))
+	return m
+}
+
+func (m *BigEndian) Flag() bool {
+	return m.xxx_Flag
+}
+
+func (m *BigEndian) SetFlag(v bool) *BigEndian {
+	m.xxx_Flag = v
+	return m
+}
+
+func (m *BigEndian) Signed24() float64 {
+	return Messages().BigEndian.Signed24.ToPhysical(float64(m.xxx_Signed24))
+}
+
+func (m *BigEndian) SetSigned24(v float64) *BigEndian {
+	m.xxx_Signed24 = int32(Messages().BigEndian.Signed24.FromPhysical(v))
+	return m
+}
+
+func (m *BigEndian) RawSigned24() int32 {
+	return m.xxx_Signed24
+}
+
+func (m *BigEndian) SetRawSigned24(v int32) *BigEndian {
+	m.xxx_Signed24 = int32(Messages().BigEndian.Signed24.SaturatedCastSigned(int64(v)))
+	return m
+}
+
+func (m *BigEndian) Unsigned7() uint8 {
+	return m.xxx_Unsigned7
+}
+
+func (m *BigEndian) SetUnsigned7(v uint8) *BigEndian {
+	m.xxx_Unsigned7 = uint8(Messages().BigEndian.Unsigned7.SaturatedCastUnsigned(uint64(v)))
+	return m
+}
+
+// Frame returns a CAN frame representing the message.
+func (m *BigEndian) Frame() can.Frame {
+	md := Messages().BigEndian
+	f := can.Frame{ID: md.ID, IsExtended: md.IsExtended, Length: md.Length}
+	md.Unsigned12.MarshalUnsigned(&f.Data, uint64(m.xxx_Unsigned12))
+	md.Signed10.MarshalSigned(&f.Data, int64(m.xxx_Signed10))
+	md.Flag.MarshalBool(&f.Data, bool(m.xxx_Flag))
+	md.Signed24.MarshalSigned(&f.Data, int64(m.xxx_Signed24))
+	md.Unsigned7.MarshalUnsigned(&f.Data, uint64(m.xxx_Unsigned7))
+	return f
+}
+
+// MarshalFrame encodes the message as a CAN frame.
+func (m *BigEndian) MarshalFrame() (can.Frame, error) {
+	return m.Frame(), nil
+}
+
+// UnmarshalFrame decodes the message from a CAN frame.
+func (m *BigEndian) UnmarshalFrame(f can.Frame) error {
+	md := Messages().BigEndian
+	switch {
+	case f.ID != md.ID:
+		return fmt.Errorf(
+			"unmarshal BigEndian: expects ID 100 (got %s with ID %d)", f.String(), f.ID,
+		)
+	case f.Length != md.Length:
+		return fmt.Errorf(
+			"unmarshal BigEndian: expects length 8 (got %s with length %d)", f.String(), f.Length,
+		)
+	case f.IsRemote:
+		return fmt.Errorf(
+			"unmarshal BigEndian: expects non-remote frame (got remote frame %s)", f.String(),
+		)
+	case f.IsExtended != md.IsExtended:
+		return fmt.Errorf(
+			"unmarshal BigEndian: expects standard ID (got %s with extended ID)", f.String(),
+		)
+	}
+	m.xxx_Unsigned12 = uint16(md.Unsigned12.UnmarshalUnsigned(f.Data))
+	m.xxx_Signed10 = int16(md.Signed10.UnmarshalSigned(f.Data))
+	m.xxx_Flag = bool(md.Flag.UnmarshalBool(f.Data))
+	m.xxx_Signed24 = int32(md.Signed24.UnmarshalSigned(f.Data))
+	m.xxx_Unsigned7 = uint8(md.Unsigned7.UnmarshalUnsigned(f.Data))
+	return nil
+}
+
+// DecodeFrame decodes the message from a CAN frame, with the bit layout of each signal resolved at generation
+// time.
+//
+// DecodeFrame decodes the same values as UnmarshalFrame, without allocating unless the frame is invalid, for
+// decoding frames at high rates into reused messages.
+func (m *BigEndian) DecodeFrame(f *can.Frame) error {
+	switch {
+	case f.ID != 100:
+		return fmt.Errorf(
+			"decode BigEndian: expects ID 100 (got %s with ID %d)", f.String(), f.ID,
+		)
+	case f.Length != 8:
+		return fmt.Errorf(
+			"decode BigEndian: expects length 8 (got %s with length %d)", f.String(), f.Length,
+		)
+	case f.IsRemote:
+		return fmt.Errorf(
+			"decode BigEndian: expects non-remote frame (got remote frame %s)", f.String(),
+		)
+	case f.IsExtended:
+		return fmt.Errorf(
+			"decode BigEndian: expects standard ID (got %s with extended ID)", f.String(),
+		)
+	}
+	le := f.Data.PackLittleEndian()
+	be := f.Data.PackBigEndian()
+	m.xxx_Unsigned12 = uint16((be >> 52) & 0xfff)
+	m.xxx_Signed10 = int16(int64(be<<12) >> 54)
+	m.xxx_Flag = bool(le&(1<<17) != 0)
+	m.xxx_Signed24 = int32(int64(be<<32) >> 40)
+	m.xxx_Unsigned7 = uint8(be & 0x7f)
+	return nil
+}
+
+// LittleEndianReader provides read access to a LittleEndian message.
+type LittleEndianReader interface {
+	can.FrameMarshaler
+	// Signed5 returns the value of the Signed5 signal.
+	Signed5() int8
+	// Flag returns the value of the Flag signal.
+	Flag() bool
+	// Unsigned17 returns the physical value of the Unsigned17 signal.
+	Unsigned17() float64
+	// RawUnsigned17 returns the raw (encoded) value of the Unsigned17 signal.
+	RawUnsigned17() uint32
+	// Signed38 returns the value of the Signed38 signal.
+	Signed38() int64
+}
+
+// LittleEndianWriter provides write access to a LittleEndian message.
+type LittleEndianWriter interface {
+	// CopyFrom copies all values from LittleEndian.
+	CopyFrom(LittleEndianReader) *LittleEndian
+	// SetSigned5 sets the value of the Signed5 signal.
+	SetSigned5(int8) *LittleEndian
+	// SetFlag sets the value of the Flag signal.
+	SetFlag(bool) *LittleEndian
+	// SetUnsigned17 sets the physical value of the Unsigned17 signal.
+	SetUnsigned17(float64) *LittleEndian
+	// SetRawUnsigned17 sets the raw (encoded) value of the Unsigned17 signal.
+	SetRawUnsigned17(uint32) *LittleEndian
+	// SetSigned38 sets the value of the Signed38 signal.
+	SetSigned38(int64) *LittleEndian
+}
+
+type LittleEndian struct {
+	xxx_Signed5    int8
+	xxx_Flag       bool
+	xxx_Unsigned17 uint32
+	xxx_Signed38   int64
+}
+
+func NewLittleEndian() *LittleEndian {
+	m := &LittleEndian{}
+	m.Reset()
+	return m
+}
+
+func (m *LittleEndian) Reset() {
+	m.xxx_Signed5 = 0
+	m.xxx_Flag = false
+	m.xxx_Unsigned17 = 0
+	m.xxx_Signed38 = 0
+}
+
+func (m *LittleEndian) CopyFrom(o LittleEndianReader) *LittleEndian {
+	f, _ := o.MarshalFrame()
+	_ = m.UnmarshalFrame(f)
+	return m
+}
+
+// Descriptor returns the LittleEndian descriptor.
+func (m *LittleEndian) Descriptor() *descriptor.Message {
+	return Messages().LittleEndian.Message
+}
+
+// String returns a compact string representation of the message.
+func (m *LittleEndian) String() string {
+	return cantext.MessageString(m)
+}
+
+func (m *LittleEndian) Signed5() int8 {
+	return m.xxx_Signed5
+}
+
+func (m *LittleEndian) SetSigned5(v int8) *LittleEndian {
+	m.xxx_Signed5 = int8(Messages().LittleEndian.Signed5.SaturatedCastSigned(int64(v)))
+	return m
+}
+
+func (m *LittleEndian) Flag() bool {
+	return m.xxx_Flag
+}
+
+func (m *LittleEndian) SetFlag(v bool) *LittleEndian {
+	m.xxx_Flag = v
+	return m
+}
+
+func (m *LittleEndian) Unsigned17() float64 {
+	return Messages().LittleEndian.Unsigned17.ToPhysical(float64(m.xxx_Unsigned17))
+}
+
+func (m *LittleEndian) SetUnsigned17(v float64) *LittleEndian {
+	m.xxx_Unsigned17 = uint32(Messages().LittleEndian.Unsigned17.FromPhysical(v))
+	return m
+}
+
+func (m *LittleEndian) RawUnsigned17() uint32 {
+	return m.xxx_Unsigned17
+}
+
+func (m *LittleEndian) SetRawUnsigned17(v uint32) *LittleEndian {
+	m.xxx_Unsigned17 = uint32(Messages().LittleEndian.Unsigned17.SaturatedCastUnsigned(uint64(v)))
+	return m
+}
+
+func (m *LittleEndian) Signed38() int64 {
+	return m.xxx_Signed38
+}
+
+func (m *LittleEndian) SetSigned38(v int64) *LittleEndian {
+	m.xxx_Signed38 = int64(Messages().LittleEndian.Signed38.SaturatedCastSigned(int64(v)))
+	return m
+}
+
+// Frame returns a CAN frame representing the message.
+func (m *LittleEndian) Frame() can.Frame {
+	md := Messages().LittleEndian
+	f := can.Frame{ID: md.ID, IsExtended: md.IsExtended, Length: md.Length}
+	md.Signed5.MarshalSigned(&f.Data, int64(m.xxx_Signed5))
+	md.Flag.MarshalBool(&f.Data, bool(m.xxx_Flag))
+	md.Unsigned17.MarshalUnsigned(&f.Data, uint64(m.xxx_Unsigned17))
+	md.Signed38.MarshalSigned(&f.Data, int64(m.xxx_Signed38))
+	return f
+}
+
+// MarshalFrame encodes the message as a CAN frame.
+func (m *LittleEndian) MarshalFrame() (can.Frame, error) {
+	return m.Frame(), nil
+}
+
+// UnmarshalFrame decodes the message from a CAN frame.
+func (m *LittleEndian) UnmarshalFrame(f can.Frame) error {
+	md := Messages().LittleEndian
+	switch {
+	case f.ID != md.ID:
+		return fmt.Errorf(
+			"unmarshal LittleEndian: expects ID 200 (got %s with ID %d)", f.String(), f.ID,
+		)
+	case f.Length != md.Length:
+		return fmt.Errorf(
+			"unmarshal LittleEndian: expects length 8 (got %s with length %d)", f.String(), f.Length,
+		)
+	case f.IsRemote:
+		return fmt.Errorf(
+			"unmarshal LittleEndian: expects non-remote frame (got remote frame %s)", f.String(),
+		)
+	case f.IsExtended != md.IsExtended:
+		return fmt.Errorf(
+			"unmarshal LittleEndian: expects standard ID (got %s with extended ID)", f.String(),
+		)
+	}
+	m.xxx_Signed5 = int8(md.Signed5.UnmarshalSigned(f.Data))
+	m.xxx_Flag = bool(md.Flag.UnmarshalBool(f.Data))
+	m.xxx_Unsigned17 = uint32(md.Unsigned17.UnmarshalUnsigned(f.Data))
+	m.xxx_Signed38 = int64(md.Signed38.UnmarshalSigned(f.Data))
+	return nil
+}
+
+// DecodeFrame decodes the message from a CAN frame, with the bit layout of each signal resolved at generation
+// time.
+//
+// DecodeFrame decodes the same values as UnmarshalFrame, without allocating unless the frame is invalid, for
+// decoding frames at high rates into reused messages.
+func (m *LittleEndian) DecodeFrame(f *can.Frame) error {
+	switch {
+	case f.ID != 200:
+		return fmt.Errorf(
+			"decode LittleEndian: expects ID 200 (got %s with ID %d)", f.String(), f.ID,
+		)
+	case f.Length != 8:
+		return fmt.Errorf(
+			"decode LittleEndian: expects length 8 (got %s with length %d)", f.String(), f.Length,
+		)
+	case f.IsRemote:
+		return fmt.Errorf(
+			"decode LittleEndian: expects non-remote frame (got remote frame %s)", f.String(),
+		)
+	case f.IsExtended:
+		return fmt.Errorf(
+			"decode LittleEndian: expects standard ID (got %s with extended ID)", f.String(),
+		)
+	}
+	le := f.Data.PackLittleEndian()
+	m.xxx_Signed5 = int8(int64(le<<56) >> 59)
+	m.xxx_Flag = bool(le&(1<<8) != 0)
+	m.xxx_Unsigned17 = uint32((le >> 9) & 0x1ffff)
+	m.xxx_Signed38 = int64(int64(le) >> 26)
+	return nil
+}
+
+// WideReader provides read access to a Wide message.
+type WideReader interface {
+	can.FrameMarshaler
+	// Unsigned64 returns the value of the Unsigned64 signal.
+	Unsigned64() uint64
+}
+
+// WideWriter provides write access to a Wide message.
+type WideWriter interface {
+	// CopyFrom copies all values from Wide.
+	CopyFrom(WideReader) *Wide
+	// SetUnsigned64 sets the value of the Unsigned64 signal.
+	SetUnsigned64(uint64) *Wide
+}
+
+type Wide struct {
+	xxx_Unsigned64 uint64
+}
+
+func NewWide() *Wide {
+	m := &Wide{}
+	m.Reset()
+	return m
+}
+
+func (m *Wide) Reset() {
+	m.xxx_Unsigned64 = 0
+}
+
+func (m *Wide) CopyFrom(o WideReader) *Wide {
+	f, _ := o.MarshalFrame()
+	_ = m.UnmarshalFrame(f)
+	return m
+}
+
+// Descriptor returns the Wide descriptor.
+func (m *Wide) Descriptor() *descriptor.Message {
+	return Messages().Wide.Message
+}
+
+// String returns a compact string representation of the message.
+func (m *Wide) String() string {
+	return cantext.MessageString(m)
+}
+
+func (m *Wide) Unsigned64() uint64 {
+	return m.xxx_Unsigned64
+}
+
+func (m *Wide) SetUnsigned64(v uint64) *Wide {
+	m.xxx_Unsigned64 = uint64(Messages().Wide.Unsigned64.SaturatedCastUnsigned(uint64(v)))
+	return m
+}
+
+// Frame returns a CAN frame representing the message.
+func (m *Wide) Frame() can.Frame {
+	md := Messages().Wide
+	f := can.Frame{ID: md.ID, IsExtended: md.IsExtended, Length: md.Length}
+	md.Unsigned64.MarshalUnsigned(&f.Data, uint64(m.xxx_Unsigned64))
+	return f
+}
+
+// MarshalFrame encodes the message as a CAN frame.
+func (m *Wide) MarshalFrame() (can.Frame, error) {
+	return m.Frame(), nil
+}
+
+// UnmarshalFrame decodes the message from a CAN frame.
+func (m *Wide) UnmarshalFrame(f can.Frame) error {
+	md := Messages().Wide
+	switch {
+	case f.ID != md.ID:
+		return fmt.Errorf(
+			"unmarshal Wide: expects ID 300 (got %s with ID %d)", f.String(), f.ID,
+		)
+	case f.Length != md.Length:
+		return fmt.Errorf(
+			"unmarshal Wide: expects length 8 (got %s with length %d)", f.String(), f.Length,
+		)
+	case f.IsRemote:
+		return fmt.Errorf(
+			"unmarshal Wide: expects non-remote frame (got remote frame %s)", f.String(),
+		)
+	case f.IsExtended != md.IsExtended:
+		return fmt.Errorf(
+			"unmarshal Wide: expects standard ID (got %s with extended ID)", f.String(),
+		)
+	}
+	m.xxx_Unsigned64 = uint64(md.Unsigned64.UnmarshalUnsigned(f.Data))
+	return nil
+}
+
+// DecodeFrame decodes the message from a CAN frame, with the bit layout of each signal resolved at generation
+// time.
+//
+// DecodeFrame decodes the same values as UnmarshalFrame, without allocating unless the frame is invalid, for
+// decoding frames at high rates into reused messages.
+func (m *Wide) DecodeFrame(f *can.Frame) error {
+	switch {
+	case f.ID != 300:
+		return fmt.Errorf(
+			"decode Wide: expects ID 300 (got %s with ID %d)", f.String(), f.ID,
+		)
+	case f.Length != 8:
+		return fmt.Errorf(
+			"decode Wide: expects length 8 (got %s with length %d)", f.String(), f.Length,
+		)
+	case f.IsRemote:
+		return fmt.Errorf(
+			"decode Wide: expects non-remote frame (got remote frame %s)", f.String(),
+		)
+	case f.IsExtended:
+		return fmt.Errorf(
+			"decode Wide: expects standard ID (got %s with extended ID)", f.String(),
+		)
+	}
+	le := f.Data.PackLittleEndian()
+	m.xxx_Unsigned64 = uint64(le)
+	return nil
+}
+
+// WideSignedReader provides read access to a WideSigned message.
+type WideSignedReader interface {
+	can.FrameMarshaler
+	// Signed64 returns the value of the Signed64 signal.
+	Signed64() int64
+}
+
+// WideSignedWriter provides write access to a WideSigned message.
+type WideSignedWriter interface {
+	// CopyFrom copies all values from WideSigned.
+	CopyFrom(WideSignedReader) *WideSigned
+	// SetSigned64 sets the value of the Signed64 signal.
+	SetSigned64(int64) *WideSigned
+}
+
+type WideSigned struct {
+	xxx_Signed64 int64
+}
+
+func NewWideSigned() *WideSigned {
+	m := &WideSigned{}
+	m.Reset()
+	return m
+}
+
+func (m *WideSigned) Reset() {
+	m.xxx_Signed64 = 0
+}
+
+func (m *WideSigned) CopyFrom(o WideSignedReader) *WideSigned {
+	f, _ := o.MarshalFrame()
+	_ = m.UnmarshalFrame(f)
+	return m
+}
+
+// Descriptor returns the WideSigned descriptor.
+func (m *WideSigned) Descriptor() *descriptor.Message {
+	return Messages().WideSigned.Message
+}
+
+// String returns a compact string representation of the message.
+func (m *WideSigned) String() string {
+	return cantext.MessageString(m)
+}
+
+func (m *WideSigned) Signed64() int64 {
+	return m.xxx_Signed64
+}
+
+func (m *WideSigned) SetSigned64(v int64) *WideSigned {
+	m.xxx_Signed64 = int64(Messages().WideSigned.Signed64.SaturatedCastSigned(int64(v)))
+	return m
+}
+
+// Frame returns a CAN frame representing the message.
+func (m *WideSigned) Frame() can.Frame {
+	md := Messages().WideSigned
+	f := can.Frame{ID: md.ID, IsExtended: md.IsExtended, Length: md.Length}
+	md.Signed64.MarshalSigned(&f.Data, int64(m.xxx_Signed64))
+	return f
+}
+
+// MarshalFrame encodes the message as a CAN frame.
+func (m *WideSigned) MarshalFrame() (can.Frame, error) {
+	return m.Frame(), nil
+}
+
+// UnmarshalFrame decodes the message from a CAN frame.
+func (m *WideSigned) UnmarshalFrame(f can.Frame) error {
+	md := Messages().WideSigned
+	switch {
+	case f.ID != md.ID:
+		return fmt.Errorf(
+			"unmarshal WideSigned: expects ID 400 (got %s with ID %d)", f.String(), f.ID,
+		)
+	case f.Length != md.Length:
+		return fmt.Errorf(
+			"unmarshal WideSigned: expects length 8 (got %s with length %d)", f.String(), f.Length,
+		)
+	case f.IsRemote:
+		return fmt.Errorf(
+			"unmarshal WideSigned: expects non-remote frame (got remote frame %s)", f.String(),
+		)
+	case f.IsExtended != md.IsExtended:
+		return fmt.Errorf(
+			"unmarshal WideSigned: expects standard ID (got %s with extended ID)", f.String(),
+		)
+	}
+	m.xxx_Signed64 = int64(md.Signed64.UnmarshalSigned(f.Data))
+	return nil
+}
+
+// DecodeFrame decodes the message from a CAN frame, with the bit layout of each signal resolved at generation
+// time.
+//
+// DecodeFrame decodes the same values as UnmarshalFrame, without allocating unless the frame is invalid, for
+// decoding frames at high rates into reused messages.
+func (m *WideSigned) DecodeFrame(f *can.Frame) error {
+	switch {
+	case f.ID != 400:
+		return fmt.Errorf(
+			"decode WideSigned: expects ID 400 (got %s with ID %d)", f.String(), f.ID,
+		)
+	case f.Length != 8:
+		return fmt.Errorf(
+			"decode WideSigned: expects length 8 (got %s with length %d)", f.String(), f.Length,
+		)
+	case f.IsRemote:
+		return fmt.Errorf(
+			"decode WideSigned: expects non-remote frame (got remote frame %s)", f.String(),
+		)
+	case f.IsExtended:
+		return fmt.Errorf(
+			"decode WideSigned: expects standard ID (got %s with extended ID)", f.String(),
+		)
+	}
+	be := f.Data.PackBigEndian()
+	m.xxx_Signed64 = int64(int64(be))
+	return nil
+}
+
+// MultiplexedReader provides read access to a Multiplexed message.
+type MultiplexedReader interface {
+	can.FrameMarshaler
+	// Low returns the value of the Low signal.
+	Low() uint16
+	// High returns the value of the High signal.
+	High() int16
+	// Mux returns the value of the Mux signal.
+	Mux() uint8
+	// Tail returns the value of the Tail signal.
+	Tail() uint16
+}
+
+// MultiplexedWriter provides write access to a Multiplexed message.
+type MultiplexedWriter interface {
+	// CopyFrom copies all values from Multiplexed.
+	CopyFrom(MultiplexedReader) *Multiplexed
+	// SetLow sets the value of the Low signal.
+	SetLow(uint16) *Multiplexed
+	// SetHigh sets the value of the High signal.
+	SetHigh(int16) *Multiplexed
+	// SetMux sets the value of the Mux signal.
+	SetMux(uint8) *Multiplexed
+	// SetTail sets the value of the Tail signal.
+	SetTail(uint16) *Multiplexed
+}
+
+type Multiplexed struct {
+	xxx_Low  uint16
+	xxx_High int16
+	xxx_Mux  uint8
+	xxx_Tail uint16
+}
+
+func NewMultiplexed() *Multiplexed {
+	m := &Multiplexed{}
+	m.Reset()
+	return m
+}
+
+func (m *Multiplexed) Reset() {
+	m.xxx_Low = 0
+	m.xxx_High = 0
+	m.xxx_Mux = 0
+	m.xxx_Tail = 0
+}
+
+func (m *Multiplexed) CopyFrom(o MultiplexedReader) *Multiplexed {
+	f, _ := o.MarshalFrame()
+	_ = m.UnmarshalFrame(f)
+	return m
+}
+
+// Descriptor returns the Multiplexed descriptor.
+func (m *Multiplexed) Descriptor() *descriptor.Message {
+	return Messages().Multiplexed.Message
+}
+
+// String returns a compact string representation of the message.
+func (m *Multiplexed) String() string {
+	return cantext.MessageString(m)
+}
+
+func (m *Multiplexed) Low() uint16 {
+	return m.xxx_Low
+}
+
+func (m *Multiplexed) SetLow(v uint16) *Multiplexed {
+	m.xxx_Low = uint16(Messages().Multiplexed.Low.SaturatedCastUnsigned(uint64(v)))
+	return m
+}
+
+func (m *Multiplexed) High() int16 {
+	return m.xxx_High
+}
+
+func (m *Multiplexed) SetHigh(v int16) *Multiplexed {
+	m.xxx_High = int16(Messages().Multiplexed.High.SaturatedCastSigned(int64(v)))
+	return m
+}
+
+func (m *Multiplexed) Mux() uint8 {
+	return m.xxx_Mux
+}
+
+func (m *Multiplexed) SetMux(v uint8) *Multiplexed {
+	m.xxx_Mux = uint8(Messages().Multiplexed.Mux.SaturatedCastUnsigned(uint64(v)))
+	return m
+}
+
+func (m *Multiplexed) Tail() uint16 {
+	return m.xxx_Tail
+}
+
+func (m *Multiplexed) SetTail(v uint16) *Multiplexed {
+	m.xxx_Tail = uint16(Messages().Multiplexed.Tail.SaturatedCastUnsigned(uint64(v)))
+	return m
+}
+
+// Frame returns a CAN frame representing the message.
+func (m *Multiplexed) Frame() can.Frame {
+	md := Messages().Multiplexed
+	f := can.Frame{ID: md.ID, IsExtended: md.IsExtended, Length: md.Length}
+	md.Mux.MarshalUnsigned(&f.Data, uint64(m.xxx_Mux))
+	md.Tail.MarshalUnsigned(&f.Data, uint64(m.xxx_Tail))
+	if m.xxx_Mux == 0 {
+		md.Low.MarshalUnsigned(&f.Data, uint64(m.xxx_Low))
+	}
+	if m.xxx_Mux == 1 {
+		md.High.MarshalSigned(&f.Data, int64(m.xxx_High))
+	}
+	return f
+}
+
+// MarshalFrame encodes the message as a CAN frame.
+func (m *Multiplexed) MarshalFrame() (can.Frame, error) {
+	return m.Frame(), nil
+}
+
+// UnmarshalFrame decodes the message from a CAN frame.
+func (m *Multiplexed) UnmarshalFrame(f can.Frame) error {
+	md := Messages().Multiplexed
+	switch {
+	case f.ID != md.ID:
+		return fmt.Errorf(
+			"unmarshal Multiplexed: expects ID 500 (got %s with ID %d)", f.String(), f.ID,
+		)
+	case f.Length != md.Length:
+		return fmt.Errorf(
+			"unmarshal Multiplexed: expects length 4 (got %s with length %d)", f.String(), f.Length,
+		)
+	case f.IsRemote:
+		return fmt.Errorf(
+			"unmarshal Multiplexed: expects non-remote frame (got remote frame %s)", f.String(),
+		)
+	case f.IsExtended != md.IsExtended:
+		return fmt.Errorf(
+			"unmarshal Multiplexed: expects standard ID (got %s with extended ID)", f.String(),
+		)
+	}
+	m.xxx_Mux = uint8(md.Mux.UnmarshalUnsigned(f.Data))
+	m.xxx_Tail = uint16(md.Tail.UnmarshalUnsigned(f.Data))
+	if m.xxx_Mux == 0 {
+		m.xxx_Low = uint16(md.Low.UnmarshalUnsigned(f.Data))
+	}
+	if m.xxx_Mux == 1 {
+		m.xxx_High = int16(md.High.UnmarshalSigned(f.Data))
+	}
+	return nil
+}
+
+// DecodeFrame decodes the message from a CAN frame, with the bit layout of each signal resolved at generation
+// time.
+//
+// DecodeFrame decodes the same values as UnmarshalFrame, without allocating unless the frame is invalid, for
+// decoding frames at high rates into reused messages.
+func (m *Multiplexed) DecodeFrame(f *can.Frame) error {
+	switch {
+	case f.ID != 500:
+		return fmt.Errorf(
+			"decode Multiplexed: expects ID 500 (got %s with ID %d)", f.String(), f.ID,
+		)
+	case f.Length != 4:
+		return fmt.Errorf(
+			"decode Multiplexed: expects length 4 (got %s with length %d)", f.String(), f.Length,
+		)
+	case f.IsRemote:
+		return fmt.Errorf(
+			"decode Multiplexed: expects non-remote frame (got remote frame %s)", f.String(),
+		)
+	case f.IsExtended:
+		return fmt.Errorf(
+			"decode Multiplexed: expects standard ID (got %s with extended ID)", f.String(),
+		)
+	}
+	le := f.Data.PackLittleEndian()
+	be := f.Data.PackBigEndian()
+	m.xxx_Mux = uint8((be >> 60) & 0xf)
+	m.xxx_Tail = uint16((le >> 16) & 0xffff)
+	if m.xxx_Mux == 0 {
+		m.xxx_Low = uint16((be >> 48) & 0xfff)
+	}
+	if m.xxx_Mux == 1 {
+		m.xxx_High = int16(int64(be<<4) >> 52)
+	}
+	return nil
+}
+
+// ExtendedReader provides read access to a Extended message.
+type ExtendedReader interface {
+	can.FrameMarshaler
+	// Float32 returns the value of the Float32 signal.
+	Float32() float32
+}
+
+// ExtendedWriter provides write access to a Extended message.
+type ExtendedWriter interface {
+	// CopyFrom copies all values from Extended.
+	CopyFrom(ExtendedReader) *Extended
+	// SetFloat32 sets the value of the Float32 signal.
+	SetFloat32(float32) *Extended
+}
+
+type Extended struct {
+	xxx_Float32 float32
+}
+
+func NewExtended() *Extended {
+	m := &Extended{}
+	m.Reset()
+	return m
+}
+
+func (m *Extended) Reset() {
+	m.xxx_Float32 = 0
+}
+
+func (m *Extended) CopyFrom(o ExtendedReader) *Extended {
+	f, _ := o.MarshalFrame()
+	_ = m.UnmarshalFrame(f)
+	return m
+}
+
+// Descriptor returns the Extended descriptor.
+func (m *Extended) Descriptor() *descriptor.Message {
+	return Messages().Extended.Message
+}
+
+// String returns a compact string representation of the message.
+func (m *Extended) String() string {
+	return cantext.MessageString(m)
+}
+
+func (m *Extended) Float32() float32 {
+	return m.xxx_Float32
+}
+
+func (m *Extended) SetFloat32(v float32) *Extended {
+	m.xxx_Float32 = float32(Messages().Extended.Float32.SaturatedCastFloat(float64(v)))
+	return m
+}
+
+// Frame returns a CAN frame representing the message.
+func (m *Extended) Frame() can.Frame {
+	md := Messages().Extended
+	f := can.Frame{ID: md.ID, IsExtended: md.IsExtended, Length: md.Length}
+	md.Float32.MarshalFloat(&f.Data, float64(m.xxx_Float32))
+	return f
+}
+
+// MarshalFrame encodes the message as a CAN frame.
+func (m *Extended) MarshalFrame() (can.Frame, error) {
+	return m.Frame(), nil
+}
+
+// UnmarshalFrame decodes the message from a CAN frame.
+func (m *Extended) UnmarshalFrame(f can.Frame) error {
+	md := Messages().Extended
+	switch {
+	case f.ID != md.ID:
+		return fmt.Errorf(
+			"unmarshal Extended: expects ID 1024 (got %s with ID %d)", f.String(), f.ID,
+		)
+	case f.Length != md.Length:
+		return fmt.Errorf(
+			"unmarshal Extended: expects length 4 (got %s with length %d)", f.String(), f.Length,
+		)
+	case f.IsRemote:
+		return fmt.Errorf(
+			"unmarshal Extended: expects non-remote frame (got remote frame %s)", f.String(),
+		)
+	case f.IsExtended != md.IsExtended:
+		return fmt.Errorf(
+			"unmarshal Extended: expects extended ID (got %s with standard ID)", f.String(),
+		)
+	}
+	m.xxx_Float32 = float32(md.Float32.UnmarshalFloat(f.Data))
+	return nil
+}
+
+// DecodeFrame decodes the message from a CAN frame, with the bit layout of each signal resolved at generation
+// time.
+//
+// DecodeFrame decodes the same values as UnmarshalFrame, without allocating unless the frame is invalid, for
+// decoding frames at high rates into reused messages.
+func (m *Extended) DecodeFrame(f *can.Frame) error {
+	switch {
+	case f.ID != 1024:
+		return fmt.Errorf(
+			"decode Extended: expects ID 1024 (got %s with ID %d)", f.String(), f.ID,
+		)
+	case f.Length != 4:
+		return fmt.Errorf(
+			"decode Extended: expects length 4 (got %s with length %d)", f.String(), f.Length,
+		)
+	case f.IsRemote:
+		return fmt.Errorf(
+			"decode Extended: expects non-remote frame (got remote frame %s)", f.String(),
+		)
+	case !f.IsExtended:
+		return fmt.Errorf(
+			"decode Extended: expects extended ID (got %s with standard ID)", f.String(),
+		)
+	}
+	be := f.Data.PackBigEndian()
+	m.xxx_Float32 = float32(math.Float32frombits(uint32((be >> 32) & 0xffffffff)))
+	return nil
+}
+
+// FrameBatch holds the messages decoded from batches of frames, in a slice per message.
+//
+// The slices are reused after Reset, so that decoding does not allocate once they have grown to the size of a
+// batch.
+type FrameBatch struct {
+	BigEndian    []BigEndian
+	LittleEndian []LittleEndian
+	Wide         []Wide
+	WideSigned   []WideSigned
+	Multiplexed  []Multiplexed
+	Extended     []Extended
+}
+
+// Reset empties the slices of the batch, keeping their capacity.
+func (b *FrameBatch) Reset() {
+	b.BigEndian = b.BigEndian[:0]
+	b.LittleEndian = b.LittleEndian[:0]
+	b.Wide = b.Wide[:0]
+	b.WideSigned = b.WideSigned[:0]
+	b.Multiplexed = b.Multiplexed[:0]
+	b.Extended = b.Extended[:0]
+}
+
+// DecodeFrames decodes a batch of frames, and appends the decoded messages to the batch.
+//
+// Remote frames and frames of unknown messages are skipped.
+func (b *FrameBatch) DecodeFrames(frames []can.Frame) error {
+	for i := range frames {
+		f := &frames[i]
+		if f.IsRemote {
+			continue
+		}
+		switch f.ID {
+		case 100:
+			if !f.IsExtended {
+				b.BigEndian = append(b.BigEndian, BigEndian{})
+				m := &b.BigEndian[len(b.BigEndian)-1]
+				if err := m.DecodeFrame(f); err != nil {
+					b.BigEndian = b.BigEndian[:len(b.BigEndian)-1]
+					return err
+				}
+			}
+		case 200:
+			if !f.IsExtended {
+				b.LittleEndian = append(b.LittleEndian, LittleEndian{})
+				m := &b.LittleEndian[len(b.LittleEndian)-1]
+				if err := m.DecodeFrame(f); err != nil {
+					b.LittleEndian = b.LittleEndian[:len(b.LittleEndian)-1]
+					return err
+				}
+			}
+		case 300:
+			if !f.IsExtended {
+				b.Wide = append(b.Wide, Wide{})
+				m := &b.Wide[len(b.Wide)-1]
+				if err := m.DecodeFrame(f); err != nil {
+					b.Wide = b.Wide[:len(b.Wide)-1]
+					return err
+				}
+			}
+		case 400:
+			if !f.IsExtended {
+				b.WideSigned = append(b.WideSigned, WideSigned{})
+				m := &b.WideSigned[len(b.WideSigned)-1]
+				if err := m.DecodeFrame(f); err != nil {
+					b.WideSigned = b.WideSigned[:len(b.WideSigned)-1]
+					return err
+				}
+			}
+		case 500:
+			if !f.IsExtended {
+				b.Multiplexed = append(b.Multiplexed, Multiplexed{})
+				m := &b.Multiplexed[len(b.Multiplexed)-1]
+				m.Reset()
+				if err := m.DecodeFrame(f); err != nil {
+					b.Multiplexed = b.Multiplexed[:len(b.Multiplexed)-1]
+					return err
+				}
+			}
+		case 1024:
+			if f.IsExtended {
+				b.Extended = append(b.Extended, Extended{})
+				m := &b.Extended[len(b.Extended)-1]
+				if err := m.DecodeFrame(f); err != nil {
+					b.Extended = b.Extended[:len(b.Extended)-1]
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// Nodes returns the decoding node descriptors.
+func Nodes() *NodesDescriptor {
+	return nd
+}
+
+// NodesDescriptor contains all decoding node descriptors.
+type NodesDescriptor struct {
+	ECU    *descriptor.Node
+	LOGGER *descriptor.Node
+}
+
+// Messages returns the decoding message descriptors.
+func Messages() *MessagesDescriptor {
+	return md
+}
+
+// MessagesDescriptor contains all decoding message descriptors.
+type MessagesDescriptor struct {
+	BigEndian    *BigEndianDescriptor
+	LittleEndian *LittleEndianDescriptor
+	Wide         *WideDescriptor
+	WideSigned   *WideSignedDescriptor
+	Multiplexed  *MultiplexedDescriptor
+	Extended     *ExtendedDescriptor
+}
+
+// UnmarshalFrame unmarshals the provided decoding CAN frame.
+func (md *MessagesDescriptor) UnmarshalFrame(f can.Frame) (generated.Message, error) {
+	switch f.ID {
+	case md.BigEndian.ID:
+		var msg BigEndian
+		if err := msg.UnmarshalFrame(f); err != nil {
+			return nil, fmt.Errorf("unmarshal decoding frame: %w", err)
+		}
+		return &msg, nil
+	case md.LittleEndian.ID:
+		var msg LittleEndian
+		if err := msg.UnmarshalFrame(f); err != nil {
+			return nil, fmt.Errorf("unmarshal decoding frame: %w", err)
+		}
+		return &msg, nil
+	case md.Wide.ID:
+		var msg Wide
+		if err := msg.UnmarshalFrame(f); err != nil {
+			return nil, fmt.Errorf("unmarshal decoding frame: %w", err)
+		}
+		return &msg, nil
+	case md.WideSigned.ID:
+		var msg WideSigned
+		if err := msg.UnmarshalFrame(f); err != nil {
+			return nil, fmt.Errorf("unmarshal decoding frame: %w", err)
+		}
+		return &msg, nil
+	case md.Multiplexed.ID:
+		var msg Multiplexed
+		if err := msg.UnmarshalFrame(f); err != nil {
+			return nil, fmt.Errorf("unmarshal decoding frame: %w", err)
+		}
+		return &msg, nil
+	case md.Extended.ID:
+		var msg Extended
+		if err := msg.UnmarshalFrame(f); err != nil {
+			return nil, fmt.Errorf("unmarshal decoding frame: %w", err)
+		}
+		return &msg, nil
+	default:
+		return nil, fmt.Errorf("unmarshal decoding frame: ID not in database: %d", f.ID)
+	}
+}
+
+type BigEndianDescriptor struct {
+	*descriptor.Message
+	Unsigned12 *descriptor.Signal
+	Signed10   *descriptor.Signal
+	Flag       *descriptor.Signal
+	Signed24   *descriptor.Signal
+	Unsigned7  *descriptor.Signal
+}
+
+type LittleEndianDescriptor struct {
+	*descriptor.Message
+	Signed5    *descriptor.Signal
+	Flag       *descriptor.Signal
+	Unsigned17 *descriptor.Signal
+	Signed38   *descriptor.Signal
+}
+
+type WideDescriptor struct {
+	*descriptor.Message
+	Unsigned64 *descriptor.Signal
+}
+
+type WideSignedDescriptor struct {
+	*descriptor.Message
+	Signed64 *descriptor.Signal
+}
+
+type MultiplexedDescriptor struct {
+	*descriptor.Message
+	Low  *descriptor.Signal
+	High *descriptor.Signal
+	Mux  *descriptor.Signal
+	Tail *descriptor.Signal
+}
+
+type ExtendedDescriptor struct {
+	*descriptor.Message
+	Float32 *descriptor.Signal
+}
+
+// Database returns the decoding database descriptor.
+func (md *MessagesDescriptor) Database() *descriptor.Database {
+	return d
+}
+
+var nd = &NodesDescriptor{
+	ECU:    d.Nodes[0],
+	LOGGER: d.Nodes[1],
+}
+
+var md = &MessagesDescriptor{
+	BigEndian: &BigEndianDescriptor{
+		Message:    d.Messages[0],
+		Unsigned12: d.Messages[0].Signals[0],
+		Signed10:   d.Messages[0].Signals[1],
+		Flag:       d.Messages[0].Signals[2],
+		Signed24:   d.Messages[0].Signals[3],
+		Unsigned7:  d.Messages[0].Signals[4],
+	},
+	LittleEndian: &LittleEndianDescriptor{
+		Message:    d.Messages[1],
+		Signed5:    d.Messages[1].Signals[0],
+		Flag:       d.Messages[1].Signals[1],
+		Unsigned17: d.Messages[1].Signals[2],
+		Signed38:   d.Messages[1].Signals[3],
+	},
+	Wide: &WideDescriptor{
+		Message:    d.Messages[2],
+		Unsigned64: d.Messages[2].Signals[0],
+	},
+	WideSigned: &WideSignedDescriptor{
+		Message:  d.Messages[3],
+		Signed64: d.Messages[3].Signals[0],
+	},
+	Multiplexed: &MultiplexedDescriptor{
+		Message: d.Messages[4],
+		Low:     d.Messages[4].Signals[0],
+		High:    d.Messages[4].Signals[1],
+		Mux:     d.Messages[4].Signals[2],
+		Tail:    d.Messages[4].Signals[3],
+	},
+	Extended: &ExtendedDescriptor{
+		Message: d.Messages[5],
+		Float32: d.Messages[5].Signals[0],
+	},
+}
+
+var d = (*descriptor.Database)(&descriptor.Database{
+	SourceFile: (string)("testdata/dbc/decoding/decoding.dbc"),
+	Version:    (string)(""),
+	Messages: ([]*descriptor.Message)([]*descriptor.Message{
+		(*descriptor.Message)(&descriptor.Message{
+			Name:        (string)("BigEndian"),
+			ID:          (uint32)(100),
+			IsExtended:  (bool)(false),
+			Length:      (uint8)(8),
+			SendType:    (descriptor.SendType)(0),
+			Description: (string)(""),
+			Signals: ([]*descriptor.Signal)([]*descriptor.Signal{
+				(*descriptor.Signal)(&descriptor.Signal{
+					Name:              (string)("Unsigned12"),
+					Start:             (uint8)(7),
+					Length:            (uint8)(12),
+					IsBigEndian:       (bool)(true),
+					IsSigned:          (bool)(false),
+					IsFloat:           (bool)(false),
+					IsMultiplexer:     (bool)(false),
+					IsMultiplexed:     (bool)(false),
+					MultiplexerValue:  (uint)(0),
+					MultiplexerName:   (string)(""),
+					MultiplexerRanges: ([]*descriptor.MultiplexerRange)(nil),
+					Offset:            (float64)(0),
+					Scale:             (float64)(1),
+					Min:               (float64)(0),
+					Max:               (float64)(4095),
+					Unit:              (string)(""),
+					Description:       (string)(""),
+					SignalType:        (string)(""),
+					ValueTable:        (string)(""),
+					ValueDescriptions: ([]*descriptor.ValueDescription)(nil),
+					ReceiverNodes: ([]string)([]string{
+						(string)("LOGGER"),
+					}),
+					DefaultValue:  (int)(0),
+					SendType:      (descriptor.SendType)(0),
+					InactiveValue: (int)(0),
+					Attributes:    ([]*descriptor.Attribute)(nil),
+				}),
+				(*descriptor.Signal)(&descriptor.Signal{
+					Name:              (string)("Signed10"),
+					Start:             (uint8)(11),
+					Length:            (uint8)(10),
+					IsBigEndian:       (bool)(true),
+					IsSigned:          (bool)(true),
+					IsFloat:           (bool)(false),
+					IsMultiplexer:     (bool)(false),
+					IsMultiplexed:     (bool)(false),
+					MultiplexerValue:  (uint)(0),
+					MultiplexerName:   (string)(""),
+					MultiplexerRanges: ([]*descriptor.MultiplexerRange)(nil),
+					Offset:            (float64)(0),
+					Scale:             (float64)(1),
+					Min:               (float64)(-512),
+					Max:               (float64)(511),
+					Unit:              (string)(""),
+					Description:       (string)(""),
+					SignalType:        (string)(""),
+					ValueTable:        (string)(""),
+					ValueDescriptions: ([]*descriptor.ValueDescription)(nil),
+					ReceiverNodes: ([]string)([]string{
+						(string)("LOGGER"),
+					}),
+					DefaultValue:  (int)(0),
+					SendType:      (descriptor.SendType)(0),
+					InactiveValue: (int)(0),
+					Attributes:    ([]*descriptor.Attribute)(nil),
+				}),
+				(*descriptor.Signal)(&descriptor.Signal{
+					Name:              (string)("Flag"),
+					Start:             (uint8)(17),
+					Length:            (uint8)(1),
+					IsBigEndian:       (bool)(true),
+					IsSigned:          (bool)(false),
+					IsFloat:           (bool)(false),
+					IsMultiplexer:     (bool)(false),
+					IsMultiplexed:     (bool)(false),
+					MultiplexerValue:  (uint)(0),
+					MultiplexerName:   (string)(""),
+					MultiplexerRanges: ([]*descriptor.MultiplexerRange)(nil),
+					Offset:            (float64)(0),
+					Scale:             (float64)(1),
+					Min:               (float64)(0),
+					Max:               (float64)(1),
+					Unit:              (string)(""),
+					Description:       (string)(""),
+					SignalType:        (string)(""),
+					ValueTable:        (string)(""),
+					ValueDescriptions: ([]*descriptor.ValueDescription)(nil),
+					ReceiverNodes: ([]string)([]string{
+						(string)("LOGGER"),
+					}),
+					DefaultValue:  (int)(0),
+					SendType:      (descriptor.SendType)(0),
+					InactiveValue: (int)(0),
+					Attributes:    ([]*descriptor.Attribute)(nil),
+				}),
+				(*descriptor.Signal)(&descriptor.Signal{
+					Name:              (string)("Signed24"),
+					Start:             (uint8)(39),
+					Length:            (uint8)(24),
+					IsBigEndian:       (bool)(true),
+					IsSigned:          (bool)(true),
+					IsFloat:           (bool)(false),
+					IsMultiplexer:     (bool)(false),
+					IsMultiplexed:     (bool)(false),
+					MultiplexerValue:  (uint)(0),
+					MultiplexerName:   (string)(""),
+					MultiplexerRanges: ([]*descriptor.MultiplexerRange)(nil),
+					Offset:            (float64)(0),
+					Scale:             (float64)(0.5),
+					Min:               (float64)(-4.194304e+06),
+					Max:               (float64)(4.1943035e+06),
+					Unit:              (string)(""),
+					Description:       (string)(""),
+					SignalType:        (string)(""),
+					ValueTable:        (string)(""),
+					ValueDescriptions: ([]*descriptor.ValueDescription)(nil),
+					ReceiverNodes: ([]string)([]string{
+						(string)("LOGGER"),
+					}),
+					DefaultValue:  (int)(0),
+					SendType:      (descriptor.SendType)(0),
+					InactiveValue: (int)(0),
+					Attributes:    ([]*descriptor.Attribute)(nil),
+				}),
+				(*descriptor.Signal)(&descriptor.Signal{
+					Name:              (string)("Unsigned7"),
+					Start:             (uint8)(62),
+					Length:            (uint8)(7),
+					IsBigEndian:       (bool)(true),
+					IsSigned:          (bool)(false),
+					IsFloat:           (bool)(false),
+					IsMultiplexer:     (bool)(false),
+					IsMultiplexed:     (bool)(false),
+					MultiplexerValue:  (uint)(0),
+					MultiplexerName:   (string)(""),
+					MultiplexerRanges: ([]*descriptor.MultiplexerRange)(nil),
+					Offset:            (float64)(0),
+					Scale:             (float64)(1),
+					Min:               (float64)(0),
+					Max:               (float64)(127),
+					Unit:              (string)(""),
+					Description:       (string)(""),
+					SignalType:        (string)(""),
+					ValueTable:        (string)(""),
+					ValueDescriptions: ([]*descriptor.ValueDescription)(nil),
+					ReceiverNodes: ([]string)([]string{
+						(string)("LOGGER"),
+					}),
+					DefaultValue:  (int)(0),
+					SendType:      (descriptor.SendType)(0),
+					InactiveValue: (int)(0),
+					Attributes:    ([]*descriptor.Attribute)(nil),
+				}),
+			}),
+			SenderNode:       (string)("ECU"),
+			TransmitterNodes: ([]string)(nil),
+			SignalGroups:     ([]*descriptor.SignalGroup)(nil),
+			CycleTime:        (time.Duration)(0),
+			CycleTimeFast:    (time.Duration)(0),
+			DelayTime:        (time.Duration)(0),
+			RepetitionCount:  (int)(0),
+			Attributes:       ([]*descriptor.Attribute)(nil),
+		}),
+		(*descriptor.Message)(&descriptor.Message{
+			Name:        (string)("LittleEndian"),
+			ID:          (uint32)(200),
+			IsExtended:  (bool)(false),
+			Length:      (uint8)(8),
+			SendType:    (descriptor.SendType)(0),
+			Description: (string)(""),
+			Signals: ([]*descriptor.Signal)([]*descriptor.Signal{
+				(*descriptor.Signal)(&descriptor.Signal{
+					Name:              (string)("Signed5"),
+					Start:             (uint8)(3),
+					Length:            (uint8)(5),
+					IsBigEndian:       (bool)(false),
+					IsSigned:          (bool)(true),
+					IsFloat:           (bool)(false),
+					IsMultiplexer:     (bool)(false),
+					IsMultiplexed:     (bool)(false),
+					MultiplexerValue:  (uint)(0),
+					MultiplexerName:   (string)(""),
+					MultiplexerRanges: ([]*descriptor.MultiplexerRange)(nil),
+					Offset:            (float64)(0),
+					Scale:             (float64)(1),
+					Min:               (float64)(-16),
+					Max:               (float64)(15),
+					Unit:              (string)(""),
+					Description:       (string)(""),
+					SignalType:        (string)(""),
+					ValueTable:        (string)(""),
+					ValueDescriptions: ([]*descriptor.ValueDescription)(nil),
+					ReceiverNodes: ([]string)([]string{
+						(string)("LOGGER"),
+					}),
+					DefaultValue:  (int)(0),
+					SendType:      (descriptor.SendType)(0),
+					InactiveValue: (int)(0),
+					Attributes:    ([]*descriptor.Attribute)(nil),
+				}),
+				(*descriptor.Signal)(&descriptor.Signal{
+					Name:              (string)("Flag"),
+					Start:             (uint8)(8),
+					Length:            (uint8)(1),
+					IsBigEndian:       (bool)(false),
+					IsSigned:          (bool)(false),
+					IsFloat:           (bool)(false),
+					IsMultiplexer:     (bool)(false),
+					IsMultiplexed:     (bool)(false),
+					MultiplexerValue:  (uint)(0),
+					MultiplexerName:   (string)(""),
+					MultiplexerRanges: ([]*descriptor.MultiplexerRange)(nil),
+					Offset:            (float64)(0),
+					Scale:             (float64)(1),
+					Min:               (float64)(0),
+					Max:               (float64)(1),
+					Unit:              (string)(""),
+					Description:       (string)(""),
+					SignalType:        (string)(""),
+					ValueTable:        (string)(""),
+					ValueDescriptions: ([]*descriptor.ValueDescription)(nil),
+					ReceiverNodes: ([]string)([]string{
+						(string)("LOGGER"),
+					}),
+					DefaultValue:  (int)(0),
+					SendType:      (descriptor.SendType)(0),
+					InactiveValue: (int)(0),
+					Attributes:    ([]*descriptor.Attribute)(nil),
+				}),
+				(*descriptor.Signal)(&descriptor.Signal{
+					Name:              (string)("Unsigned17"),
+					Start:             (uint8)(9),
+					Length:            (uint8)(17),
+					IsBigEndian:       (bool)(false),
+					IsSigned:          (bool)(false),
+					IsFloat:           (bool)(false),
+					IsMultiplexer:     (bool)(false),
+					IsMultiplexed:     (bool)(false),
+					MultiplexerValue:  (uint)(0),
+					MultiplexerName:   (string)(""),
+					MultiplexerRanges: ([]*descriptor.MultiplexerRange)(nil),
+					Offset:            (float64)(-100),
+					Scale:             (float64)(0.1),
+					Min:               (float64)(-100),
+					Max:               (float64)(13007.1),
+					Unit:              (string)(""),
+					Description:       (string)(""),
+					SignalType:        (string)(""),
+					ValueTable:        (string)(""),
+					ValueDescriptions: ([]*descriptor.ValueDescription)(nil),
+					ReceiverNodes: ([]string)([]string{
+						(string)("LOGGER"),
+					}),
+					DefaultValue:  (int)(0),
+					SendType:      (descriptor.SendType)(0),
+					InactiveValue: (int)(0),
+					Attributes:    ([]*descriptor.Attribute)(nil),
+				}),
+				(*descriptor.Signal)(&descriptor.Signal{
+					Name:              (string)("Signed38"),
+					Start:             (uint8)(26),
+					Length:            (uint8)(38),
+					IsBigEndian:       (bool)(false),
+					IsSigned:          (bool)(true),
+					IsFloat:           (bool)(false),
+					IsMultiplexer:     (bool)(false),
+					IsMultiplexed:     (bool)(false),
+					MultiplexerValue:  (uint)(0),
+					MultiplexerName:   (string)(""),
+					MultiplexerRanges: ([]*descriptor.MultiplexerRange)(nil),
+					Offset:            (float64)(0),
+					Scale:             (float64)(1),
+					Min:               (float64)(0),
+					Max:               (float64)(0),
+					Unit:              (string)(""),
+					Description:       (string)(""),
+					SignalType:        (string)(""),
+					ValueTable:        (string)(""),
+					ValueDescriptions: ([]*descriptor.ValueDescription)(nil),
+					ReceiverNodes: ([]string)([]string{
+						(string)("LOGGER"),
+					}),
+					DefaultValue:  (int)(0),
+					SendType:      (descriptor.SendType)(0),
+					InactiveValue: (int)(0),
+					Attributes:    ([]*descriptor.Attribute)(nil),
+				}),
+			}),
+			SenderNode:       (string)("ECU"),
+			TransmitterNodes: ([]string)(nil),
+			SignalGroups:     ([]*descriptor.SignalGroup)(nil),
+			CycleTime:        (time.Duration)(0),
+			CycleTimeFast:    (time.Duration)(0),
+			DelayTime:        (time.Duration)(0),
+			RepetitionCount:  (int)(0),
+			Attributes:       ([]*descriptor.Attribute)(nil),
+		}),
+		(*descriptor.Message)(&descriptor.Message{
+			Name:        (string)("Wide"),
+			ID:          (uint32)(300),
+			IsExtended:  (bool)(false),
+			Length:      (uint8)(8),
+			SendType:    (descriptor.SendType)(0),
+			Description: (string)(""),
+			Signals: ([]*descriptor.Signal)([]*descriptor.Signal{
+				(*descriptor.Signal)(&descriptor.Signal{
+					Name:              (string)("Unsigned64"),
+					Start:             (uint8)(0),
+					Length:            (uint8)(64),
+					IsBigEndian:       (bool)(false),
+					IsSigned:          (bool)(false),
+					IsFloat:           (bool)(false),
+					IsMultiplexer:     (bool)(false),
+					IsMultiplexed:     (bool)(false),
+					MultiplexerValue:  (uint)(0),
+					MultiplexerName:   (string)(""),
+					MultiplexerRanges: ([]*descriptor.MultiplexerRange)(nil),
+					Offset:            (float64)(0),
+					Scale:             (float64)(1),
+					Min:               (float64)(0),
+					Max:               (float64)(0),
+					Unit:              (string)(""),
+					Description:       (string)(""),
+					SignalType:        (string)(""),
+					ValueTable:        (string)(""),
+					ValueDescriptions: ([]*descriptor.ValueDescription)(nil),
+					ReceiverNodes: ([]string)([]string{
+						(string)("LOGGER"),
+					}),
+					DefaultValue:  (int)(0),
+					SendType:      (descriptor.SendType)(0),
+					InactiveValue: (int)(0),
+					Attributes:    ([]*descriptor.Attribute)(nil),
+				}),
+			}),
+			SenderNode:       (string)("ECU"),
+			TransmitterNodes: ([]string)(nil),
+			SignalGroups:     ([]*descriptor.SignalGroup)(nil),
+			CycleTime:        (time.Duration)(0),
+			CycleTimeFast:    (time.Duration)(0),
+			DelayTime:        (time.Duration)(0),
+			RepetitionCount:  (int)(0),
+			Attributes:       ([]*descriptor.Attribute)(nil),
+		}),
+		(*descriptor.Message)(&descriptor.Message{
+			Name:        (string)("WideSigned"),
+			ID:          (uint32)(400),
+			IsExtended:  (bool)(false),
+			Length:      (uint8)(8),
+			SendType:    (descriptor.SendType)(0),
+			Description: (string)(""),
+			Signals: ([]*descriptor.Signal)([]*descriptor.Signal{
+				(*descriptor.Signal)(&descriptor.Signal{
+					Name:              (string)("Signed64"),
+					Start:             (uint8)(7),
+					Length:            (uint8)(64),
+					IsBigEndian:       (bool)(true),
+					IsSigned:          (bool)(true),
+					IsFloat:           (bool)(false),
+					IsMultiplexer:     (bool)(false),
+					IsMultiplexed:     (bool)(false),
+					MultiplexerValue:  (uint)(0),
+					MultiplexerName:   (string)(""),
+					MultiplexerRanges: ([]*descriptor.MultiplexerRange)(nil),
+					Offset:            (float64)(0),
+					Scale:             (float64)(1),
+					Min:               (float64)(0),
+					Max:               (float64)(0),
+					Unit:              (string)(""),
+					Description:       (string)(""),
+					SignalType:        (string)(""),
+					ValueTable:        (string)(""),
+					ValueDescriptions: ([]*descriptor.ValueDescription)(nil),
+					ReceiverNodes: ([]string)([]string{
+						(string)("LOGGER"),
+					}),
+					DefaultValue:  (int)(0),
+					SendType:      (descriptor.SendType)(0),
+					InactiveValue: (int)(0),
+					Attributes:    ([]*descriptor.Attribute)(nil),
+				}),
+			}),
+			SenderNode:       (string)("ECU"),
+			TransmitterNodes: ([]string)(nil),
+			SignalGroups:     ([]*descriptor.SignalGroup)(nil),
+			CycleTime:        (time.Duration)(0),
+			CycleTimeFast:    (time.Duration)(0),
+			DelayTime:        (time.Duration)(0),
+			RepetitionCount:  (int)(0),
+			Attributes:       ([]*descriptor.Attribute)(nil),
+		}),
+		(*descriptor.Message)(&descriptor.Message{
+			Name:        (string)("Multiplexed"),
+			ID:          (uint32)(500),
+			IsExtended:  (bool)(false),
+			Length:      (uint8)(4),
+			SendType:    (descriptor.SendType)(0),
+			Description: (string)(""),
+			Signals: ([]*descriptor.Signal)([]*descriptor.Signal{
+				(*descriptor.Signal)(&descriptor.Signal{
+					Name:              (string)("Low"),
+					Start:             (uint8)(3),
+					Length:            (uint8)(12),
+					IsBigEndian:       (bool)(true),
+					IsSigned:          (bool)(false),
+					IsFloat:           (bool)(false),
+					IsMultiplexer:     (bool)(false),
+					IsMultiplexed:     (bool)(true),
+					MultiplexerValue:  (uint)(0),
+					MultiplexerName:   (string)(""),
+					MultiplexerRanges: ([]*descriptor.MultiplexerRange)(nil),
+					Offset:            (float64)(0),
+					Scale:             (float64)(1),
+					Min:               (float64)(0),
+					Max:               (float64)(4095),
+					Unit:              (string)(""),
+					Description:       (string)(""),
+					SignalType:        (string)(""),
+					ValueTable:        (string)(""),
+					ValueDescriptions: ([]*descriptor.ValueDescription)(nil),
+					ReceiverNodes: ([]string)([]string{
+						(string)("LOGGER"),
+					}),
+					DefaultValue:  (int)(0),
+					SendType:      (descriptor.SendType)(0),
+					InactiveValue: (int)(0),
+					Attributes:    ([]*descriptor.Attribute)(nil),
+				}),
+				(*descriptor.Signal)(&descriptor.Signal{
+					Name:              (string)("High"),
+					Start:             (uint8)(3),
+					Length:            (uint8)(12),
+					IsBigEndian:       (bool)(true),
+					IsSigned:          (bool)(true),
+					IsFloat:           (bool)(false),
+					IsMultiplexer:     (bool)(false),
+					IsMultiplexed:     (bool)(true),
+					MultiplexerValue:  (uint)(1),
+					MultiplexerName:   (string)(""),
+					MultiplexerRanges: ([]*descriptor.MultiplexerRange)(nil),
+					Offset:            (float64)(0),
+					Scale:             (float64)(1),
+					Min:               (float64)(-2048),
+					Max:               (float64)(2047),
+					Unit:              (string)(""),
+					Description:       (string)(""),
+					SignalType:        (string)(""),
+					ValueTable:        (string)(""),
+					ValueDescriptions: ([]*descriptor.ValueDescription)(nil),
+					ReceiverNodes: ([]string)([]string{
+						(string)("LOGGER"),
+					}),
+					DefaultValue:  (int)(0),
+					SendType:      (descriptor.SendType)(0),
+					InactiveValue: (int)(0),
+					Attributes:    ([]*descriptor.Attribute)(nil),
+				}),
+				(*descriptor.Signal)(&descriptor.Signal{
+					Name:              (string)("Mux"),
+					Start:             (uint8)(7),
+					Length:            (uint8)(4),
+					IsBigEndian:       (bool)(true),
+					IsSigned:          (bool)(false),
+					IsFloat:           (bool)(false),
+					IsMultiplexer:     (bool)(true),
+					IsMultiplexed:     (bool)(false),
+					MultiplexerValue:  (uint)(0),
+					MultiplexerName:   (string)(""),
+					MultiplexerRanges: ([]*descriptor.MultiplexerRange)(nil),
+					Offset:            (float64)(0),
+					Scale:             (float64)(1),
+					Min:               (float64)(0),
+					Max:               (float64)(15),
+					Unit:              (string)(""),
+					Description:       (string)(""),
+					SignalType:        (string)(""),
+					ValueTable:        (string)(""),
+					ValueDescriptions: ([]*descriptor.ValueDescription)(nil),
+					ReceiverNodes: ([]string)([]string{
+						(string)("LOGGER"),
+					}),
+					DefaultValue:  (int)(0),
+					SendType:      (descriptor.SendType)(0),
+					InactiveValue: (int)(0),
+					Attributes:    ([]*descriptor.Attribute)(nil),
+				}),
+				(*descriptor.Signal)(&descriptor.Signal{
+					Name:              (string)("Tail"),
+					Start:             (uint8)(16),
+					Length:            (uint8)(16),
+					IsBigEndian:       (bool)(false),
+					IsSigned:          (bool)(false),
+					IsFloat:           (bool)(false),
+					IsMultiplexer:     (bool)(false),
+					IsMultiplexed:     (bool)(false),
+					MultiplexerValue:  (uint)(0),
+					MultiplexerName:   (string)(""),
+					MultiplexerRanges: ([]*descriptor.MultiplexerRange)(nil),
+					Offset:            (float64)(0),
+					Scale:             (float64)(1),
+					Min:               (float64)(0),
+					Max:               (float64)(65535),
+					Unit:              (string)(""),
+					Description:       (string)(""),
+					SignalType:        (string)(""),
+					ValueTable:        (string)(""),
+					ValueDescriptions: ([]*descriptor.ValueDescription)(nil),
+					ReceiverNodes: ([]string)([]string{
+						(string)("LOGGER"),
+					}),
+					DefaultValue:  (int)(0),
+					SendType:      (descriptor.SendType)(0),
+					InactiveValue: (int)(0),
+					Attributes:    ([]*descriptor.Attribute)(nil),
+				}),
+			}),
+			SenderNode:       (string)("ECU"),
+			TransmitterNodes: ([]string)(nil),
+			SignalGroups:     ([]*descriptor.SignalGroup)(nil),
+			CycleTime:        (time.Duration)(0),
+			CycleTimeFast:    (time.Duration)(0),
+			DelayTime:        (time.Duration)(0),
+			RepetitionCount:  (int)(0),
+			Attributes:       ([]*descriptor.Attribute)(nil),
+		}),
+		(*descriptor.Message)(&descriptor.Message{
+			Name:        (string)("Extended"),
+			ID:          (uint32)(1024),
+			IsExtended:  (bool)(true),
+			Length:      (uint8)(4),
+			SendType:    (descriptor.SendType)(0),
+			Description: (string)(""),
+			Signals: ([]*descriptor.Signal)([]*descriptor.Signal{
+				(*descriptor.Signal)(&descriptor.Signal{
+					Name:              (string)("Float32"),
+					Start:             (uint8)(7),
+					Length:            (uint8)(32),
+					IsBigEndian:       (bool)(true),
+					IsSigned:          (bool)(true),
+					IsFloat:           (bool)(true),
+					IsMultiplexer:     (bool)(false),
+					IsMultiplexed:     (bool)(false),
+					MultiplexerValue:  (uint)(0),
+					MultiplexerName:   (string)(""),
+					MultiplexerRanges: ([]*descriptor.MultiplexerRange)(nil),
+					Offset:            (float64)(0),
+					Scale:             (float64)(1),
+					Min:               (float64)(0),
+					Max:               (float64)(0),
+					Unit:              (string)(""),
+					Description:       (string)(""),
+					SignalType:        (string)(""),
+					ValueTable:        (string)(""),
+					ValueDescriptions: ([]*descriptor.ValueDescription)(nil),
+					ReceiverNodes: ([]string)([]string{
+						(string)("LOGGER"),
+					}),
+					DefaultValue:  (int)(0),
+					SendType:      (descriptor.SendType)(0),
+					InactiveValue: (int)(0),
+					Attributes:    ([]*descriptor.Attribute)(nil),
+				}),
+			}),
+			SenderNode:       (string)("ECU"),
+			TransmitterNodes: ([]string)(nil),
+			SignalGroups:     ([]*descriptor.SignalGroup)(nil),
+			CycleTime:        (time.Duration)(0),
+			CycleTimeFast:    (time.Duration)(0),
+			DelayTime:        (time.Duration)(0),
+			RepetitionCount:  (int)(0),
+			Attributes:       ([]*descriptor.Attribute)(nil),
+		}),
+	}),
+	Nodes: ([]*descriptor.Node)([]*descriptor.Node{
+		(*descriptor.Node)(&descriptor.Node{
+			Name:        (string)("ECU"),
+			Description: (string)(""),
+			Attributes:  ([]*descriptor.Attribute)(nil),
+		}),
+		(*descriptor.Node)(&descriptor.Node{
+			Name:        (string)("LOGGER"),
+			Description: (string)(""),
+			Attributes:  ([]*descriptor.Attribute)(nil),
+		}),
+	}),
+	ValueTables:          ([]*descriptor.ValueTable)(nil),
+	EnvironmentVariables: ([]*descriptor.EnvironmentVariable)(nil),
+	Attributes:           ([]*descriptor.Attribute)(nil),
+})
@@ -6,6 +6,7 @@ package examplecan
 import (
 	"context"
 	"fmt"
+	"math"
 	"net"
 	"net/http"
 	"sync"
@@ -24,6 +25,7 @@ import (
 var (
 	_ = context.Background
 	_ = fmt.Print
+	_ = math.Float32frombits
 	_ = net.Dial
 	_ = http.Error
 	_ = sync.Mutex{}
@@ -109,6 +111,33 @@ func (m *EmptyMessage) UnmarshalFrame(f can.Frame) error {
 	return nil
 }
 
+// DecodeFrame decodes the message from a CAN frame, with the bit layout of each signal resolved at generation
+// time.
+//
+// DecodeFrame decodes the same values as UnmarshalFrame, without allocating unless the frame is invalid, for
+// decoding frames at high rates into reused messages.
+func (m *EmptyMessage) DecodeFrame(f *can.Frame) error {
+	switch {
+	case f.ID != 1:
+		return fmt.Errorf(
+			"decode EmptyMessage: expects ID 1 (got %s with ID %d)", f.String(), f.ID,
+		)
+	case f.Length != 0:
+		return fmt.Errorf(
+			"decode EmptyMessage: expects length 0 (got %s with length %d)", f.String(), f.Length,
+		)
+	case f.IsRemote:
+		return fmt.Errorf(
+			"decode EmptyMessage: expects non-remote frame (got remote frame %s)", f.String(),
+		)
+	case f.IsExtended:
+		return fmt.Errorf(
+			"decode EmptyMessage: expects standard ID (got %s with extended ID)", f.String(),
+		)
+	}
+	return nil
+}
+
 // DriverHeartbeatReader provides read access to a DriverHeartbeat message.
 type DriverHeartbeatReader interface {
 	can.FrameMarshaler
@@ -227,6 +256,35 @@ func (m *DriverHeartbeat) UnmarshalFrame(f can.Frame) error {
 	return nil
 }
 
+// DecodeFrame decodes the message from a CAN frame, with the bit layout of each signal resolved at generation
+// time.
+//
+// DecodeFrame decodes the same values as UnmarshalFrame, without allocating unless the frame is invalid, for
+// decoding frames at high rates into reused messages.
+func (m *DriverHeartbeat) DecodeFrame(f *can.Frame) error {
+	switch {
+	case f.ID != 100:
+		return fmt.Errorf(
+			"decode DriverHeartbeat: expects ID 100 (got %s with ID %d)", f.String(), f.ID,
+		)
+	case f.Length != 1:
+		return fmt.Errorf(
+			"decode DriverHeartbeat: expects length 1 (got %s with length %d)", f.String(), f.Length,
+		)
+	case f.IsRemote:
+		return fmt.Errorf(
+			"decode DriverHeartbeat: expects non-remote frame (got remote frame %s)", f.String(),
+		)
+	case f.IsExtended:
+		return fmt.Errorf(
+			"decode DriverHeartbeat: expects standard ID (got %s with extended ID)", f.String(),
+		)
+	}
+	le := f.Data.PackLittleEndian()
+	m.xxx_Command = DriverHeartbeat_Command(le & 0xff)
+	return nil
+}
+
 // MotorCommandReader provides read access to a MotorCommand message.
 type MotorCommandReader interface {
 	can.FrameMarshaler
@@ -362,6 +420,36 @@ func (m *MotorCommand) UnmarshalFrame(f can.Frame) error {
 	return nil
 }
 
+// DecodeFrame decodes the message from a CAN frame, with the bit layout of each signal resolved at generation
+// time.
+//
+// DecodeFrame decodes the same values as UnmarshalFrame, without allocating unless the frame is invalid, for
+// decoding frames at high rates into reused messages.
+func (m *MotorCommand) DecodeFrame(f *can.Frame) error {
+	switch {
+	case f.ID != 101:
+		return fmt.Errorf(
+			"decode MotorCommand: expects ID 101 (got %s with ID %d)", f.String(), f.ID,
+		)
+	case f.Length != 1:
+		return fmt.Errorf(
+			"decode MotorCommand: expects length 1 (got %s with length %d)", f.String(), f.Length,
+		)
+	case f.IsRemote:
+		return fmt.Errorf(
+			"decode MotorCommand: expects non-remote frame (got remote frame %s)", f.String(),
+		)
+	case f.IsExtended:
+		return fmt.Errorf(
+			"decode MotorCommand: expects standard ID (got %s with extended ID)", f.String(),
+		)
+	}
+	le := f.Data.PackLittleEndian()
+	m.xxx_Steer = int8(int64(le<<60) >> 60)
+	m.xxx_Drive = uint8((le >> 4) & 0xf)
+	return nil
+}
+
 // SensorSonarsReader provides read access to a SensorSonars message.
 type SensorSonarsReader interface {
 	can.FrameMarshaler
@@ -743,6 +831,60 @@ func (m *SensorSonars) UnmarshalFrame(f can.Frame) error {
 	return nil
 }
 
+// DecodeFrame decodes the message from a CAN frame, with the bit layout of each signal resolved at generation
+// time.
+//
+// DecodeFrame decodes the same values as UnmarshalFrame, without allocating unless the frame is invalid, for
+// decoding frames at high rates into reused messages.
+func (m *SensorSonars) DecodeFrame(f *can.Frame) error {
+	switch {
+	case f.ID != 200:
+		return fmt.Errorf(
+			"decode SensorSonars: expects ID 200 (got %s with ID %d)", f.String(), f.ID,
+		)
+	case f.Length != 8:
+		return fmt.Errorf(
+			"decode SensorSonars: expects length 8 (got %s with length %d)", f.String(), f.Length,
+		)
+	case f.IsRemote:
+		return fmt.Errorf(
+			"decode SensorSonars: expects non-remote frame (got remote frame %s)", f.String(),
+		)
+	case f.IsExtended:
+		return fmt.Errorf(
+			"decode SensorSonars: expects standard ID (got %s with extended ID)", f.String(),
+		)
+	}
+	le := f.Data.PackLittleEndian()
+	m.xxx_Mux = uint8(le & 0xf)
+	m.xxx_ErrCount = uint16((le >> 4) & 0xfff)
+	if m.xxx_Mux == 0 {
+		m.xxx_Left = uint16((le >> 16) & 0xfff)
+	}
+	if m.xxx_Mux == 1 {
+		m.xxx_NoFiltLeft = uint16((le >> 16) & 0xfff)
+	}
+	if m.xxx_Mux == 0 {
+		m.xxx_Middle = uint16((le >> 28) & 0xfff)
+	}
+	if m.xxx_Mux == 1 {
+		m.xxx_NoFiltMiddle = uint16((le >> 28) & 0xfff)
+	}
+	if m.xxx_Mux == 0 {
+		m.xxx_Right = uint16((le >> 40) & 0xfff)
+	}
+	if m.xxx_Mux == 1 {
+		m.xxx_NoFiltRight = uint16((le >> 40) & 0xfff)
+	}
+	if m.xxx_Mux == 0 {
+		m.xxx_Rear = uint16((le >> 52) & 0xfff)
+	}
+	if m.xxx_Mux == 1 {
+		m.xxx_NoFiltRear = uint16((le >> 52) & 0xfff)
+	}
+	return nil
+}
+
 // MotorStatusReader provides read access to a MotorStatus message.
 type MotorStatusReader interface {
 	can.FrameMarshaler
@@ -865,6 +1007,36 @@ func (m *MotorStatus) UnmarshalFrame(f can.Frame) error {
 	return nil
 }
 
+// DecodeFrame decodes the message from a CAN frame, with the bit layout of each signal resolved at generation
+// time.
+//
+// DecodeFrame decodes the same values as UnmarshalFrame, without allocating unless the frame is invalid, for
+// decoding frames at high rates into reused messages.
+func (m *MotorStatus) DecodeFrame(f *can.Frame) error {
+	switch {
+	case f.ID != 400:
+		return fmt.Errorf(
+			"decode MotorStatus: expects ID 400 (got %s with ID %d)", f.String(), f.ID,
+		)
+	case f.Length != 3:
+		return fmt.Errorf(
+			"decode MotorStatus: expects length 3 (got %s with length %d)", f.String(), f.Length,
+		)
+	case f.IsRemote:
+		return fmt.Errorf(
+			"decode MotorStatus: expects non-remote frame (got remote frame %s)", f.String(),
+		)
+	case f.IsExtended:
+		return fmt.Errorf(
+			"decode MotorStatus: expects standard ID (got %s with extended ID)", f.String(),
+		)
+	}
+	le := f.Data.PackLittleEndian()
+	m.xxx_WheelError = bool(le&(1<<0) != 0)
+	m.xxx_SpeedKph = uint16((le >> 8) & 0xffff)
+	return nil
+}
+
 // IODebugReader provides read access to a IODebug message.
 type IODebugReader interface {
 	can.FrameMarshaler
@@ -1133,6 +1305,40 @@ func (m *IODebug) UnmarshalFrame(f can.Frame) error {
 	return nil
 }
 
+// DecodeFrame decodes the message from a CAN frame, with the bit layout of each signal resolved at generation
+// time.
+//
+// DecodeFrame decodes the same values as UnmarshalFrame, without allocating unless the frame is invalid, for
+// decoding frames at high rates into reused messages.
+func (m *IODebug) DecodeFrame(f *can.Frame) error {
+	switch {
+	case f.ID != 500:
+		return fmt.Errorf(
+			"decode IODebug: expects ID 500 (got %s with ID %d)", f.String(), f.ID,
+		)
+	case f.Length != 6:
+		return fmt.Errorf(
+			"decode IODebug: expects length 6 (got %s with length %d)", f.String(), f.Length,
+		)
+	case f.IsRemote:
+		return fmt.Errorf(
+			"decode IODebug: expects non-remote frame (got remote frame %s)", f.String(),
+		)
+	case f.IsExtended:
+		return fmt.Errorf(
+			"decode IODebug: expects standard ID (got %s with extended ID)", f.String(),
+		)
+	}
+	le := f.Data.PackLittleEndian()
+	m.xxx_TestUnsigned = uint8(le & 0xff)
+	m.xxx_TestEnum = IODebug_TestEnum((le >> 8) & 0x3f)
+	m.xxx_TestSigned = int8(int64(le<<40) >> 56)
+	m.xxx_TestFloat = uint8((le >> 24) & 0xff)
+	m.xxx_TestBoolEnum = IODebug_TestBoolEnum(le&(1<<32) != 0)
+	m.xxx_TestScaledEnum = IODebug_TestScaledEnum((le >> 40) & 0x3)
+	return nil
+}
+
 // IOFloat32Reader provides read access to a IOFloat32 message.
 type IOFloat32Reader interface {
 	can.FrameMarshaler
@@ -1255,6 +1461,36 @@ func (m *IOFloat32) UnmarshalFrame(f can.Frame) error {
 	return nil
 }
 
+// DecodeFrame decodes the message from a CAN frame, with the bit layout of each signal resolved at generation
+// time.
+//
+// DecodeFrame decodes the same values as UnmarshalFrame, without allocating unless the frame is invalid, for
+// decoding frames at high rates into reused messages.
+func (m *IOFloat32) DecodeFrame(f *can.Frame) error {
+	switch {
+	case f.ID != 600:
+		return fmt.Errorf(
+			"decode IOFloat32: expects ID 600 (got %s with ID %d)", f.String(), f.ID,
+		)
+	case f.Length != 8:
+		return fmt.Errorf(
+			"decode IOFloat32: expects length 8 (got %s with length %d)", f.String(), f.Length,
+		)
+	case f.IsRemote:
+		return fmt.Errorf(
+			"decode IOFloat32: expects non-remote frame (got remote frame %s)", f.String(),
+		)
+	case f.IsExtended:
+		return fmt.Errorf(
+			"decode IOFloat32: expects standard ID (got %s with extended ID)", f.String(),
+		)
+	}
+	le := f.Data.PackLittleEndian()
+	m.xxx_Float32ValueNoRange = float32(math.Float32frombits(uint32(le & 0xffffffff)))
+	m.xxx_Float32WithRange = float32(math.Float32frombits(uint32((le >> 32) & 0xffffffff)))
+	return nil
+}
+
 // SignalNameFormattingReader provides read access to a SignalNameFormatting message.
 type SignalNameFormattingReader interface {
 	can.FrameMarshaler
@@ -1347,6 +1583,35 @@ func (m *SignalNameFormatting) UnmarshalFrame(f can.Frame) error {
 	return nil
 }
 
+// DecodeFrame decodes the message from a CAN frame, with the bit layout of each signal resolved at generation
+// time.
+//
+// DecodeFrame decodes the same values as UnmarshalFrame, without allocating unless the frame is invalid, for
+// decoding frames at high rates into reused messages.
+func (m *SignalNameFormatting) DecodeFrame(f *can.Frame) error {
+	switch {
+	case f.ID != 700:
+		return fmt.Errorf(
+			"decode SignalNameFormatting: expects ID 700 (got %s with ID %d)", f.String(), f.ID,
+		)
+	case f.Length != 8:
+		return fmt.Errorf(
+			"decode SignalNameFormatting: expects length 8 (got %s with length %d)", f.String(), f.Length,
+		)
+	case f.IsRemote:
+		return fmt.Errorf(
+			"decode SignalNameFormatting: expects non-remote frame (got remote frame %s)", f.String(),
+		)
+	case f.IsExtended:
+		return fmt.Errorf(
+			"decode SignalNameFormatting: expects standard ID (got %s with extended ID)", f.String(),
+		)
+	}
+	le := f.Data.PackLittleEndian()
+	m.xxx_non_capitalized_signal = int8(int64(le<<56) >> 56)
+	return nil
+}
+
 type DBG interface {
 	sync.Locker
 	Tx() DBG_Tx
@@ -1370,24 +1635,62 @@ type DBG_Rx_SensorSonars interface {
 	SensorSonarsReader
 	ReceiveTime() time.Time
 	SetAfterReceiveHook(h func(context.Context) error)
+	// SubscribeMux calls the hook when the trigger fires for the Mux signal.
+	SubscribeMux(trigger canrunner.SignalTrigger, h func(ctx context.Context, prev, curr SensorSonarsReader) error)
+	// SubscribeErrCount calls the hook when the trigger fires for the ErrCount signal.
+	SubscribeErrCount(trigger canrunner.SignalTrigger, h func(ctx context.Context, prev, curr SensorSonarsReader) error)
+	// SubscribeLeft calls the hook when the trigger fires for the Left signal.
+	SubscribeLeft(trigger canrunner.SignalTrigger, h func(ctx context.Context, prev, curr SensorSonarsReader) error)
+	// SubscribeNoFiltLeft calls the hook when the trigger fires for the NoFiltLeft signal.
+	SubscribeNoFiltLeft(trigger canrunner.SignalTrigger, h func(ctx context.Context, prev, curr SensorSonarsReader) error)
+	// SubscribeMiddle calls the hook when the trigger fires for the Middle signal.
+	SubscribeMiddle(trigger canrunner.SignalTrigger, h func(ctx context.Context, prev, curr SensorSonarsReader) error)
+	// SubscribeNoFiltMiddle calls the hook when the trigger fires for the NoFiltMiddle signal.
+	SubscribeNoFiltMiddle(trigger canrunner.SignalTrigger, h func(ctx context.Context, prev, curr SensorSonarsReader) error)
+	// SubscribeRight calls the hook when the trigger fires for the Right signal.
+	SubscribeRight(trigger canrunner.SignalTrigger, h func(ctx context.Context, prev, curr SensorSonarsReader) error)
+	// SubscribeNoFiltRight calls the hook when the trigger fires for the NoFiltRight signal.
+	SubscribeNoFiltRight(trigger canrunner.SignalTrigger, h func(ctx context.Context, prev, curr SensorSonarsReader) error)
+	// SubscribeRear calls the hook when the trigger fires for the Rear signal.
+	SubscribeRear(trigger canrunner.SignalTrigger, h func(ctx context.Context, prev, curr SensorSonarsReader) error)
+	// SubscribeNoFiltRear calls the hook when the trigger fires for the NoFiltRear signal.
+	SubscribeNoFiltRear(trigger canrunner.SignalTrigger, h func(ctx context.Context, prev, curr SensorSonarsReader) error)
 }
 
 type DBG_Rx_IODebug interface {
 	IODebugReader
 	ReceiveTime() time.Time
 	SetAfterReceiveHook(h func(context.Context) error)
+	// SubscribeTestUnsigned calls the hook when the trigger fires for the TestUnsigned signal.
+	SubscribeTestUnsigned(trigger canrunner.SignalTrigger, h func(ctx context.Context, prev, curr IODebugReader) error)
+	// SubscribeTestEnum calls the hook when the trigger fires for the TestEnum signal.
+	SubscribeTestEnum(trigger canrunner.SignalTrigger, h func(ctx context.Context, prev, curr IODebugReader) error)
+	// SubscribeTestSigned calls the hook when the trigger fires for the TestSigned signal.
+	SubscribeTestSigned(trigger canrunner.SignalTrigger, h func(ctx context.Context, prev, curr IODebugReader) error)
+	// SubscribeTestFloat calls the hook when the trigger fires for the TestFloat signal.
+	SubscribeTestFloat(trigger canrunner.SignalTrigger, h func(ctx context.Context, prev, curr IODebugReader) error)
+	// SubscribeTestBoolEnum calls the hook when the trigger fires for the TestBoolEnum signal.
+	SubscribeTestBoolEnum(trigger canrunner.SignalTrigger, h func(ctx context.Context, prev, curr IODebugReader) error)
+	// SubscribeTestScaledEnum calls the hook when the trigger fires for the TestScaledEnum signal.
+	SubscribeTestScaledEnum(trigger canrunner.SignalTrigger, h func(ctx context.Context, prev, curr IODebugReader) error)
 }
 
 type DBG_Rx_IOFloat32 interface {
 	IOFloat32Reader
 	ReceiveTime() time.Time
 	SetAfterReceiveHook(h func(context.Context) error)
+	// SubscribeFloat32ValueNoRange calls the hook when the trigger fires for the Float32ValueNoRange signal.
+	SubscribeFloat32ValueNoRange(trigger canrunner.SignalTrigger, h func(ctx context.Context, prev, curr IOFloat32Reader) error)
+	// SubscribeFloat32WithRange calls the hook when the trigger fires for the Float32WithRange signal.
+	SubscribeFloat32WithRange(trigger canrunner.SignalTrigger, h func(ctx context.Context, prev, curr IOFloat32Reader) error)
 }
 
 type DBG_Rx_SignalNameFormatting interface {
 	SignalNameFormattingReader
 	ReceiveTime() time.Time
 	SetAfterReceiveHook(h func(context.Context) error)
+	// Subscribenon_capitalized_signal calls the hook when the trigger fires for the non_capitalized_signal signal.
+	Subscribenon_capitalized_signal(trigger canrunner.SignalTrigger, h func(ctx context.Context, prev, curr SignalNameFormattingReader) error)
 }
 
 type xxx_DBG struct {
@@ -1403,8 +1706,6 @@ var _ canrunner.Node = &xxx_DBG{}
 
 func NewDBG(network, address string) DBG {
 	n := &xxx_DBG{network: network, address: address}
-	n.rx.parentMutex = &n.Mutex
-	n.tx.parentMutex = &n.Mutex
 	n.rx.xxx_SensorSonars.init()
 	n.rx.xxx_SensorSonars.Reset()
 	n.rx.xxx_IODebug.init()
@@ -1413,6 +1714,13 @@ func NewDBG(network, address string) DBG {
 	n.rx.xxx_IOFloat32.Reset()
 	n.rx.xxx_SignalNameFormatting.init()
 	n.rx.xxx_SignalNameFormatting.Reset()
+	n.rx.debugHandler = candebug.NewHandler(&n.Mutex, []generated.Message{
+		&n.rx.xxx_SensorSonars,
+		&n.rx.xxx_IODebug,
+		&n.rx.xxx_IOFloat32,
+		&n.rx.xxx_SignalNameFormatting,
+	})
+	n.tx.debugHandler = candebug.NewHandler(&n.Mutex, []generated.Message{})
 	return n
 }
 
@@ -1429,7 +1737,7 @@ func (n *xxx_DBG) Tx() DBG_Tx {
 }
 
 type xxx_DBG_Rx struct {
-	parentMutex              *sync.Mutex
+	debugHandler             *candebug.Handler
 	xxx_SensorSonars         xxx_DBG_Rx_SensorSonars
 	xxx_IODebug              xxx_DBG_Rx_IODebug
 	xxx_IOFloat32            xxx_DBG_Rx_IOFloat32
@@ -1439,14 +1747,7 @@ type xxx_DBG_Rx struct {
 var _ DBG_Rx = &xxx_DBG_Rx{}
 
 func (rx *xxx_DBG_Rx) ServeHTTP(w http.ResponseWriter, r *http.Request) {
-	rx.parentMutex.Lock()
-	defer rx.parentMutex.Unlock()
-	candebug.ServeMessagesHTTP(w, r, []generated.Message{
-		&rx.xxx_SensorSonars,
-		&rx.xxx_IODebug,
-		&rx.xxx_IOFloat32,
-		&rx.xxx_SignalNameFormatting,
-	})
+	rx.debugHandler.ServeHTTP(w, r)
 }
 
 func (rx *xxx_DBG_Rx) SensorSonars() DBG_Rx_SensorSonars {
@@ -1466,15 +1767,13 @@ func (rx *xxx_DBG_Rx) SignalNameFormatting() DBG_Rx_SignalNameFormatting {
 }
 
 type xxx_DBG_Tx struct {
-	parentMutex *sync.Mutex
+	debugHandler *candebug.Handler
 }
 
 var _ DBG_Tx = &xxx_DBG_Tx{}
 
 func (tx *xxx_DBG_Tx) ServeHTTP(w http.ResponseWriter, r *http.Request) {
-	tx.parentMutex.Lock()
-	defer tx.parentMutex.Unlock()
-	candebug.ServeMessagesHTTP(w, r, []generated.Message{})
+	tx.debugHandler.ServeHTTP(w, r)
 }
 
 func (n *xxx_DBG) Descriptor() *descriptor.Node {
@@ -1506,8 +1805,9 @@ func (n *xxx_DBG) TransmittedMessages() []canrunner.TransmittedMessage {
 
 type xxx_DBG_Rx_SensorSonars struct {
 	SensorSonars
-	receiveTime      time.Time
-	afterReceiveHook func(context.Context) error
+	receiveTime         time.Time
+	afterReceiveHook    func(context.Context) error
+	signalSubscriptions canrunner.SignalSubscriptions
 }
 
 func (m *xxx_DBG_Rx_SensorSonars) init() {
@@ -1530,12 +1830,219 @@ func (m *xxx_DBG_Rx_SensorSonars) SetReceiveTime(t time.Time) {
 	m.receiveTime = t
 }
 
+func (m *xxx_DBG_Rx_SensorSonars) SignalSubscriptions() *canrunner.SignalSubscriptions {
+	return &m.signalSubscriptions
+}
+
+func (m *xxx_DBG_Rx_SensorSonars) SubscribeMux(
+	trigger canrunner.SignalTrigger,
+	h func(ctx context.Context, prev, curr SensorSonarsReader) error,
+) {
+	m.signalSubscriptions.Subscribe(
+		Messages().SensorSonars.Mux,
+		trigger,
+		func(ctx context.Context, prevFrame, currFrame can.Frame) error {
+			var prev, curr SensorSonars
+			if err := prev.UnmarshalFrame(prevFrame); err != nil {
+				return err
+			}
+			if err := curr.UnmarshalFrame(currFrame); err != nil {
+				return err
+			}
+			return h(ctx, &prev, &curr)
+		},
+	)
+}
+
+func (m *xxx_DBG_Rx_SensorSonars) SubscribeErrCount(
+	trigger canrunner.SignalTrigger,
+	h func(ctx context.Context, prev, curr SensorSonarsReader) error,
+) {
+	m.signalSubscriptions.Subscribe(
+		Messages().SensorSonars.ErrCount,
+		trigger,
+		func(ctx context.Context, prevFrame, currFrame can.Frame) error {
+			var prev, curr SensorSonars
+			if err := prev.UnmarshalFrame(prevFrame); err != nil {
+				return err
+			}
+			if err := curr.UnmarshalFrame(currFrame); err != nil {
+				return err
+			}
+			return h(ctx, &prev, &curr)
+		},
+	)
+}
+
+func (m *xxx_DBG_Rx_SensorSonars) SubscribeLeft(
+	trigger canrunner.SignalTrigger,
+	h func(ctx context.Context, prev, curr SensorSonarsReader) error,
+) {
+	m.signalSubscriptions.Subscribe(
+		Messages().SensorSonars.Left,
+		trigger,
+		func(ctx context.Context, prevFrame, currFrame can.Frame) error {
+			var prev, curr SensorSonars
+			if err := prev.UnmarshalFrame(prevFrame); err != nil {
+				return err
+			}
+			if err := curr.UnmarshalFrame(currFrame); err != nil {
+				return err
+			}
+			return h(ctx, &prev, &curr)
+		},
+	)
+}
+
+func (m *xxx_DBG_Rx_SensorSonars) SubscribeNoFiltLeft(
+	trigger canrunner.SignalTrigger,
+	h func(ctx context.Context, prev, curr SensorSonarsReader) error,
+) {
+	m.signalSubscriptions.Subscribe(
+		Messages().SensorSonars.NoFiltLeft,
+		trigger,
+		func(ctx context.Context, prevFrame, currFrame can.Frame) error {
+			var prev, curr SensorSonars
+			if err := prev.UnmarshalFrame(prevFrame); err != nil {
+				return err
+			}
+			if err := curr.UnmarshalFrame(currFrame); err != nil {
+				return err
+			}
+			return h(ctx, &prev, &curr)
+		},
+	)
+}
+
+func (m *xxx_DBG_Rx_SensorSonars) SubscribeMiddle(
+	trigger canrunner.SignalTrigger,
+	h func(ctx context.Context, prev, curr SensorSonarsReader) error,
+) {
+	m.signalSubscriptions.Subscribe(
+		Messages().SensorSonars.Middle,
+		trigger,
+		func(ctx context.Context, prevFrame, currFrame can.Frame) error {
+			var prev, curr SensorSonars
+			if err := prev.UnmarshalFrame(prevFrame); err != nil {
+				return err
+			}
+			if err := curr.UnmarshalFrame(currFrame); err != nil {
+				return err
+			}
+			return h(ctx, &prev, &curr)
+		},
+	)
+}
+
+func (m *xxx_DBG_Rx_SensorSonars) SubscribeNoFiltMiddle(
+	trigger canrunner.SignalTrigger,
+	h func(ctx context.Context, prev, curr SensorSonarsReader) error,
+) {
+	m.signalSubscriptions.Subscribe(
+		Messages().SensorSonars.NoFiltMiddle,
+		trigger,
+		func(ctx context.Context, prevFrame, currFrame can.Frame) error {
+			var prev, curr SensorSonars
+			if err := prev.UnmarshalFrame(prevFrame); err != nil {
+				return err
+			}
+			if err := curr.UnmarshalFrame(currFrame); err != nil {
+				return err
+			}
+			return h(ctx, &prev, &curr)
+		},
+	)
+}
+
+func (m *xxx_DBG_Rx_SensorSonars) SubscribeRight(
+	trigger canrunner.SignalTrigger,
+	h func(ctx context.Context, prev, curr SensorSonarsReader) error,
+) {
+	m.signalSubscriptions.Subscribe(
+		Messages().SensorSonars.Right,
+		trigger,
+		func(ctx context.Context, prevFrame, currFrame can.Frame) error {
+			var prev, curr SensorSonars
+			if err := prev.UnmarshalFrame(prevFrame); err != nil {
+				return err
+			}
+			if err := curr.UnmarshalFrame(currFrame); err != nil {
+				return err
+			}
+			return h(ctx, &prev, &curr)
+		},
+	)
+}
+
+func (m *xxx_DBG_Rx_SensorSonars) SubscribeNoFiltRight(
+	trigger canrunner.SignalTrigger,
+	h func(ctx context.Context, prev, curr SensorSonarsReader) error,
+) {
+	m.signalSubscriptions.Subscribe(
+		Messages().SensorSonars.NoFiltRight,
+		trigger,
+		func(ctx context.Context, prevFrame, currFrame can.Frame) error {
+			var prev, curr SensorSonars
+			if err := prev.UnmarshalFrame(prevFrame); err != nil {
+				return err
+			}
+			if err := curr.UnmarshalFrame(currFrame); err != nil {
+				return err
+			}
+			return h(ctx, &prev, &curr)
+		},
+	)
+}
+
+func (m *xxx_DBG_Rx_SensorSonars) SubscribeRear(
+	trigger canrunner.SignalTrigger,
+	h func(ctx context.Context, prev, curr SensorSonarsReader) error,
+) {
+	m.signalSubscriptions.Subscribe(
+		Messages().SensorSonars.Rear,
+		trigger,
+		func(ctx context.Context, prevFrame, currFrame can.Frame) error {
+			var prev, curr SensorSonars
+			if err := prev.UnmarshalFrame(prevFrame); err != nil {
+				return err
+			}
+			if err := curr.UnmarshalFrame(currFrame); err != nil {
+				return err
+			}
+			return h(ctx, &prev, &curr)
+		},
+	)
+}
+
+func (m *xxx_DBG_Rx_SensorSonars) SubscribeNoFiltRear(
+	trigger canrunner.SignalTrigger,
+	h func(ctx context.Context, prev, curr SensorSonarsReader) error,
+) {
+	m.signalSubscriptions.Subscribe(
+		Messages().SensorSonars.NoFiltRear,
+		trigger,
+		func(ctx context.Context, prevFrame, currFrame can.Frame) error {
+			var prev, curr SensorSonars
+			if err := prev.UnmarshalFrame(prevFrame); err != nil {
+				return err
+			}
+			if err := curr.UnmarshalFrame(currFrame); err != nil {
+				return err
+			}
+			return h(ctx, &prev, &curr)
+		},
+	)
+}
+
 var _ canrunner.ReceivedMessage = &xxx_DBG_Rx_SensorSonars{}
 
+var _ canrunner.SignalSubscriber = &xxx_DBG_Rx_SensorSonars{}
+
 type xxx_DBG_Rx_IODebug struct {
 	IODebug
-	receiveTime      time.Time
-	afterReceiveHook func(context.Context) error
+	receiveTime         time.Time
+	afterReceiveHook    func(context.Context) error
+	signalSubscriptions canrunner.SignalSubscriptions
 }
 
 func (m *xxx_DBG_Rx_IODebug) init() {
@@ -1558,12 +2065,139 @@ func (m *xxx_DBG_Rx_IODebug) SetReceiveTime(t time.Time) {
 	m.receiveTime = t
 }
 
+func (m *xxx_DBG_Rx_IODebug) SignalSubscriptions() *canrunner.SignalSubscriptions {
+	return &m.signalSubscriptions
+}
+
+func (m *xxx_DBG_Rx_IODebug) SubscribeTestUnsigned(
+	trigger canrunner.SignalTrigger,
+	h func(ctx context.Context, prev, curr IODebugReader) error,
+) {
+	m.signalSubscriptions.Subscribe(
+		Messages().IODebug.TestUnsigned,
+		trigger,
+		func(ctx context.Context, prevFrame, currFrame can.Frame) error {
+			var prev, curr IODebug
+			if err := prev.UnmarshalFrame(prevFrame); err != nil {
+				return err
+			}
+			if err := curr.UnmarshalFrame(currFrame); err != nil {
+				return err
+			}
+			return h(ctx, &prev, &curr)
+		},
+	)
+}
+
+func (m *xxx_DBG_Rx_IODebug) SubscribeTestEnum(
+	trigger canrunner.SignalTrigger,
+	h func(ctx context.Context, prev, curr IODebugReader) error,
+) {
+	m.signalSubscriptions.Subscribe(
+		Messages().IODebug.TestEnum,
+		trigger,
+		func(ctx context.Context, prevFrame, currFrame can.Frame) error {
+			var prev, curr IODebug
+			if err := prev.UnmarshalFrame(prevFrame); err != nil {
+				return err
+			}
+			if err := curr.UnmarshalFrame(currFrame); err != nil {
+				return err
+			}
+			return h(ctx, &prev, &curr)
+		},
+	)
+}
+
+func (m *xxx_DBG_Rx_IODebug) SubscribeTestSigned(
+	trigger canrunner.SignalTrigger,
+	h func(ctx context.Context, prev, curr IODebugReader) error,
+) {
+	m.signalSubscriptions.Subscribe(
+		Messages().IODebug.TestSigned,
+		trigger,
+		func(ctx context.Context, prevFrame, currFrame can.Frame) error {
+			var prev, curr IODebug
+			if err := prev.UnmarshalFrame(prevFrame); err != nil {
+				return err
+			}
+			if err := curr.UnmarshalFrame(currFrame); err != nil {
+				return err
+			}
+			return h(ctx, &prev, &curr)
+		},
+	)
+}
+
+func (m *xxx_DBG_Rx_IODebug) SubscribeTestFloat(
+	trigger canrunner.SignalTrigger,
+	h func(ctx context.Context, prev, curr IODebugReader) error,
+) {
+	m.signalSubscriptions.Subscribe(
+		Messages().IODebug.TestFloat,
+		trigger,
+		func(ctx context.Context, prevFrame, currFrame can.Frame) error {
+			var prev, curr IODebug
+			if err := prev.UnmarshalFrame(prevFrame); err != nil {
+				return err
+			}
+			if err := curr.UnmarshalFrame(currFrame); err != nil {
+				return err
+			}
+			return h(ctx, &prev, &curr)
+		},
+	)
+}
+
+func (m *xxx_DBG_Rx_IODebug) SubscribeTestBoolEnum(
+	trigger canrunner.SignalTrigger,
+	h func(ctx context.Context, prev, curr IODebugReader) error,
+) {
+	m.signalSubscriptions.Subscribe(
+		Messages().IODebug.TestBoolEnum,
+		trigger,
+		func(ctx context.Context, prevFrame, currFrame can.Frame) error {
+			var prev, curr IODebug
+			if err := prev.UnmarshalFrame(prevFrame); err != nil {
+				return err
+			}
+			if err := curr.UnmarshalFrame(currFrame); err != nil {
+				return err
+			}
+			return h(ctx, &prev, &curr)
+		},
+	)
+}
+
+func (m *xxx_DBG_Rx_IODebug) SubscribeTestScaledEnum(
+	trigger canrunner.SignalTrigger,
+	h func(ctx context.Context, prev, curr IODebugReader) error,
+) {
+	m.signalSubscriptions.Subscribe(
+		Messages().IODebug.TestScaledEnum,
+		trigger,
+		func(ctx context.Context, prevFrame, currFrame can.Frame) error {
+			var prev, curr IODebug
+			if err := prev.UnmarshalFrame(prevFrame); err != nil {
+				return err
+			}
+			if err := curr.UnmarshalFrame(currFrame); err != nil {
+				return err
+			}
+			return h(ctx, &prev, &curr)
+		},
+	)
+}
+
 var _ canrunner.ReceivedMessage = &xxx_DBG_Rx_IODebug{}
 
+var _ canrunner.SignalSubscriber = &xxx_DBG_Rx_IODebug{}
+
 type xxx_DBG_Rx_IOFloat32 struct {
 	IOFloat32
-	receiveTime      time.Time
-	afterReceiveHook func(context.Context) error
+	receiveTime         time.Time
+	afterReceiveHook    func(context.Context) error
+	signalSubscriptions canrunner.SignalSubscriptions
 }
 
 func (m *xxx_DBG_Rx_IOFloat32) init() {
@@ -1586,12 +2220,59 @@ func (m *xxx_DBG_Rx_IOFloat32) SetReceiveTime(t time.Time) {
 	m.receiveTime = t
 }
 
+func (m *xxx_DBG_Rx_IOFloat32) SignalSubscriptions() *canrunner.SignalSubscriptions {
+	return &m.signalSubscriptions
+}
+
+func (m *xxx_DBG_Rx_IOFloat32) SubscribeFloat32ValueNoRange(
+	trigger canrunner.SignalTrigger,
+	h func(ctx context.Context, prev, curr IOFloat32Reader) error,
+) {
+	m.signalSubscriptions.Subscribe(
+		Messages().IOFloat32.Float32ValueNoRange,
+		trigger,
+		func(ctx context.Context, prevFrame, currFrame can.Frame) error {
+			var prev, curr IOFloat32
+			if err := prev.UnmarshalFrame(prevFrame); err != nil {
+				return err
+			}
+			if err := curr.UnmarshalFrame(currFrame); err != nil {
+				return err
+			}
+			return h(ctx, &prev, &curr)
+		},
+	)
+}
+
+func (m *xxx_DBG_Rx_IOFloat32) SubscribeFloat32WithRange(
+	trigger canrunner.SignalTrigger,
+	h func(ctx context.Context, prev, curr IOFloat32Reader) error,
+) {
+	m.signalSubscriptions.Subscribe(
+		Messages().IOFloat32.Float32WithRange,
+		trigger,
+		func(ctx context.Context, prevFrame, currFrame can.Frame) error {
+			var prev, curr IOFloat32
+			if err := prev.UnmarshalFrame(prevFrame); err != nil {
+				return err
+			}
+			if err := curr.UnmarshalFrame(currFrame); err != nil {
+				return err
+			}
+			return h(ctx, &prev, &curr)
+		},
+	)
+}
+
 var _ canrunner.ReceivedMessage = &xxx_DBG_Rx_IOFloat32{}
 
+var _ canrunner.SignalSubscriber = &xxx_DBG_Rx_IOFloat32{}
+
 type xxx_DBG_Rx_SignalNameFormatting struct {
 	SignalNameFormatting
-	receiveTime      time.Time
-	afterReceiveHook func(context.Context) error
+	receiveTime         time.Time
+	afterReceiveHook    func(context.Context) error
+	signalSubscriptions canrunner.SignalSubscriptions
 }
 
 func (m *xxx_DBG_Rx_SignalNameFormatting) init() {
@@ -1614,8 +2295,34 @@ func (m *xxx_DBG_Rx_SignalNameFormatting) SetReceiveTime(t time.Time) {
 	m.receiveTime = t
 }
 
+func (m *xxx_DBG_Rx_SignalNameFormatting) SignalSubscriptions() *canrunner.SignalSubscriptions {
+	return &m.signalSubscriptions
+}
+
+func (m *xxx_DBG_Rx_SignalNameFormatting) Subscribenon_capitalized_signal(
+	trigger canrunner.SignalTrigger,
+	h func(ctx context.Context, prev, curr SignalNameFormattingReader) error,
+) {
+	m.signalSubscriptions.Subscribe(
+		Messages().SignalNameFormatting.non_capitalized_signal,
+		trigger,
+		func(ctx context.Context, prevFrame, currFrame can.Frame) error {
+			var prev, curr SignalNameFormatting
+			if err := prev.UnmarshalFrame(prevFrame); err != nil {
+				return err
+			}
+			if err := curr.UnmarshalFrame(currFrame); err != nil {
+				return err
+			}
+			return h(ctx, &prev, &curr)
+		},
+	)
+}
+
 var _ canrunner.ReceivedMessage = &xxx_DBG_Rx_SignalNameFormatting{}
 
+var _ canrunner.SignalSubscriber = &xxx_DBG_Rx_SignalNameFormatting{}
+
 type DRIVER interface {
 	sync.Locker
 	Tx() DRIVER_Tx
@@ -1639,12 +2346,36 @@ type DRIVER_Rx_SensorSonars interface {
 	SensorSonarsReader
 	ReceiveTime() time.Time
 	SetAfterReceiveHook(h func(context.Context) error)
+	// SubscribeMux calls the hook when the trigger fires for the Mux signal.
+	SubscribeMux(trigger canrunner.SignalTrigger, h func(ctx context.Context, prev, curr SensorSonarsReader) error)
+	// SubscribeErrCount calls the hook when the trigger fires for the ErrCount signal.
+	SubscribeErrCount(trigger canrunner.SignalTrigger, h func(ctx context.Context, prev, curr SensorSonarsReader) error)
+	// SubscribeLeft calls the hook when the trigger fires for the Left signal.
+	SubscribeLeft(trigger canrunner.SignalTrigger, h func(ctx context.Context, prev, curr SensorSonarsReader) error)
+	// SubscribeNoFiltLeft calls the hook when the trigger fires for the NoFiltLeft signal.
+	SubscribeNoFiltLeft(trigger canrunner.SignalTrigger, h func(ctx context.Context, prev, curr SensorSonarsReader) error)
+	// SubscribeMiddle calls the hook when the trigger fires for the Middle signal.
+	SubscribeMiddle(trigger canrunner.SignalTrigger, h func(ctx context.Context, prev, curr SensorSonarsReader) error)
+	// SubscribeNoFiltMiddle calls the hook when the trigger fires for the NoFiltMiddle signal.
+	SubscribeNoFiltMiddle(trigger canrunner.SignalTrigger, h func(ctx context.Context, prev, curr SensorSonarsReader) error)
+	// SubscribeRight calls the hook when the trigger fires for the Right signal.
+	SubscribeRight(trigger canrunner.SignalTrigger, h func(ctx context.Context, prev, curr SensorSonarsReader) error)
+	// SubscribeNoFiltRight calls the hook when the trigger fires for the NoFiltRight signal.
+	SubscribeNoFiltRight(trigger canrunner.SignalTrigger, h func(ctx context.Context, prev, curr SensorSonarsReader) error)
+	// SubscribeRear calls the hook when the trigger fires for the Rear signal.
+	SubscribeRear(trigger canrunner.SignalTrigger, h func(ctx context.Context, prev, curr SensorSonarsReader) error)
+	// SubscribeNoFiltRear calls the hook when the trigger fires for the NoFiltRear signal.
+	SubscribeNoFiltRear(trigger canrunner.SignalTrigger, h func(ctx context.Context, prev, curr SensorSonarsReader) error)
 }
 
 type DRIVER_Rx_MotorStatus interface {
 	MotorStatusReader
 	ReceiveTime() time.Time
 	SetAfterReceiveHook(h func(context.Context) error)
+	// SubscribeWheelError calls the hook when the trigger fires for the WheelError signal.
+	SubscribeWheelError(trigger canrunner.SignalTrigger, h func(ctx context.Context, prev, curr MotorStatusReader) error)
+	// SubscribeSpeedKph calls the hook when the trigger fires for the SpeedKph signal.
+	SubscribeSpeedKph(trigger canrunner.SignalTrigger, h func(ctx context.Context, prev, curr MotorStatusReader) error)
 }
 
 type DRIVER_Tx_DriverHeartbeat interface {
@@ -1684,8 +2415,6 @@ var _ canrunner.Node = &xxx_DRIVER{}
 
 func NewDRIVER(network, address string) DRIVER {
 	n := &xxx_DRIVER{network: network, address: address}
-	n.rx.parentMutex = &n.Mutex
-	n.tx.parentMutex = &n.Mutex
 	n.rx.xxx_SensorSonars.init()
 	n.rx.xxx_SensorSonars.Reset()
 	n.rx.xxx_MotorStatus.init()
@@ -1694,6 +2423,14 @@ func NewDRIVER(network, address string) DRIVER {
 	n.tx.xxx_DriverHeartbeat.Reset()
 	n.tx.xxx_MotorCommand.init()
 	n.tx.xxx_MotorCommand.Reset()
+	n.rx.debugHandler = candebug.NewHandler(&n.Mutex, []generated.Message{
+		&n.rx.xxx_SensorSonars,
+		&n.rx.xxx_MotorStatus,
+	})
+	n.tx.debugHandler = candebug.NewHandler(&n.Mutex, []generated.Message{
+		&n.tx.xxx_DriverHeartbeat,
+		&n.tx.xxx_MotorCommand,
+	})
 	return n
 }
 
@@ -1710,7 +2447,7 @@ func (n *xxx_DRIVER) Tx() DRIVER_Tx {
 }
 
 type xxx_DRIVER_Rx struct {
-	parentMutex      *sync.Mutex
+	debugHandler     *candebug.Handler
 	xxx_SensorSonars xxx_DRIVER_Rx_SensorSonars
 	xxx_MotorStatus  xxx_DRIVER_Rx_MotorStatus
 }
@@ -1718,12 +2455,7 @@ type xxx_DRIVER_Rx struct {
 var _ DRIVER_Rx = &xxx_DRIVER_Rx{}
 
 func (rx *xxx_DRIVER_Rx) ServeHTTP(w http.ResponseWriter, r *http.Request) {
-	rx.parentMutex.Lock()
-	defer rx.parentMutex.Unlock()
-	candebug.ServeMessagesHTTP(w, r, []generated.Message{
-		&rx.xxx_SensorSonars,
-		&rx.xxx_MotorStatus,
-	})
+	rx.debugHandler.ServeHTTP(w, r)
 }
 
 func (rx *xxx_DRIVER_Rx) SensorSonars() DRIVER_Rx_SensorSonars {
@@ -1735,7 +2467,7 @@ func (rx *xxx_DRIVER_Rx) MotorStatus() DRIVER_Rx_MotorStatus {
 }
 
 type xxx_DRIVER_Tx struct {
-	parentMutex         *sync.Mutex
+	debugHandler        *candebug.Handler
 	xxx_DriverHeartbeat xxx_DRIVER_Tx_DriverHeartbeat
 	xxx_MotorCommand    xxx_DRIVER_Tx_MotorCommand
 }
@@ -1743,12 +2475,7 @@ type xxx_DRIVER_Tx struct {
 var _ DRIVER_Tx = &xxx_DRIVER_Tx{}
 
 func (tx *xxx_DRIVER_Tx) ServeHTTP(w http.ResponseWriter, r *http.Request) {
-	tx.parentMutex.Lock()
-	defer tx.parentMutex.Unlock()
-	candebug.ServeMessagesHTTP(w, r, []generated.Message{
-		&tx.xxx_DriverHeartbeat,
-		&tx.xxx_MotorCommand,
-	})
+	tx.debugHandler.ServeHTTP(w, r)
 }
 
 func (tx *xxx_DRIVER_Tx) DriverHeartbeat() DRIVER_Tx_DriverHeartbeat {
@@ -1787,8 +2514,9 @@ func (n *xxx_DRIVER) TransmittedMessages() []canrunner.TransmittedMessage {
 
 type xxx_DRIVER_Rx_SensorSonars struct {
 	SensorSonars
-	receiveTime      time.Time
-	afterReceiveHook func(context.Context) error
+	receiveTime         time.Time
+	afterReceiveHook    func(context.Context) error
+	signalSubscriptions canrunner.SignalSubscriptions
 }
 
 func (m *xxx_DRIVER_Rx_SensorSonars) init() {
@@ -1811,12 +2539,219 @@ func (m *xxx_DRIVER_Rx_SensorSonars) SetReceiveTime(t time.Time) {
 	m.receiveTime = t
 }
 
+func (m *xxx_DRIVER_Rx_SensorSonars) SignalSubscriptions() *canrunner.SignalSubscriptions {
+	return &m.signalSubscriptions
+}
+
+func (m *xxx_DRIVER_Rx_SensorSonars) SubscribeMux(
+	trigger canrunner.SignalTrigger,
+	h func(ctx context.Context, prev, curr SensorSonarsReader) error,
+) {
+	m.signalSubscriptions.Subscribe(
+		Messages().SensorSonars.Mux,
+		trigger,
+		func(ctx context.Context, prevFrame, currFrame can.Frame) error {
+			var prev, curr SensorSonars
+			if err := prev.UnmarshalFrame(prevFrame); err != nil {
+				return err
+			}
+			if err := curr.UnmarshalFrame(currFrame); err != nil {
+				return err
+			}
+			return h(ctx, &prev, &curr)
+		},
+	)
+}
+
+func (m *xxx_DRIVER_Rx_SensorSonars) SubscribeErrCount(
+	trigger canrunner.SignalTrigger,
+	h func(ctx context.Context, prev, curr SensorSonarsReader) error,
+) {
+	m.signalSubscriptions.Subscribe(
+		Messages().SensorSonars.ErrCount,
+		trigger,
+		func(ctx context.Context, prevFrame, currFrame can.Frame) error {
+			var prev, curr SensorSonars
+			if err := prev.UnmarshalFrame(prevFrame); err != nil {
+				return err
+			}
+			if err := curr.UnmarshalFrame(currFrame); err != nil {
+				return err
+			}
+			return h(ctx, &prev, &curr)
+		},
+	)
+}
+
+func (m *xxx_DRIVER_Rx_SensorSonars) SubscribeLeft(
+	trigger canrunner.SignalTrigger,
+	h func(ctx context.Context, prev, curr SensorSonarsReader) error,
+) {
+	m.signalSubscriptions.Subscribe(
+		Messages().SensorSonars.Left,
+		trigger,
+		func(ctx context.Context, prevFrame, currFrame can.Frame) error {
+			var prev, curr SensorSonars
+			if err := prev.UnmarshalFrame(prevFrame); err != nil {
+				return err
+			}
+			if err := curr.UnmarshalFrame(currFrame); err != nil {
+				return err
+			}
+			return h(ctx, &prev, &curr)
+		},
+	)
+}
+
+func (m *xxx_DRIVER_Rx_SensorSonars) SubscribeNoFiltLeft(
+	trigger canrunner.SignalTrigger,
+	h func(ctx context.Context, prev, curr SensorSonarsReader) error,
+) {
+	m.signalSubscriptions.Subscribe(
+		Messages().SensorSonars.NoFiltLeft,
+		trigger,
+		func(ctx context.Context, prevFrame, currFrame can.Frame) error {
+			var prev, curr SensorSonars
+			if err := prev.UnmarshalFrame(prevFrame); err != nil {
+				return err
+			}
+			if err := curr.UnmarshalFrame(currFrame); err != nil {
+				return err
+			}
+			return h(ctx, &prev, &curr)
+		},
+	)
+}
+
+func (m *xxx_DRIVER_Rx_SensorSonars) SubscribeMiddle(
+	trigger canrunner.SignalTrigger,
+	h func(ctx context.Context, prev, curr SensorSonarsReader) error,
+) {
+	m.signalSubscriptions.Subscribe(
+		Messages().SensorSonars.Middle,
+		trigger,
+		func(ctx context.Context, prevFrame, currFrame can.Frame) error {
+			var prev, curr SensorSonars
+			if err := prev.UnmarshalFrame(prevFrame); err != nil {
+				return err
+			}
+			if err := curr.UnmarshalFrame(currFrame); err != nil {
+				return err
+			}
+			return h(ctx, &prev, &curr)
+		},
+	)
+}
+
+func (m *xxx_DRIVER_Rx_SensorSonars) SubscribeNoFiltMiddle(
+	trigger canrunner.SignalTrigger,
+	h func(ctx context.Context, prev, curr SensorSonarsReader) error,
+) {
+	m.signalSubscriptions.Subscribe(
+		Messages().SensorSonars.NoFiltMiddle,
+		trigger,
+		func(ctx context.Context, prevFrame, currFrame can.Frame) error {
+			var prev, curr SensorSonars
+			if err := prev.UnmarshalFrame(prevFrame); err != nil {
+				return err
+			}
+			if err := curr.UnmarshalFrame(currFrame); err != nil {
+				return err
+			}
+			return h(ctx, &prev, &curr)
+		},
+	)
+}
+
+func (m *xxx_DRIVER_Rx_SensorSonars) SubscribeRight(
+	trigger canrunner.SignalTrigger,
+	h func(ctx context.Context, prev, curr SensorSonarsReader) error,
+) {
+	m.signalSubscriptions.Subscribe(
+		Messages().SensorSonars.Right,
+		trigger,
+		func(ctx context.Context, prevFrame, currFrame can.Frame) error {
+			var prev, curr SensorSonars
+			if err := prev.UnmarshalFrame(prevFrame); err != nil {
+				return err
+			}
+			if err := curr.UnmarshalFrame(currFrame); err != nil {
+				return err
+			}
+			return h(ctx, &prev, &curr)
+		},
+	)
+}
+
+func (m *xxx_DRIVER_Rx_SensorSonars) SubscribeNoFiltRight(
+	trigger canrunner.SignalTrigger,
+	h func(ctx context.Context, prev, curr SensorSonarsReader) error,
+) {
+	m.signalSubscriptions.Subscribe(
+		Messages().SensorSonars.NoFiltRight,
+		trigger,
+		func(ctx context.Context, prevFrame, currFrame can.Frame) error {
+			var prev, curr SensorSonars
+			if err := prev.UnmarshalFrame(prevFrame); err != nil {
+				return err
+			}
+			if err := curr.UnmarshalFrame(currFrame); err != nil {
+				return err
+			}
+			return h(ctx, &prev, &curr)
+		},
+	)
+}
+
+func (m *xxx_DRIVER_Rx_SensorSonars) SubscribeRear(
+	trigger canrunner.SignalTrigger,
+	h func(ctx context.Context, prev, curr SensorSonarsReader) error,
+) {
+	m.signalSubscriptions.Subscribe(
+		Messages().SensorSonars.Rear,
+		trigger,
+		func(ctx context.Context, prevFrame, currFrame can.Frame) error {
+			var prev, curr SensorSonars
+			if err := prev.UnmarshalFrame(prevFrame); err != nil {
+				return err
+			}
+			if err := curr.UnmarshalFrame(currFrame); err != nil {
+				return err
+			}
+			return h(ctx, &prev, &curr)
+		},
+	)
+}
+
+func (m *xxx_DRIVER_Rx_SensorSonars) SubscribeNoFiltRear(
+	trigger canrunner.SignalTrigger,
+	h func(ctx context.Context, prev, curr SensorSonarsReader) error,
+) {
+	m.signalSubscriptions.Subscribe(
+		Messages().SensorSonars.NoFiltRear,
+		trigger,
+		func(ctx context.Context, prevFrame, currFrame can.Frame) error {
+			var prev, curr SensorSonars
+			if err := prev.UnmarshalFrame(prevFrame); err != nil {
+				return err
+			}
+			if err := curr.UnmarshalFrame(currFrame); err != nil {
+				return err
+			}
+			return h(ctx, &prev, &curr)
+		},
+	)
+}
+
 var _ canrunner.ReceivedMessage = &xxx_DRIVER_Rx_SensorSonars{}
 
+var _ canrunner.SignalSubscriber = &xxx_DRIVER_Rx_SensorSonars{}
+
 type xxx_DRIVER_Rx_MotorStatus struct {
 	MotorStatus
-	receiveTime      time.Time
-	afterReceiveHook func(context.Context) error
+	receiveTime         time.Time
+	afterReceiveHook    func(context.Context) error
+	signalSubscriptions canrunner.SignalSubscriptions
 }
 
 func (m *xxx_DRIVER_Rx_MotorStatus) init() {
@@ -1839,8 +2774,54 @@ func (m *xxx_DRIVER_Rx_MotorStatus) SetReceiveTime(t time.Time) {
 	m.receiveTime = t
 }
 
+func (m *xxx_DRIVER_Rx_MotorStatus) SignalSubscriptions() *canrunner.SignalSubscriptions {
+	return &m.signalSubscriptions
+}
+
+func (m *xxx_DRIVER_Rx_MotorStatus) SubscribeWheelError(
+	trigger canrunner.SignalTrigger,
+	h func(ctx context.Context, prev, curr MotorStatusReader) error,
+) {
+	m.signalSubscriptions.Subscribe(
+		Messages().MotorStatus.WheelError,
+		trigger,
+		func(ctx context.Context, prevFrame, currFrame can.Frame) error {
+			var prev, curr MotorStatus
+			if err := prev.UnmarshalFrame(prevFrame); err != nil {
+				return err
+			}
+			if err := curr.UnmarshalFrame(currFrame); err != nil {
+				return err
+			}
+			return h(ctx, &prev, &curr)
+		},
+	)
+}
+
+func (m *xxx_DRIVER_Rx_MotorStatus) SubscribeSpeedKph(
+	trigger canrunner.SignalTrigger,
+	h func(ctx context.Context, prev, curr MotorStatusReader) error,
+) {
+	m.signalSubscriptions.Subscribe(
+		Messages().MotorStatus.SpeedKph,
+		trigger,
+		func(ctx context.Context, prevFrame, currFrame can.Frame) error {
+			var prev, curr MotorStatus
+			if err := prev.UnmarshalFrame(prevFrame); err != nil {
+				return err
+			}
+			if err := curr.UnmarshalFrame(currFrame); err != nil {
+				return err
+			}
+			return h(ctx, &prev, &curr)
+		},
+	)
+}
+
 var _ canrunner.ReceivedMessage = &xxx_DRIVER_Rx_MotorStatus{}
 
+var _ canrunner.SignalSubscriber = &xxx_DRIVER_Rx_MotorStatus{}
+
 type xxx_DRIVER_Tx_DriverHeartbeat struct {
 	DriverHeartbeat
 	transmitTime       time.Time
@@ -1993,12 +2974,36 @@ type IO_Rx_SensorSonars interface {
 	SensorSonarsReader
 	ReceiveTime() time.Time
 	SetAfterReceiveHook(h func(context.Context) error)
+	// SubscribeMux calls the hook when the trigger fires for the Mux signal.
+	SubscribeMux(trigger canrunner.SignalTrigger, h func(ctx context.Context, prev, curr SensorSonarsReader) error)
+	// SubscribeErrCount calls the hook when the trigger fires for the ErrCount signal.
+	SubscribeErrCount(trigger canrunner.SignalTrigger, h func(ctx context.Context, prev, curr SensorSonarsReader) error)
+	// SubscribeLeft calls the hook when the trigger fires for the Left signal.
+	SubscribeLeft(trigger canrunner.SignalTrigger, h func(ctx context.Context, prev, curr SensorSonarsReader) error)
+	// SubscribeNoFiltLeft calls the hook when the trigger fires for the NoFiltLeft signal.
+	SubscribeNoFiltLeft(trigger canrunner.SignalTrigger, h func(ctx context.Context, prev, curr SensorSonarsReader) error)
+	// SubscribeMiddle calls the hook when the trigger fires for the Middle signal.
+	SubscribeMiddle(trigger canrunner.SignalTrigger, h func(ctx context.Context, prev, curr SensorSonarsReader) error)
+	// SubscribeNoFiltMiddle calls the hook when the trigger fires for the NoFiltMiddle signal.
+	SubscribeNoFiltMiddle(trigger canrunner.SignalTrigger, h func(ctx context.Context, prev, curr SensorSonarsReader) error)
+	// SubscribeRight calls the hook when the trigger fires for the Right signal.
+	SubscribeRight(trigger canrunner.SignalTrigger, h func(ctx context.Context, prev, curr SensorSonarsReader) error)
+	// SubscribeNoFiltRight calls the hook when the trigger fires for the NoFiltRight signal.
+	SubscribeNoFiltRight(trigger canrunner.SignalTrigger, h func(ctx context.Context, prev, curr SensorSonarsReader) error)
+	// SubscribeRear calls the hook when the trigger fires for the Rear signal.
+	SubscribeRear(trigger canrunner.SignalTrigger, h func(ctx context.Context, prev, curr SensorSonarsReader) error)
+	// SubscribeNoFiltRear calls the hook when the trigger fires for the NoFiltRear signal.
+	SubscribeNoFiltRear(trigger canrunner.SignalTrigger, h func(ctx context.Context, prev, curr SensorSonarsReader) error)
 }
 
 type IO_Rx_MotorStatus interface {
 	MotorStatusReader
 	ReceiveTime() time.Time
 	SetAfterReceiveHook(h func(context.Context) error)
+	// SubscribeWheelError calls the hook when the trigger fires for the WheelError signal.
+	SubscribeWheelError(trigger canrunner.SignalTrigger, h func(ctx context.Context, prev, curr MotorStatusReader) error)
+	// SubscribeSpeedKph calls the hook when the trigger fires for the SpeedKph signal.
+	SubscribeSpeedKph(trigger canrunner.SignalTrigger, h func(ctx context.Context, prev, curr MotorStatusReader) error)
 }
 
 type IO_Tx_IODebug interface {
@@ -2022,14 +3027,19 @@ var _ canrunner.Node = &xxx_IO{}
 
 func NewIO(network, address string) IO {
 	n := &xxx_IO{network: network, address: address}
-	n.rx.parentMutex = &n.Mutex
-	n.tx.parentMutex = &n.Mutex
 	n.rx.xxx_SensorSonars.init()
 	n.rx.xxx_SensorSonars.Reset()
 	n.rx.xxx_MotorStatus.init()
 	n.rx.xxx_MotorStatus.Reset()
 	n.tx.xxx_IODebug.init()
 	n.tx.xxx_IODebug.Reset()
+	n.rx.debugHandler = candebug.NewHandler(&n.Mutex, []generated.Message{
+		&n.rx.xxx_SensorSonars,
+		&n.rx.xxx_MotorStatus,
+	})
+	n.tx.debugHandler = candebug.NewHandler(&n.Mutex, []generated.Message{
+		&n.tx.xxx_IODebug,
+	})
 	return n
 }
 
@@ -2046,7 +3056,7 @@ func (n *xxx_IO) Tx() IO_Tx {
 }
 
 type xxx_IO_Rx struct {
-	parentMutex      *sync.Mutex
+	debugHandler     *candebug.Handler
 	xxx_SensorSonars xxx_IO_Rx_SensorSonars
 	xxx_MotorStatus  xxx_IO_Rx_MotorStatus
 }
@@ -2054,12 +3064,7 @@ type xxx_IO_Rx struct {
 var _ IO_Rx = &xxx_IO_Rx{}
 
 func (rx *xxx_IO_Rx) ServeHTTP(w http.ResponseWriter, r *http.Request) {
-	rx.parentMutex.Lock()
-	defer rx.parentMutex.Unlock()
-	candebug.ServeMessagesHTTP(w, r, []generated.Message{
-		&rx.xxx_SensorSonars,
-		&rx.xxx_MotorStatus,
-	})
+	rx.debugHandler.ServeHTTP(w, r)
 }
 
 func (rx *xxx_IO_Rx) SensorSonars() IO_Rx_SensorSonars {
@@ -2071,18 +3076,14 @@ func (rx *xxx_IO_Rx) MotorStatus() IO_Rx_MotorStatus {
 }
 
 type xxx_IO_Tx struct {
-	parentMutex *sync.Mutex
-	xxx_IODebug xxx_IO_Tx_IODebug
+	debugHandler *candebug.Handler
+	xxx_IODebug  xxx_IO_Tx_IODebug
 }
 
 var _ IO_Tx = &xxx_IO_Tx{}
 
 func (tx *xxx_IO_Tx) ServeHTTP(w http.ResponseWriter, r *http.Request) {
-	tx.parentMutex.Lock()
-	defer tx.parentMutex.Unlock()
-	candebug.ServeMessagesHTTP(w, r, []generated.Message{
-		&tx.xxx_IODebug,
-	})
+	tx.debugHandler.ServeHTTP(w, r)
 }
 
 func (tx *xxx_IO_Tx) IODebug() IO_Tx_IODebug {
@@ -2116,8 +3117,9 @@ func (n *xxx_IO) TransmittedMessages() []canrunner.TransmittedMessage {
 
 type xxx_IO_Rx_SensorSonars struct {
 	SensorSonars
-	receiveTime      time.Time
-	afterReceiveHook func(context.Context) error
+	receiveTime         time.Time
+	afterReceiveHook    func(context.Context) error
+	signalSubscriptions canrunner.SignalSubscriptions
 }
 
 func (m *xxx_IO_Rx_SensorSonars) init() {
@@ -2140,12 +3142,219 @@ func (m *xxx_IO_Rx_SensorSonars) SetReceiveTime(t time.Time) {
 	m.receiveTime = t
 }
 
+func (m *xxx_IO_Rx_SensorSonars) SignalSubscriptions() *canrunner.SignalSubscriptions {
+	return &m.signalSubscriptions
+}
+
+func (m *xxx_IO_Rx_SensorSonars) SubscribeMux(
+	trigger canrunner.SignalTrigger,
+	h func(ctx context.Context, prev, curr SensorSonarsReader) error,
+) {
+	m.signalSubscriptions.Subscribe(
+		Messages().SensorSonars.Mux,
+		trigger,
+		func(ctx context.Context, prevFrame, currFrame can.Frame) error {
+			var prev, curr SensorSonars
+			if err := prev.UnmarshalFrame(prevFrame); err != nil {
+				return err
+			}
+			if err := curr.UnmarshalFrame(currFrame); err != nil {
+				return err
+			}
+			return h(ctx, &prev, &curr)
+		},
+	)
+}
+
+func (m *xxx_IO_Rx_SensorSonars) SubscribeErrCount(
+	trigger canrunner.SignalTrigger,
+	h func(ctx context.Context, prev, curr SensorSonarsReader) error,
+) {
+	m.signalSubscriptions.Subscribe(
+		Messages().SensorSonars.ErrCount,
+		trigger,
+		func(ctx context.Context, prevFrame, currFrame can.Frame) error {
+			var prev, curr SensorSonars
+			if err := prev.UnmarshalFrame(prevFrame); err != nil {
+				return err
+			}
+			if err := curr.UnmarshalFrame(currFrame); err != nil {
+				return err
+			}
+			return h(ctx, &prev, &curr)
+		},
+	)
+}
+
+func (m *xxx_IO_Rx_SensorSonars) SubscribeLeft(
+	trigger canrunner.SignalTrigger,
+	h func(ctx context.Context, prev, curr SensorSonarsReader) error,
+) {
+	m.signalSubscriptions.Subscribe(
+		Messages().SensorSonars.Left,
+		trigger,
+		func(ctx context.Context, prevFrame, currFrame can.Frame) error {
+			var prev, curr SensorSonars
+			if err := prev.UnmarshalFrame(prevFrame); err != nil {
+				return err
+			}
+			if err := curr.UnmarshalFrame(currFrame); err != nil {
+				return err
+			}
+			return h(ctx, &prev, &curr)
+		},
+	)
+}
+
+func (m *xxx_IO_Rx_SensorSonars) SubscribeNoFiltLeft(
+	trigger canrunner.SignalTrigger,
+	h func(ctx context.Context, prev, curr SensorSonarsReader) error,
+) {
+	m.signalSubscriptions.Subscribe(
+		Messages().SensorSonars.NoFiltLeft,
+		trigger,
+		func(ctx context.Context, prevFrame, currFrame can.Frame) error {
+			var prev, curr SensorSonars
+			if err := prev.UnmarshalFrame(prevFrame); err != nil {
+				return err
+			}
+			if err := curr.UnmarshalFrame(currFrame); err != nil {
+				return err
+			}
+			return h(ctx, &prev, &curr)
+		},
+	)
+}
+
+func (m *xxx_IO_Rx_SensorSonars) SubscribeMiddle(
+	trigger canrunner.SignalTrigger,
+	h func(ctx context.Context, prev, curr SensorSonarsReader) error,
+) {
+	m.signalSubscriptions.Subscribe(
+		Messages().SensorSonars.Middle,
+		trigger,
+		func(ctx context.Context, prevFrame, currFrame can.Frame) error {
+			var prev, curr SensorSonars
+			if err := prev.UnmarshalFrame(prevFrame); err != nil {
+				return err
+			}
+			if err := curr.UnmarshalFrame(currFrame); err != nil {
+				return err
+			}
+			return h(ctx, &prev, &curr)
+		},
+	)
+}
+
+func (m *xxx_IO_Rx_SensorSonars) SubscribeNoFiltMiddle(
+	trigger canrunner.SignalTrigger,
+	h func(ctx context.Context, prev, curr SensorSonarsReader) error,
+) {
+	m.signalSubscriptions.Subscribe(
+		Messages().SensorSonars.NoFiltMiddle,
+		trigger,
+		func(ctx context.Context, prevFrame, currFrame can.Frame) error {
+			var prev, curr SensorSonars
+			if err := prev.UnmarshalFrame(prevFrame); err != nil {
+				return err
+			}
+			if err := curr.UnmarshalFrame(currFrame); err != nil {
+				return err
+			}
+			return h(ctx, &prev, &curr)
+		},
+	)
+}
+
+func (m *xxx_IO_Rx_SensorSonars) SubscribeRight(
+	trigger canrunner.SignalTrigger,
+	h func(ctx context.Context, prev, curr SensorSonarsReader) error,
+) {
+	m.signalSubscriptions.Subscribe(
+		Messages().SensorSonars.Right,
+		trigger,
+		func(ctx context.Context, prevFrame, currFrame can.Frame) error {
+			var prev, curr SensorSonars
+			if err := prev.UnmarshalFrame(prevFrame); err != nil {
+				return err
+			}
+			if err := curr.UnmarshalFrame(currFrame); err != nil {
+				return err
+			}
+			return h(ctx, &prev, &curr)
+		},
+	)
+}
+
+func (m *xxx_IO_Rx_SensorSonars) SubscribeNoFiltRight(
+	trigger canrunner.SignalTrigger,
+	h func(ctx context.Context, prev, curr SensorSonarsReader) error,
+) {
+	m.signalSubscriptions.Subscribe(
+		Messages().SensorSonars.NoFiltRight,
+		trigger,
+		func(ctx context.Context, prevFrame, currFrame can.Frame) error {
+			var prev, curr SensorSonars
+			if err := prev.UnmarshalFrame(prevFrame); err != nil {
+				return err
+			}
+			if err := curr.UnmarshalFrame(currFrame); err != nil {
+				return err
+			}
+			return h(ctx, &prev, &curr)
+		},
+	)
+}
+
+func (m *xxx_IO_Rx_SensorSonars) SubscribeRear(
+	trigger canrunner.SignalTrigger,
+	h func(ctx context.Context, prev, curr SensorSonarsReader) error,
+) {
+	m.signalSubscriptions.Subscribe(
+		Messages().SensorSonars.Rear,
+		trigger,
+		func(ctx context.Context, prevFrame, currFrame can.Frame) error {
+			var prev, curr SensorSonars
+			if err := prev.UnmarshalFrame(prevFrame); err != nil {
+				return err
+			}
+			if err := curr.UnmarshalFrame(currFrame); err != nil {
+				return err
+			}
+			return h(ctx, &prev, &curr)
+		},
+	)
+}
+
+func (m *xxx_IO_Rx_SensorSonars) SubscribeNoFiltRear(
+	trigger canrunner.SignalTrigger,
+	h func(ctx context.Context, prev, curr SensorSonarsReader) error,
+) {
+	m.signalSubscriptions.Subscribe(
+		Messages().SensorSonars.NoFiltRear,
+		trigger,
+		func(ctx context.Context, prevFrame, currFrame can.Frame) error {
+			var prev, curr SensorSonars
+			if err := prev.UnmarshalFrame(prevFrame); err != nil {
+				return err
+			}
+			if err := curr.UnmarshalFrame(currFrame); err != nil {
+				return err
+			}
+			return h(ctx, &prev, &curr)
+		},
+	)
+}
+
 var _ canrunner.ReceivedMessage = &xxx_IO_Rx_SensorSonars{}
 
+var _ canrunner.SignalSubscriber = &xxx_IO_Rx_SensorSonars{}
+
 type xxx_IO_Rx_MotorStatus struct {
 	MotorStatus
-	receiveTime      time.Time
-	afterReceiveHook func(context.Context) error
+	receiveTime         time.Time
+	afterReceiveHook    func(context.Context) error
+	signalSubscriptions canrunner.SignalSubscriptions
 }
 
 func (m *xxx_IO_Rx_MotorStatus) init() {
@@ -2168,8 +3377,54 @@ func (m *xxx_IO_Rx_MotorStatus) SetReceiveTime(t time.Time) {
 	m.receiveTime = t
 }
 
+func (m *xxx_IO_Rx_MotorStatus) SignalSubscriptions() *canrunner.SignalSubscriptions {
+	return &m.signalSubscriptions
+}
+
+func (m *xxx_IO_Rx_MotorStatus) SubscribeWheelError(
+	trigger canrunner.SignalTrigger,
+	h func(ctx context.Context, prev, curr MotorStatusReader) error,
+) {
+	m.signalSubscriptions.Subscribe(
+		Messages().MotorStatus.WheelError,
+		trigger,
+		func(ctx context.Context, prevFrame, currFrame can.Frame) error {
+			var prev, curr MotorStatus
+			if err := prev.UnmarshalFrame(prevFrame); err != nil {
+				return err
+			}
+			if err := curr.UnmarshalFrame(currFrame); err != nil {
+				return err
+			}
+			return h(ctx, &prev, &curr)
+		},
+	)
+}
+
+func (m *xxx_IO_Rx_MotorStatus) SubscribeSpeedKph(
+	trigger canrunner.SignalTrigger,
+	h func(ctx context.Context, prev, curr MotorStatusReader) error,
+) {
+	m.signalSubscriptions.Subscribe(
+		Messages().MotorStatus.SpeedKph,
+		trigger,
+		func(ctx context.Context, prevFrame, currFrame can.Frame) error {
+			var prev, curr MotorStatus
+			if err := prev.UnmarshalFrame(prevFrame); err != nil {
+				return err
+			}
+			if err := curr.UnmarshalFrame(currFrame); err != nil {
+				return err
+			}
+			return h(ctx, &prev, &curr)
+		},
+	)
+}
+
 var _ canrunner.ReceivedMessage = &xxx_IO_Rx_MotorStatus{}
 
+var _ canrunner.SignalSubscriber = &xxx_IO_Rx_MotorStatus{}
+
 type xxx_IO_Tx_IODebug struct {
 	IODebug
 	transmitTime       time.Time
@@ -2257,12 +3512,18 @@ type MOTOR_Rx_DriverHeartbeat interface {
 	DriverHeartbeatReader
 	ReceiveTime() time.Time
 	SetAfterReceiveHook(h func(context.Context) error)
+	// SubscribeCommand calls the hook when the trigger fires for the Command signal.
+	SubscribeCommand(trigger canrunner.SignalTrigger, h func(ctx context.Context, prev, curr DriverHeartbeatReader) error)
 }
 
 type MOTOR_Rx_MotorCommand interface {
 	MotorCommandReader
 	ReceiveTime() time.Time
 	SetAfterReceiveHook(h func(context.Context) error)
+	// SubscribeSteer calls the hook when the trigger fires for the Steer signal.
+	SubscribeSteer(trigger canrunner.SignalTrigger, h func(ctx context.Context, prev, curr MotorCommandReader) error)
+	// SubscribeDrive calls the hook when the trigger fires for the Drive signal.
+	SubscribeDrive(trigger canrunner.SignalTrigger, h func(ctx context.Context, prev, curr MotorCommandReader) error)
 }
 
 type MOTOR_Tx_MotorStatus interface {
@@ -2290,14 +3551,19 @@ var _ canrunner.Node = &xxx_MOTOR{}
 
 func NewMOTOR(network, address string) MOTOR {
 	n := &xxx_MOTOR{network: network, address: address}
-	n.rx.parentMutex = &n.Mutex
-	n.tx.parentMutex = &n.Mutex
 	n.rx.xxx_DriverHeartbeat.init()
 	n.rx.xxx_DriverHeartbeat.Reset()
 	n.rx.xxx_MotorCommand.init()
 	n.rx.xxx_MotorCommand.Reset()
 	n.tx.xxx_MotorStatus.init()
 	n.tx.xxx_MotorStatus.Reset()
+	n.rx.debugHandler = candebug.NewHandler(&n.Mutex, []generated.Message{
+		&n.rx.xxx_DriverHeartbeat,
+		&n.rx.xxx_MotorCommand,
+	})
+	n.tx.debugHandler = candebug.NewHandler(&n.Mutex, []generated.Message{
+		&n.tx.xxx_MotorStatus,
+	})
 	return n
 }
 
@@ -2314,7 +3580,7 @@ func (n *xxx_MOTOR) Tx() MOTOR_Tx {
 }
 
 type xxx_MOTOR_Rx struct {
-	parentMutex         *sync.Mutex
+	debugHandler        *candebug.Handler
 	xxx_DriverHeartbeat xxx_MOTOR_Rx_DriverHeartbeat
 	xxx_MotorCommand    xxx_MOTOR_Rx_MotorCommand
 }
@@ -2322,12 +3588,7 @@ type xxx_MOTOR_Rx struct {
 var _ MOTOR_Rx = &xxx_MOTOR_Rx{}
 
 func (rx *xxx_MOTOR_Rx) ServeHTTP(w http.ResponseWriter, r *http.Request) {
-	rx.parentMutex.Lock()
-	defer rx.parentMutex.Unlock()
-	candebug.ServeMessagesHTTP(w, r, []generated.Message{
-		&rx.xxx_DriverHeartbeat,
-		&rx.xxx_MotorCommand,
-	})
+	rx.debugHandler.ServeHTTP(w, r)
 }
 
 func (rx *xxx_MOTOR_Rx) DriverHeartbeat() MOTOR_Rx_DriverHeartbeat {
@@ -2339,18 +3600,14 @@ func (rx *xxx_MOTOR_Rx) MotorCommand() MOTOR_Rx_MotorCommand {
 }
 
 type xxx_MOTOR_Tx struct {
-	parentMutex     *sync.Mutex
+	debugHandler    *candebug.Handler
 	xxx_MotorStatus xxx_MOTOR_Tx_MotorStatus
 }
 
 var _ MOTOR_Tx = &xxx_MOTOR_Tx{}
 
 func (tx *xxx_MOTOR_Tx) ServeHTTP(w http.ResponseWriter, r *http.Request) {
-	tx.parentMutex.Lock()
-	defer tx.parentMutex.Unlock()
-	candebug.ServeMessagesHTTP(w, r, []generated.Message{
-		&tx.xxx_MotorStatus,
-	})
+	tx.debugHandler.ServeHTTP(w, r)
 }
 
 func (tx *xxx_MOTOR_Tx) MotorStatus() MOTOR_Tx_MotorStatus {
@@ -2384,8 +3641,9 @@ func (n *xxx_MOTOR) TransmittedMessages() []canrunner.TransmittedMessage {
 
 type xxx_MOTOR_Rx_DriverHeartbeat struct {
 	DriverHeartbeat
-	receiveTime      time.Time
-	afterReceiveHook func(context.Context) error
+	receiveTime         time.Time
+	afterReceiveHook    func(context.Context) error
+	signalSubscriptions canrunner.SignalSubscriptions
 }
 
 func (m *xxx_MOTOR_Rx_DriverHeartbeat) init() {
@@ -2408,12 +3666,39 @@ func (m *xxx_MOTOR_Rx_DriverHeartbeat) SetReceiveTime(t time.Time) {
 	m.receiveTime = t
 }
 
+func (m *xxx_MOTOR_Rx_DriverHeartbeat) SignalSubscriptions() *canrunner.SignalSubscriptions {
+	return &m.signalSubscriptions
+}
+
+func (m *xxx_MOTOR_Rx_DriverHeartbeat) SubscribeCommand(
+	trigger canrunner.SignalTrigger,
+	h func(ctx context.Context, prev, curr DriverHeartbeatReader) error,
+) {
+	m.signalSubscriptions.Subscribe(
+		Messages().DriverHeartbeat.Command,
+		trigger,
+		func(ctx context.Context, prevFrame, currFrame can.Frame) error {
+			var prev, curr DriverHeartbeat
+			if err := prev.UnmarshalFrame(prevFrame); err != nil {
+				return err
+			}
+			if err := curr.UnmarshalFrame(currFrame); err != nil {
+				return err
+			}
+			return h(ctx, &prev, &curr)
+		},
+	)
+}
+
 var _ canrunner.ReceivedMessage = &xxx_MOTOR_Rx_DriverHeartbeat{}
 
+var _ canrunner.SignalSubscriber = &xxx_MOTOR_Rx_DriverHeartbeat{}
+
 type xxx_MOTOR_Rx_MotorCommand struct {
 	MotorCommand
-	receiveTime      time.Time
-	afterReceiveHook func(context.Context) error
+	receiveTime         time.Time
+	afterReceiveHook    func(context.Context) error
+	signalSubscriptions canrunner.SignalSubscriptions
 }
 
 func (m *xxx_MOTOR_Rx_MotorCommand) init() {
@@ -2436,8 +3721,54 @@ func (m *xxx_MOTOR_Rx_MotorCommand) SetReceiveTime(t time.Time) {
 	m.receiveTime = t
 }
 
+func (m *xxx_MOTOR_Rx_MotorCommand) SignalSubscriptions() *canrunner.SignalSubscriptions {
+	return &m.signalSubscriptions
+}
+
+func (m *xxx_MOTOR_Rx_MotorCommand) SubscribeSteer(
+	trigger canrunner.SignalTrigger,
+	h func(ctx context.Context, prev, curr MotorCommandReader) error,
+) {
+	m.signalSubscriptions.Subscribe(
+		Messages().MotorCommand.Steer,
+		trigger,
+		func(ctx context.Context, prevFrame, currFrame can.Frame) error {
+			var prev, curr MotorCommand
+			if err := prev.UnmarshalFrame(prevFrame); err != nil {
+				return err
+			}
+			if err := curr.UnmarshalFrame(currFrame); err != nil {
+				return err
+			}
+			return h(ctx, &prev, &curr)
+		},
+	)
+}
+
+func (m *xxx_MOTOR_Rx_MotorCommand) SubscribeDrive(
+	trigger canrunner.SignalTrigger,
+	h func(ctx context.Context, prev, curr MotorCommandReader) error,
+) {
+	m.signalSubscriptions.Subscribe(
+		Messages().MotorCommand.Drive,
+		trigger,
+		func(ctx context.Context, prevFrame, currFrame can.Frame) error {
+			var prev, curr MotorCommand
+			if err := prev.UnmarshalFrame(prevFrame); err != nil {
+				return err
+			}
+			if err := curr.UnmarshalFrame(currFrame); err != nil {
+				return err
+			}
+			return h(ctx, &prev, &curr)
+		},
+	)
+}
+
 var _ canrunner.ReceivedMessage = &xxx_MOTOR_Rx_MotorCommand{}
 
+var _ canrunner.SignalSubscriber = &xxx_MOTOR_Rx_MotorCommand{}
+
 type xxx_MOTOR_Tx_MotorStatus struct {
 	MotorStatus
 	transmitTime       time.Time
@@ -2524,6 +3855,8 @@ type SENSOR_Rx_DriverHeartbeat interface {
 	DriverHeartbeatReader
 	ReceiveTime() time.Time
 	SetAfterReceiveHook(h func(context.Context) error)
+	// SubscribeCommand calls the hook when the trigger fires for the Command signal.
+	SubscribeCommand(trigger canrunner.SignalTrigger, h func(ctx context.Context, prev, curr DriverHeartbeatReader) error)
 }
 
 type SENSOR_Tx_SensorSonars interface {
@@ -2551,12 +3884,16 @@ var _ canrunner.Node = &xxx_SENSOR{}
 
 func NewSENSOR(network, address string) SENSOR {
 	n := &xxx_SENSOR{network: network, address: address}
-	n.rx.parentMutex = &n.Mutex
-	n.tx.parentMutex = &n.Mutex
 	n.rx.xxx_DriverHeartbeat.init()
 	n.rx.xxx_DriverHeartbeat.Reset()
 	n.tx.xxx_SensorSonars.init()
 	n.tx.xxx_SensorSonars.Reset()
+	n.rx.debugHandler = candebug.NewHandler(&n.Mutex, []generated.Message{
+		&n.rx.xxx_DriverHeartbeat,
+	})
+	n.tx.debugHandler = candebug.NewHandler(&n.Mutex, []generated.Message{
+		&n.tx.xxx_SensorSonars,
+	})
 	return n
 }
 
@@ -2573,18 +3910,14 @@ func (n *xxx_SENSOR) Tx() SENSOR_Tx {
 }
 
 type xxx_SENSOR_Rx struct {
-	parentMutex         *sync.Mutex
+	debugHandler        *candebug.Handler
 	xxx_DriverHeartbeat xxx_SENSOR_Rx_DriverHeartbeat
 }
 
 var _ SENSOR_Rx = &xxx_SENSOR_Rx{}
 
 func (rx *xxx_SENSOR_Rx) ServeHTTP(w http.ResponseWriter, r *http.Request) {
-	rx.parentMutex.Lock()
-	defer rx.parentMutex.Unlock()
-	candebug.ServeMessagesHTTP(w, r, []generated.Message{
-		&rx.xxx_DriverHeartbeat,
-	})
+	rx.debugHandler.ServeHTTP(w, r)
 }
 
 func (rx *xxx_SENSOR_Rx) DriverHeartbeat() SENSOR_Rx_DriverHeartbeat {
@@ -2592,18 +3925,14 @@ func (rx *xxx_SENSOR_Rx) DriverHeartbeat() SENSOR_Rx_DriverHeartbeat {
 }
 
 type xxx_SENSOR_Tx struct {
-	parentMutex      *sync.Mutex
+	debugHandler     *candebug.Handler
 	xxx_SensorSonars xxx_SENSOR_Tx_SensorSonars
 }
 
 var _ SENSOR_Tx = &xxx_SENSOR_Tx{}
 
 func (tx *xxx_SENSOR_Tx) ServeHTTP(w http.ResponseWriter, r *http.Request) {
-	tx.parentMutex.Lock()
-	defer tx.parentMutex.Unlock()
-	candebug.ServeMessagesHTTP(w, r, []generated.Message{
-		&tx.xxx_SensorSonars,
-	})
+	tx.debugHandler.ServeHTTP(w, r)
 }
 
 func (tx *xxx_SENSOR_Tx) SensorSonars() SENSOR_Tx_SensorSonars {
@@ -2635,8 +3964,9 @@ func (n *xxx_SENSOR) TransmittedMessages() []canrunner.TransmittedMessage {
 
 type xxx_SENSOR_Rx_DriverHeartbeat struct {
 	DriverHeartbeat
-	receiveTime      time.Time
-	afterReceiveHook func(context.Context) error
+	receiveTime         time.Time
+	afterReceiveHook    func(context.Context) error
+	signalSubscriptions canrunner.SignalSubscriptions
 }
 
 func (m *xxx_SENSOR_Rx_DriverHeartbeat) init() {
@@ -2659,8 +3989,34 @@ func (m *xxx_SENSOR_Rx_DriverHeartbeat) SetReceiveTime(t time.Time) {
 	m.receiveTime = t
 }
 
+func (m *xxx_SENSOR_Rx_DriverHeartbeat) SignalSubscriptions() *canrunner.SignalSubscriptions {
+	return &m.signalSubscriptions
+}
+
+func (m *xxx_SENSOR_Rx_DriverHeartbeat) SubscribeCommand(
+	trigger canrunner.SignalTrigger,
+	h func(ctx context.Context, prev, curr DriverHeartbeatReader) error,
+) {
+	m.signalSubscriptions.Subscribe(
+		Messages().DriverHeartbeat.Command,
+		trigger,
+		func(ctx context.Context, prevFrame, currFrame can.Frame) error {
+			var prev, curr DriverHeartbeat
+			if err := prev.UnmarshalFrame(prevFrame); err != nil {
+				return err
+			}
+			if err := curr.UnmarshalFrame(currFrame); err != nil {
+				return err
+			}
+			return h(ctx, &prev, &curr)
+		},
+	)
+}
+
 var _ canrunner.ReceivedMessage = &xxx_SENSOR_Rx_DriverHeartbeat{}
 
+var _ canrunner.SignalSubscriber = &xxx_SENSOR_Rx_DriverHeartbeat{}
+
 type xxx_SENSOR_Tx_SensorSonars struct {
 	SensorSonars
 	transmitTime       time.Time
@@ -2726,6 +4082,121 @@ func (m *xxx_SENSOR_Tx_SensorSonars) TransmitEventChan() <-chan struct{} {
 
 var _ canrunner.TransmittedMessage = &xxx_SENSOR_Tx_SensorSonars{}
 
+// FrameBatch holds the messages decoded from batches of frames, in a slice per message.
+//
+// The slices are reused after Reset, so that decoding does not allocate once they have grown to the size of a
+// batch.
+type FrameBatch struct {
+	EmptyMessage         []EmptyMessage
+	DriverHeartbeat      []DriverHeartbeat
+	MotorCommand         []MotorCommand
+	SensorSonars         []SensorSonars
+	MotorStatus          []MotorStatus
+	IODebug              []IODebug
+	IOFloat32            []IOFloat32
+	SignalNameFormatting []SignalNameFormatting
+}
+
+// Reset empties the slices of the batch, keeping their capacity.
+func (b *FrameBatch) Reset() {
+	b.EmptyMessage = b.EmptyMessage[:0]
+	b.DriverHeartbeat = b.DriverHeartbeat[:0]
+	b.MotorCommand = b.MotorCommand[:0]
+	b.SensorSonars = b.SensorSonars[:0]
+	b.MotorStatus = b.MotorStatus[:0]
+	b.IODebug = b.IODebug[:0]
+	b.IOFloat32 = b.IOFloat32[:0]
+	b.SignalNameFormatting = b.SignalNameFormatting[:0]
+}
+
+// DecodeFrames decodes a batch of frames, and appends the decoded messages to the batch.
+//
+// Remote frames and frames of unknown messages are skipped.
+func (b *FrameBatch) DecodeFrames(frames []can.Frame) error {
+	for i := range frames {
+		f := &frames[i]
+		if f.IsRemote {
+			continue
+		}
+		switch f.ID {
+		case 1:
+			if !f.IsExtended {
+				b.EmptyMessage = append(b.EmptyMessage, EmptyMessage{})
+				m := &b.EmptyMessage[len(b.EmptyMessage)-1]
+				if err := m.DecodeFrame(f); err != nil {
+					b.EmptyMessage = b.EmptyMessage[:len(b.EmptyMessage)-1]
+					return err
+				}
+			}
+		case 100:
+			if !f.IsExtended {
+				b.DriverHeartbeat = append(b.DriverHeartbeat, DriverHeartbeat{})
+				m := &b.DriverHeartbeat[len(b.DriverHeartbeat)-1]
+				if err := m.DecodeFrame(f); err != nil {
+					b.DriverHeartbeat = b.DriverHeartbeat[:len(b.DriverHeartbeat)-1]
+					return err
+				}
+			}
+		case 101:
+			if !f.IsExtended {
+				b.MotorCommand = append(b.MotorCommand, MotorCommand{})
+				m := &b.MotorCommand[len(b.MotorCommand)-1]
+				if err := m.DecodeFrame(f); err != nil {
+					b.MotorCommand = b.MotorCommand[:len(b.MotorCommand)-1]
+					return err
+				}
+			}
+		case 200:
+			if !f.IsExtended {
+				b.SensorSonars = append(b.SensorSonars, SensorSonars{})
+				m := &b.SensorSonars[len(b.SensorSonars)-1]
+				m.Reset()
+				if err := m.DecodeFrame(f); err != nil {
+					b.SensorSonars = b.SensorSonars[:len(b.SensorSonars)-1]
+					return err
+				}
+			}
+		case 400:
+			if !f.IsExtended {
+				b.MotorStatus = append(b.MotorStatus, MotorStatus{})
+				m := &b.MotorStatus[len(b.MotorStatus)-1]
+				if err := m.DecodeFrame(f); err != nil {
+					b.MotorStatus = b.MotorStatus[:len(b.MotorStatus)-1]
+					return err
+				}
+			}
+		case 500:
+			if !f.IsExtended {
+				b.IODebug = append(b.IODebug, IODebug{})
+				m := &b.IODebug[len(b.IODebug)-1]
+				if err := m.DecodeFrame(f); err != nil {
+					b.IODebug = b.IODebug[:len(b.IODebug)-1]
+					return err
+				}
+			}
+		case 600:
+			if !f.IsExtended {
+				b.IOFloat32 = append(b.IOFloat32, IOFloat32{})
+				m := &b.IOFloat32[len(b.IOFloat32)-1]
+				if err := m.DecodeFrame(f); err != nil {
+					b.IOFloat32 = b.IOFloat32[:len(b.IOFloat32)-1]
+					return err
+				}
+			}
+		case 700:
+			if !f.IsExtended {
+				b.SignalNameFormatting = append(b.SignalNameFormatting, SignalNameFormatting{})
+				m := &b.SignalNameFormatting[len(b.SignalNameFormatting)-1]
+				if err := m.DecodeFrame(f); err != nil {
+					b.SignalNameFormatting = b.SignalNameFormatting[:len(b.SignalNameFormatting)-1]
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
 // Nodes returns the example node descriptors.
 func Nodes() *NodesDescriptor {
 	return nd
@@ -2938,16 +4409,38 @@ var d = (*descriptor.Database)(&descriptor.Database{
 	Version:    (string)(""),
 	Messages: ([]*descriptor.Message)([]*descriptor.Message{
 		(*descriptor.Message)(&descriptor.Message{
-			Name:        (string)("EmptyMessage"),
-			ID:          (uint32)(1),
-			IsExtended:  (bool)(false),
-			Length:      (uint8)(0),
-			SendType:    (descriptor.SendType)(0),
-			Description: (string)(""),
-			Signals:     ([]*descriptor.Signal)(nil),
-			SenderNode:  (string)("DBG"),
-			CycleTime:   (time.Duration)(0),
-			DelayTime:   (time.Duration)(0),
+			Name:             (string)("EmptyMessage"),
+			ID:               (uint32)(1),
+			IsExtended:       (bool)(false),
+			Length:           (uint8)(0),
+			SendType:         (descriptor.SendType)(0),
+			Description:      (string)(""),
+			Signals:          ([]*descriptor.Signal)(nil),
+			SenderNode:       (string)("DBG"),
+			TransmitterNodes: ([]string)(nil),
+			SignalGroups:     ([]*descriptor.SignalGroup)(nil),
+			CycleTime:        (time.Duration)(0),
+			CycleTimeFast:    (time.Duration)(0),
+			DelayTime:        (time.Duration)(0),
+			RepetitionCount:  (int)(0),
+			Attributes: ([]*descriptor.Attribute)([]*descriptor.Attribute{
+				(*descriptor.Attribute)(&descriptor.Attribute{
+					Name:        (string)("GenMsgCycleTime"),
+					Type:        (descriptor.AttributeType)(0),
+					IntValue:    (int64)(0),
+					FloatValue:  (float64)(0),
+					StringValue: (string)(""),
+					IsDefault:   (bool)(true),
+				}),
+				(*descriptor.Attribute)(&descriptor.Attribute{
+					Name:        (string)("GenMsgSendType"),
+					Type:        (descriptor.AttributeType)(4),
+					IntValue:    (int64)(0),
+					FloatValue:  (float64)(0),
+					StringValue: (string)("None"),
+					IsDefault:   (bool)(false),
+				}),
+			}),
 		}),
 		(*descriptor.Message)(&descriptor.Message{
 			Name:        (string)("DriverHeartbeat"),
@@ -2958,21 +4451,25 @@ var d = (*descriptor.Database)(&descriptor.Database{
 			Description: (string)("Sync message used to synchronize the controllers"),
 			Signals: ([]*descriptor.Signal)([]*descriptor.Signal{
 				(*descriptor.Signal)(&descriptor.Signal{
-					Name:             (string)("Command"),
-					Start:            (uint8)(0),
-					Length:           (uint8)(8),
-					IsBigEndian:      (bool)(false),
-					IsSigned:         (bool)(false),
-					IsFloat:          (bool)(false),
-					IsMultiplexer:    (bool)(false),
-					IsMultiplexed:    (bool)(false),
-					MultiplexerValue: (uint)(0),
-					Offset:           (float64)(0),
-					Scale:            (float64)(1),
-					Min:              (float64)(0),
-					Max:              (float64)(0),
-					Unit:             (string)(""),
-					Description:      (string)(""),
+					Name:              (string)("Command"),
+					Start:             (uint8)(0),
+					Length:            (uint8)(8),
+					IsBigEndian:       (bool)(false),
+					IsSigned:          (bool)(false),
+					IsFloat:           (bool)(false),
+					IsMultiplexer:     (bool)(false),
+					IsMultiplexed:     (bool)(false),
+					MultiplexerValue:  (uint)(0),
+					MultiplexerName:   (string)(""),
+					MultiplexerRanges: ([]*descriptor.MultiplexerRange)(nil),
+					Offset:            (float64)(0),
+					Scale:             (float64)(1),
+					Min:               (float64)(0),
+					Max:               (float64)(0),
+					Unit:              (string)(""),
+					Description:       (string)(""),
+					SignalType:        (string)(""),
+					ValueTable:        (string)(""),
 					ValueDescriptions: ([]*descriptor.ValueDescription)([]*descriptor.ValueDescription{
 						(*descriptor.ValueDescription)(&descriptor.ValueDescription{
 							Value:       (int64)(0),
@@ -2995,12 +4492,54 @@ var d = (*descriptor.Database)(&descriptor.Database{
 						(string)("SENSOR"),
 						(string)("MOTOR"),
 					}),
-					DefaultValue: (int)(0),
+					DefaultValue:  (int)(0),
+					SendType:      (descriptor.SendType)(0),
+					InactiveValue: (int)(0),
+					Attributes: ([]*descriptor.Attribute)([]*descriptor.Attribute{
+						(*descriptor.Attribute)(&descriptor.Attribute{
+							Name:        (string)("FieldType"),
+							Type:        (descriptor.AttributeType)(3),
+							IntValue:    (int64)(0),
+							FloatValue:  (float64)(0),
+							StringValue: (string)("Command"),
+							IsDefault:   (bool)(false),
+						}),
+						(*descriptor.Attribute)(&descriptor.Attribute{
+							Name:        (string)("GenSigStartValue"),
+							Type:        (descriptor.AttributeType)(0),
+							IntValue:    (int64)(0),
+							FloatValue:  (float64)(0),
+							StringValue: (string)(""),
+							IsDefault:   (bool)(true),
+						}),
+					}),
+				}),
+			}),
+			SenderNode:       (string)("DRIVER"),
+			TransmitterNodes: ([]string)(nil),
+			SignalGroups:     ([]*descriptor.SignalGroup)(nil),
+			CycleTime:        (time.Duration)(1000000000),
+			CycleTimeFast:    (time.Duration)(0),
+			DelayTime:        (time.Duration)(0),
+			RepetitionCount:  (int)(0),
+			Attributes: ([]*descriptor.Attribute)([]*descriptor.Attribute{
+				(*descriptor.Attribute)(&descriptor.Attribute{
+					Name:        (string)("GenMsgCycleTime"),
+					Type:        (descriptor.AttributeType)(0),
+					IntValue:    (int64)(1000),
+					FloatValue:  (float64)(0),
+					StringValue: (string)(""),
+					IsDefault:   (bool)(false),
+				}),
+				(*descriptor.Attribute)(&descriptor.Attribute{
+					Name:        (string)("GenMsgSendType"),
+					Type:        (descriptor.AttributeType)(4),
+					IntValue:    (int64)(1),
+					FloatValue:  (float64)(0),
+					StringValue: (string)("Cyclic"),
+					IsDefault:   (bool)(false),
 				}),
 			}),
-			SenderNode: (string)("DRIVER"),
-			CycleTime:  (time.Duration)(1000000000),
-			DelayTime:  (time.Duration)(0),
 		}),
 		(*descriptor.Message)(&descriptor.Message{
 			Name:        (string)("MotorCommand"),
@@ -3020,17 +4559,41 @@ var d = (*descriptor.Database)(&descriptor.Database{
 					IsMultiplexer:     (bool)(false),
 					IsMultiplexed:     (bool)(false),
 					MultiplexerValue:  (uint)(0),
+					MultiplexerName:   (string)(""),
+					MultiplexerRanges: ([]*descriptor.MultiplexerRange)(nil),
 					Offset:            (float64)(-5),
 					Scale:             (float64)(1),
 					Min:               (float64)(-5),
 					Max:               (float64)(5),
 					Unit:              (string)(""),
 					Description:       (string)(""),
+					SignalType:        (string)(""),
+					ValueTable:        (string)(""),
 					ValueDescriptions: ([]*descriptor.ValueDescription)(nil),
 					ReceiverNodes: ([]string)([]string{
 						(string)("MOTOR"),
 					}),
-					DefaultValue: (int)(0),
+					DefaultValue:  (int)(0),
+					SendType:      (descriptor.SendType)(0),
+					InactiveValue: (int)(0),
+					Attributes: ([]*descriptor.Attribute)([]*descriptor.Attribute{
+						(*descriptor.Attribute)(&descriptor.Attribute{
+							Name:        (string)("FieldType"),
+							Type:        (descriptor.AttributeType)(3),
+							IntValue:    (int64)(0),
+							FloatValue:  (float64)(0),
+							StringValue: (string)(""),
+							IsDefault:   (bool)(true),
+						}),
+						(*descriptor.Attribute)(&descriptor.Attribute{
+							Name:        (string)("GenSigStartValue"),
+							Type:        (descriptor.AttributeType)(0),
+							IntValue:    (int64)(0),
+							FloatValue:  (float64)(0),
+							StringValue: (string)(""),
+							IsDefault:   (bool)(true),
+						}),
+					}),
 				}),
 				(*descriptor.Signal)(&descriptor.Signal{
 					Name:              (string)("Drive"),
@@ -3042,22 +4605,68 @@ var d = (*descriptor.Database)(&descriptor.Database{
 					IsMultiplexer:     (bool)(false),
 					IsMultiplexed:     (bool)(false),
 					MultiplexerValue:  (uint)(0),
+					MultiplexerName:   (string)(""),
+					MultiplexerRanges: ([]*descriptor.MultiplexerRange)(nil),
 					Offset:            (float64)(0),
 					Scale:             (float64)(1),
 					Min:               (float64)(0),
 					Max:               (float64)(9),
 					Unit:              (string)(""),
 					Description:       (string)(""),
+					SignalType:        (string)(""),
+					ValueTable:        (string)(""),
 					ValueDescriptions: ([]*descriptor.ValueDescription)(nil),
 					ReceiverNodes: ([]string)([]string{
 						(string)("MOTOR"),
 					}),
-					DefaultValue: (int)(0),
+					DefaultValue:  (int)(0),
+					SendType:      (descriptor.SendType)(0),
+					InactiveValue: (int)(0),
+					Attributes: ([]*descriptor.Attribute)([]*descriptor.Attribute{
+						(*descriptor.Attribute)(&descriptor.Attribute{
+							Name:        (string)("FieldType"),
+							Type:        (descriptor.AttributeType)(3),
+							IntValue:    (int64)(0),
+							FloatValue:  (float64)(0),
+							StringValue: (string)(""),
+							IsDefault:   (bool)(true),
+						}),
+						(*descriptor.Attribute)(&descriptor.Attribute{
+							Name:        (string)("GenSigStartValue"),
+							Type:        (descriptor.AttributeType)(0),
+							IntValue:    (int64)(0),
+							FloatValue:  (float64)(0),
+							StringValue: (string)(""),
+							IsDefault:   (bool)(true),
+						}),
+					}),
+				}),
+			}),
+			SenderNode:       (string)("DRIVER"),
+			TransmitterNodes: ([]string)(nil),
+			SignalGroups:     ([]*descriptor.SignalGroup)(nil),
+			CycleTime:        (time.Duration)(100000000),
+			CycleTimeFast:    (time.Duration)(0),
+			DelayTime:        (time.Duration)(0),
+			RepetitionCount:  (int)(0),
+			Attributes: ([]*descriptor.Attribute)([]*descriptor.Attribute{
+				(*descriptor.Attribute)(&descriptor.Attribute{
+					Name:        (string)("GenMsgCycleTime"),
+					Type:        (descriptor.AttributeType)(0),
+					IntValue:    (int64)(100),
+					FloatValue:  (float64)(0),
+					StringValue: (string)(""),
+					IsDefault:   (bool)(false),
+				}),
+				(*descriptor.Attribute)(&descriptor.Attribute{
+					Name:        (string)("GenMsgSendType"),
+					Type:        (descriptor.AttributeType)(4),
+					IntValue:    (int64)(1),
+					FloatValue:  (float64)(0),
+					StringValue: (string)("Cyclic"),
+					IsDefault:   (bool)(false),
 				}),
 			}),
-			SenderNode: (string)("DRIVER"),
-			CycleTime:  (time.Duration)(100000000),
-			DelayTime:  (time.Duration)(0),
 		}),
 		(*descriptor.Message)(&descriptor.Message{
 			Name:        (string)("SensorSonars"),
@@ -3077,18 +4686,42 @@ var d = (*descriptor.Database)(&descriptor.Database{
 					IsMultiplexer:     (bool)(true),
 					IsMultiplexed:     (bool)(false),
 					MultiplexerValue:  (uint)(0),
+					MultiplexerName:   (string)(""),
+					MultiplexerRanges: ([]*descriptor.MultiplexerRange)(nil),
 					Offset:            (float64)(0),
 					Scale:             (float64)(1),
 					Min:               (float64)(0),
 					Max:               (float64)(0),
 					Unit:              (string)(""),
 					Description:       (string)(""),
+					SignalType:        (string)(""),
+					ValueTable:        (string)(""),
 					ValueDescriptions: ([]*descriptor.ValueDescription)(nil),
 					ReceiverNodes: ([]string)([]string{
 						(string)("DRIVER"),
 						(string)("IO"),
 					}),
-					DefaultValue: (int)(0),
+					DefaultValue:  (int)(0),
+					SendType:      (descriptor.SendType)(0),
+					InactiveValue: (int)(0),
+					Attributes: ([]*descriptor.Attribute)([]*descriptor.Attribute{
+						(*descriptor.Attribute)(&descriptor.Attribute{
+							Name:        (string)("FieldType"),
+							Type:        (descriptor.AttributeType)(3),
+							IntValue:    (int64)(0),
+							FloatValue:  (float64)(0),
+							StringValue: (string)(""),
+							IsDefault:   (bool)(true),
+						}),
+						(*descriptor.Attribute)(&descriptor.Attribute{
+							Name:        (string)("GenSigStartValue"),
+							Type:        (descriptor.AttributeType)(0),
+							IntValue:    (int64)(0),
+							FloatValue:  (float64)(0),
+							StringValue: (string)(""),
+							IsDefault:   (bool)(true),
+						}),
+					}),
 				}),
 				(*descriptor.Signal)(&descriptor.Signal{
 					Name:              (string)("ErrCount"),
@@ -3100,18 +4733,42 @@ var d = (*descriptor.Database)(&descriptor.Database{
 					IsMultiplexer:     (bool)(false),
 					IsMultiplexed:     (bool)(false),
 					MultiplexerValue:  (uint)(0),
+					MultiplexerName:   (string)(""),
+					MultiplexerRanges: ([]*descriptor.MultiplexerRange)(nil),
 					Offset:            (float64)(0),
 					Scale:             (float64)(1),
 					Min:               (float64)(0),
 					Max:               (float64)(0),
 					Unit:              (string)(""),
 					Description:       (string)(""),
+					SignalType:        (string)(""),
+					ValueTable:        (string)(""),
 					ValueDescriptions: ([]*descriptor.ValueDescription)(nil),
 					ReceiverNodes: ([]string)([]string{
 						(string)("DRIVER"),
 						(string)("IO"),
 					}),
-					DefaultValue: (int)(0),
+					DefaultValue:  (int)(0),
+					SendType:      (descriptor.SendType)(0),
+					InactiveValue: (int)(0),
+					Attributes: ([]*descriptor.Attribute)([]*descriptor.Attribute{
+						(*descriptor.Attribute)(&descriptor.Attribute{
+							Name:        (string)("FieldType"),
+							Type:        (descriptor.AttributeType)(3),
+							IntValue:    (int64)(0),
+							FloatValue:  (float64)(0),
+							StringValue: (string)(""),
+							IsDefault:   (bool)(true),
+						}),
+						(*descriptor.Attribute)(&descriptor.Attribute{
+							Name:        (string)("GenSigStartValue"),
+							Type:        (descriptor.AttributeType)(0),
+							IntValue:    (int64)(0),
+							FloatValue:  (float64)(0),
+							StringValue: (string)(""),
+							IsDefault:   (bool)(true),
+						}),
+					}),
 				}),
 				(*descriptor.Signal)(&descriptor.Signal{
 					Name:              (string)("Left"),
@@ -3123,18 +4780,42 @@ var d = (*descriptor.Database)(&descriptor.Database{
 					IsMultiplexer:     (bool)(false),
 					IsMultiplexed:     (bool)(true),
 					MultiplexerValue:  (uint)(0),
+					MultiplexerName:   (string)(""),
+					MultiplexerRanges: ([]*descriptor.MultiplexerRange)(nil),
 					Offset:            (float64)(0),
 					Scale:             (float64)(0.1),
 					Min:               (float64)(0),
 					Max:               (float64)(0),
 					Unit:              (string)(""),
 					Description:       (string)(""),
+					SignalType:        (string)(""),
+					ValueTable:        (string)(""),
 					ValueDescriptions: ([]*descriptor.ValueDescription)(nil),
 					ReceiverNodes: ([]string)([]string{
 						(string)("DRIVER"),
 						(string)("IO"),
 					}),
-					DefaultValue: (int)(0),
+					DefaultValue:  (int)(0),
+					SendType:      (descriptor.SendType)(0),
+					InactiveValue: (int)(0),
+					Attributes: ([]*descriptor.Attribute)([]*descriptor.Attribute{
+						(*descriptor.Attribute)(&descriptor.Attribute{
+							Name:        (string)("FieldType"),
+							Type:        (descriptor.AttributeType)(3),
+							IntValue:    (int64)(0),
+							FloatValue:  (float64)(0),
+							StringValue: (string)(""),
+							IsDefault:   (bool)(true),
+						}),
+						(*descriptor.Attribute)(&descriptor.Attribute{
+							Name:        (string)("GenSigStartValue"),
+							Type:        (descriptor.AttributeType)(0),
+							IntValue:    (int64)(0),
+							FloatValue:  (float64)(0),
+							StringValue: (string)(""),
+							IsDefault:   (bool)(true),
+						}),
+					}),
 				}),
 				(*descriptor.Signal)(&descriptor.Signal{
 					Name:              (string)("NoFiltLeft"),
@@ -3146,17 +4827,41 @@ var d = (*descriptor.Database)(&descriptor.Database{
 					IsMultiplexer:     (bool)(false),
 					IsMultiplexed:     (bool)(true),
 					MultiplexerValue:  (uint)(1),
+					MultiplexerName:   (string)(""),
+					MultiplexerRanges: ([]*descriptor.MultiplexerRange)(nil),
 					Offset:            (float64)(0),
 					Scale:             (float64)(0.1),
 					Min:               (float64)(0),
 					Max:               (float64)(0),
 					Unit:              (string)(""),
 					Description:       (string)(""),
+					SignalType:        (string)(""),
+					ValueTable:        (string)(""),
 					ValueDescriptions: ([]*descriptor.ValueDescription)(nil),
 					ReceiverNodes: ([]string)([]string{
 						(string)("DBG"),
 					}),
-					DefaultValue: (int)(0),
+					DefaultValue:  (int)(0),
+					SendType:      (descriptor.SendType)(0),
+					InactiveValue: (int)(0),
+					Attributes: ([]*descriptor.Attribute)([]*descriptor.Attribute{
+						(*descriptor.Attribute)(&descriptor.Attribute{
+							Name:        (string)("FieldType"),
+							Type:        (descriptor.AttributeType)(3),
+							IntValue:    (int64)(0),
+							FloatValue:  (float64)(0),
+							StringValue: (string)(""),
+							IsDefault:   (bool)(true),
+						}),
+						(*descriptor.Attribute)(&descriptor.Attribute{
+							Name:        (string)("GenSigStartValue"),
+							Type:        (descriptor.AttributeType)(0),
+							IntValue:    (int64)(0),
+							FloatValue:  (float64)(0),
+							StringValue: (string)(""),
+							IsDefault:   (bool)(true),
+						}),
+					}),
 				}),
 				(*descriptor.Signal)(&descriptor.Signal{
 					Name:              (string)("Middle"),
@@ -3168,18 +4873,42 @@ var d = (*descriptor.Database)(&descriptor.Database{
 					IsMultiplexer:     (bool)(false),
 					IsMultiplexed:     (bool)(true),
 					MultiplexerValue:  (uint)(0),
+					MultiplexerName:   (string)(""),
+					MultiplexerRanges: ([]*descriptor.MultiplexerRange)(nil),
 					Offset:            (float64)(0),
 					Scale:             (float64)(0.1),
 					Min:               (float64)(0),
 					Max:               (float64)(0),
 					Unit:              (string)(""),
 					Description:       (string)(""),
+					SignalType:        (string)(""),
+					ValueTable:        (string)(""),
 					ValueDescriptions: ([]*descriptor.ValueDescription)(nil),
 					ReceiverNodes: ([]string)([]string{
 						(string)("DRIVER"),
 						(string)("IO"),
 					}),
-					DefaultValue: (int)(0),
+					DefaultValue:  (int)(0),
+					SendType:      (descriptor.SendType)(0),
+					InactiveValue: (int)(0),
+					Attributes: ([]*descriptor.Attribute)([]*descriptor.Attribute{
+						(*descriptor.Attribute)(&descriptor.Attribute{
+							Name:        (string)("FieldType"),
+							Type:        (descriptor.AttributeType)(3),
+							IntValue:    (int64)(0),
+							FloatValue:  (float64)(0),
+							StringValue: (string)(""),
+							IsDefault:   (bool)(true),
+						}),
+						(*descriptor.Attribute)(&descriptor.Attribute{
+							Name:        (string)("GenSigStartValue"),
+							Type:        (descriptor.AttributeType)(0),
+							IntValue:    (int64)(0),
+							FloatValue:  (float64)(0),
+							StringValue: (string)(""),
+							IsDefault:   (bool)(true),
+						}),
+					}),
 				}),
 				(*descriptor.Signal)(&descriptor.Signal{
 					Name:              (string)("NoFiltMiddle"),
@@ -3191,17 +4920,41 @@ var d = (*descriptor.Database)(&descriptor.Database{
 					IsMultiplexer:     (bool)(false),
 					IsMultiplexed:     (bool)(true),
 					MultiplexerValue:  (uint)(1),
+					MultiplexerName:   (string)(""),
+					MultiplexerRanges: ([]*descriptor.MultiplexerRange)(nil),
 					Offset:            (float64)(0),
 					Scale:             (float64)(0.1),
 					Min:               (float64)(0),
 					Max:               (float64)(0),
 					Unit:              (string)(""),
 					Description:       (string)(""),
+					SignalType:        (string)(""),
+					ValueTable:        (string)(""),
 					ValueDescriptions: ([]*descriptor.ValueDescription)(nil),
 					ReceiverNodes: ([]string)([]string{
 						(string)("DBG"),
 					}),
-					DefaultValue: (int)(0),
+					DefaultValue:  (int)(0),
+					SendType:      (descriptor.SendType)(0),
+					InactiveValue: (int)(0),
+					Attributes: ([]*descriptor.Attribute)([]*descriptor.Attribute{
+						(*descriptor.Attribute)(&descriptor.Attribute{
+							Name:        (string)("FieldType"),
+							Type:        (descriptor.AttributeType)(3),
+							IntValue:    (int64)(0),
+							FloatValue:  (float64)(0),
+							StringValue: (string)(""),
+							IsDefault:   (bool)(true),
+						}),
+						(*descriptor.Attribute)(&descriptor.Attribute{
+							Name:        (string)("GenSigStartValue"),
+							Type:        (descriptor.AttributeType)(0),
+							IntValue:    (int64)(0),
+							FloatValue:  (float64)(0),
+							StringValue: (string)(""),
+							IsDefault:   (bool)(true),
+						}),
+					}),
 				}),
 				(*descriptor.Signal)(&descriptor.Signal{
 					Name:              (string)("Right"),
@@ -3213,18 +4966,42 @@ var d = (*descriptor.Database)(&descriptor.Database{
 					IsMultiplexer:     (bool)(false),
 					IsMultiplexed:     (bool)(true),
 					MultiplexerValue:  (uint)(0),
+					MultiplexerName:   (string)(""),
+					MultiplexerRanges: ([]*descriptor.MultiplexerRange)(nil),
 					Offset:            (float64)(0),
 					Scale:             (float64)(0.1),
 					Min:               (float64)(0),
 					Max:               (float64)(0),
 					Unit:              (string)(""),
 					Description:       (string)(""),
+					SignalType:        (string)(""),
+					ValueTable:        (string)(""),
 					ValueDescriptions: ([]*descriptor.ValueDescription)(nil),
 					ReceiverNodes: ([]string)([]string{
 						(string)("DRIVER"),
 						(string)("IO"),
 					}),
-					DefaultValue: (int)(0),
+					DefaultValue:  (int)(0),
+					SendType:      (descriptor.SendType)(0),
+					InactiveValue: (int)(0),
+					Attributes: ([]*descriptor.Attribute)([]*descriptor.Attribute{
+						(*descriptor.Attribute)(&descriptor.Attribute{
+							Name:        (string)("FieldType"),
+							Type:        (descriptor.AttributeType)(3),
+							IntValue:    (int64)(0),
+							FloatValue:  (float64)(0),
+							StringValue: (string)(""),
+							IsDefault:   (bool)(true),
+						}),
+						(*descriptor.Attribute)(&descriptor.Attribute{
+							Name:        (string)("GenSigStartValue"),
+							Type:        (descriptor.AttributeType)(0),
+							IntValue:    (int64)(0),
+							FloatValue:  (float64)(0),
+							StringValue: (string)(""),
+							IsDefault:   (bool)(true),
+						}),
+					}),
 				}),
 				(*descriptor.Signal)(&descriptor.Signal{
 					Name:              (string)("NoFiltRight"),
@@ -3236,17 +5013,41 @@ var d = (*descriptor.Database)(&descriptor.Database{
 					IsMultiplexer:     (bool)(false),
 					IsMultiplexed:     (bool)(true),
 					MultiplexerValue:  (uint)(1),
+					MultiplexerName:   (string)(""),
+					MultiplexerRanges: ([]*descriptor.MultiplexerRange)(nil),
 					Offset:            (float64)(0),
 					Scale:             (float64)(0.1),
 					Min:               (float64)(0),
 					Max:               (float64)(0),
 					Unit:              (string)(""),
 					Description:       (string)(""),
+					SignalType:        (string)(""),
+					ValueTable:        (string)(""),
 					ValueDescriptions: ([]*descriptor.ValueDescription)(nil),
 					ReceiverNodes: ([]string)([]string{
 						(string)("DBG"),
 					}),
-					DefaultValue: (int)(0),
+					DefaultValue:  (int)(0),
+					SendType:      (descriptor.SendType)(0),
+					InactiveValue: (int)(0),
+					Attributes: ([]*descriptor.Attribute)([]*descriptor.Attribute{
+						(*descriptor.Attribute)(&descriptor.Attribute{
+							Name:        (string)("FieldType"),
+							Type:        (descriptor.AttributeType)(3),
+							IntValue:    (int64)(0),
+							FloatValue:  (float64)(0),
+							StringValue: (string)(""),
+							IsDefault:   (bool)(true),
+						}),
+						(*descriptor.Attribute)(&descriptor.Attribute{
+							Name:        (string)("GenSigStartValue"),
+							Type:        (descriptor.AttributeType)(0),
+							IntValue:    (int64)(0),
+							FloatValue:  (float64)(0),
+							StringValue: (string)(""),
+							IsDefault:   (bool)(true),
+						}),
+					}),
 				}),
 				(*descriptor.Signal)(&descriptor.Signal{
 					Name:              (string)("Rear"),
@@ -3258,18 +5059,42 @@ var d = (*descriptor.Database)(&descriptor.Database{
 					IsMultiplexer:     (bool)(false),
 					IsMultiplexed:     (bool)(true),
 					MultiplexerValue:  (uint)(0),
+					MultiplexerName:   (string)(""),
+					MultiplexerRanges: ([]*descriptor.MultiplexerRange)(nil),
 					Offset:            (float64)(0),
 					Scale:             (float64)(0.1),
 					Min:               (float64)(0),
 					Max:               (float64)(0),
 					Unit:              (string)(""),
 					Description:       (string)(""),
+					SignalType:        (string)(""),
+					ValueTable:        (string)(""),
 					ValueDescriptions: ([]*descriptor.ValueDescription)(nil),
 					ReceiverNodes: ([]string)([]string{
 						(string)("DRIVER"),
 						(string)("IO"),
 					}),
-					DefaultValue: (int)(0),
+					DefaultValue:  (int)(0),
+					SendType:      (descriptor.SendType)(0),
+					InactiveValue: (int)(0),
+					Attributes: ([]*descriptor.Attribute)([]*descriptor.Attribute{
+						(*descriptor.Attribute)(&descriptor.Attribute{
+							Name:        (string)("FieldType"),
+							Type:        (descriptor.AttributeType)(3),
+							IntValue:    (int64)(0),
+							FloatValue:  (float64)(0),
+							StringValue: (string)(""),
+							IsDefault:   (bool)(true),
+						}),
+						(*descriptor.Attribute)(&descriptor.Attribute{
+							Name:        (string)("GenSigStartValue"),
+							Type:        (descriptor.AttributeType)(0),
+							IntValue:    (int64)(0),
+							FloatValue:  (float64)(0),
+							StringValue: (string)(""),
+							IsDefault:   (bool)(true),
+						}),
+					}),
 				}),
 				(*descriptor.Signal)(&descriptor.Signal{
 					Name:              (string)("NoFiltRear"),
@@ -3281,22 +5106,68 @@ var d = (*descriptor.Database)(&descriptor.Database{
 					IsMultiplexer:     (bool)(false),
 					IsMultiplexed:     (bool)(true),
 					MultiplexerValue:  (uint)(1),
+					MultiplexerName:   (string)(""),
+					MultiplexerRanges: ([]*descriptor.MultiplexerRange)(nil),
 					Offset:            (float64)(0),
 					Scale:             (float64)(0.1),
 					Min:               (float64)(0),
 					Max:               (float64)(0),
 					Unit:              (string)(""),
 					Description:       (string)(""),
+					SignalType:        (string)(""),
+					ValueTable:        (string)(""),
 					ValueDescriptions: ([]*descriptor.ValueDescription)(nil),
 					ReceiverNodes: ([]string)([]string{
 						(string)("DBG"),
 					}),
-					DefaultValue: (int)(0),
+					DefaultValue:  (int)(0),
+					SendType:      (descriptor.SendType)(0),
+					InactiveValue: (int)(0),
+					Attributes: ([]*descriptor.Attribute)([]*descriptor.Attribute{
+						(*descriptor.Attribute)(&descriptor.Attribute{
+							Name:        (string)("FieldType"),
+							Type:        (descriptor.AttributeType)(3),
+							IntValue:    (int64)(0),
+							FloatValue:  (float64)(0),
+							StringValue: (string)(""),
+							IsDefault:   (bool)(true),
+						}),
+						(*descriptor.Attribute)(&descriptor.Attribute{
+							Name:        (string)("GenSigStartValue"),
+							Type:        (descriptor.AttributeType)(0),
+							IntValue:    (int64)(0),
+							FloatValue:  (float64)(0),
+							StringValue: (string)(""),
+							IsDefault:   (bool)(true),
+						}),
+					}),
+				}),
+			}),
+			SenderNode:       (string)("SENSOR"),
+			TransmitterNodes: ([]string)(nil),
+			SignalGroups:     ([]*descriptor.SignalGroup)(nil),
+			CycleTime:        (time.Duration)(100000000),
+			CycleTimeFast:    (time.Duration)(0),
+			DelayTime:        (time.Duration)(0),
+			RepetitionCount:  (int)(0),
+			Attributes: ([]*descriptor.Attribute)([]*descriptor.Attribute{
+				(*descriptor.Attribute)(&descriptor.Attribute{
+					Name:        (string)("GenMsgCycleTime"),
+					Type:        (descriptor.AttributeType)(0),
+					IntValue:    (int64)(100),
+					FloatValue:  (float64)(0),
+					StringValue: (string)(""),
+					IsDefault:   (bool)(false),
+				}),
+				(*descriptor.Attribute)(&descriptor.Attribute{
+					Name:        (string)("GenMsgSendType"),
+					Type:        (descriptor.AttributeType)(4),
+					IntValue:    (int64)(1),
+					FloatValue:  (float64)(0),
+					StringValue: (string)("Cyclic"),
+					IsDefault:   (bool)(false),
 				}),
 			}),
-			SenderNode: (string)("SENSOR"),
-			CycleTime:  (time.Duration)(100000000),
-			DelayTime:  (time.Duration)(0),
 		}),
 		(*descriptor.Message)(&descriptor.Message{
 			Name:        (string)("MotorStatus"),
@@ -3316,18 +5187,42 @@ var d = (*descriptor.Database)(&descriptor.Database{
 					IsMultiplexer:     (bool)(false),
 					IsMultiplexed:     (bool)(false),
 					MultiplexerValue:  (uint)(0),
+					MultiplexerName:   (string)(""),
+					MultiplexerRanges: ([]*descriptor.MultiplexerRange)(nil),
 					Offset:            (float64)(0),
 					Scale:             (float64)(1),
 					Min:               (float64)(0),
 					Max:               (float64)(0),
 					Unit:              (string)(""),
 					Description:       (string)(""),
+					SignalType:        (string)(""),
+					ValueTable:        (string)(""),
 					ValueDescriptions: ([]*descriptor.ValueDescription)(nil),
 					ReceiverNodes: ([]string)([]string{
 						(string)("DRIVER"),
 						(string)("IO"),
 					}),
-					DefaultValue: (int)(0),
+					DefaultValue:  (int)(0),
+					SendType:      (descriptor.SendType)(0),
+					InactiveValue: (int)(0),
+					Attributes: ([]*descriptor.Attribute)([]*descriptor.Attribute{
+						(*descriptor.Attribute)(&descriptor.Attribute{
+							Name:        (string)("FieldType"),
+							Type:        (descriptor.AttributeType)(3),
+							IntValue:    (int64)(0),
+							FloatValue:  (float64)(0),
+							StringValue: (string)(""),
+							IsDefault:   (bool)(true),
+						}),
+						(*descriptor.Attribute)(&descriptor.Attribute{
+							Name:        (string)("GenSigStartValue"),
+							Type:        (descriptor.AttributeType)(0),
+							IntValue:    (int64)(0),
+							FloatValue:  (float64)(0),
+							StringValue: (string)(""),
+							IsDefault:   (bool)(true),
+						}),
+					}),
 				}),
 				(*descriptor.Signal)(&descriptor.Signal{
 					Name:              (string)("SpeedKph"),
@@ -3339,23 +5234,69 @@ var d = (*descriptor.Database)(&descriptor.Database{
 					IsMultiplexer:     (bool)(false),
 					IsMultiplexed:     (bool)(false),
 					MultiplexerValue:  (uint)(0),
+					MultiplexerName:   (string)(""),
+					MultiplexerRanges: ([]*descriptor.MultiplexerRange)(nil),
 					Offset:            (float64)(0),
 					Scale:             (float64)(0.001),
 					Min:               (float64)(0),
 					Max:               (float64)(0),
 					Unit:              (string)("km/h"),
 					Description:       (string)(""),
+					SignalType:        (string)(""),
+					ValueTable:        (string)(""),
 					ValueDescriptions: ([]*descriptor.ValueDescription)(nil),
 					ReceiverNodes: ([]string)([]string{
 						(string)("DRIVER"),
 						(string)("IO"),
 					}),
-					DefaultValue: (int)(0),
+					DefaultValue:  (int)(0),
+					SendType:      (descriptor.SendType)(0),
+					InactiveValue: (int)(0),
+					Attributes: ([]*descriptor.Attribute)([]*descriptor.Attribute{
+						(*descriptor.Attribute)(&descriptor.Attribute{
+							Name:        (string)("FieldType"),
+							Type:        (descriptor.AttributeType)(3),
+							IntValue:    (int64)(0),
+							FloatValue:  (float64)(0),
+							StringValue: (string)(""),
+							IsDefault:   (bool)(true),
+						}),
+						(*descriptor.Attribute)(&descriptor.Attribute{
+							Name:        (string)("GenSigStartValue"),
+							Type:        (descriptor.AttributeType)(0),
+							IntValue:    (int64)(0),
+							FloatValue:  (float64)(0),
+							StringValue: (string)(""),
+							IsDefault:   (bool)(true),
+						}),
+					}),
+				}),
+			}),
+			SenderNode:       (string)("MOTOR"),
+			TransmitterNodes: ([]string)(nil),
+			SignalGroups:     ([]*descriptor.SignalGroup)(nil),
+			CycleTime:        (time.Duration)(100000000),
+			CycleTimeFast:    (time.Duration)(0),
+			DelayTime:        (time.Duration)(0),
+			RepetitionCount:  (int)(0),
+			Attributes: ([]*descriptor.Attribute)([]*descriptor.Attribute{
+				(*descriptor.Attribute)(&descriptor.Attribute{
+					Name:        (string)("GenMsgCycleTime"),
+					Type:        (descriptor.AttributeType)(0),
+					IntValue:    (int64)(100),
+					FloatValue:  (float64)(0),
+					StringValue: (string)(""),
+					IsDefault:   (bool)(false),
+				}),
+				(*descriptor.Attribute)(&descriptor.Attribute{
+					Name:        (string)("GenMsgSendType"),
+					Type:        (descriptor.AttributeType)(4),
+					IntValue:    (int64)(1),
+					FloatValue:  (float64)(0),
+					StringValue: (string)("Cyclic"),
+					IsDefault:   (bool)(false),
 				}),
 			}),
-			SenderNode: (string)("MOTOR"),
-			CycleTime:  (time.Duration)(100000000),
-			DelayTime:  (time.Duration)(0),
 		}),
 		(*descriptor.Message)(&descriptor.Message{
 			Name:        (string)("IODebug"),
@@ -3375,34 +5316,62 @@ var d = (*descriptor.Database)(&descriptor.Database{
 					IsMultiplexer:     (bool)(false),
 					IsMultiplexed:     (bool)(false),
 					MultiplexerValue:  (uint)(0),
+					MultiplexerName:   (string)(""),
+					MultiplexerRanges: ([]*descriptor.MultiplexerRange)(nil),
 					Offset:            (float64)(0),
 					Scale:             (float64)(1),
 					Min:               (float64)(0),
 					Max:               (float64)(0),
 					Unit:              (string)(""),
 					Description:       (string)(""),
+					SignalType:        (string)(""),
+					ValueTable:        (string)(""),
 					ValueDescriptions: ([]*descriptor.ValueDescription)(nil),
 					ReceiverNodes: ([]string)([]string{
 						(string)("DBG"),
 					}),
-					DefaultValue: (int)(0),
+					DefaultValue:  (int)(0),
+					SendType:      (descriptor.SendType)(0),
+					InactiveValue: (int)(0),
+					Attributes: ([]*descriptor.Attribute)([]*descriptor.Attribute{
+						(*descriptor.Attribute)(&descriptor.Attribute{
+							Name:        (string)("FieldType"),
+							Type:        (descriptor.AttributeType)(3),
+							IntValue:    (int64)(0),
+							FloatValue:  (float64)(0),
+							StringValue: (string)(""),
+							IsDefault:   (bool)(true),
+						}),
+						(*descriptor.Attribute)(&descriptor.Attribute{
+							Name:        (string)("GenSigStartValue"),
+							Type:        (descriptor.AttributeType)(0),
+							IntValue:    (int64)(0),
+							FloatValue:  (float64)(0),
+							StringValue: (string)(""),
+							IsDefault:   (bool)(true),
+						}),
+					}),
 				}),
 				(*descriptor.Signal)(&descriptor.Signal{
-					Name:             (string)("TestEnum"),
-					Start:            (uint8)(8),
-					Length:           (uint8)(6),
-					IsBigEndian:      (bool)(false),
-					IsSigned:         (bool)(false),
-					IsFloat:          (bool)(false),
-					IsMultiplexer:    (bool)(false),
-					IsMultiplexed:    (bool)(false),
-					MultiplexerValue: (uint)(0),
-					Offset:           (float64)(0),
-					Scale:            (float64)(1),
-					Min:              (float64)(0),
-					Max:              (float64)(0),
-					Unit:             (string)(""),
-					Description:      (string)(""),
+					Name:              (string)("TestEnum"),
+					Start:             (uint8)(8),
+					Length:            (uint8)(6),
+					IsBigEndian:       (bool)(false),
+					IsSigned:          (bool)(false),
+					IsFloat:           (bool)(false),
+					IsMultiplexer:     (bool)(false),
+					IsMultiplexed:     (bool)(false),
+					MultiplexerValue:  (uint)(0),
+					MultiplexerName:   (string)(""),
+					MultiplexerRanges: ([]*descriptor.MultiplexerRange)(nil),
+					Offset:            (float64)(0),
+					Scale:             (float64)(1),
+					Min:               (float64)(0),
+					Max:               (float64)(0),
+					Unit:              (string)(""),
+					Description:       (string)(""),
+					SignalType:        (string)(""),
+					ValueTable:        (string)(""),
 					ValueDescriptions: ([]*descriptor.ValueDescription)([]*descriptor.ValueDescription{
 						(*descriptor.ValueDescription)(&descriptor.ValueDescription{
 							Value:       (int64)(1),
@@ -3416,7 +5385,27 @@ var d = (*descriptor.Database)(&descriptor.Database{
 					ReceiverNodes: ([]string)([]string{
 						(string)("DBG"),
 					}),
-					DefaultValue: (int)(2),
+					DefaultValue:  (int)(2),
+					SendType:      (descriptor.SendType)(0),
+					InactiveValue: (int)(0),
+					Attributes: ([]*descriptor.Attribute)([]*descriptor.Attribute{
+						(*descriptor.Attribute)(&descriptor.Attribute{
+							Name:        (string)("FieldType"),
+							Type:        (descriptor.AttributeType)(3),
+							IntValue:    (int64)(0),
+							FloatValue:  (float64)(0),
+							StringValue: (string)("TestEnum"),
+							IsDefault:   (bool)(false),
+						}),
+						(*descriptor.Attribute)(&descriptor.Attribute{
+							Name:        (string)("GenSigStartValue"),
+							Type:        (descriptor.AttributeType)(0),
+							IntValue:    (int64)(2),
+							FloatValue:  (float64)(0),
+							StringValue: (string)(""),
+							IsDefault:   (bool)(false),
+						}),
+					}),
 				}),
 				(*descriptor.Signal)(&descriptor.Signal{
 					Name:              (string)("TestSigned"),
@@ -3428,17 +5417,41 @@ var d = (*descriptor.Database)(&descriptor.Database{
 					IsMultiplexer:     (bool)(false),
 					IsMultiplexed:     (bool)(false),
 					MultiplexerValue:  (uint)(0),
+					MultiplexerName:   (string)(""),
+					MultiplexerRanges: ([]*descriptor.MultiplexerRange)(nil),
 					Offset:            (float64)(0),
 					Scale:             (float64)(1),
 					Min:               (float64)(0),
 					Max:               (float64)(0),
 					Unit:              (string)(""),
 					Description:       (string)(""),
+					SignalType:        (string)(""),
+					ValueTable:        (string)(""),
 					ValueDescriptions: ([]*descriptor.ValueDescription)(nil),
 					ReceiverNodes: ([]string)([]string{
 						(string)("DBG"),
 					}),
-					DefaultValue: (int)(0),
+					DefaultValue:  (int)(0),
+					SendType:      (descriptor.SendType)(0),
+					InactiveValue: (int)(0),
+					Attributes: ([]*descriptor.Attribute)([]*descriptor.Attribute{
+						(*descriptor.Attribute)(&descriptor.Attribute{
+							Name:        (string)("FieldType"),
+							Type:        (descriptor.AttributeType)(3),
+							IntValue:    (int64)(0),
+							FloatValue:  (float64)(0),
+							StringValue: (string)(""),
+							IsDefault:   (bool)(true),
+						}),
+						(*descriptor.Attribute)(&descriptor.Attribute{
+							Name:        (string)("GenSigStartValue"),
+							Type:        (descriptor.AttributeType)(0),
+							IntValue:    (int64)(0),
+							FloatValue:  (float64)(0),
+							StringValue: (string)(""),
+							IsDefault:   (bool)(true),
+						}),
+					}),
 				}),
 				(*descriptor.Signal)(&descriptor.Signal{
 					Name:              (string)("TestFloat"),
@@ -3450,34 +5463,62 @@ var d = (*descriptor.Database)(&descriptor.Database{
 					IsMultiplexer:     (bool)(false),
 					IsMultiplexed:     (bool)(false),
 					MultiplexerValue:  (uint)(0),
+					MultiplexerName:   (string)(""),
+					MultiplexerRanges: ([]*descriptor.MultiplexerRange)(nil),
 					Offset:            (float64)(0),
 					Scale:             (float64)(0.5),
 					Min:               (float64)(0),
 					Max:               (float64)(0),
 					Unit:              (string)(""),
 					Description:       (string)(""),
+					SignalType:        (string)(""),
+					ValueTable:        (string)(""),
 					ValueDescriptions: ([]*descriptor.ValueDescription)(nil),
 					ReceiverNodes: ([]string)([]string{
 						(string)("DBG"),
 					}),
-					DefaultValue: (int)(0),
+					DefaultValue:  (int)(0),
+					SendType:      (descriptor.SendType)(0),
+					InactiveValue: (int)(0),
+					Attributes: ([]*descriptor.Attribute)([]*descriptor.Attribute{
+						(*descriptor.Attribute)(&descriptor.Attribute{
+							Name:        (string)("FieldType"),
+							Type:        (descriptor.AttributeType)(3),
+							IntValue:    (int64)(0),
+							FloatValue:  (float64)(0),
+							StringValue: (string)(""),
+							IsDefault:   (bool)(true),
+						}),
+						(*descriptor.Attribute)(&descriptor.Attribute{
+							Name:        (string)("GenSigStartValue"),
+							Type:        (descriptor.AttributeType)(0),
+							IntValue:    (int64)(0),
+							FloatValue:  (float64)(0),
+							StringValue: (string)(""),
+							IsDefault:   (bool)(true),
+						}),
+					}),
 				}),
 				(*descriptor.Signal)(&descriptor.Signal{
-					Name:             (string)("TestBoolEnum"),
-					Start:            (uint8)(32),
-					Length:           (uint8)(1),
-					IsBigEndian:      (bool)(false),
-					IsSigned:         (bool)(false),
-					IsFloat:          (bool)(false),
-					IsMultiplexer:    (bool)(false),
-					IsMultiplexed:    (bool)(false),
-					MultiplexerValue: (uint)(0),
-					Offset:           (float64)(0),
-					Scale:            (float64)(1),
-					Min:              (float64)(0),
-					Max:              (float64)(0),
-					Unit:             (string)(""),
-					Description:      (string)(""),
+					Name:              (string)("TestBoolEnum"),
+					Start:             (uint8)(32),
+					Length:            (uint8)(1),
+					IsBigEndian:       (bool)(false),
+					IsSigned:          (bool)(false),
+					IsFloat:           (bool)(false),
+					IsMultiplexer:     (bool)(false),
+					IsMultiplexed:     (bool)(false),
+					MultiplexerValue:  (uint)(0),
+					MultiplexerName:   (string)(""),
+					MultiplexerRanges: ([]*descriptor.MultiplexerRange)(nil),
+					Offset:            (float64)(0),
+					Scale:             (float64)(1),
+					Min:               (float64)(0),
+					Max:               (float64)(0),
+					Unit:              (string)(""),
+					Description:       (string)(""),
+					SignalType:        (string)(""),
+					ValueTable:        (string)(""),
 					ValueDescriptions: ([]*descriptor.ValueDescription)([]*descriptor.ValueDescription{
 						(*descriptor.ValueDescription)(&descriptor.ValueDescription{
 							Value:       (int64)(0),
@@ -3491,24 +5532,48 @@ var d = (*descriptor.Database)(&descriptor.Database{
 					ReceiverNodes: ([]string)([]string{
 						(string)("DBG"),
 					}),
-					DefaultValue: (int)(0),
+					DefaultValue:  (int)(0),
+					SendType:      (descriptor.SendType)(0),
+					InactiveValue: (int)(0),
+					Attributes: ([]*descriptor.Attribute)([]*descriptor.Attribute{
+						(*descriptor.Attribute)(&descriptor.Attribute{
+							Name:        (string)("FieldType"),
+							Type:        (descriptor.AttributeType)(3),
+							IntValue:    (int64)(0),
+							FloatValue:  (float64)(0),
+							StringValue: (string)(""),
+							IsDefault:   (bool)(true),
+						}),
+						(*descriptor.Attribute)(&descriptor.Attribute{
+							Name:        (string)("GenSigStartValue"),
+							Type:        (descriptor.AttributeType)(0),
+							IntValue:    (int64)(0),
+							FloatValue:  (float64)(0),
+							StringValue: (string)(""),
+							IsDefault:   (bool)(true),
+						}),
+					}),
 				}),
 				(*descriptor.Signal)(&descriptor.Signal{
-					Name:             (string)("TestScaledEnum"),
-					Start:            (uint8)(40),
-					Length:           (uint8)(2),
-					IsBigEndian:      (bool)(false),
-					IsSigned:         (bool)(false),
-					IsFloat:          (bool)(false),
-					IsMultiplexer:    (bool)(false),
-					IsMultiplexed:    (bool)(false),
-					MultiplexerValue: (uint)(0),
-					Offset:           (float64)(0),
-					Scale:            (float64)(2),
-					Min:              (float64)(0),
-					Max:              (float64)(6),
-					Unit:             (string)(""),
-					Description:      (string)(""),
+					Name:              (string)("TestScaledEnum"),
+					Start:             (uint8)(40),
+					Length:            (uint8)(2),
+					IsBigEndian:       (bool)(false),
+					IsSigned:          (bool)(false),
+					IsFloat:           (bool)(false),
+					IsMultiplexer:     (bool)(false),
+					IsMultiplexed:     (bool)(false),
+					MultiplexerValue:  (uint)(0),
+					MultiplexerName:   (string)(""),
+					MultiplexerRanges: ([]*descriptor.MultiplexerRange)(nil),
+					Offset:            (float64)(0),
+					Scale:             (float64)(2),
+					Min:               (float64)(0),
+					Max:               (float64)(6),
+					Unit:              (string)(""),
+					Description:       (string)(""),
+					SignalType:        (string)(""),
+					ValueTable:        (string)(""),
 					ValueDescriptions: ([]*descriptor.ValueDescription)([]*descriptor.ValueDescription{
 						(*descriptor.ValueDescription)(&descriptor.ValueDescription{
 							Value:       (int64)(0),
@@ -3530,12 +5595,54 @@ var d = (*descriptor.Database)(&descriptor.Database{
 					ReceiverNodes: ([]string)([]string{
 						(string)("DBG"),
 					}),
-					DefaultValue: (int)(0),
+					DefaultValue:  (int)(0),
+					SendType:      (descriptor.SendType)(0),
+					InactiveValue: (int)(0),
+					Attributes: ([]*descriptor.Attribute)([]*descriptor.Attribute{
+						(*descriptor.Attribute)(&descriptor.Attribute{
+							Name:        (string)("FieldType"),
+							Type:        (descriptor.AttributeType)(3),
+							IntValue:    (int64)(0),
+							FloatValue:  (float64)(0),
+							StringValue: (string)(""),
+							IsDefault:   (bool)(true),
+						}),
+						(*descriptor.Attribute)(&descriptor.Attribute{
+							Name:        (string)("GenSigStartValue"),
+							Type:        (descriptor.AttributeType)(0),
+							IntValue:    (int64)(0),
+							FloatValue:  (float64)(0),
+							StringValue: (string)(""),
+							IsDefault:   (bool)(true),
+						}),
+					}),
+				}),
+			}),
+			SenderNode:       (string)("IO"),
+			TransmitterNodes: ([]string)(nil),
+			SignalGroups:     ([]*descriptor.SignalGroup)(nil),
+			CycleTime:        (time.Duration)(0),
+			CycleTimeFast:    (time.Duration)(0),
+			DelayTime:        (time.Duration)(0),
+			RepetitionCount:  (int)(0),
+			Attributes: ([]*descriptor.Attribute)([]*descriptor.Attribute{
+				(*descriptor.Attribute)(&descriptor.Attribute{
+					Name:        (string)("GenMsgCycleTime"),
+					Type:        (descriptor.AttributeType)(0),
+					IntValue:    (int64)(0),
+					FloatValue:  (float64)(0),
+					StringValue: (string)(""),
+					IsDefault:   (bool)(true),
+				}),
+				(*descriptor.Attribute)(&descriptor.Attribute{
+					Name:        (string)("GenMsgSendType"),
+					Type:        (descriptor.AttributeType)(4),
+					IntValue:    (int64)(2),
+					FloatValue:  (float64)(0),
+					StringValue: (string)("OnEvent"),
+					IsDefault:   (bool)(false),
 				}),
 			}),
-			SenderNode: (string)("IO"),
-			CycleTime:  (time.Duration)(0),
-			DelayTime:  (time.Duration)(0),
 		}),
 		(*descriptor.Message)(&descriptor.Message{
 			Name:        (string)("IOFloat32"),
@@ -3555,17 +5662,41 @@ var d = (*descriptor.Database)(&descriptor.Database{
 					IsMultiplexer:     (bool)(false),
 					IsMultiplexed:     (bool)(false),
 					MultiplexerValue:  (uint)(0),
+					MultiplexerName:   (string)(""),
+					MultiplexerRanges: ([]*descriptor.MultiplexerRange)(nil),
 					Offset:            (float64)(0),
 					Scale:             (float64)(1),
 					Min:               (float64)(0),
 					Max:               (float64)(0),
 					Unit:              (string)(""),
 					Description:       (string)(""),
+					SignalType:        (string)(""),
+					ValueTable:        (string)(""),
 					ValueDescriptions: ([]*descriptor.ValueDescription)(nil),
 					ReceiverNodes: ([]string)([]string{
 						(string)("DBG"),
 					}),
-					DefaultValue: (int)(0),
+					DefaultValue:  (int)(0),
+					SendType:      (descriptor.SendType)(0),
+					InactiveValue: (int)(0),
+					Attributes: ([]*descriptor.Attribute)([]*descriptor.Attribute{
+						(*descriptor.Attribute)(&descriptor.Attribute{
+							Name:        (string)("FieldType"),
+							Type:        (descriptor.AttributeType)(3),
+							IntValue:    (int64)(0),
+							FloatValue:  (float64)(0),
+							StringValue: (string)(""),
+							IsDefault:   (bool)(true),
+						}),
+						(*descriptor.Attribute)(&descriptor.Attribute{
+							Name:        (string)("GenSigStartValue"),
+							Type:        (descriptor.AttributeType)(0),
+							IntValue:    (int64)(0),
+							FloatValue:  (float64)(0),
+							StringValue: (string)(""),
+							IsDefault:   (bool)(true),
+						}),
+					}),
 				}),
 				(*descriptor.Signal)(&descriptor.Signal{
 					Name:              (string)("Float32WithRange"),
@@ -3577,22 +5708,60 @@ var d = (*descriptor.Database)(&descriptor.Database{
 					IsMultiplexer:     (bool)(false),
 					IsMultiplexed:     (bool)(false),
 					MultiplexerValue:  (uint)(0),
+					MultiplexerName:   (string)(""),
+					MultiplexerRanges: ([]*descriptor.MultiplexerRange)(nil),
 					Offset:            (float64)(0),
 					Scale:             (float64)(1),
 					Min:               (float64)(-100),
 					Max:               (float64)(100),
 					Unit:              (string)(""),
 					Description:       (string)(""),
+					SignalType:        (string)(""),
+					ValueTable:        (string)(""),
 					ValueDescriptions: ([]*descriptor.ValueDescription)(nil),
 					ReceiverNodes: ([]string)([]string{
 						(string)("DBG"),
 					}),
-					DefaultValue: (int)(0),
+					DefaultValue:  (int)(0),
+					SendType:      (descriptor.SendType)(0),
+					InactiveValue: (int)(0),
+					Attributes: ([]*descriptor.Attribute)([]*descriptor.Attribute{
+						(*descriptor.Attribute)(&descriptor.Attribute{
+							Name:        (string)("FieldType"),
+							Type:        (descriptor.AttributeType)(3),
+							IntValue:    (int64)(0),
+							FloatValue:  (float64)(0),
+							StringValue: (string)(""),
+							IsDefault:   (bool)(true),
+						}),
+						(*descriptor.Attribute)(&descriptor.Attribute{
+							Name:        (string)("GenSigStartValue"),
+							Type:        (descriptor.AttributeType)(0),
+							IntValue:    (int64)(0),
+							FloatValue:  (float64)(0),
+							StringValue: (string)(""),
+							IsDefault:   (bool)(true),
+						}),
+					}),
+				}),
+			}),
+			SenderNode:       (string)("IO"),
+			TransmitterNodes: ([]string)(nil),
+			SignalGroups:     ([]*descriptor.SignalGroup)(nil),
+			CycleTime:        (time.Duration)(0),
+			CycleTimeFast:    (time.Duration)(0),
+			DelayTime:        (time.Duration)(0),
+			RepetitionCount:  (int)(0),
+			Attributes: ([]*descriptor.Attribute)([]*descriptor.Attribute{
+				(*descriptor.Attribute)(&descriptor.Attribute{
+					Name:        (string)("GenMsgCycleTime"),
+					Type:        (descriptor.AttributeType)(0),
+					IntValue:    (int64)(0),
+					FloatValue:  (float64)(0),
+					StringValue: (string)(""),
+					IsDefault:   (bool)(true),
 				}),
 			}),
-			SenderNode: (string)("IO"),
-			CycleTime:  (time.Duration)(0),
-			DelayTime:  (time.Duration)(0),
 		}),
 		(*descriptor.Message)(&descriptor.Message{
 			Name:        (string)("SignalNameFormatting"),
@@ -3612,44 +5781,136 @@ var d = (*descriptor.Database)(&descriptor.Database{
 					IsMultiplexer:     (bool)(false),
 					IsMultiplexed:     (bool)(false),
 					MultiplexerValue:  (uint)(0),
+					MultiplexerName:   (string)(""),
+					MultiplexerRanges: ([]*descriptor.MultiplexerRange)(nil),
 					Offset:            (float64)(0),
 					Scale:             (float64)(1),
 					Min:               (float64)(0),
 					Max:               (float64)(0),
 					Unit:              (string)(""),
 					Description:       (string)(""),
+					SignalType:        (string)(""),
+					ValueTable:        (string)(""),
 					ValueDescriptions: ([]*descriptor.ValueDescription)(nil),
 					ReceiverNodes: ([]string)([]string{
 						(string)("DBG"),
 					}),
-					DefaultValue: (int)(0),
+					DefaultValue:  (int)(0),
+					SendType:      (descriptor.SendType)(0),
+					InactiveValue: (int)(0),
+					Attributes: ([]*descriptor.Attribute)([]*descriptor.Attribute{
+						(*descriptor.Attribute)(&descriptor.Attribute{
+							Name:        (string)("FieldType"),
+							Type:        (descriptor.AttributeType)(3),
+							IntValue:    (int64)(0),
+							FloatValue:  (float64)(0),
+							StringValue: (string)(""),
+							IsDefault:   (bool)(true),
+						}),
+						(*descriptor.Attribute)(&descriptor.Attribute{
+							Name:        (string)("GenSigStartValue"),
+							Type:        (descriptor.AttributeType)(0),
+							IntValue:    (int64)(0),
+							FloatValue:  (float64)(0),
+							StringValue: (string)(""),
+							IsDefault:   (bool)(true),
+						}),
+					}),
+				}),
+			}),
+			SenderNode:       (string)("IO"),
+			TransmitterNodes: ([]string)(nil),
+			SignalGroups:     ([]*descriptor.SignalGroup)(nil),
+			CycleTime:        (time.Duration)(0),
+			CycleTimeFast:    (time.Duration)(0),
+			DelayTime:        (time.Duration)(0),
+			RepetitionCount:  (int)(0),
+			Attributes: ([]*descriptor.Attribute)([]*descriptor.Attribute{
+				(*descriptor.Attribute)(&descriptor.Attribute{
+					Name:        (string)("GenMsgCycleTime"),
+					Type:        (descriptor.AttributeType)(0),
+					IntValue:    (int64)(0),
+					FloatValue:  (float64)(0),
+					StringValue: (string)(""),
+					IsDefault:   (bool)(true),
 				}),
 			}),
-			SenderNode: (string)("IO"),
-			CycleTime:  (time.Duration)(0),
-			DelayTime:  (time.Duration)(0),
 		}),
 	}),
 	Nodes: ([]*descriptor.Node)([]*descriptor.Node{
 		(*descriptor.Node)(&descriptor.Node{
 			Name:        (string)("DBG"),
 			Description: (string)(""),
+			Attributes:  ([]*descriptor.Attribute)(nil),
 		}),
 		(*descriptor.Node)(&descriptor.Node{
 			Name:        (string)("DRIVER"),
 			Description: (string)("The driver controller driving the car"),
+			Attributes:  ([]*descriptor.Attribute)(nil),
 		}),
 		(*descriptor.Node)(&descriptor.Node{
 			Name:        (string)("IO"),
 			Description: (string)(""),
+			Attributes:  ([]*descriptor.Attribute)(nil),
 		}),
 		(*descriptor.Node)(&descriptor.Node{
 			Name:        (string)("MOTOR"),
 			Description: (string)("The motor controller of the car"),
+			Attributes:  ([]*descriptor.Attribute)(nil),
 		}),
 		(*descriptor.Node)(&descriptor.Node{
 			Name:        (string)("SENSOR"),
 			Description: (string)("The sensor controller of the car"),
+			Attributes:  ([]*descriptor.Attribute)(nil),
+		}),
+	}),
+	ValueTables: ([]*descriptor.ValueTable)(nil),
+	EnvironmentVariables: ([]*descriptor.EnvironmentVariable)([]*descriptor.EnvironmentVariable{
+		(*descriptor.EnvironmentVariable)(&descriptor.EnvironmentVariable{
+			Name:         (string)("BrakeEngaged"),
+			ID:           (uint64)(10),
+			Type:         (descriptor.EnvironmentVariableType)(0),
+			Min:          (float64)(0),
+			Max:          (float64)(1),
+			Unit:         (string)(""),
+			InitialValue: (float64)(0),
+			DataSize:     (uint64)(0),
+			AccessType:   (descriptor.AccessType)(0),
+			AccessNodes: ([]string)([]string{
+				(string)("Vector__XXX"),
+			}),
+			Description:       (string)("Brake fully engaged"),
+			ValueTable:        (string)(""),
+			ValueDescriptions: ([]*descriptor.ValueDescription)(nil),
+			Attributes:        ([]*descriptor.Attribute)(nil),
+		}),
+		(*descriptor.EnvironmentVariable)(&descriptor.EnvironmentVariable{
+			Name:         (string)("Torque"),
+			ID:           (uint64)(16),
+			Type:         (descriptor.EnvironmentVariableType)(1),
+			Min:          (float64)(0),
+			Max:          (float64)(30000),
+			Unit:         (string)("mNm"),
+			InitialValue: (float64)(500),
+			DataSize:     (uint64)(0),
+			AccessType:   (descriptor.AccessType)(0),
+			AccessNodes: ([]string)([]string{
+				(string)("Vector__XXX"),
+			}),
+			Description:       (string)(""),
+			ValueTable:        (string)(""),
+			ValueDescriptions: ([]*descriptor.ValueDescription)(nil),
+			Attributes:        ([]*descriptor.Attribute)(nil),
+		}),
+	}),
+	Attributes: ([]*descriptor.Attribute)([]*descriptor.Attribute{
+		(*descriptor.Attribute)(&descriptor.Attribute{
+			Name:        (string)("BusType"),
+			Type:        (descriptor.AttributeType)(3),
+			IntValue:    (int64)(0),
+			FloatValue:  (float64)(0),
+			StringValue: (string)("CAN"),
+			IsDefault:   (bool)(true),
 		}),
 	}),
 })
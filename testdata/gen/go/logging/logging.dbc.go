@@ -0,0 +1,913 @@
+// Package loggingcan provides primitives for encoding and decoding logging CAN messages.
+//
+// Source: testdata/dbc/logging/logging.dbc
+package loggingcan
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"go.einride.tech/can"
+	"go.einride.tech/can/pkg/candebug"
+	"go.einride.tech/can/pkg/canrunner"
+	"go.einride.tech/can/pkg/cantext"
+	"go.einride.tech/can/pkg/descriptor"
+	"go.einride.tech/can/pkg/generated"
+	"go.einride.tech/can/pkg/socketcan"
+)
+
+// prevent unused imports
+var (
+	_ = context.Background
+	_ = fmt.Print
+	_ = math.Float32frombits
+	_ = net.Dial
+	_ = http.Error
+	_ = sync.Mutex{}
+	_ = time.Now
+	_ = socketcan.Dial
+	_ = candebug.ServeMessagesHTTP
+	_ = canrunner.Run
+)
+
+// Generated code. DO NOT EDIT.
+// MotorStatusReader provides read access to a MotorStatus message.
+type MotorStatusReader interface {
+	can.FrameMarshaler
+	// Speed returns the physical value of the Speed signal.
+	Speed() float64
+	// RawSpeed returns the raw (encoded) value of the Speed signal.
+	RawSpeed() uint16
+	// State returns the value of the State signal.
+	State() MotorStatus_State
+}
+
+// MotorStatusWriter provides write access to a MotorStatus message.
+type MotorStatusWriter interface {
+	// CopyFrom copies all values from MotorStatus.
+	CopyFrom(MotorStatusReader) *MotorStatus
+	// SetSpeed sets the physical value of the Speed signal.
+	SetSpeed(float64) *MotorStatus
+	// SetRawSpeed sets the raw (encoded) value of the Speed signal.
+	SetRawSpeed(uint16) *MotorStatus
+	// SetState sets the value of the State signal.
+	SetState(MotorStatus_State) *MotorStatus
+}
+
+type MotorStatus struct {
+	xxx_Speed uint16
+	xxx_State MotorStatus_State
+}
+
+func NewMotorStatus() *MotorStatus {
+	m := &MotorStatus{}
+	m.Reset()
+	return m
+}
+
+func (m *MotorStatus) Reset() {
+	m.xxx_Speed = 0
+	m.xxx_State = 0
+}
+
+func (m *MotorStatus) CopyFrom(o MotorStatusReader) *MotorStatus {
+	f, _ := o.MarshalFrame()
+	_ = m.UnmarshalFrame(f)
+	return m
+}
+
+// Descriptor returns the MotorStatus descriptor.
+func (m *MotorStatus) Descriptor() *descriptor.Message {
+	return Messages().MotorStatus.Message
+}
+
+// String returns a compact string representation of the message.
+func (m *MotorStatus) String() string {
+	return cantext.MessageString(m)
+}
+
+func (m *MotorStatus) Speed() float64 {
+	return Messages().MotorStatus.Speed.ToPhysical(float64(m.xxx_Speed))
+}
+
+func (m *MotorStatus) SetSpeed(v float64) *MotorStatus {
+	m.xxx_Speed = uint16(Messages().MotorStatus.Speed.FromPhysical(v))
+	return m
+}
+
+func (m *MotorStatus) RawSpeed() uint16 {
+	return m.xxx_Speed
+}
+
+func (m *MotorStatus) SetRawSpeed(v uint16) *MotorStatus {
+	m.xxx_Speed = uint16(Messages().MotorStatus.Speed.SaturatedCastUnsigned(uint64(v)))
+	return m
+}
+
+func (m *MotorStatus) State() MotorStatus_State {
+	return m.xxx_State
+}
+
+func (m *MotorStatus) SetState(v MotorStatus_State) *MotorStatus {
+	m.xxx_State = MotorStatus_State(Messages().MotorStatus.State.SaturatedCastUnsigned(uint64(v)))
+	return m
+}
+
+// MotorStatus_State models the State signal of the MotorStatus message.
+type MotorStatus_State uint8
+
+// Value descriptions for the State signal of the MotorStatus message.
+const (
+	MotorStatus_State_Off       MotorStatus_State = 0
+	MotorStatus_State_Onrunning MotorStatus_State = 1
+)
+
+func (v MotorStatus_State) String() string {
+	switch v {
+	case 0:
+		return "Off"
+	case 1:
+		return "On, running"
+	default:
+		return fmt.Sprintf("MotorStatus_State(%d)", v)
+	}
+}
+
+// Frame returns a CAN frame representing the message.
+func (m *MotorStatus) Frame() can.Frame {
+	md := Messages().MotorStatus
+	f := can.Frame{ID: md.ID, IsExtended: md.IsExtended, Length: md.Length}
+	md.Speed.MarshalUnsigned(&f.Data, uint64(m.xxx_Speed))
+	md.State.MarshalUnsigned(&f.Data, uint64(m.xxx_State))
+	return f
+}
+
+// MarshalFrame encodes the message as a CAN frame.
+func (m *MotorStatus) MarshalFrame() (can.Frame, error) {
+	return m.Frame(), nil
+}
+
+// UnmarshalFrame decodes the message from a CAN frame.
+func (m *MotorStatus) UnmarshalFrame(f can.Frame) error {
+	md := Messages().MotorStatus
+	switch {
+	case f.ID != md.ID:
+		return fmt.Errorf(
+			"unmarshal MotorStatus: expects ID 100 (got %s with ID %d)", f.String(), f.ID,
+		)
+	case f.Length != md.Length:
+		return fmt.Errorf(
+			"unmarshal MotorStatus: expects length 3 (got %s with length %d)", f.String(), f.Length,
+		)
+	case f.IsRemote:
+		return fmt.Errorf(
+			"unmarshal MotorStatus: expects non-remote frame (got remote frame %s)", f.String(),
+		)
+	case f.IsExtended != md.IsExtended:
+		return fmt.Errorf(
+			"unmarshal MotorStatus: expects standard ID (got %s with extended ID)", f.String(),
+		)
+	}
+	m.xxx_Speed = uint16(md.Speed.UnmarshalUnsigned(f.Data))
+	m.xxx_State = MotorStatus_State(md.State.UnmarshalUnsigned(f.Data))
+	return nil
+}
+
+// DecodeFrame decodes the message from a CAN frame, with the bit layout of each signal resolved at generation
+// time.
+//
+// DecodeFrame decodes the same values as UnmarshalFrame, without allocating unless the frame is invalid, for
+// decoding frames at high rates into reused messages.
+func (m *MotorStatus) DecodeFrame(f *can.Frame) error {
+	switch {
+	case f.ID != 100:
+		return fmt.Errorf(
+			"decode MotorStatus: expects ID 100 (got %s with ID %d)", f.String(), f.ID,
+		)
+	case f.Length != 3:
+		return fmt.Errorf(
+			"decode MotorStatus: expects length 3 (got %s with length %d)", f.String(), f.Length,
+		)
+	case f.IsRemote:
+		return fmt.Errorf(
+			"decode MotorStatus: expects non-remote frame (got remote frame %s)", f.String(),
+		)
+	case f.IsExtended:
+		return fmt.Errorf(
+			"decode MotorStatus: expects standard ID (got %s with extended ID)", f.String(),
+		)
+	}
+	le := f.Data.PackLittleEndian()
+	m.xxx_Speed = uint16(le & 0xffff)
+	m.xxx_State = MotorStatus_State((le >> 16) & 0x3)
+	return nil
+}
+
+// UnusedReader provides read access to a Unused message.
+type UnusedReader interface {
+	can.FrameMarshaler
+	// Value returns the value of the Value signal.
+	Value() uint8
+}
+
+// UnusedWriter provides write access to a Unused message.
+type UnusedWriter interface {
+	// CopyFrom copies all values from Unused.
+	CopyFrom(UnusedReader) *Unused
+	// SetValue sets the value of the Value signal.
+	SetValue(uint8) *Unused
+}
+
+type Unused struct {
+	xxx_Value uint8
+}
+
+func NewUnused() *Unused {
+	m := &Unused{}
+	m.Reset()
+	return m
+}
+
+func (m *Unused) Reset() {
+	m.xxx_Value = 0
+}
+
+func (m *Unused) CopyFrom(o UnusedReader) *Unused {
+	f, _ := o.MarshalFrame()
+	_ = m.UnmarshalFrame(f)
+	return m
+}
+
+// Descriptor returns the Unused descriptor.
+func (m *Unused) Descriptor() *descriptor.Message {
+	return Messages().Unused.Message
+}
+
+// String returns a compact string representation of the message.
+func (m *Unused) String() string {
+	return cantext.MessageString(m)
+}
+
+func (m *Unused) Value() uint8 {
+	return m.xxx_Value
+}
+
+func (m *Unused) SetValue(v uint8) *Unused {
+	m.xxx_Value = uint8(Messages().Unused.Value.SaturatedCastUnsigned(uint64(v)))
+	return m
+}
+
+// Frame returns a CAN frame representing the message.
+func (m *Unused) Frame() can.Frame {
+	md := Messages().Unused
+	f := can.Frame{ID: md.ID, IsExtended: md.IsExtended, Length: md.Length}
+	md.Value.MarshalUnsigned(&f.Data, uint64(m.xxx_Value))
+	return f
+}
+
+// MarshalFrame encodes the message as a CAN frame.
+func (m *Unused) MarshalFrame() (can.Frame, error) {
+	return m.Frame(), nil
+}
+
+// UnmarshalFrame decodes the message from a CAN frame.
+func (m *Unused) UnmarshalFrame(f can.Frame) error {
+	md := Messages().Unused
+	switch {
+	case f.ID != md.ID:
+		return fmt.Errorf(
+			"unmarshal Unused: expects ID 300 (got %s with ID %d)", f.String(), f.ID,
+		)
+	case f.Length != md.Length:
+		return fmt.Errorf(
+			"unmarshal Unused: expects length 1 (got %s with length %d)", f.String(), f.Length,
+		)
+	case f.IsRemote:
+		return fmt.Errorf(
+			"unmarshal Unused: expects non-remote frame (got remote frame %s)", f.String(),
+		)
+	case f.IsExtended != md.IsExtended:
+		return fmt.Errorf(
+			"unmarshal Unused: expects standard ID (got %s with extended ID)", f.String(),
+		)
+	}
+	m.xxx_Value = uint8(md.Value.UnmarshalUnsigned(f.Data))
+	return nil
+}
+
+// DecodeFrame decodes the message from a CAN frame, with the bit layout of each signal resolved at generation
+// time.
+//
+// DecodeFrame decodes the same values as UnmarshalFrame, without allocating unless the frame is invalid, for
+// decoding frames at high rates into reused messages.
+func (m *Unused) DecodeFrame(f *can.Frame) error {
+	switch {
+	case f.ID != 300:
+		return fmt.Errorf(
+			"decode Unused: expects ID 300 (got %s with ID %d)", f.String(), f.ID,
+		)
+	case f.Length != 1:
+		return fmt.Errorf(
+			"decode Unused: expects length 1 (got %s with length %d)", f.String(), f.Length,
+		)
+	case f.IsRemote:
+		return fmt.Errorf(
+			"decode Unused: expects non-remote frame (got remote frame %s)", f.String(),
+		)
+	case f.IsExtended:
+		return fmt.Errorf(
+			"decode Unused: expects standard ID (got %s with extended ID)", f.String(),
+		)
+	}
+	le := f.Data.PackLittleEndian()
+	m.xxx_Value = uint8(le & 0xff)
+	return nil
+}
+
+// DiagnosticsReader provides read access to a Diagnostics message.
+type DiagnosticsReader interface {
+	can.FrameMarshaler
+	// Mux returns the value of the Mux signal.
+	Mux() uint8
+	// Counter returns the value of the Counter signal.
+	Counter() uint8
+	// Error returns the value of the Error signal.
+	Error() int8
+}
+
+// DiagnosticsWriter provides write access to a Diagnostics message.
+type DiagnosticsWriter interface {
+	// CopyFrom copies all values from Diagnostics.
+	CopyFrom(DiagnosticsReader) *Diagnostics
+	// SetMux sets the value of the Mux signal.
+	SetMux(uint8) *Diagnostics
+	// SetCounter sets the value of the Counter signal.
+	SetCounter(uint8) *Diagnostics
+	// SetError sets the value of the Error signal.
+	SetError(int8) *Diagnostics
+}
+
+type Diagnostics struct {
+	xxx_Mux     uint8
+	xxx_Counter uint8
+	xxx_Error   int8
+}
+
+func NewDiagnostics() *Diagnostics {
+	m := &Diagnostics{}
+	m.Reset()
+	return m
+}
+
+func (m *Diagnostics) Reset() {
+	m.xxx_Mux = 0
+	m.xxx_Counter = 0
+	m.xxx_Error = 0
+}
+
+func (m *Diagnostics) CopyFrom(o DiagnosticsReader) *Diagnostics {
+	f, _ := o.MarshalFrame()
+	_ = m.UnmarshalFrame(f)
+	return m
+}
+
+// Descriptor returns the Diagnostics descriptor.
+func (m *Diagnostics) Descriptor() *descriptor.Message {
+	return Messages().Diagnostics.Message
+}
+
+// String returns a compact string representation of the message.
+func (m *Diagnostics) String() string {
+	return cantext.MessageString(m)
+}
+
+func (m *Diagnostics) Mux() uint8 {
+	return m.xxx_Mux
+}
+
+func (m *Diagnostics) SetMux(v uint8) *Diagnostics {
+	m.xxx_Mux = uint8(Messages().Diagnostics.Mux.SaturatedCastUnsigned(uint64(v)))
+	return m
+}
+
+func (m *Diagnostics) Counter() uint8 {
+	return m.xxx_Counter
+}
+
+func (m *Diagnostics) SetCounter(v uint8) *Diagnostics {
+	m.xxx_Counter = uint8(Messages().Diagnostics.Counter.SaturatedCastUnsigned(uint64(v)))
+	return m
+}
+
+func (m *Diagnostics) Error() int8 {
+	return m.xxx_Error
+}
+
+func (m *Diagnostics) SetError(v int8) *Diagnostics {
+	m.xxx_Error = int8(Messages().Diagnostics.Error.SaturatedCastSigned(int64(v)))
+	return m
+}
+
+// Frame returns a CAN frame representing the message.
+func (m *Diagnostics) Frame() can.Frame {
+	md := Messages().Diagnostics
+	f := can.Frame{ID: md.ID, IsExtended: md.IsExtended, Length: md.Length}
+	md.Mux.MarshalUnsigned(&f.Data, uint64(m.xxx_Mux))
+	if m.xxx_Mux == 0 {
+		md.Counter.MarshalUnsigned(&f.Data, uint64(m.xxx_Counter))
+	}
+	if m.xxx_Mux == 1 {
+		md.Error.MarshalSigned(&f.Data, int64(m.xxx_Error))
+	}
+	return f
+}
+
+// MarshalFrame encodes the message as a CAN frame.
+func (m *Diagnostics) MarshalFrame() (can.Frame, error) {
+	return m.Frame(), nil
+}
+
+// UnmarshalFrame decodes the message from a CAN frame.
+func (m *Diagnostics) UnmarshalFrame(f can.Frame) error {
+	md := Messages().Diagnostics
+	switch {
+	case f.ID != md.ID:
+		return fmt.Errorf(
+			"unmarshal Diagnostics: expects ID 417001744 (got %s with ID %d)", f.String(), f.ID,
+		)
+	case f.Length != md.Length:
+		return fmt.Errorf(
+			"unmarshal Diagnostics: expects length 2 (got %s with length %d)", f.String(), f.Length,
+		)
+	case f.IsRemote:
+		return fmt.Errorf(
+			"unmarshal Diagnostics: expects non-remote frame (got remote frame %s)", f.String(),
+		)
+	case f.IsExtended != md.IsExtended:
+		return fmt.Errorf(
+			"unmarshal Diagnostics: expects extended ID (got %s with standard ID)", f.String(),
+		)
+	}
+	m.xxx_Mux = uint8(md.Mux.UnmarshalUnsigned(f.Data))
+	if m.xxx_Mux == 0 {
+		m.xxx_Counter = uint8(md.Counter.UnmarshalUnsigned(f.Data))
+	}
+	if m.xxx_Mux == 1 {
+		m.xxx_Error = int8(md.Error.UnmarshalSigned(f.Data))
+	}
+	return nil
+}
+
+// DecodeFrame decodes the message from a CAN frame, with the bit layout of each signal resolved at generation
+// time.
+//
+// DecodeFrame decodes the same values as UnmarshalFrame, without allocating unless the frame is invalid, for
+// decoding frames at high rates into reused messages.
+func (m *Diagnostics) DecodeFrame(f *can.Frame) error {
+	switch {
+	case f.ID != 417001744:
+		return fmt.Errorf(
+			"decode Diagnostics: expects ID 417001744 (got %s with ID %d)", f.String(), f.ID,
+		)
+	case f.Length != 2:
+		return fmt.Errorf(
+			"decode Diagnostics: expects length 2 (got %s with length %d)", f.String(), f.Length,
+		)
+	case f.IsRemote:
+		return fmt.Errorf(
+			"decode Diagnostics: expects non-remote frame (got remote frame %s)", f.String(),
+		)
+	case !f.IsExtended:
+		return fmt.Errorf(
+			"decode Diagnostics: expects extended ID (got %s with standard ID)", f.String(),
+		)
+	}
+	le := f.Data.PackLittleEndian()
+	m.xxx_Mux = uint8(le & 0xf)
+	if m.xxx_Mux == 0 {
+		m.xxx_Counter = uint8((le >> 8) & 0xff)
+	}
+	if m.xxx_Mux == 1 {
+		m.xxx_Error = int8(int64(le<<48) >> 56)
+	}
+	return nil
+}
+
+// FrameBatch holds the messages decoded from batches of frames, in a slice per message.
+//
+// The slices are reused after Reset, so that decoding does not allocate once they have grown to the size of a
+// batch.
+type FrameBatch struct {
+	MotorStatus []MotorStatus
+	Unused      []Unused
+	Diagnostics []Diagnostics
+}
+
+// Reset empties the slices of the batch, keeping their capacity.
+func (b *FrameBatch) Reset() {
+	b.MotorStatus = b.MotorStatus[:0]
+	b.Unused = b.Unused[:0]
+	b.Diagnostics = b.Diagnostics[:0]
+}
+
+// DecodeFrames decodes a batch of frames, and appends the decoded messages to the batch.
+//
+// Remote frames and frames of unknown messages are skipped.
+func (b *FrameBatch) DecodeFrames(frames []can.Frame) error {
+	for i := range frames {
+		f := &frames[i]
+		if f.IsRemote {
+			continue
+		}
+		switch f.ID {
+		case 100:
+			if !f.IsExtended {
+				b.MotorStatus = append(b.MotorStatus, MotorStatus{})
+				m := &b.MotorStatus[len(b.MotorStatus)-1]
+				if err := m.DecodeFrame(f); err != nil {
+					b.MotorStatus = b.MotorStatus[:len(b.MotorStatus)-1]
+					return err
+				}
+			}
+		case 300:
+			if !f.IsExtended {
+				b.Unused = append(b.Unused, Unused{})
+				m := &b.Unused[len(b.Unused)-1]
+				if err := m.DecodeFrame(f); err != nil {
+					b.Unused = b.Unused[:len(b.Unused)-1]
+					return err
+				}
+			}
+		case 417001744:
+			if f.IsExtended {
+				b.Diagnostics = append(b.Diagnostics, Diagnostics{})
+				m := &b.Diagnostics[len(b.Diagnostics)-1]
+				m.Reset()
+				if err := m.DecodeFrame(f); err != nil {
+					b.Diagnostics = b.Diagnostics[:len(b.Diagnostics)-1]
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// Nodes returns the logging node descriptors.
+func Nodes() *NodesDescriptor {
+	return nd
+}
+
+// NodesDescriptor contains all logging node descriptors.
+type NodesDescriptor struct {
+	LOGGER *descriptor.Node
+	MOTOR  *descriptor.Node
+}
+
+// Messages returns the logging message descriptors.
+func Messages() *MessagesDescriptor {
+	return md
+}
+
+// MessagesDescriptor contains all logging message descriptors.
+type MessagesDescriptor struct {
+	MotorStatus *MotorStatusDescriptor
+	Unused      *UnusedDescriptor
+	Diagnostics *DiagnosticsDescriptor
+}
+
+// UnmarshalFrame unmarshals the provided logging CAN frame.
+func (md *MessagesDescriptor) UnmarshalFrame(f can.Frame) (generated.Message, error) {
+	switch f.ID {
+	case md.MotorStatus.ID:
+		var msg MotorStatus
+		if err := msg.UnmarshalFrame(f); err != nil {
+			return nil, fmt.Errorf("unmarshal logging frame: %w", err)
+		}
+		return &msg, nil
+	case md.Unused.ID:
+		var msg Unused
+		if err := msg.UnmarshalFrame(f); err != nil {
+			return nil, fmt.Errorf("unmarshal logging frame: %w", err)
+		}
+		return &msg, nil
+	case md.Diagnostics.ID:
+		var msg Diagnostics
+		if err := msg.UnmarshalFrame(f); err != nil {
+			return nil, fmt.Errorf("unmarshal logging frame: %w", err)
+		}
+		return &msg, nil
+	default:
+		return nil, fmt.Errorf("unmarshal logging frame: ID not in database: %d", f.ID)
+	}
+}
+
+type MotorStatusDescriptor struct {
+	*descriptor.Message
+	Speed *descriptor.Signal
+	State *descriptor.Signal
+}
+
+type UnusedDescriptor struct {
+	*descriptor.Message
+	Value *descriptor.Signal
+}
+
+type DiagnosticsDescriptor struct {
+	*descriptor.Message
+	Mux     *descriptor.Signal
+	Counter *descriptor.Signal
+	Error   *descriptor.Signal
+}
+
+// Database returns the logging database descriptor.
+func (md *MessagesDescriptor) Database() *descriptor.Database {
+	return d
+}
+
+var nd = &NodesDescriptor{
+	LOGGER: d.Nodes[0],
+	MOTOR:  d.Nodes[1],
+}
+
+var md = &MessagesDescriptor{
+	MotorStatus: &MotorStatusDescriptor{
+		Message: d.Messages[0],
+		Speed:   d.Messages[0].Signals[0],
+		State:   d.Messages[0].Signals[1],
+	},
+	Unused: &UnusedDescriptor{
+		Message: d.Messages[1],
+		Value:   d.Messages[1].Signals[0],
+	},
+	Diagnostics: &DiagnosticsDescriptor{
+		Message: d.Messages[2],
+		Mux:     d.Messages[2].Signals[0],
+		Counter: d.Messages[2].Signals[1],
+		Error:   d.Messages[2].Signals[2],
+	},
+}
+
+var d = (*descriptor.Database)(&descriptor.Database{
+	SourceFile: (string)("testdata/dbc/logging/logging.dbc"),
+	Version:    (string)(""),
+	Messages: ([]*descriptor.Message)([]*descriptor.Message{
+		(*descriptor.Message)(&descriptor.Message{
+			Name:        (string)("MotorStatus"),
+			ID:          (uint32)(100),
+			IsExtended:  (bool)(false),
+			Length:      (uint8)(3),
+			SendType:    (descriptor.SendType)(0),
+			Description: (string)("Status of the motor"),
+			Signals: ([]*descriptor.Signal)([]*descriptor.Signal{
+				(*descriptor.Signal)(&descriptor.Signal{
+					Name:              (string)("Speed"),
+					Start:             (uint8)(0),
+					Length:            (uint8)(16),
+					IsBigEndian:       (bool)(false),
+					IsSigned:          (bool)(false),
+					IsFloat:           (bool)(false),
+					IsMultiplexer:     (bool)(false),
+					IsMultiplexed:     (bool)(false),
+					MultiplexerValue:  (uint)(0),
+					MultiplexerName:   (string)(""),
+					MultiplexerRanges: ([]*descriptor.MultiplexerRange)(nil),
+					Offset:            (float64)(-10),
+					Scale:             (float64)(0.5),
+					Min:               (float64)(-10),
+					Max:               (float64)(100),
+					Unit:              (string)("km/h"),
+					Description:       (string)(""),
+					SignalType:        (string)(""),
+					ValueTable:        (string)(""),
+					ValueDescriptions: ([]*descriptor.ValueDescription)(nil),
+					ReceiverNodes: ([]string)([]string{
+						(string)("LOGGER"),
+					}),
+					DefaultValue:  (int)(0),
+					SendType:      (descriptor.SendType)(0),
+					InactiveValue: (int)(0),
+					Attributes:    ([]*descriptor.Attribute)(nil),
+				}),
+				(*descriptor.Signal)(&descriptor.Signal{
+					Name:              (string)("State"),
+					Start:             (uint8)(16),
+					Length:            (uint8)(2),
+					IsBigEndian:       (bool)(false),
+					IsSigned:          (bool)(false),
+					IsFloat:           (bool)(false),
+					IsMultiplexer:     (bool)(false),
+					IsMultiplexed:     (bool)(false),
+					MultiplexerValue:  (uint)(0),
+					MultiplexerName:   (string)(""),
+					MultiplexerRanges: ([]*descriptor.MultiplexerRange)(nil),
+					Offset:            (float64)(0),
+					Scale:             (float64)(1),
+					Min:               (float64)(0),
+					Max:               (float64)(0),
+					Unit:              (string)(""),
+					Description:       (string)(""),
+					SignalType:        (string)(""),
+					ValueTable:        (string)(""),
+					ValueDescriptions: ([]*descriptor.ValueDescription)([]*descriptor.ValueDescription{
+						(*descriptor.ValueDescription)(&descriptor.ValueDescription{
+							Value:       (int64)(0),
+							Description: (string)("Off"),
+						}),
+						(*descriptor.ValueDescription)(&descriptor.ValueDescription{
+							Value:       (int64)(1),
+							Description: (string)("On, running"),
+						}),
+					}),
+					ReceiverNodes: ([]string)([]string{
+						(string)("LOGGER"),
+					}),
+					DefaultValue:  (int)(0),
+					SendType:      (descriptor.SendType)(0),
+					InactiveValue: (int)(0),
+					Attributes:    ([]*descriptor.Attribute)(nil),
+				}),
+			}),
+			SenderNode:       (string)("MOTOR"),
+			TransmitterNodes: ([]string)(nil),
+			SignalGroups:     ([]*descriptor.SignalGroup)(nil),
+			CycleTime:        (time.Duration)(0),
+			CycleTimeFast:    (time.Duration)(0),
+			DelayTime:        (time.Duration)(0),
+			RepetitionCount:  (int)(0),
+			Attributes:       ([]*descriptor.Attribute)(nil),
+		}),
+		(*descriptor.Message)(&descriptor.Message{
+			Name:        (string)("Unused"),
+			ID:          (uint32)(300),
+			IsExtended:  (bool)(false),
+			Length:      (uint8)(1),
+			SendType:    (descriptor.SendType)(0),
+			Description: (string)(""),
+			Signals: ([]*descriptor.Signal)([]*descriptor.Signal{
+				(*descriptor.Signal)(&descriptor.Signal{
+					Name:              (string)("Value"),
+					Start:             (uint8)(0),
+					Length:            (uint8)(8),
+					IsBigEndian:       (bool)(false),
+					IsSigned:          (bool)(false),
+					IsFloat:           (bool)(false),
+					IsMultiplexer:     (bool)(false),
+					IsMultiplexed:     (bool)(false),
+					MultiplexerValue:  (uint)(0),
+					MultiplexerName:   (string)(""),
+					MultiplexerRanges: ([]*descriptor.MultiplexerRange)(nil),
+					Offset:            (float64)(0),
+					Scale:             (float64)(1),
+					Min:               (float64)(0),
+					Max:               (float64)(0),
+					Unit:              (string)(""),
+					Description:       (string)(""),
+					SignalType:        (string)(""),
+					ValueTable:        (string)(""),
+					ValueDescriptions: ([]*descriptor.ValueDescription)(nil),
+					ReceiverNodes: ([]string)([]string{
+						(string)("LOGGER"),
+					}),
+					DefaultValue:  (int)(0),
+					SendType:      (descriptor.SendType)(0),
+					InactiveValue: (int)(0),
+					Attributes:    ([]*descriptor.Attribute)(nil),
+				}),
+			}),
+			SenderNode:       (string)("MOTOR"),
+			TransmitterNodes: ([]string)(nil),
+			SignalGroups:     ([]*descriptor.SignalGroup)(nil),
+			CycleTime:        (time.Duration)(0),
+			CycleTimeFast:    (time.Duration)(0),
+			DelayTime:        (time.Duration)(0),
+			RepetitionCount:  (int)(0),
+			Attributes:       ([]*descriptor.Attribute)(nil),
+		}),
+		(*descriptor.Message)(&descriptor.Message{
+			Name:        (string)("Diagnostics"),
+			ID:          (uint32)(417001744),
+			IsExtended:  (bool)(true),
+			Length:      (uint8)(2),
+			SendType:    (descriptor.SendType)(0),
+			Description: (string)(""),
+			Signals: ([]*descriptor.Signal)([]*descriptor.Signal{
+				(*descriptor.Signal)(&descriptor.Signal{
+					Name:              (string)("Mux"),
+					Start:             (uint8)(0),
+					Length:            (uint8)(4),
+					IsBigEndian:       (bool)(false),
+					IsSigned:          (bool)(false),
+					IsFloat:           (bool)(false),
+					IsMultiplexer:     (bool)(true),
+					IsMultiplexed:     (bool)(false),
+					MultiplexerValue:  (uint)(0),
+					MultiplexerName:   (string)(""),
+					MultiplexerRanges: ([]*descriptor.MultiplexerRange)(nil),
+					Offset:            (float64)(0),
+					Scale:             (float64)(1),
+					Min:               (float64)(0),
+					Max:               (float64)(0),
+					Unit:              (string)(""),
+					Description:       (string)(""),
+					SignalType:        (string)(""),
+					ValueTable:        (string)(""),
+					ValueDescriptions: ([]*descriptor.ValueDescription)(nil),
+					ReceiverNodes: ([]string)([]string{
+						(string)("LOGGER"),
+					}),
+					DefaultValue:  (int)(0),
+					SendType:      (descriptor.SendType)(0),
+					InactiveValue: (int)(0),
+					Attributes:    ([]*descriptor.Attribute)(nil),
+				}),
+				(*descriptor.Signal)(&descriptor.Signal{
+					Name:              (string)("Counter"),
+					Start:             (uint8)(8),
+					Length:            (uint8)(8),
+					IsBigEndian:       (bool)(false),
+					IsSigned:          (bool)(false),
+					IsFloat:           (bool)(false),
+					IsMultiplexer:     (bool)(false),
+					IsMultiplexed:     (bool)(true),
+					MultiplexerValue:  (uint)(0),
+					MultiplexerName:   (string)(""),
+					MultiplexerRanges: ([]*descriptor.MultiplexerRange)(nil),
+					Offset:            (float64)(0),
+					Scale:             (float64)(1),
+					Min:               (float64)(0),
+					Max:               (float64)(0),
+					Unit:              (string)(""),
+					Description:       (string)(""),
+					SignalType:        (string)(""),
+					ValueTable:        (string)(""),
+					ValueDescriptions: ([]*descriptor.ValueDescription)(nil),
+					ReceiverNodes: ([]string)([]string{
+						(string)("LOGGER"),
+					}),
+					DefaultValue:  (int)(0),
+					SendType:      (descriptor.SendType)(0),
+					InactiveValue: (int)(0),
+					Attributes:    ([]*descriptor.Attribute)(nil),
+				}),
+				(*descriptor.Signal)(&descriptor.Signal{
+					Name:              (string)("Error"),
+					Start:             (uint8)(8),
+					Length:            (uint8)(8),
+					IsBigEndian:       (bool)(false),
+					IsSigned:          (bool)(true),
+					IsFloat:           (bool)(false),
+					IsMultiplexer:     (bool)(false),
+					IsMultiplexed:     (bool)(true),
+					MultiplexerValue:  (uint)(1),
+					MultiplexerName:   (string)(""),
+					MultiplexerRanges: ([]*descriptor.MultiplexerRange)(nil),
+					Offset:            (float64)(0),
+					Scale:             (float64)(1),
+					Min:               (float64)(0),
+					Max:               (float64)(0),
+					Unit:              (string)(""),
+					Description:       (string)(""),
+					SignalType:        (string)(""),
+					ValueTable:        (string)(""),
+					ValueDescriptions: ([]*descriptor.ValueDescription)(nil),
+					ReceiverNodes: ([]string)([]string{
+						(string)("LOGGER"),
+					}),
+					DefaultValue:  (int)(0),
+					SendType:      (descriptor.SendType)(0),
+					InactiveValue: (int)(0),
+					Attributes:    ([]*descriptor.Attribute)(nil),
+				}),
+			}),
+			SenderNode:       (string)("MOTOR"),
+			TransmitterNodes: ([]string)(nil),
+			SignalGroups:     ([]*descriptor.SignalGroup)(nil),
+			CycleTime:        (time.Duration)(0),
+			CycleTimeFast:    (time.Duration)(0),
+			DelayTime:        (time.Duration)(0),
+			RepetitionCount:  (int)(0),
+			Attributes:       ([]*descriptor.Attribute)(nil),
+		}),
+	}),
+	Nodes: ([]*descriptor.Node)([]*descriptor.Node{
+		(*descriptor.Node)(&descriptor.Node{
+			Name:        (string)("LOGGER"),
+			Description: (string)(""),
+			Attributes:  ([]*descriptor.Attribute)(nil),
+		}),
+		(*descriptor.Node)(&descriptor.Node{
+			Name:        (string)("MOTOR"),
+			Description: (string)(""),
+			Attributes:  ([]*descriptor.Attribute)(nil),
+		}),
+	}),
+	ValueTables:          ([]*descriptor.ValueTable)(nil),
+	EnvironmentVariables: ([]*descriptor.EnvironmentVariable)(nil),
+	Attributes:           ([]*descriptor.Attribute)(nil),
+})
@@ -0,0 +1,615 @@
+// Package multiplexingcan provides primitives for encoding and decoding multiplexing CAN messages.
+//
+// Source: testdata/dbc/multiplexing/multiplexing.dbc
+package multiplexingcan
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"go.einride.tech/can"
+	"go.einride.tech/can/pkg/candebug"
+	"go.einride.tech/can/pkg/canrunner"
+	"go.einride.tech/can/pkg/cantext"
+	"go.einride.tech/can/pkg/descriptor"
+	"go.einride.tech/can/pkg/generated"
+	"go.einride.tech/can/pkg/socketcan"
+)
+
+// prevent unused imports
+var (
+	_ = context.Background
+	_ = fmt.Print
+	_ = math.Float32frombits
+	_ = net.Dial
+	_ = http.Error
+	_ = sync.Mutex{}
+	_ = time.Now
+	_ = socketcan.Dial
+	_ = candebug.ServeMessagesHTTP
+	_ = canrunner.Run
+)
+
+// Generated code. DO NOT EDIT.
+// DiagnosticsReader provides read access to a Diagnostics message.
+type DiagnosticsReader interface {
+	can.FrameMarshaler
+	// Service returns the value of the Service signal.
+	Service() uint8
+	// Counter returns the value of the Counter signal.
+	Counter() uint8
+	// Page returns the value of the Page signal.
+	Page() uint8
+	// Subfunction returns the value of the Subfunction signal.
+	Subfunction() uint8
+	// Value returns the value of the Value signal.
+	Value() uint16
+	// Session returns the value of the Session signal.
+	Session() uint8
+}
+
+// DiagnosticsWriter provides write access to a Diagnostics message.
+type DiagnosticsWriter interface {
+	// CopyFrom copies all values from Diagnostics.
+	CopyFrom(DiagnosticsReader) *Diagnostics
+	// SetService sets the value of the Service signal.
+	SetService(uint8) *Diagnostics
+	// SetCounter sets the value of the Counter signal.
+	SetCounter(uint8) *Diagnostics
+	// SetPage sets the value of the Page signal.
+	SetPage(uint8) *Diagnostics
+	// SetSubfunction sets the value of the Subfunction signal.
+	SetSubfunction(uint8) *Diagnostics
+	// SetValue sets the value of the Value signal.
+	SetValue(uint16) *Diagnostics
+	// SetSession sets the value of the Session signal.
+	SetSession(uint8) *Diagnostics
+}
+
+type Diagnostics struct {
+	xxx_Service     uint8
+	xxx_Counter     uint8
+	xxx_Page        uint8
+	xxx_Subfunction uint8
+	xxx_Value       uint16
+	xxx_Session     uint8
+}
+
+func NewDiagnostics() *Diagnostics {
+	m := &Diagnostics{}
+	m.Reset()
+	return m
+}
+
+func (m *Diagnostics) Reset() {
+	m.xxx_Service = 0
+	m.xxx_Counter = 0
+	m.xxx_Page = 0
+	m.xxx_Subfunction = 0
+	m.xxx_Value = 0
+	m.xxx_Session = 0
+}
+
+func (m *Diagnostics) CopyFrom(o DiagnosticsReader) *Diagnostics {
+	f, _ := o.MarshalFrame()
+	_ = m.UnmarshalFrame(f)
+	return m
+}
+
+// Descriptor returns the Diagnostics descriptor.
+func (m *Diagnostics) Descriptor() *descriptor.Message {
+	return Messages().Diagnostics.Message
+}
+
+// String returns a compact string representation of the message.
+func (m *Diagnostics) String() string {
+	return cantext.MessageString(m)
+}
+
+func (m *Diagnostics) Service() uint8 {
+	return m.xxx_Service
+}
+
+func (m *Diagnostics) SetService(v uint8) *Diagnostics {
+	m.xxx_Service = uint8(Messages().Diagnostics.Service.SaturatedCastUnsigned(uint64(v)))
+	return m
+}
+
+func (m *Diagnostics) Counter() uint8 {
+	return m.xxx_Counter
+}
+
+func (m *Diagnostics) SetCounter(v uint8) *Diagnostics {
+	m.xxx_Counter = uint8(Messages().Diagnostics.Counter.SaturatedCastUnsigned(uint64(v)))
+	return m
+}
+
+func (m *Diagnostics) Page() uint8 {
+	return m.xxx_Page
+}
+
+func (m *Diagnostics) SetPage(v uint8) *Diagnostics {
+	m.xxx_Page = uint8(Messages().Diagnostics.Page.SaturatedCastUnsigned(uint64(v)))
+	return m
+}
+
+func (m *Diagnostics) Subfunction() uint8 {
+	return m.xxx_Subfunction
+}
+
+func (m *Diagnostics) SetSubfunction(v uint8) *Diagnostics {
+	m.xxx_Subfunction = uint8(Messages().Diagnostics.Subfunction.SaturatedCastUnsigned(uint64(v)))
+	return m
+}
+
+func (m *Diagnostics) Value() uint16 {
+	return m.xxx_Value
+}
+
+func (m *Diagnostics) SetValue(v uint16) *Diagnostics {
+	m.xxx_Value = uint16(Messages().Diagnostics.Value.SaturatedCastUnsigned(uint64(v)))
+	return m
+}
+
+func (m *Diagnostics) Session() uint8 {
+	return m.xxx_Session
+}
+
+func (m *Diagnostics) SetSession(v uint8) *Diagnostics {
+	m.xxx_Session = uint8(Messages().Diagnostics.Session.SaturatedCastUnsigned(uint64(v)))
+	return m
+}
+
+// Frame returns a CAN frame representing the message.
+func (m *Diagnostics) Frame() can.Frame {
+	md := Messages().Diagnostics
+	f := can.Frame{ID: md.ID, IsExtended: md.IsExtended, Length: md.Length}
+	md.Service.MarshalUnsigned(&f.Data, uint64(m.xxx_Service))
+	md.Page.MarshalUnsigned(&f.Data, uint64(m.xxx_Page))
+	if m.xxx_Page <= 3 {
+		md.Counter.MarshalUnsigned(&f.Data, uint64(m.xxx_Counter))
+	}
+	if m.xxx_Service == 1 {
+		md.Subfunction.MarshalUnsigned(&f.Data, uint64(m.xxx_Subfunction))
+	}
+	if m.xxx_Service == 16 {
+		md.Session.MarshalUnsigned(&f.Data, uint64(m.xxx_Session))
+	}
+	if m.xxx_Service == 1 && (m.xxx_Subfunction == 2 || m.xxx_Subfunction >= 4 && m.xxx_Subfunction <= 6) {
+		md.Value.MarshalUnsigned(&f.Data, uint64(m.xxx_Value))
+	}
+	return f
+}
+
+// MarshalFrame encodes the message as a CAN frame.
+func (m *Diagnostics) MarshalFrame() (can.Frame, error) {
+	return m.Frame(), nil
+}
+
+// UnmarshalFrame decodes the message from a CAN frame.
+func (m *Diagnostics) UnmarshalFrame(f can.Frame) error {
+	md := Messages().Diagnostics
+	switch {
+	case f.ID != md.ID:
+		return fmt.Errorf(
+			"unmarshal Diagnostics: expects ID 100 (got %s with ID %d)", f.String(), f.ID,
+		)
+	case f.Length != md.Length:
+		return fmt.Errorf(
+			"unmarshal Diagnostics: expects length 8 (got %s with length %d)", f.String(), f.Length,
+		)
+	case f.IsRemote:
+		return fmt.Errorf(
+			"unmarshal Diagnostics: expects non-remote frame (got remote frame %s)", f.String(),
+		)
+	case f.IsExtended != md.IsExtended:
+		return fmt.Errorf(
+			"unmarshal Diagnostics: expects standard ID (got %s with extended ID)", f.String(),
+		)
+	}
+	m.xxx_Service = uint8(md.Service.UnmarshalUnsigned(f.Data))
+	m.xxx_Page = uint8(md.Page.UnmarshalUnsigned(f.Data))
+	if m.xxx_Page <= 3 {
+		m.xxx_Counter = uint8(md.Counter.UnmarshalUnsigned(f.Data))
+	}
+	if m.xxx_Service == 1 {
+		m.xxx_Subfunction = uint8(md.Subfunction.UnmarshalUnsigned(f.Data))
+	}
+	if m.xxx_Service == 16 {
+		m.xxx_Session = uint8(md.Session.UnmarshalUnsigned(f.Data))
+	}
+	if m.xxx_Service == 1 && (m.xxx_Subfunction == 2 || m.xxx_Subfunction >= 4 && m.xxx_Subfunction <= 6) {
+		m.xxx_Value = uint16(md.Value.UnmarshalUnsigned(f.Data))
+	}
+	return nil
+}
+
+// DecodeFrame decodes the message from a CAN frame, with the bit layout of each signal resolved at generation
+// time.
+//
+// DecodeFrame decodes the same values as UnmarshalFrame, without allocating unless the frame is invalid, for
+// decoding frames at high rates into reused messages.
+func (m *Diagnostics) DecodeFrame(f *can.Frame) error {
+	switch {
+	case f.ID != 100:
+		return fmt.Errorf(
+			"decode Diagnostics: expects ID 100 (got %s with ID %d)", f.String(), f.ID,
+		)
+	case f.Length != 8:
+		return fmt.Errorf(
+			"decode Diagnostics: expects length 8 (got %s with length %d)", f.String(), f.Length,
+		)
+	case f.IsRemote:
+		return fmt.Errorf(
+			"decode Diagnostics: expects non-remote frame (got remote frame %s)", f.String(),
+		)
+	case f.IsExtended:
+		return fmt.Errorf(
+			"decode Diagnostics: expects standard ID (got %s with extended ID)", f.String(),
+		)
+	}
+	le := f.Data.PackLittleEndian()
+	m.xxx_Service = uint8(le & 0xff)
+	m.xxx_Page = uint8((le >> 56) & 0xff)
+	if m.xxx_Page <= 3 {
+		m.xxx_Counter = uint8((le >> 32) & 0xff)
+	}
+	if m.xxx_Service == 1 {
+		m.xxx_Subfunction = uint8((le >> 8) & 0xff)
+	}
+	if m.xxx_Service == 16 {
+		m.xxx_Session = uint8((le >> 8) & 0xff)
+	}
+	if m.xxx_Service == 1 && (m.xxx_Subfunction == 2 || m.xxx_Subfunction >= 4 && m.xxx_Subfunction <= 6) {
+		m.xxx_Value = uint16((le >> 16) & 0xffff)
+	}
+	return nil
+}
+
+// FrameBatch holds the messages decoded from batches of frames, in a slice per message.
+//
+// The slices are reused after Reset, so that decoding does not allocate once they have grown to the size of a
+// batch.
+type FrameBatch struct {
+	Diagnostics []Diagnostics
+}
+
+// Reset empties the slices of the batch, keeping their capacity.
+func (b *FrameBatch) Reset() {
+	b.Diagnostics = b.Diagnostics[:0]
+}
+
+// DecodeFrames decodes a batch of frames, and appends the decoded messages to the batch.
+//
+// Remote frames and frames of unknown messages are skipped.
+func (b *FrameBatch) DecodeFrames(frames []can.Frame) error {
+	for i := range frames {
+		f := &frames[i]
+		if f.IsRemote {
+			continue
+		}
+		switch f.ID {
+		case 100:
+			if !f.IsExtended {
+				b.Diagnostics = append(b.Diagnostics, Diagnostics{})
+				m := &b.Diagnostics[len(b.Diagnostics)-1]
+				m.Reset()
+				if err := m.DecodeFrame(f); err != nil {
+					b.Diagnostics = b.Diagnostics[:len(b.Diagnostics)-1]
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// Nodes returns the multiplexing node descriptors.
+func Nodes() *NodesDescriptor {
+	return nd
+}
+
+// NodesDescriptor contains all multiplexing node descriptors.
+type NodesDescriptor struct {
+	ECU    *descriptor.Node
+	TESTER *descriptor.Node
+}
+
+// Messages returns the multiplexing message descriptors.
+func Messages() *MessagesDescriptor {
+	return md
+}
+
+// MessagesDescriptor contains all multiplexing message descriptors.
+type MessagesDescriptor struct {
+	Diagnostics *DiagnosticsDescriptor
+}
+
+// UnmarshalFrame unmarshals the provided multiplexing CAN frame.
+func (md *MessagesDescriptor) UnmarshalFrame(f can.Frame) (generated.Message, error) {
+	switch f.ID {
+	case md.Diagnostics.ID:
+		var msg Diagnostics
+		if err := msg.UnmarshalFrame(f); err != nil {
+			return nil, fmt.Errorf("unmarshal multiplexing frame: %w", err)
+		}
+		return &msg, nil
+	default:
+		return nil, fmt.Errorf("unmarshal multiplexing frame: ID not in database: %d", f.ID)
+	}
+}
+
+type DiagnosticsDescriptor struct {
+	*descriptor.Message
+	Service     *descriptor.Signal
+	Counter     *descriptor.Signal
+	Page        *descriptor.Signal
+	Subfunction *descriptor.Signal
+	Value       *descriptor.Signal
+	Session     *descriptor.Signal
+}
+
+// Database returns the multiplexing database descriptor.
+func (md *MessagesDescriptor) Database() *descriptor.Database {
+	return d
+}
+
+var nd = &NodesDescriptor{
+	ECU:    d.Nodes[0],
+	TESTER: d.Nodes[1],
+}
+
+var md = &MessagesDescriptor{
+	Diagnostics: &DiagnosticsDescriptor{
+		Message:     d.Messages[0],
+		Service:     d.Messages[0].Signals[0],
+		Counter:     d.Messages[0].Signals[1],
+		Page:        d.Messages[0].Signals[2],
+		Subfunction: d.Messages[0].Signals[3],
+		Value:       d.Messages[0].Signals[4],
+		Session:     d.Messages[0].Signals[5],
+	},
+}
+
+var d = (*descriptor.Database)(&descriptor.Database{
+	SourceFile: (string)("testdata/dbc/multiplexing/multiplexing.dbc"),
+	Version:    (string)(""),
+	Messages: ([]*descriptor.Message)([]*descriptor.Message{
+		(*descriptor.Message)(&descriptor.Message{
+			Name:        (string)("Diagnostics"),
+			ID:          (uint32)(100),
+			IsExtended:  (bool)(false),
+			Length:      (uint8)(8),
+			SendType:    (descriptor.SendType)(0),
+			Description: (string)(""),
+			Signals: ([]*descriptor.Signal)([]*descriptor.Signal{
+				(*descriptor.Signal)(&descriptor.Signal{
+					Name:              (string)("Service"),
+					Start:             (uint8)(0),
+					Length:            (uint8)(8),
+					IsBigEndian:       (bool)(false),
+					IsSigned:          (bool)(false),
+					IsFloat:           (bool)(false),
+					IsMultiplexer:     (bool)(true),
+					IsMultiplexed:     (bool)(false),
+					MultiplexerValue:  (uint)(0),
+					MultiplexerName:   (string)(""),
+					MultiplexerRanges: ([]*descriptor.MultiplexerRange)(nil),
+					Offset:            (float64)(0),
+					Scale:             (float64)(1),
+					Min:               (float64)(0),
+					Max:               (float64)(255),
+					Unit:              (string)(""),
+					Description:       (string)(""),
+					SignalType:        (string)(""),
+					ValueTable:        (string)(""),
+					ValueDescriptions: ([]*descriptor.ValueDescription)(nil),
+					ReceiverNodes: ([]string)([]string{
+						(string)("TESTER"),
+					}),
+					DefaultValue:  (int)(0),
+					SendType:      (descriptor.SendType)(0),
+					InactiveValue: (int)(0),
+					Attributes:    ([]*descriptor.Attribute)(nil),
+				}),
+				(*descriptor.Signal)(&descriptor.Signal{
+					Name:             (string)("Counter"),
+					Start:            (uint8)(32),
+					Length:           (uint8)(8),
+					IsBigEndian:      (bool)(false),
+					IsSigned:         (bool)(false),
+					IsFloat:          (bool)(false),
+					IsMultiplexer:    (bool)(false),
+					IsMultiplexed:    (bool)(true),
+					MultiplexerValue: (uint)(0),
+					MultiplexerName:  (string)("Page"),
+					MultiplexerRanges: ([]*descriptor.MultiplexerRange)([]*descriptor.MultiplexerRange{
+						(*descriptor.MultiplexerRange)(&descriptor.MultiplexerRange{
+							Min: (uint)(0),
+							Max: (uint)(3),
+						}),
+					}),
+					Offset:            (float64)(0),
+					Scale:             (float64)(1),
+					Min:               (float64)(0),
+					Max:               (float64)(255),
+					Unit:              (string)(""),
+					Description:       (string)(""),
+					SignalType:        (string)(""),
+					ValueTable:        (string)(""),
+					ValueDescriptions: ([]*descriptor.ValueDescription)(nil),
+					ReceiverNodes: ([]string)([]string{
+						(string)("TESTER"),
+					}),
+					DefaultValue:  (int)(0),
+					SendType:      (descriptor.SendType)(0),
+					InactiveValue: (int)(0),
+					Attributes:    ([]*descriptor.Attribute)(nil),
+				}),
+				(*descriptor.Signal)(&descriptor.Signal{
+					Name:              (string)("Page"),
+					Start:             (uint8)(56),
+					Length:            (uint8)(8),
+					IsBigEndian:       (bool)(false),
+					IsSigned:          (bool)(false),
+					IsFloat:           (bool)(false),
+					IsMultiplexer:     (bool)(true),
+					IsMultiplexed:     (bool)(false),
+					MultiplexerValue:  (uint)(0),
+					MultiplexerName:   (string)(""),
+					MultiplexerRanges: ([]*descriptor.MultiplexerRange)(nil),
+					Offset:            (float64)(0),
+					Scale:             (float64)(1),
+					Min:               (float64)(0),
+					Max:               (float64)(255),
+					Unit:              (string)(""),
+					Description:       (string)(""),
+					SignalType:        (string)(""),
+					ValueTable:        (string)(""),
+					ValueDescriptions: ([]*descriptor.ValueDescription)(nil),
+					ReceiverNodes: ([]string)([]string{
+						(string)("TESTER"),
+					}),
+					DefaultValue:  (int)(0),
+					SendType:      (descriptor.SendType)(0),
+					InactiveValue: (int)(0),
+					Attributes:    ([]*descriptor.Attribute)(nil),
+				}),
+				(*descriptor.Signal)(&descriptor.Signal{
+					Name:             (string)("Subfunction"),
+					Start:            (uint8)(8),
+					Length:           (uint8)(8),
+					IsBigEndian:      (bool)(false),
+					IsSigned:         (bool)(false),
+					IsFloat:          (bool)(false),
+					IsMultiplexer:    (bool)(true),
+					IsMultiplexed:    (bool)(true),
+					MultiplexerValue: (uint)(1),
+					MultiplexerName:  (string)("Service"),
+					MultiplexerRanges: ([]*descriptor.MultiplexerRange)([]*descriptor.MultiplexerRange{
+						(*descriptor.MultiplexerRange)(&descriptor.MultiplexerRange{
+							Min: (uint)(1),
+							Max: (uint)(1),
+						}),
+					}),
+					Offset:            (float64)(0),
+					Scale:             (float64)(1),
+					Min:               (float64)(0),
+					Max:               (float64)(255),
+					Unit:              (string)(""),
+					Description:       (string)(""),
+					SignalType:        (string)(""),
+					ValueTable:        (string)(""),
+					ValueDescriptions: ([]*descriptor.ValueDescription)(nil),
+					ReceiverNodes: ([]string)([]string{
+						(string)("TESTER"),
+					}),
+					DefaultValue:  (int)(0),
+					SendType:      (descriptor.SendType)(0),
+					InactiveValue: (int)(0),
+					Attributes:    ([]*descriptor.Attribute)(nil),
+				}),
+				(*descriptor.Signal)(&descriptor.Signal{
+					Name:             (string)("Value"),
+					Start:            (uint8)(16),
+					Length:           (uint8)(16),
+					IsBigEndian:      (bool)(false),
+					IsSigned:         (bool)(false),
+					IsFloat:          (bool)(false),
+					IsMultiplexer:    (bool)(false),
+					IsMultiplexed:    (bool)(true),
+					MultiplexerValue: (uint)(2),
+					MultiplexerName:  (string)("Subfunction"),
+					MultiplexerRanges: ([]*descriptor.MultiplexerRange)([]*descriptor.MultiplexerRange{
+						(*descriptor.MultiplexerRange)(&descriptor.MultiplexerRange{
+							Min: (uint)(2),
+							Max: (uint)(2),
+						}),
+						(*descriptor.MultiplexerRange)(&descriptor.MultiplexerRange{
+							Min: (uint)(4),
+							Max: (uint)(6),
+						}),
+					}),
+					Offset:            (float64)(0),
+					Scale:             (float64)(1),
+					Min:               (float64)(0),
+					Max:               (float64)(65535),
+					Unit:              (string)(""),
+					Description:       (string)(""),
+					SignalType:        (string)(""),
+					ValueTable:        (string)(""),
+					ValueDescriptions: ([]*descriptor.ValueDescription)(nil),
+					ReceiverNodes: ([]string)([]string{
+						(string)("TESTER"),
+					}),
+					DefaultValue:  (int)(0),
+					SendType:      (descriptor.SendType)(0),
+					InactiveValue: (int)(0),
+					Attributes:    ([]*descriptor.Attribute)(nil),
+				}),
+				(*descriptor.Signal)(&descriptor.Signal{
+					Name:             (string)("Session"),
+					Start:            (uint8)(8),
+					Length:           (uint8)(8),
+					IsBigEndian:      (bool)(false),
+					IsSigned:         (bool)(false),
+					IsFloat:          (bool)(false),
+					IsMultiplexer:    (bool)(false),
+					IsMultiplexed:    (bool)(true),
+					MultiplexerValue: (uint)(16),
+					MultiplexerName:  (string)("Service"),
+					MultiplexerRanges: ([]*descriptor.MultiplexerRange)([]*descriptor.MultiplexerRange{
+						(*descriptor.MultiplexerRange)(&descriptor.MultiplexerRange{
+							Min: (uint)(16),
+							Max: (uint)(16),
+						}),
+					}),
+					Offset:            (float64)(0),
+					Scale:             (float64)(1),
+					Min:               (float64)(0),
+					Max:               (float64)(255),
+					Unit:              (string)(""),
+					Description:       (string)(""),
+					SignalType:        (string)(""),
+					ValueTable:        (string)(""),
+					ValueDescriptions: ([]*descriptor.ValueDescription)(nil),
+					ReceiverNodes: ([]string)([]string{
+						(string)("TESTER"),
+					}),
+					DefaultValue:  (int)(0),
+					SendType:      (descriptor.SendType)(0),
+					InactiveValue: (int)(0),
+					Attributes:    ([]*descriptor.Attribute)(nil),
+				}),
+			}),
+			SenderNode:       (string)("ECU"),
+			TransmitterNodes: ([]string)(nil),
+			SignalGroups:     ([]*descriptor.SignalGroup)(nil),
+			CycleTime:        (time.Duration)(0),
+			CycleTimeFast:    (time.Duration)(0),
+			DelayTime:        (time.Duration)(0),
+			RepetitionCount:  (int)(0),
+			Attributes:       ([]*descriptor.Attribute)(nil),
+		}),
+	}),
+	Nodes: ([]*descriptor.Node)([]*descriptor.Node{
+		(*descriptor.Node)(&descriptor.Node{
+			Name:        (string)("ECU"),
+			Description: (string)(""),
+			Attributes:  ([]*descriptor.Attribute)(nil),
+		}),
+		(*descriptor.Node)(&descriptor.Node{
+			Name:        (string)("TESTER"),
+			Description: (string)(""),
+			Attributes:  ([]*descriptor.Attribute)(nil),
+		}),
+	}),
+	ValueTables:          ([]*descriptor.ValueTable)(nil),
+	EnvironmentVariables: ([]*descriptor.EnvironmentVariable)(nil),
+	Attributes:           ([]*descriptor.Attribute)(nil),
+})
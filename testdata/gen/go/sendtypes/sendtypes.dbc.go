@@ -0,0 +1,1892 @@
+// Package sendtypescan provides primitives for encoding and decoding sendtypes CAN messages.
+//
+// Source: testdata/dbc/sendtypes/sendtypes.dbc
+package sendtypescan
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"go.einride.tech/can"
+	"go.einride.tech/can/pkg/candebug"
+	"go.einride.tech/can/pkg/canrunner"
+	"go.einride.tech/can/pkg/cantext"
+	"go.einride.tech/can/pkg/descriptor"
+	"go.einride.tech/can/pkg/generated"
+	"go.einride.tech/can/pkg/socketcan"
+)
+
+// prevent unused imports
+var (
+	_ = context.Background
+	_ = fmt.Print
+	_ = math.Float32frombits
+	_ = net.Dial
+	_ = http.Error
+	_ = sync.Mutex{}
+	_ = time.Now
+	_ = socketcan.Dial
+	_ = candebug.ServeMessagesHTTP
+	_ = canrunner.Run
+)
+
+// Generated code. DO NOT EDIT.
+// LightsReader provides read access to a Lights message.
+type LightsReader interface {
+	can.FrameMarshaler
+	// HeadLights returns the value of the HeadLights signal.
+	HeadLights() uint8
+	// Brightness returns the value of the Brightness signal.
+	Brightness() uint8
+	// Counter returns the value of the Counter signal.
+	Counter() uint8
+}
+
+// LightsWriter provides write access to a Lights message.
+type LightsWriter interface {
+	// CopyFrom copies all values from Lights.
+	CopyFrom(LightsReader) *Lights
+	// SetHeadLights sets the value of the HeadLights signal.
+	SetHeadLights(uint8) *Lights
+	// SetBrightness sets the value of the Brightness signal.
+	SetBrightness(uint8) *Lights
+	// SetCounter sets the value of the Counter signal.
+	SetCounter(uint8) *Lights
+}
+
+type Lights struct {
+	xxx_HeadLights uint8
+	xxx_Brightness uint8
+	xxx_Counter    uint8
+}
+
+func NewLights() *Lights {
+	m := &Lights{}
+	m.Reset()
+	return m
+}
+
+func (m *Lights) Reset() {
+	m.xxx_HeadLights = 0
+	m.xxx_Brightness = 0
+	m.xxx_Counter = 0
+}
+
+func (m *Lights) CopyFrom(o LightsReader) *Lights {
+	f, _ := o.MarshalFrame()
+	_ = m.UnmarshalFrame(f)
+	return m
+}
+
+// Descriptor returns the Lights descriptor.
+func (m *Lights) Descriptor() *descriptor.Message {
+	return Messages().Lights.Message
+}
+
+// String returns a compact string representation of the message.
+func (m *Lights) String() string {
+	return cantext.MessageString(m)
+}
+
+func (m *Lights) HeadLights() uint8 {
+	return m.xxx_HeadLights
+}
+
+func (m *Lights) SetHeadLights(v uint8) *Lights {
+	m.xxx_HeadLights = uint8(Messages().Lights.HeadLights.SaturatedCastUnsigned(uint64(v)))
+	return m
+}
+
+func (m *Lights) Brightness() uint8 {
+	return m.xxx_Brightness
+}
+
+func (m *Lights) SetBrightness(v uint8) *Lights {
+	m.xxx_Brightness = uint8(Messages().Lights.Brightness.SaturatedCastUnsigned(uint64(v)))
+	return m
+}
+
+func (m *Lights) Counter() uint8 {
+	return m.xxx_Counter
+}
+
+func (m *Lights) SetCounter(v uint8) *Lights {
+	m.xxx_Counter = uint8(Messages().Lights.Counter.SaturatedCastUnsigned(uint64(v)))
+	return m
+}
+
+// Frame returns a CAN frame representing the message.
+func (m *Lights) Frame() can.Frame {
+	md := Messages().Lights
+	f := can.Frame{ID: md.ID, IsExtended: md.IsExtended, Length: md.Length}
+	md.HeadLights.MarshalUnsigned(&f.Data, uint64(m.xxx_HeadLights))
+	md.Brightness.MarshalUnsigned(&f.Data, uint64(m.xxx_Brightness))
+	md.Counter.MarshalUnsigned(&f.Data, uint64(m.xxx_Counter))
+	return f
+}
+
+// MarshalFrame encodes the message as a CAN frame.
+func (m *Lights) MarshalFrame() (can.Frame, error) {
+	return m.Frame(), nil
+}
+
+// UnmarshalFrame decodes the message from a CAN frame.
+func (m *Lights) UnmarshalFrame(f can.Frame) error {
+	md := Messages().Lights
+	switch {
+	case f.ID != md.ID:
+		return fmt.Errorf(
+			"unmarshal Lights: expects ID 100 (got %s with ID %d)", f.String(), f.ID,
+		)
+	case f.Length != md.Length:
+		return fmt.Errorf(
+			"unmarshal Lights: expects length 2 (got %s with length %d)", f.String(), f.Length,
+		)
+	case f.IsRemote:
+		return fmt.Errorf(
+			"unmarshal Lights: expects non-remote frame (got remote frame %s)", f.String(),
+		)
+	case f.IsExtended != md.IsExtended:
+		return fmt.Errorf(
+			"unmarshal Lights: expects standard ID (got %s with extended ID)", f.String(),
+		)
+	}
+	m.xxx_HeadLights = uint8(md.HeadLights.UnmarshalUnsigned(f.Data))
+	m.xxx_Brightness = uint8(md.Brightness.UnmarshalUnsigned(f.Data))
+	m.xxx_Counter = uint8(md.Counter.UnmarshalUnsigned(f.Data))
+	return nil
+}
+
+// DecodeFrame decodes the message from a CAN frame, with the bit layout of each signal resolved at generation
+// time.
+//
+// DecodeFrame decodes the same values as UnmarshalFrame, without allocating unless the frame is invalid, for
+// decoding frames at high rates into reused messages.
+func (m *Lights) DecodeFrame(f *can.Frame) error {
+	switch {
+	case f.ID != 100:
+		return fmt.Errorf(
+			"decode Lights: expects ID 100 (got %s with ID %d)", f.String(), f.ID,
+		)
+	case f.Length != 2:
+		return fmt.Errorf(
+			"decode Lights: expects length 2 (got %s with length %d)", f.String(), f.Length,
+		)
+	case f.IsRemote:
+		return fmt.Errorf(
+			"decode Lights: expects non-remote frame (got remote frame %s)", f.String(),
+		)
+	case f.IsExtended:
+		return fmt.Errorf(
+			"decode Lights: expects standard ID (got %s with extended ID)", f.String(),
+		)
+	}
+	le := f.Data.PackLittleEndian()
+	m.xxx_HeadLights = uint8(le & 0x3)
+	m.xxx_Brightness = uint8((le >> 4) & 0xf)
+	m.xxx_Counter = uint8((le >> 8) & 0xff)
+	return nil
+}
+
+// StatusReader provides read access to a Status message.
+type StatusReader interface {
+	can.FrameMarshaler
+	// Warning returns the value of the Warning signal.
+	Warning() bool
+	// Level returns the value of the Level signal.
+	Level() uint8
+}
+
+// StatusWriter provides write access to a Status message.
+type StatusWriter interface {
+	// CopyFrom copies all values from Status.
+	CopyFrom(StatusReader) *Status
+	// SetWarning sets the value of the Warning signal.
+	SetWarning(bool) *Status
+	// SetLevel sets the value of the Level signal.
+	SetLevel(uint8) *Status
+}
+
+type Status struct {
+	xxx_Warning bool
+	xxx_Level   uint8
+}
+
+func NewStatus() *Status {
+	m := &Status{}
+	m.Reset()
+	return m
+}
+
+func (m *Status) Reset() {
+	m.xxx_Warning = false
+	m.xxx_Level = 0
+}
+
+func (m *Status) CopyFrom(o StatusReader) *Status {
+	f, _ := o.MarshalFrame()
+	_ = m.UnmarshalFrame(f)
+	return m
+}
+
+// Descriptor returns the Status descriptor.
+func (m *Status) Descriptor() *descriptor.Message {
+	return Messages().Status.Message
+}
+
+// String returns a compact string representation of the message.
+func (m *Status) String() string {
+	return cantext.MessageString(m)
+}
+
+func (m *Status) Warning() bool {
+	return m.xxx_Warning
+}
+
+func (m *Status) SetWarning(v bool) *Status {
+	m.xxx_Warning = v
+	return m
+}
+
+func (m *Status) Level() uint8 {
+	return m.xxx_Level
+}
+
+func (m *Status) SetLevel(v uint8) *Status {
+	m.xxx_Level = uint8(Messages().Status.Level.SaturatedCastUnsigned(uint64(v)))
+	return m
+}
+
+// Frame returns a CAN frame representing the message.
+func (m *Status) Frame() can.Frame {
+	md := Messages().Status
+	f := can.Frame{ID: md.ID, IsExtended: md.IsExtended, Length: md.Length}
+	md.Warning.MarshalBool(&f.Data, bool(m.xxx_Warning))
+	md.Level.MarshalUnsigned(&f.Data, uint64(m.xxx_Level))
+	return f
+}
+
+// MarshalFrame encodes the message as a CAN frame.
+func (m *Status) MarshalFrame() (can.Frame, error) {
+	return m.Frame(), nil
+}
+
+// UnmarshalFrame decodes the message from a CAN frame.
+func (m *Status) UnmarshalFrame(f can.Frame) error {
+	md := Messages().Status
+	switch {
+	case f.ID != md.ID:
+		return fmt.Errorf(
+			"unmarshal Status: expects ID 200 (got %s with ID %d)", f.String(), f.ID,
+		)
+	case f.Length != md.Length:
+		return fmt.Errorf(
+			"unmarshal Status: expects length 1 (got %s with length %d)", f.String(), f.Length,
+		)
+	case f.IsRemote:
+		return fmt.Errorf(
+			"unmarshal Status: expects non-remote frame (got remote frame %s)", f.String(),
+		)
+	case f.IsExtended != md.IsExtended:
+		return fmt.Errorf(
+			"unmarshal Status: expects standard ID (got %s with extended ID)", f.String(),
+		)
+	}
+	m.xxx_Warning = bool(md.Warning.UnmarshalBool(f.Data))
+	m.xxx_Level = uint8(md.Level.UnmarshalUnsigned(f.Data))
+	return nil
+}
+
+// DecodeFrame decodes the message from a CAN frame, with the bit layout of each signal resolved at generation
+// time.
+//
+// DecodeFrame decodes the same values as UnmarshalFrame, without allocating unless the frame is invalid, for
+// decoding frames at high rates into reused messages.
+func (m *Status) DecodeFrame(f *can.Frame) error {
+	switch {
+	case f.ID != 200:
+		return fmt.Errorf(
+			"decode Status: expects ID 200 (got %s with ID %d)", f.String(), f.ID,
+		)
+	case f.Length != 1:
+		return fmt.Errorf(
+			"decode Status: expects length 1 (got %s with length %d)", f.String(), f.Length,
+		)
+	case f.IsRemote:
+		return fmt.Errorf(
+			"decode Status: expects non-remote frame (got remote frame %s)", f.String(),
+		)
+	case f.IsExtended:
+		return fmt.Errorf(
+			"decode Status: expects standard ID (got %s with extended ID)", f.String(),
+		)
+	}
+	le := f.Data.PackLittleEndian()
+	m.xxx_Warning = bool(le&(1<<0) != 0)
+	m.xxx_Level = uint8((le >> 4) & 0xf)
+	return nil
+}
+
+// CommandReader provides read access to a Command message.
+type CommandReader interface {
+	can.FrameMarshaler
+	// Request returns the value of the Request signal.
+	Request() uint8
+}
+
+// CommandWriter provides write access to a Command message.
+type CommandWriter interface {
+	// CopyFrom copies all values from Command.
+	CopyFrom(CommandReader) *Command
+	// SetRequest sets the value of the Request signal.
+	SetRequest(uint8) *Command
+}
+
+type Command struct {
+	xxx_Request uint8
+}
+
+func NewCommand() *Command {
+	m := &Command{}
+	m.Reset()
+	return m
+}
+
+func (m *Command) Reset() {
+	m.xxx_Request = 0
+}
+
+func (m *Command) CopyFrom(o CommandReader) *Command {
+	f, _ := o.MarshalFrame()
+	_ = m.UnmarshalFrame(f)
+	return m
+}
+
+// Descriptor returns the Command descriptor.
+func (m *Command) Descriptor() *descriptor.Message {
+	return Messages().Command.Message
+}
+
+// String returns a compact string representation of the message.
+func (m *Command) String() string {
+	return cantext.MessageString(m)
+}
+
+func (m *Command) Request() uint8 {
+	return m.xxx_Request
+}
+
+func (m *Command) SetRequest(v uint8) *Command {
+	m.xxx_Request = uint8(Messages().Command.Request.SaturatedCastUnsigned(uint64(v)))
+	return m
+}
+
+// Frame returns a CAN frame representing the message.
+func (m *Command) Frame() can.Frame {
+	md := Messages().Command
+	f := can.Frame{ID: md.ID, IsExtended: md.IsExtended, Length: md.Length}
+	md.Request.MarshalUnsigned(&f.Data, uint64(m.xxx_Request))
+	return f
+}
+
+// MarshalFrame encodes the message as a CAN frame.
+func (m *Command) MarshalFrame() (can.Frame, error) {
+	return m.Frame(), nil
+}
+
+// UnmarshalFrame decodes the message from a CAN frame.
+func (m *Command) UnmarshalFrame(f can.Frame) error {
+	md := Messages().Command
+	switch {
+	case f.ID != md.ID:
+		return fmt.Errorf(
+			"unmarshal Command: expects ID 300 (got %s with ID %d)", f.String(), f.ID,
+		)
+	case f.Length != md.Length:
+		return fmt.Errorf(
+			"unmarshal Command: expects length 1 (got %s with length %d)", f.String(), f.Length,
+		)
+	case f.IsRemote:
+		return fmt.Errorf(
+			"unmarshal Command: expects non-remote frame (got remote frame %s)", f.String(),
+		)
+	case f.IsExtended != md.IsExtended:
+		return fmt.Errorf(
+			"unmarshal Command: expects standard ID (got %s with extended ID)", f.String(),
+		)
+	}
+	m.xxx_Request = uint8(md.Request.UnmarshalUnsigned(f.Data))
+	return nil
+}
+
+// DecodeFrame decodes the message from a CAN frame, with the bit layout of each signal resolved at generation
+// time.
+//
+// DecodeFrame decodes the same values as UnmarshalFrame, without allocating unless the frame is invalid, for
+// decoding frames at high rates into reused messages.
+func (m *Command) DecodeFrame(f *can.Frame) error {
+	switch {
+	case f.ID != 300:
+		return fmt.Errorf(
+			"decode Command: expects ID 300 (got %s with ID %d)", f.String(), f.ID,
+		)
+	case f.Length != 1:
+		return fmt.Errorf(
+			"decode Command: expects length 1 (got %s with length %d)", f.String(), f.Length,
+		)
+	case f.IsRemote:
+		return fmt.Errorf(
+			"decode Command: expects non-remote frame (got remote frame %s)", f.String(),
+		)
+	case f.IsExtended:
+		return fmt.Errorf(
+			"decode Command: expects standard ID (got %s with extended ID)", f.String(),
+		)
+	}
+	le := f.Data.PackLittleEndian()
+	m.xxx_Request = uint8(le & 0xf)
+	return nil
+}
+
+type ECU interface {
+	sync.Locker
+	Tx() ECU_Tx
+	Rx() ECU_Rx
+	Run(ctx context.Context) error
+}
+
+type ECU_Rx interface {
+	http.Handler // for debugging
+}
+
+type ECU_Tx interface {
+	http.Handler // for debugging
+	Lights() ECU_Tx_Lights
+	Status() ECU_Tx_Status
+	Command() ECU_Tx_Command
+}
+
+type ECU_Tx_Lights interface {
+	LightsReader
+	// CopyFrom copies all values from Lights.
+	CopyFrom(LightsReader) ECU_Tx_Lights
+	// SetHeadLights sets the value of the HeadLights signal.
+	SetHeadLights(uint8) ECU_Tx_Lights
+	// SetBrightness sets the value of the Brightness signal.
+	SetBrightness(uint8) ECU_Tx_Lights
+	// SetCounter sets the value of the Counter signal.
+	SetCounter(uint8) ECU_Tx_Lights
+	TransmitTime() time.Time
+	Transmit(ctx context.Context) error
+	SetBeforeTransmitHook(h func(context.Context) error)
+}
+
+type ECU_Tx_Status interface {
+	StatusReader
+	// CopyFrom copies all values from Status.
+	CopyFrom(StatusReader) ECU_Tx_Status
+	// SetWarning sets the value of the Warning signal.
+	SetWarning(bool) ECU_Tx_Status
+	// SetLevel sets the value of the Level signal.
+	SetLevel(uint8) ECU_Tx_Status
+	TransmitTime() time.Time
+	Transmit(ctx context.Context) error
+	SetBeforeTransmitHook(h func(context.Context) error)
+	// SetCyclicTransmissionEnabled enables/disables cyclic transmission.
+	SetCyclicTransmissionEnabled(bool)
+	// IsCyclicTransmissionEnabled returns whether cyclic transmission is enabled/disabled.
+	IsCyclicTransmissionEnabled() bool
+}
+
+type ECU_Tx_Command interface {
+	CommandReader
+	// CopyFrom copies all values from Command.
+	CopyFrom(CommandReader) ECU_Tx_Command
+	// SetRequest sets the value of the Request signal.
+	SetRequest(uint8) ECU_Tx_Command
+	TransmitTime() time.Time
+	Transmit(ctx context.Context) error
+	SetBeforeTransmitHook(h func(context.Context) error)
+	// SetCyclicTransmissionEnabled enables/disables cyclic transmission.
+	SetCyclicTransmissionEnabled(bool)
+	// IsCyclicTransmissionEnabled returns whether cyclic transmission is enabled/disabled.
+	IsCyclicTransmissionEnabled() bool
+}
+
+type xxx_ECU struct {
+	sync.Mutex // protects all node state
+	network    string
+	address    string
+	rx         xxx_ECU_Rx
+	tx         xxx_ECU_Tx
+}
+
+var _ ECU = &xxx_ECU{}
+var _ canrunner.Node = &xxx_ECU{}
+
+func NewECU(network, address string) ECU {
+	n := &xxx_ECU{network: network, address: address}
+	n.tx.xxx_Lights.init()
+	n.tx.xxx_Lights.Reset()
+	n.tx.xxx_Status.init()
+	n.tx.xxx_Status.Reset()
+	n.tx.xxx_Command.init()
+	n.tx.xxx_Command.Reset()
+	n.rx.debugHandler = candebug.NewHandler(&n.Mutex, []generated.Message{})
+	n.tx.debugHandler = candebug.NewHandler(&n.Mutex, []generated.Message{
+		&n.tx.xxx_Lights,
+		&n.tx.xxx_Status,
+		&n.tx.xxx_Command,
+	})
+	return n
+}
+
+func (n *xxx_ECU) Run(ctx context.Context) error {
+	return canrunner.Run(ctx, n)
+}
+
+func (n *xxx_ECU) Rx() ECU_Rx {
+	return &n.rx
+}
+
+func (n *xxx_ECU) Tx() ECU_Tx {
+	return &n.tx
+}
+
+type xxx_ECU_Rx struct {
+	debugHandler *candebug.Handler
+}
+
+var _ ECU_Rx = &xxx_ECU_Rx{}
+
+func (rx *xxx_ECU_Rx) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	rx.debugHandler.ServeHTTP(w, r)
+}
+
+type xxx_ECU_Tx struct {
+	debugHandler *candebug.Handler
+	xxx_Lights   xxx_ECU_Tx_Lights
+	xxx_Status   xxx_ECU_Tx_Status
+	xxx_Command  xxx_ECU_Tx_Command
+}
+
+var _ ECU_Tx = &xxx_ECU_Tx{}
+
+func (tx *xxx_ECU_Tx) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	tx.debugHandler.ServeHTTP(w, r)
+}
+
+func (tx *xxx_ECU_Tx) Lights() ECU_Tx_Lights {
+	return &tx.xxx_Lights
+}
+
+func (tx *xxx_ECU_Tx) Status() ECU_Tx_Status {
+	return &tx.xxx_Status
+}
+
+func (tx *xxx_ECU_Tx) Command() ECU_Tx_Command {
+	return &tx.xxx_Command
+}
+
+func (n *xxx_ECU) Descriptor() *descriptor.Node {
+	return Nodes().ECU
+}
+
+func (n *xxx_ECU) Connect() (net.Conn, error) {
+	return socketcan.Dial(n.network, n.address)
+}
+
+func (n *xxx_ECU) ReceivedMessage(id uint32) (canrunner.ReceivedMessage, bool) {
+	switch id {
+	default:
+		return nil, false
+	}
+}
+
+func (n *xxx_ECU) TransmittedMessages() []canrunner.TransmittedMessage {
+	return []canrunner.TransmittedMessage{
+		&n.tx.xxx_Lights,
+		&n.tx.xxx_Status,
+		&n.tx.xxx_Command,
+	}
+}
+
+type xxx_ECU_Tx_Lights struct {
+	Lights
+	transmitTime       time.Time
+	beforeTransmitHook func(context.Context) error
+	isCyclicEnabled    bool
+	wakeUpChan         chan struct{}
+	transmitEventChan  chan struct{}
+	writeTracker       canrunner.WriteTracker
+}
+
+var _ ECU_Tx_Lights = &xxx_ECU_Tx_Lights{}
+var _ canrunner.TransmittedMessage = &xxx_ECU_Tx_Lights{}
+
+func (m *xxx_ECU_Tx_Lights) init() {
+	m.beforeTransmitHook = func(context.Context) error { return nil }
+	m.wakeUpChan = make(chan struct{}, 1)
+	m.transmitEventChan = make(chan struct{})
+}
+
+func (m *xxx_ECU_Tx_Lights) SetBeforeTransmitHook(h func(context.Context) error) {
+	m.beforeTransmitHook = h
+}
+
+func (m *xxx_ECU_Tx_Lights) BeforeTransmitHook() func(context.Context) error {
+	return m.beforeTransmitHook
+}
+
+func (m *xxx_ECU_Tx_Lights) TransmitTime() time.Time {
+	return m.transmitTime
+}
+
+func (m *xxx_ECU_Tx_Lights) SetTransmitTime(t time.Time) {
+	m.transmitTime = t
+}
+
+func (m *xxx_ECU_Tx_Lights) IsCyclicTransmissionEnabled() bool {
+	return m.isCyclicEnabled
+}
+
+func (m *xxx_ECU_Tx_Lights) SetCyclicTransmissionEnabled(b bool) {
+	m.isCyclicEnabled = b
+	select {
+	case m.wakeUpChan <- struct{}{}:
+	default:
+	}
+}
+
+func (m *xxx_ECU_Tx_Lights) WakeUpChan() <-chan struct{} {
+	return m.wakeUpChan
+}
+
+func (m *xxx_ECU_Tx_Lights) Transmit(ctx context.Context) error {
+	select {
+	case m.transmitEventChan <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("event-triggered transmit of Lights: %w", ctx.Err())
+	}
+}
+
+func (m *xxx_ECU_Tx_Lights) TransmitEventChan() <-chan struct{} {
+	return m.transmitEventChan
+}
+
+func (m *xxx_ECU_Tx_Lights) WriteTracker() *canrunner.WriteTracker {
+	return &m.writeTracker
+}
+
+func (m *xxx_ECU_Tx_Lights) CopyFrom(o LightsReader) ECU_Tx_Lights {
+	m.Lights.CopyFrom(o)
+	m.writeTracker.Written(Messages().Lights.HeadLights)
+	m.writeTracker.Written(Messages().Lights.Brightness)
+	m.writeTracker.Written(Messages().Lights.Counter)
+	return m
+}
+
+func (m *xxx_ECU_Tx_Lights) SetHeadLights(v uint8) ECU_Tx_Lights {
+	m.Lights.SetHeadLights(v)
+	m.writeTracker.Written(Messages().Lights.HeadLights)
+	return m
+}
+
+func (m *xxx_ECU_Tx_Lights) SetBrightness(v uint8) ECU_Tx_Lights {
+	m.Lights.SetBrightness(v)
+	m.writeTracker.Written(Messages().Lights.Brightness)
+	return m
+}
+
+func (m *xxx_ECU_Tx_Lights) SetCounter(v uint8) ECU_Tx_Lights {
+	m.Lights.SetCounter(v)
+	m.writeTracker.Written(Messages().Lights.Counter)
+	return m
+}
+
+var _ canrunner.WriteTracked = &xxx_ECU_Tx_Lights{}
+
+var _ canrunner.TransmittedMessage = &xxx_ECU_Tx_Lights{}
+
+type xxx_ECU_Tx_Status struct {
+	Status
+	transmitTime       time.Time
+	beforeTransmitHook func(context.Context) error
+	isCyclicEnabled    bool
+	wakeUpChan         chan struct{}
+	transmitEventChan  chan struct{}
+	writeTracker       canrunner.WriteTracker
+}
+
+var _ ECU_Tx_Status = &xxx_ECU_Tx_Status{}
+var _ canrunner.TransmittedMessage = &xxx_ECU_Tx_Status{}
+
+func (m *xxx_ECU_Tx_Status) init() {
+	m.beforeTransmitHook = func(context.Context) error { return nil }
+	m.wakeUpChan = make(chan struct{}, 1)
+	m.transmitEventChan = make(chan struct{})
+}
+
+func (m *xxx_ECU_Tx_Status) SetBeforeTransmitHook(h func(context.Context) error) {
+	m.beforeTransmitHook = h
+}
+
+func (m *xxx_ECU_Tx_Status) BeforeTransmitHook() func(context.Context) error {
+	return m.beforeTransmitHook
+}
+
+func (m *xxx_ECU_Tx_Status) TransmitTime() time.Time {
+	return m.transmitTime
+}
+
+func (m *xxx_ECU_Tx_Status) SetTransmitTime(t time.Time) {
+	m.transmitTime = t
+}
+
+func (m *xxx_ECU_Tx_Status) IsCyclicTransmissionEnabled() bool {
+	return m.isCyclicEnabled
+}
+
+func (m *xxx_ECU_Tx_Status) SetCyclicTransmissionEnabled(b bool) {
+	m.isCyclicEnabled = b
+	select {
+	case m.wakeUpChan <- struct{}{}:
+	default:
+	}
+}
+
+func (m *xxx_ECU_Tx_Status) WakeUpChan() <-chan struct{} {
+	return m.wakeUpChan
+}
+
+func (m *xxx_ECU_Tx_Status) Transmit(ctx context.Context) error {
+	select {
+	case m.transmitEventChan <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("event-triggered transmit of Status: %w", ctx.Err())
+	}
+}
+
+func (m *xxx_ECU_Tx_Status) TransmitEventChan() <-chan struct{} {
+	return m.transmitEventChan
+}
+
+func (m *xxx_ECU_Tx_Status) WriteTracker() *canrunner.WriteTracker {
+	return &m.writeTracker
+}
+
+func (m *xxx_ECU_Tx_Status) CopyFrom(o StatusReader) ECU_Tx_Status {
+	m.Status.CopyFrom(o)
+	m.writeTracker.Written(Messages().Status.Warning)
+	m.writeTracker.Written(Messages().Status.Level)
+	return m
+}
+
+func (m *xxx_ECU_Tx_Status) SetWarning(v bool) ECU_Tx_Status {
+	m.Status.SetWarning(v)
+	m.writeTracker.Written(Messages().Status.Warning)
+	return m
+}
+
+func (m *xxx_ECU_Tx_Status) SetLevel(v uint8) ECU_Tx_Status {
+	m.Status.SetLevel(v)
+	m.writeTracker.Written(Messages().Status.Level)
+	return m
+}
+
+var _ canrunner.WriteTracked = &xxx_ECU_Tx_Status{}
+
+var _ canrunner.TransmittedMessage = &xxx_ECU_Tx_Status{}
+
+type xxx_ECU_Tx_Command struct {
+	Command
+	transmitTime       time.Time
+	beforeTransmitHook func(context.Context) error
+	isCyclicEnabled    bool
+	wakeUpChan         chan struct{}
+	transmitEventChan  chan struct{}
+	writeTracker       canrunner.WriteTracker
+}
+
+var _ ECU_Tx_Command = &xxx_ECU_Tx_Command{}
+var _ canrunner.TransmittedMessage = &xxx_ECU_Tx_Command{}
+
+func (m *xxx_ECU_Tx_Command) init() {
+	m.beforeTransmitHook = func(context.Context) error { return nil }
+	m.wakeUpChan = make(chan struct{}, 1)
+	m.transmitEventChan = make(chan struct{})
+}
+
+func (m *xxx_ECU_Tx_Command) SetBeforeTransmitHook(h func(context.Context) error) {
+	m.beforeTransmitHook = h
+}
+
+func (m *xxx_ECU_Tx_Command) BeforeTransmitHook() func(context.Context) error {
+	return m.beforeTransmitHook
+}
+
+func (m *xxx_ECU_Tx_Command) TransmitTime() time.Time {
+	return m.transmitTime
+}
+
+func (m *xxx_ECU_Tx_Command) SetTransmitTime(t time.Time) {
+	m.transmitTime = t
+}
+
+func (m *xxx_ECU_Tx_Command) IsCyclicTransmissionEnabled() bool {
+	return m.isCyclicEnabled
+}
+
+func (m *xxx_ECU_Tx_Command) SetCyclicTransmissionEnabled(b bool) {
+	m.isCyclicEnabled = b
+	select {
+	case m.wakeUpChan <- struct{}{}:
+	default:
+	}
+}
+
+func (m *xxx_ECU_Tx_Command) WakeUpChan() <-chan struct{} {
+	return m.wakeUpChan
+}
+
+func (m *xxx_ECU_Tx_Command) Transmit(ctx context.Context) error {
+	select {
+	case m.transmitEventChan <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("event-triggered transmit of Command: %w", ctx.Err())
+	}
+}
+
+func (m *xxx_ECU_Tx_Command) TransmitEventChan() <-chan struct{} {
+	return m.transmitEventChan
+}
+
+func (m *xxx_ECU_Tx_Command) WriteTracker() *canrunner.WriteTracker {
+	return &m.writeTracker
+}
+
+func (m *xxx_ECU_Tx_Command) CopyFrom(o CommandReader) ECU_Tx_Command {
+	m.Command.CopyFrom(o)
+	m.writeTracker.Written(Messages().Command.Request)
+	return m
+}
+
+func (m *xxx_ECU_Tx_Command) SetRequest(v uint8) ECU_Tx_Command {
+	m.Command.SetRequest(v)
+	m.writeTracker.Written(Messages().Command.Request)
+	return m
+}
+
+var _ canrunner.WriteTracked = &xxx_ECU_Tx_Command{}
+
+var _ canrunner.TransmittedMessage = &xxx_ECU_Tx_Command{}
+
+type GATEWAY interface {
+	sync.Locker
+	Tx() GATEWAY_Tx
+	Rx() GATEWAY_Rx
+	Run(ctx context.Context) error
+}
+
+type GATEWAY_Rx interface {
+	http.Handler // for debugging
+	Lights() GATEWAY_Rx_Lights
+	Status() GATEWAY_Rx_Status
+	Command() GATEWAY_Rx_Command
+}
+
+type GATEWAY_Tx interface {
+	http.Handler // for debugging
+}
+
+type GATEWAY_Rx_Lights interface {
+	LightsReader
+	ReceiveTime() time.Time
+	SetAfterReceiveHook(h func(context.Context) error)
+	// SubscribeHeadLights calls the hook when the trigger fires for the HeadLights signal.
+	SubscribeHeadLights(trigger canrunner.SignalTrigger, h func(ctx context.Context, prev, curr LightsReader) error)
+	// SubscribeBrightness calls the hook when the trigger fires for the Brightness signal.
+	SubscribeBrightness(trigger canrunner.SignalTrigger, h func(ctx context.Context, prev, curr LightsReader) error)
+	// SubscribeCounter calls the hook when the trigger fires for the Counter signal.
+	SubscribeCounter(trigger canrunner.SignalTrigger, h func(ctx context.Context, prev, curr LightsReader) error)
+}
+
+type GATEWAY_Rx_Status interface {
+	StatusReader
+	ReceiveTime() time.Time
+	SetAfterReceiveHook(h func(context.Context) error)
+	// SubscribeWarning calls the hook when the trigger fires for the Warning signal.
+	SubscribeWarning(trigger canrunner.SignalTrigger, h func(ctx context.Context, prev, curr StatusReader) error)
+	// SubscribeLevel calls the hook when the trigger fires for the Level signal.
+	SubscribeLevel(trigger canrunner.SignalTrigger, h func(ctx context.Context, prev, curr StatusReader) error)
+}
+
+type GATEWAY_Rx_Command interface {
+	CommandReader
+	ReceiveTime() time.Time
+	SetAfterReceiveHook(h func(context.Context) error)
+	// SubscribeRequest calls the hook when the trigger fires for the Request signal.
+	SubscribeRequest(trigger canrunner.SignalTrigger, h func(ctx context.Context, prev, curr CommandReader) error)
+}
+
+type xxx_GATEWAY struct {
+	sync.Mutex // protects all node state
+	network    string
+	address    string
+	rx         xxx_GATEWAY_Rx
+	tx         xxx_GATEWAY_Tx
+}
+
+var _ GATEWAY = &xxx_GATEWAY{}
+var _ canrunner.Node = &xxx_GATEWAY{}
+
+func NewGATEWAY(network, address string) GATEWAY {
+	n := &xxx_GATEWAY{network: network, address: address}
+	n.rx.xxx_Lights.init()
+	n.rx.xxx_Lights.Reset()
+	n.rx.xxx_Status.init()
+	n.rx.xxx_Status.Reset()
+	n.rx.xxx_Command.init()
+	n.rx.xxx_Command.Reset()
+	n.rx.debugHandler = candebug.NewHandler(&n.Mutex, []generated.Message{
+		&n.rx.xxx_Lights,
+		&n.rx.xxx_Status,
+		&n.rx.xxx_Command,
+	})
+	n.tx.debugHandler = candebug.NewHandler(&n.Mutex, []generated.Message{})
+	return n
+}
+
+func (n *xxx_GATEWAY) Run(ctx context.Context) error {
+	return canrunner.Run(ctx, n)
+}
+
+func (n *xxx_GATEWAY) Rx() GATEWAY_Rx {
+	return &n.rx
+}
+
+func (n *xxx_GATEWAY) Tx() GATEWAY_Tx {
+	return &n.tx
+}
+
+type xxx_GATEWAY_Rx struct {
+	debugHandler *candebug.Handler
+	xxx_Lights   xxx_GATEWAY_Rx_Lights
+	xxx_Status   xxx_GATEWAY_Rx_Status
+	xxx_Command  xxx_GATEWAY_Rx_Command
+}
+
+var _ GATEWAY_Rx = &xxx_GATEWAY_Rx{}
+
+func (rx *xxx_GATEWAY_Rx) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	rx.debugHandler.ServeHTTP(w, r)
+}
+
+func (rx *xxx_GATEWAY_Rx) Lights() GATEWAY_Rx_Lights {
+	return &rx.xxx_Lights
+}
+
+func (rx *xxx_GATEWAY_Rx) Status() GATEWAY_Rx_Status {
+	return &rx.xxx_Status
+}
+
+func (rx *xxx_GATEWAY_Rx) Command() GATEWAY_Rx_Command {
+	return &rx.xxx_Command
+}
+
+type xxx_GATEWAY_Tx struct {
+	debugHandler *candebug.Handler
+}
+
+var _ GATEWAY_Tx = &xxx_GATEWAY_Tx{}
+
+func (tx *xxx_GATEWAY_Tx) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	tx.debugHandler.ServeHTTP(w, r)
+}
+
+func (n *xxx_GATEWAY) Descriptor() *descriptor.Node {
+	return Nodes().GATEWAY
+}
+
+func (n *xxx_GATEWAY) Connect() (net.Conn, error) {
+	return socketcan.Dial(n.network, n.address)
+}
+
+func (n *xxx_GATEWAY) ReceivedMessage(id uint32) (canrunner.ReceivedMessage, bool) {
+	switch id {
+	case 100:
+		return &n.rx.xxx_Lights, true
+	case 200:
+		return &n.rx.xxx_Status, true
+	case 300:
+		return &n.rx.xxx_Command, true
+	default:
+		return nil, false
+	}
+}
+
+func (n *xxx_GATEWAY) TransmittedMessages() []canrunner.TransmittedMessage {
+	return []canrunner.TransmittedMessage{}
+}
+
+type xxx_GATEWAY_Rx_Lights struct {
+	Lights
+	receiveTime         time.Time
+	afterReceiveHook    func(context.Context) error
+	signalSubscriptions canrunner.SignalSubscriptions
+}
+
+func (m *xxx_GATEWAY_Rx_Lights) init() {
+	m.afterReceiveHook = func(context.Context) error { return nil }
+}
+
+func (m *xxx_GATEWAY_Rx_Lights) SetAfterReceiveHook(h func(context.Context) error) {
+	m.afterReceiveHook = h
+}
+
+func (m *xxx_GATEWAY_Rx_Lights) AfterReceiveHook() func(context.Context) error {
+	return m.afterReceiveHook
+}
+
+func (m *xxx_GATEWAY_Rx_Lights) ReceiveTime() time.Time {
+	return m.receiveTime
+}
+
+func (m *xxx_GATEWAY_Rx_Lights) SetReceiveTime(t time.Time) {
+	m.receiveTime = t
+}
+
+func (m *xxx_GATEWAY_Rx_Lights) SignalSubscriptions() *canrunner.SignalSubscriptions {
+	return &m.signalSubscriptions
+}
+
+func (m *xxx_GATEWAY_Rx_Lights) SubscribeHeadLights(
+	trigger canrunner.SignalTrigger,
+	h func(ctx context.Context, prev, curr LightsReader) error,
+) {
+	m.signalSubscriptions.Subscribe(
+		Messages().Lights.HeadLights,
+		trigger,
+		func(ctx context.Context, prevFrame, currFrame can.Frame) error {
+			var prev, curr Lights
+			if err := prev.UnmarshalFrame(prevFrame); err != nil {
+				return err
+			}
+			if err := curr.UnmarshalFrame(currFrame); err != nil {
+				return err
+			}
+			return h(ctx, &prev, &curr)
+		},
+	)
+}
+
+func (m *xxx_GATEWAY_Rx_Lights) SubscribeBrightness(
+	trigger canrunner.SignalTrigger,
+	h func(ctx context.Context, prev, curr LightsReader) error,
+) {
+	m.signalSubscriptions.Subscribe(
+		Messages().Lights.Brightness,
+		trigger,
+		func(ctx context.Context, prevFrame, currFrame can.Frame) error {
+			var prev, curr Lights
+			if err := prev.UnmarshalFrame(prevFrame); err != nil {
+				return err
+			}
+			if err := curr.UnmarshalFrame(currFrame); err != nil {
+				return err
+			}
+			return h(ctx, &prev, &curr)
+		},
+	)
+}
+
+func (m *xxx_GATEWAY_Rx_Lights) SubscribeCounter(
+	trigger canrunner.SignalTrigger,
+	h func(ctx context.Context, prev, curr LightsReader) error,
+) {
+	m.signalSubscriptions.Subscribe(
+		Messages().Lights.Counter,
+		trigger,
+		func(ctx context.Context, prevFrame, currFrame can.Frame) error {
+			var prev, curr Lights
+			if err := prev.UnmarshalFrame(prevFrame); err != nil {
+				return err
+			}
+			if err := curr.UnmarshalFrame(currFrame); err != nil {
+				return err
+			}
+			return h(ctx, &prev, &curr)
+		},
+	)
+}
+
+var _ canrunner.ReceivedMessage = &xxx_GATEWAY_Rx_Lights{}
+
+var _ canrunner.SignalSubscriber = &xxx_GATEWAY_Rx_Lights{}
+
+type xxx_GATEWAY_Rx_Status struct {
+	Status
+	receiveTime         time.Time
+	afterReceiveHook    func(context.Context) error
+	signalSubscriptions canrunner.SignalSubscriptions
+}
+
+func (m *xxx_GATEWAY_Rx_Status) init() {
+	m.afterReceiveHook = func(context.Context) error { return nil }
+}
+
+func (m *xxx_GATEWAY_Rx_Status) SetAfterReceiveHook(h func(context.Context) error) {
+	m.afterReceiveHook = h
+}
+
+func (m *xxx_GATEWAY_Rx_Status) AfterReceiveHook() func(context.Context) error {
+	return m.afterReceiveHook
+}
+
+func (m *xxx_GATEWAY_Rx_Status) ReceiveTime() time.Time {
+	return m.receiveTime
+}
+
+func (m *xxx_GATEWAY_Rx_Status) SetReceiveTime(t time.Time) {
+	m.receiveTime = t
+}
+
+func (m *xxx_GATEWAY_Rx_Status) SignalSubscriptions() *canrunner.SignalSubscriptions {
+	return &m.signalSubscriptions
+}
+
+func (m *xxx_GATEWAY_Rx_Status) SubscribeWarning(
+	trigger canrunner.SignalTrigger,
+	h func(ctx context.Context, prev, curr StatusReader) error,
+) {
+	m.signalSubscriptions.Subscribe(
+		Messages().Status.Warning,
+		trigger,
+		func(ctx context.Context, prevFrame, currFrame can.Frame) error {
+			var prev, curr Status
+			if err := prev.UnmarshalFrame(prevFrame); err != nil {
+				return err
+			}
+			if err := curr.UnmarshalFrame(currFrame); err != nil {
+				return err
+			}
+			return h(ctx, &prev, &curr)
+		},
+	)
+}
+
+func (m *xxx_GATEWAY_Rx_Status) SubscribeLevel(
+	trigger canrunner.SignalTrigger,
+	h func(ctx context.Context, prev, curr StatusReader) error,
+) {
+	m.signalSubscriptions.Subscribe(
+		Messages().Status.Level,
+		trigger,
+		func(ctx context.Context, prevFrame, currFrame can.Frame) error {
+			var prev, curr Status
+			if err := prev.UnmarshalFrame(prevFrame); err != nil {
+				return err
+			}
+			if err := curr.UnmarshalFrame(currFrame); err != nil {
+				return err
+			}
+			return h(ctx, &prev, &curr)
+		},
+	)
+}
+
+var _ canrunner.ReceivedMessage = &xxx_GATEWAY_Rx_Status{}
+
+var _ canrunner.SignalSubscriber = &xxx_GATEWAY_Rx_Status{}
+
+type xxx_GATEWAY_Rx_Command struct {
+	Command
+	receiveTime         time.Time
+	afterReceiveHook    func(context.Context) error
+	signalSubscriptions canrunner.SignalSubscriptions
+}
+
+func (m *xxx_GATEWAY_Rx_Command) init() {
+	m.afterReceiveHook = func(context.Context) error { return nil }
+}
+
+func (m *xxx_GATEWAY_Rx_Command) SetAfterReceiveHook(h func(context.Context) error) {
+	m.afterReceiveHook = h
+}
+
+func (m *xxx_GATEWAY_Rx_Command) AfterReceiveHook() func(context.Context) error {
+	return m.afterReceiveHook
+}
+
+func (m *xxx_GATEWAY_Rx_Command) ReceiveTime() time.Time {
+	return m.receiveTime
+}
+
+func (m *xxx_GATEWAY_Rx_Command) SetReceiveTime(t time.Time) {
+	m.receiveTime = t
+}
+
+func (m *xxx_GATEWAY_Rx_Command) SignalSubscriptions() *canrunner.SignalSubscriptions {
+	return &m.signalSubscriptions
+}
+
+func (m *xxx_GATEWAY_Rx_Command) SubscribeRequest(
+	trigger canrunner.SignalTrigger,
+	h func(ctx context.Context, prev, curr CommandReader) error,
+) {
+	m.signalSubscriptions.Subscribe(
+		Messages().Command.Request,
+		trigger,
+		func(ctx context.Context, prevFrame, currFrame can.Frame) error {
+			var prev, curr Command
+			if err := prev.UnmarshalFrame(prevFrame); err != nil {
+				return err
+			}
+			if err := curr.UnmarshalFrame(currFrame); err != nil {
+				return err
+			}
+			return h(ctx, &prev, &curr)
+		},
+	)
+}
+
+var _ canrunner.ReceivedMessage = &xxx_GATEWAY_Rx_Command{}
+
+var _ canrunner.SignalSubscriber = &xxx_GATEWAY_Rx_Command{}
+
+// FrameBatch holds the messages decoded from batches of frames, in a slice per message.
+//
+// The slices are reused after Reset, so that decoding does not allocate once they have grown to the size of a
+// batch.
+type FrameBatch struct {
+	Lights  []Lights
+	Status  []Status
+	Command []Command
+}
+
+// Reset empties the slices of the batch, keeping their capacity.
+func (b *FrameBatch) Reset() {
+	b.Lights = b.Lights[:0]
+	b.Status = b.Status[:0]
+	b.Command = b.Command[:0]
+}
+
+// DecodeFrames decodes a batch of frames, and appends the decoded messages to the batch.
+//
+// Remote frames and frames of unknown messages are skipped.
+func (b *FrameBatch) DecodeFrames(frames []can.Frame) error {
+	for i := range frames {
+		f := &frames[i]
+		if f.IsRemote {
+			continue
+		}
+		switch f.ID {
+		case 100:
+			if !f.IsExtended {
+				b.Lights = append(b.Lights, Lights{})
+				m := &b.Lights[len(b.Lights)-1]
+				if err := m.DecodeFrame(f); err != nil {
+					b.Lights = b.Lights[:len(b.Lights)-1]
+					return err
+				}
+			}
+		case 200:
+			if !f.IsExtended {
+				b.Status = append(b.Status, Status{})
+				m := &b.Status[len(b.Status)-1]
+				if err := m.DecodeFrame(f); err != nil {
+					b.Status = b.Status[:len(b.Status)-1]
+					return err
+				}
+			}
+		case 300:
+			if !f.IsExtended {
+				b.Command = append(b.Command, Command{})
+				m := &b.Command[len(b.Command)-1]
+				if err := m.DecodeFrame(f); err != nil {
+					b.Command = b.Command[:len(b.Command)-1]
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// Nodes returns the sendtypes node descriptors.
+func Nodes() *NodesDescriptor {
+	return nd
+}
+
+// NodesDescriptor contains all sendtypes node descriptors.
+type NodesDescriptor struct {
+	ECU     *descriptor.Node
+	GATEWAY *descriptor.Node
+}
+
+// Messages returns the sendtypes message descriptors.
+func Messages() *MessagesDescriptor {
+	return md
+}
+
+// MessagesDescriptor contains all sendtypes message descriptors.
+type MessagesDescriptor struct {
+	Lights  *LightsDescriptor
+	Status  *StatusDescriptor
+	Command *CommandDescriptor
+}
+
+// UnmarshalFrame unmarshals the provided sendtypes CAN frame.
+func (md *MessagesDescriptor) UnmarshalFrame(f can.Frame) (generated.Message, error) {
+	switch f.ID {
+	case md.Lights.ID:
+		var msg Lights
+		if err := msg.UnmarshalFrame(f); err != nil {
+			return nil, fmt.Errorf("unmarshal sendtypes frame: %w", err)
+		}
+		return &msg, nil
+	case md.Status.ID:
+		var msg Status
+		if err := msg.UnmarshalFrame(f); err != nil {
+			return nil, fmt.Errorf("unmarshal sendtypes frame: %w", err)
+		}
+		return &msg, nil
+	case md.Command.ID:
+		var msg Command
+		if err := msg.UnmarshalFrame(f); err != nil {
+			return nil, fmt.Errorf("unmarshal sendtypes frame: %w", err)
+		}
+		return &msg, nil
+	default:
+		return nil, fmt.Errorf("unmarshal sendtypes frame: ID not in database: %d", f.ID)
+	}
+}
+
+type LightsDescriptor struct {
+	*descriptor.Message
+	HeadLights *descriptor.Signal
+	Brightness *descriptor.Signal
+	Counter    *descriptor.Signal
+}
+
+type StatusDescriptor struct {
+	*descriptor.Message
+	Warning *descriptor.Signal
+	Level   *descriptor.Signal
+}
+
+type CommandDescriptor struct {
+	*descriptor.Message
+	Request *descriptor.Signal
+}
+
+// Database returns the sendtypes database descriptor.
+func (md *MessagesDescriptor) Database() *descriptor.Database {
+	return d
+}
+
+var nd = &NodesDescriptor{
+	ECU:     d.Nodes[0],
+	GATEWAY: d.Nodes[1],
+}
+
+var md = &MessagesDescriptor{
+	Lights: &LightsDescriptor{
+		Message:    d.Messages[0],
+		HeadLights: d.Messages[0].Signals[0],
+		Brightness: d.Messages[0].Signals[1],
+		Counter:    d.Messages[0].Signals[2],
+	},
+	Status: &StatusDescriptor{
+		Message: d.Messages[1],
+		Warning: d.Messages[1].Signals[0],
+		Level:   d.Messages[1].Signals[1],
+	},
+	Command: &CommandDescriptor{
+		Message: d.Messages[2],
+		Request: d.Messages[2].Signals[0],
+	},
+}
+
+var d = (*descriptor.Database)(&descriptor.Database{
+	SourceFile: (string)("testdata/dbc/sendtypes/sendtypes.dbc"),
+	Version:    (string)(""),
+	Messages: ([]*descriptor.Message)([]*descriptor.Message{
+		(*descriptor.Message)(&descriptor.Message{
+			Name:        (string)("Lights"),
+			ID:          (uint32)(100),
+			IsExtended:  (bool)(false),
+			Length:      (uint8)(2),
+			SendType:    (descriptor.SendType)(4),
+			Description: (string)("Sent when the light state changes"),
+			Signals: ([]*descriptor.Signal)([]*descriptor.Signal{
+				(*descriptor.Signal)(&descriptor.Signal{
+					Name:              (string)("HeadLights"),
+					Start:             (uint8)(0),
+					Length:            (uint8)(2),
+					IsBigEndian:       (bool)(false),
+					IsSigned:          (bool)(false),
+					IsFloat:           (bool)(false),
+					IsMultiplexer:     (bool)(false),
+					IsMultiplexed:     (bool)(false),
+					MultiplexerValue:  (uint)(0),
+					MultiplexerName:   (string)(""),
+					MultiplexerRanges: ([]*descriptor.MultiplexerRange)(nil),
+					Offset:            (float64)(0),
+					Scale:             (float64)(1),
+					Min:               (float64)(0),
+					Max:               (float64)(3),
+					Unit:              (string)(""),
+					Description:       (string)(""),
+					SignalType:        (string)(""),
+					ValueTable:        (string)(""),
+					ValueDescriptions: ([]*descriptor.ValueDescription)(nil),
+					ReceiverNodes: ([]string)([]string{
+						(string)("GATEWAY"),
+					}),
+					DefaultValue:  (int)(0),
+					SendType:      (descriptor.SendType)(0),
+					InactiveValue: (int)(0),
+					Attributes: ([]*descriptor.Attribute)([]*descriptor.Attribute{
+						(*descriptor.Attribute)(&descriptor.Attribute{
+							Name:        (string)("GenSigInactiveValue"),
+							Type:        (descriptor.AttributeType)(0),
+							IntValue:    (int64)(0),
+							FloatValue:  (float64)(0),
+							StringValue: (string)(""),
+							IsDefault:   (bool)(true),
+						}),
+						(*descriptor.Attribute)(&descriptor.Attribute{
+							Name:        (string)("GenSigSendType"),
+							Type:        (descriptor.AttributeType)(4),
+							IntValue:    (int64)(4),
+							FloatValue:  (float64)(0),
+							StringValue: (string)("NoSigSendType"),
+							IsDefault:   (bool)(true),
+						}),
+					}),
+				}),
+				(*descriptor.Signal)(&descriptor.Signal{
+					Name:              (string)("Brightness"),
+					Start:             (uint8)(4),
+					Length:            (uint8)(4),
+					IsBigEndian:       (bool)(false),
+					IsSigned:          (bool)(false),
+					IsFloat:           (bool)(false),
+					IsMultiplexer:     (bool)(false),
+					IsMultiplexed:     (bool)(false),
+					MultiplexerValue:  (uint)(0),
+					MultiplexerName:   (string)(""),
+					MultiplexerRanges: ([]*descriptor.MultiplexerRange)(nil),
+					Offset:            (float64)(0),
+					Scale:             (float64)(1),
+					Min:               (float64)(0),
+					Max:               (float64)(15),
+					Unit:              (string)(""),
+					Description:       (string)(""),
+					SignalType:        (string)(""),
+					ValueTable:        (string)(""),
+					ValueDescriptions: ([]*descriptor.ValueDescription)(nil),
+					ReceiverNodes: ([]string)([]string{
+						(string)("GATEWAY"),
+					}),
+					DefaultValue:  (int)(0),
+					SendType:      (descriptor.SendType)(1),
+					InactiveValue: (int)(0),
+					Attributes: ([]*descriptor.Attribute)([]*descriptor.Attribute{
+						(*descriptor.Attribute)(&descriptor.Attribute{
+							Name:        (string)("GenSigInactiveValue"),
+							Type:        (descriptor.AttributeType)(0),
+							IntValue:    (int64)(0),
+							FloatValue:  (float64)(0),
+							StringValue: (string)(""),
+							IsDefault:   (bool)(true),
+						}),
+						(*descriptor.Attribute)(&descriptor.Attribute{
+							Name:        (string)("GenSigSendType"),
+							Type:        (descriptor.AttributeType)(4),
+							IntValue:    (int64)(0),
+							FloatValue:  (float64)(0),
+							StringValue: (string)("Cyclic"),
+							IsDefault:   (bool)(false),
+						}),
+					}),
+				}),
+				(*descriptor.Signal)(&descriptor.Signal{
+					Name:              (string)("Counter"),
+					Start:             (uint8)(8),
+					Length:            (uint8)(8),
+					IsBigEndian:       (bool)(false),
+					IsSigned:          (bool)(false),
+					IsFloat:           (bool)(false),
+					IsMultiplexer:     (bool)(false),
+					IsMultiplexed:     (bool)(false),
+					MultiplexerValue:  (uint)(0),
+					MultiplexerName:   (string)(""),
+					MultiplexerRanges: ([]*descriptor.MultiplexerRange)(nil),
+					Offset:            (float64)(0),
+					Scale:             (float64)(1),
+					Min:               (float64)(0),
+					Max:               (float64)(255),
+					Unit:              (string)(""),
+					Description:       (string)(""),
+					SignalType:        (string)(""),
+					ValueTable:        (string)(""),
+					ValueDescriptions: ([]*descriptor.ValueDescription)(nil),
+					ReceiverNodes: ([]string)([]string{
+						(string)("GATEWAY"),
+					}),
+					DefaultValue:  (int)(0),
+					SendType:      (descriptor.SendType)(0),
+					InactiveValue: (int)(0),
+					Attributes: ([]*descriptor.Attribute)([]*descriptor.Attribute{
+						(*descriptor.Attribute)(&descriptor.Attribute{
+							Name:        (string)("GenSigInactiveValue"),
+							Type:        (descriptor.AttributeType)(0),
+							IntValue:    (int64)(0),
+							FloatValue:  (float64)(0),
+							StringValue: (string)(""),
+							IsDefault:   (bool)(true),
+						}),
+						(*descriptor.Attribute)(&descriptor.Attribute{
+							Name:        (string)("GenSigSendType"),
+							Type:        (descriptor.AttributeType)(4),
+							IntValue:    (int64)(4),
+							FloatValue:  (float64)(0),
+							StringValue: (string)("NoSigSendType"),
+							IsDefault:   (bool)(false),
+						}),
+					}),
+				}),
+			}),
+			SenderNode:       (string)("ECU"),
+			TransmitterNodes: ([]string)(nil),
+			SignalGroups:     ([]*descriptor.SignalGroup)(nil),
+			CycleTime:        (time.Duration)(0),
+			CycleTimeFast:    (time.Duration)(10000000),
+			DelayTime:        (time.Duration)(0),
+			RepetitionCount:  (int)(2),
+			Attributes: ([]*descriptor.Attribute)([]*descriptor.Attribute{
+				(*descriptor.Attribute)(&descriptor.Attribute{
+					Name:        (string)("GenMsgCycleTime"),
+					Type:        (descriptor.AttributeType)(0),
+					IntValue:    (int64)(0),
+					FloatValue:  (float64)(0),
+					StringValue: (string)(""),
+					IsDefault:   (bool)(true),
+				}),
+				(*descriptor.Attribute)(&descriptor.Attribute{
+					Name:        (string)("GenMsgCycleTimeFast"),
+					Type:        (descriptor.AttributeType)(0),
+					IntValue:    (int64)(10),
+					FloatValue:  (float64)(0),
+					StringValue: (string)(""),
+					IsDefault:   (bool)(false),
+				}),
+				(*descriptor.Attribute)(&descriptor.Attribute{
+					Name:        (string)("GenMsgDelayTime"),
+					Type:        (descriptor.AttributeType)(0),
+					IntValue:    (int64)(0),
+					FloatValue:  (float64)(0),
+					StringValue: (string)(""),
+					IsDefault:   (bool)(true),
+				}),
+				(*descriptor.Attribute)(&descriptor.Attribute{
+					Name:        (string)("GenMsgNrOfRepetition"),
+					Type:        (descriptor.AttributeType)(0),
+					IntValue:    (int64)(2),
+					FloatValue:  (float64)(0),
+					StringValue: (string)(""),
+					IsDefault:   (bool)(false),
+				}),
+				(*descriptor.Attribute)(&descriptor.Attribute{
+					Name:        (string)("GenMsgSendType"),
+					Type:        (descriptor.AttributeType)(4),
+					IntValue:    (int64)(2),
+					FloatValue:  (float64)(0),
+					StringValue: (string)("OnChange"),
+					IsDefault:   (bool)(false),
+				}),
+			}),
+		}),
+		(*descriptor.Message)(&descriptor.Message{
+			Name:        (string)("Status"),
+			ID:          (uint32)(200),
+			IsExtended:  (bool)(false),
+			Length:      (uint8)(1),
+			SendType:    (descriptor.SendType)(7),
+			Description: (string)("Sent fast while a warning is active"),
+			Signals: ([]*descriptor.Signal)([]*descriptor.Signal{
+				(*descriptor.Signal)(&descriptor.Signal{
+					Name:              (string)("Warning"),
+					Start:             (uint8)(0),
+					Length:            (uint8)(1),
+					IsBigEndian:       (bool)(false),
+					IsSigned:          (bool)(false),
+					IsFloat:           (bool)(false),
+					IsMultiplexer:     (bool)(false),
+					IsMultiplexed:     (bool)(false),
+					MultiplexerValue:  (uint)(0),
+					MultiplexerName:   (string)(""),
+					MultiplexerRanges: ([]*descriptor.MultiplexerRange)(nil),
+					Offset:            (float64)(0),
+					Scale:             (float64)(1),
+					Min:               (float64)(0),
+					Max:               (float64)(1),
+					Unit:              (string)(""),
+					Description:       (string)(""),
+					SignalType:        (string)(""),
+					ValueTable:        (string)(""),
+					ValueDescriptions: ([]*descriptor.ValueDescription)(nil),
+					ReceiverNodes: ([]string)([]string{
+						(string)("GATEWAY"),
+					}),
+					DefaultValue:  (int)(0),
+					SendType:      (descriptor.SendType)(0),
+					InactiveValue: (int)(0),
+					Attributes: ([]*descriptor.Attribute)([]*descriptor.Attribute{
+						(*descriptor.Attribute)(&descriptor.Attribute{
+							Name:        (string)("GenSigInactiveValue"),
+							Type:        (descriptor.AttributeType)(0),
+							IntValue:    (int64)(0),
+							FloatValue:  (float64)(0),
+							StringValue: (string)(""),
+							IsDefault:   (bool)(true),
+						}),
+						(*descriptor.Attribute)(&descriptor.Attribute{
+							Name:        (string)("GenSigSendType"),
+							Type:        (descriptor.AttributeType)(4),
+							IntValue:    (int64)(4),
+							FloatValue:  (float64)(0),
+							StringValue: (string)("NoSigSendType"),
+							IsDefault:   (bool)(true),
+						}),
+					}),
+				}),
+				(*descriptor.Signal)(&descriptor.Signal{
+					Name:              (string)("Level"),
+					Start:             (uint8)(4),
+					Length:            (uint8)(4),
+					IsBigEndian:       (bool)(false),
+					IsSigned:          (bool)(false),
+					IsFloat:           (bool)(false),
+					IsMultiplexer:     (bool)(false),
+					IsMultiplexed:     (bool)(false),
+					MultiplexerValue:  (uint)(0),
+					MultiplexerName:   (string)(""),
+					MultiplexerRanges: ([]*descriptor.MultiplexerRange)(nil),
+					Offset:            (float64)(0),
+					Scale:             (float64)(1),
+					Min:               (float64)(0),
+					Max:               (float64)(15),
+					Unit:              (string)(""),
+					Description:       (string)(""),
+					SignalType:        (string)(""),
+					ValueTable:        (string)(""),
+					ValueDescriptions: ([]*descriptor.ValueDescription)(nil),
+					ReceiverNodes: ([]string)([]string{
+						(string)("GATEWAY"),
+					}),
+					DefaultValue:  (int)(0),
+					SendType:      (descriptor.SendType)(0),
+					InactiveValue: (int)(1),
+					Attributes: ([]*descriptor.Attribute)([]*descriptor.Attribute{
+						(*descriptor.Attribute)(&descriptor.Attribute{
+							Name:        (string)("GenSigInactiveValue"),
+							Type:        (descriptor.AttributeType)(0),
+							IntValue:    (int64)(1),
+							FloatValue:  (float64)(0),
+							StringValue: (string)(""),
+							IsDefault:   (bool)(false),
+						}),
+						(*descriptor.Attribute)(&descriptor.Attribute{
+							Name:        (string)("GenSigSendType"),
+							Type:        (descriptor.AttributeType)(4),
+							IntValue:    (int64)(4),
+							FloatValue:  (float64)(0),
+							StringValue: (string)("NoSigSendType"),
+							IsDefault:   (bool)(true),
+						}),
+					}),
+				}),
+			}),
+			SenderNode:       (string)("ECU"),
+			TransmitterNodes: ([]string)(nil),
+			SignalGroups:     ([]*descriptor.SignalGroup)(nil),
+			CycleTime:        (time.Duration)(1000000000),
+			CycleTimeFast:    (time.Duration)(20000000),
+			DelayTime:        (time.Duration)(0),
+			RepetitionCount:  (int)(0),
+			Attributes: ([]*descriptor.Attribute)([]*descriptor.Attribute{
+				(*descriptor.Attribute)(&descriptor.Attribute{
+					Name:        (string)("GenMsgCycleTime"),
+					Type:        (descriptor.AttributeType)(0),
+					IntValue:    (int64)(1000),
+					FloatValue:  (float64)(0),
+					StringValue: (string)(""),
+					IsDefault:   (bool)(false),
+				}),
+				(*descriptor.Attribute)(&descriptor.Attribute{
+					Name:        (string)("GenMsgCycleTimeFast"),
+					Type:        (descriptor.AttributeType)(0),
+					IntValue:    (int64)(20),
+					FloatValue:  (float64)(0),
+					StringValue: (string)(""),
+					IsDefault:   (bool)(false),
+				}),
+				(*descriptor.Attribute)(&descriptor.Attribute{
+					Name:        (string)("GenMsgDelayTime"),
+					Type:        (descriptor.AttributeType)(0),
+					IntValue:    (int64)(0),
+					FloatValue:  (float64)(0),
+					StringValue: (string)(""),
+					IsDefault:   (bool)(true),
+				}),
+				(*descriptor.Attribute)(&descriptor.Attribute{
+					Name:        (string)("GenMsgNrOfRepetition"),
+					Type:        (descriptor.AttributeType)(0),
+					IntValue:    (int64)(0),
+					FloatValue:  (float64)(0),
+					StringValue: (string)(""),
+					IsDefault:   (bool)(true),
+				}),
+				(*descriptor.Attribute)(&descriptor.Attribute{
+					Name:        (string)("GenMsgSendType"),
+					Type:        (descriptor.AttributeType)(4),
+					IntValue:    (int64)(5),
+					FloatValue:  (float64)(0),
+					StringValue: (string)("CyclicIfActiveFast"),
+					IsDefault:   (bool)(false),
+				}),
+			}),
+		}),
+		(*descriptor.Message)(&descriptor.Message{
+			Name:        (string)("Command"),
+			ID:          (uint32)(300),
+			IsExtended:  (bool)(false),
+			Length:      (uint8)(1),
+			SendType:    (descriptor.SendType)(1),
+			Description: (string)("Sent cyclically, and when a request is written"),
+			Signals: ([]*descriptor.Signal)([]*descriptor.Signal{
+				(*descriptor.Signal)(&descriptor.Signal{
+					Name:              (string)("Request"),
+					Start:             (uint8)(0),
+					Length:            (uint8)(4),
+					IsBigEndian:       (bool)(false),
+					IsSigned:          (bool)(false),
+					IsFloat:           (bool)(false),
+					IsMultiplexer:     (bool)(false),
+					IsMultiplexed:     (bool)(false),
+					MultiplexerValue:  (uint)(0),
+					MultiplexerName:   (string)(""),
+					MultiplexerRanges: ([]*descriptor.MultiplexerRange)(nil),
+					Offset:            (float64)(0),
+					Scale:             (float64)(1),
+					Min:               (float64)(0),
+					Max:               (float64)(15),
+					Unit:              (string)(""),
+					Description:       (string)(""),
+					SignalType:        (string)(""),
+					ValueTable:        (string)(""),
+					ValueDescriptions: ([]*descriptor.ValueDescription)(nil),
+					ReceiverNodes: ([]string)([]string{
+						(string)("GATEWAY"),
+					}),
+					DefaultValue:  (int)(0),
+					SendType:      (descriptor.SendType)(3),
+					InactiveValue: (int)(0),
+					Attributes: ([]*descriptor.Attribute)([]*descriptor.Attribute{
+						(*descriptor.Attribute)(&descriptor.Attribute{
+							Name:        (string)("GenSigInactiveValue"),
+							Type:        (descriptor.AttributeType)(0),
+							IntValue:    (int64)(0),
+							FloatValue:  (float64)(0),
+							StringValue: (string)(""),
+							IsDefault:   (bool)(true),
+						}),
+						(*descriptor.Attribute)(&descriptor.Attribute{
+							Name:        (string)("GenSigSendType"),
+							Type:        (descriptor.AttributeType)(4),
+							IntValue:    (int64)(1),
+							FloatValue:  (float64)(0),
+							StringValue: (string)("OnWrite"),
+							IsDefault:   (bool)(false),
+						}),
+					}),
+				}),
+			}),
+			SenderNode:       (string)("ECU"),
+			TransmitterNodes: ([]string)(nil),
+			SignalGroups:     ([]*descriptor.SignalGroup)(nil),
+			CycleTime:        (time.Duration)(1000000000),
+			CycleTimeFast:    (time.Duration)(0),
+			DelayTime:        (time.Duration)(50000000),
+			RepetitionCount:  (int)(0),
+			Attributes: ([]*descriptor.Attribute)([]*descriptor.Attribute{
+				(*descriptor.Attribute)(&descriptor.Attribute{
+					Name:        (string)("GenMsgCycleTime"),
+					Type:        (descriptor.AttributeType)(0),
+					IntValue:    (int64)(1000),
+					FloatValue:  (float64)(0),
+					StringValue: (string)(""),
+					IsDefault:   (bool)(false),
+				}),
+				(*descriptor.Attribute)(&descriptor.Attribute{
+					Name:        (string)("GenMsgCycleTimeFast"),
+					Type:        (descriptor.AttributeType)(0),
+					IntValue:    (int64)(0),
+					FloatValue:  (float64)(0),
+					StringValue: (string)(""),
+					IsDefault:   (bool)(true),
+				}),
+				(*descriptor.Attribute)(&descriptor.Attribute{
+					Name:        (string)("GenMsgDelayTime"),
+					Type:        (descriptor.AttributeType)(0),
+					IntValue:    (int64)(50),
+					FloatValue:  (float64)(0),
+					StringValue: (string)(""),
+					IsDefault:   (bool)(false),
+				}),
+				(*descriptor.Attribute)(&descriptor.Attribute{
+					Name:        (string)("GenMsgNrOfRepetition"),
+					Type:        (descriptor.AttributeType)(0),
+					IntValue:    (int64)(0),
+					FloatValue:  (float64)(0),
+					StringValue: (string)(""),
+					IsDefault:   (bool)(true),
+				}),
+				(*descriptor.Attribute)(&descriptor.Attribute{
+					Name:        (string)("GenMsgSendType"),
+					Type:        (descriptor.AttributeType)(4),
+					IntValue:    (int64)(0),
+					FloatValue:  (float64)(0),
+					StringValue: (string)("Cyclic"),
+					IsDefault:   (bool)(false),
+				}),
+			}),
+		}),
+	}),
+	Nodes: ([]*descriptor.Node)([]*descriptor.Node{
+		(*descriptor.Node)(&descriptor.Node{
+			Name:        (string)("ECU"),
+			Description: (string)(""),
+			Attributes:  ([]*descriptor.Attribute)(nil),
+		}),
+		(*descriptor.Node)(&descriptor.Node{
+			Name:        (string)("GATEWAY"),
+			Description: (string)(""),
+			Attributes:  ([]*descriptor.Attribute)(nil),
+		}),
+	}),
+	ValueTables:          ([]*descriptor.ValueTable)(nil),
+	EnvironmentVariables: ([]*descriptor.EnvironmentVariable)(nil),
+	Attributes:           ([]*descriptor.Attribute)(nil),
+})
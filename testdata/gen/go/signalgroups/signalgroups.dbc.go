@@ -0,0 +1,970 @@
+// Package signalgroupscan provides primitives for encoding and decoding signalgroups CAN messages.
+//
+// Source: testdata/dbc/signalgroups/signalgroups.dbc
+package signalgroupscan
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"go.einride.tech/can"
+	"go.einride.tech/can/pkg/candebug"
+	"go.einride.tech/can/pkg/canrunner"
+	"go.einride.tech/can/pkg/cantext"
+	"go.einride.tech/can/pkg/descriptor"
+	"go.einride.tech/can/pkg/generated"
+	"go.einride.tech/can/pkg/socketcan"
+)
+
+// prevent unused imports
+var (
+	_ = context.Background
+	_ = fmt.Print
+	_ = math.Float32frombits
+	_ = net.Dial
+	_ = http.Error
+	_ = sync.Mutex{}
+	_ = time.Now
+	_ = socketcan.Dial
+	_ = candebug.ServeMessagesHTTP
+	_ = canrunner.Run
+)
+
+// Generated code. DO NOT EDIT.
+// DrivetrainReader provides read access to a Drivetrain message.
+type DrivetrainReader interface {
+	can.FrameMarshaler
+	// Gear returns the value of the Gear signal.
+	Gear() Drivetrain_Gear
+	// Speed returns the physical value of the Speed signal.
+	Speed() float64
+	// RawSpeed returns the raw (encoded) value of the Speed signal.
+	RawSpeed() uint16
+	// Counter returns the value of the Counter signal.
+	Counter() uint8
+	// MotionGroup returns the raw values of the Motion signal group.
+	MotionGroup() Drivetrain_MotionGroup
+}
+
+// DrivetrainWriter provides write access to a Drivetrain message.
+type DrivetrainWriter interface {
+	// CopyFrom copies all values from Drivetrain.
+	CopyFrom(DrivetrainReader) *Drivetrain
+	// SetGear sets the value of the Gear signal.
+	SetGear(Drivetrain_Gear) *Drivetrain
+	// SetSpeed sets the physical value of the Speed signal.
+	SetSpeed(float64) *Drivetrain
+	// SetRawSpeed sets the raw (encoded) value of the Speed signal.
+	SetRawSpeed(uint16) *Drivetrain
+	// SetCounter sets the value of the Counter signal.
+	SetCounter(uint8) *Drivetrain
+	// SetMotionGroup sets the raw values of the Motion signal group.
+	SetMotionGroup(Drivetrain_MotionGroup) *Drivetrain
+}
+
+type Drivetrain struct {
+	xxx_Gear    Drivetrain_Gear
+	xxx_Speed   uint16
+	xxx_Counter uint8
+}
+
+func NewDrivetrain() *Drivetrain {
+	m := &Drivetrain{}
+	m.Reset()
+	return m
+}
+
+func (m *Drivetrain) Reset() {
+	m.xxx_Gear = 2
+	m.xxx_Speed = 0
+	m.xxx_Counter = 0
+}
+
+func (m *Drivetrain) CopyFrom(o DrivetrainReader) *Drivetrain {
+	f, _ := o.MarshalFrame()
+	_ = m.UnmarshalFrame(f)
+	return m
+}
+
+// Descriptor returns the Drivetrain descriptor.
+func (m *Drivetrain) Descriptor() *descriptor.Message {
+	return Messages().Drivetrain.Message
+}
+
+// String returns a compact string representation of the message.
+func (m *Drivetrain) String() string {
+	return cantext.MessageString(m)
+}
+
+func (m *Drivetrain) Gear() Drivetrain_Gear {
+	return m.xxx_Gear
+}
+
+func (m *Drivetrain) SetGear(v Drivetrain_Gear) *Drivetrain {
+	m.xxx_Gear = Drivetrain_Gear(Messages().Drivetrain.Gear.SaturatedCastUnsigned(uint64(v)))
+	return m
+}
+
+func (m *Drivetrain) Speed() float64 {
+	return Messages().Drivetrain.Speed.ToPhysical(float64(m.xxx_Speed))
+}
+
+func (m *Drivetrain) SetSpeed(v float64) *Drivetrain {
+	m.xxx_Speed = uint16(Messages().Drivetrain.Speed.FromPhysical(v))
+	return m
+}
+
+func (m *Drivetrain) RawSpeed() uint16 {
+	return m.xxx_Speed
+}
+
+func (m *Drivetrain) SetRawSpeed(v uint16) *Drivetrain {
+	m.xxx_Speed = uint16(Messages().Drivetrain.Speed.SaturatedCastUnsigned(uint64(v)))
+	return m
+}
+
+func (m *Drivetrain) Counter() uint8 {
+	return m.xxx_Counter
+}
+
+func (m *Drivetrain) SetCounter(v uint8) *Drivetrain {
+	m.xxx_Counter = uint8(Messages().Drivetrain.Counter.SaturatedCastUnsigned(uint64(v)))
+	return m
+}
+
+// Drivetrain_Gear models the Gear signal of the Drivetrain message.
+type Drivetrain_Gear uint8
+
+// Value descriptions for the Gear signal of the Drivetrain message.
+const (
+	Drivetrain_Gear_Park    Drivetrain_Gear = 0
+	Drivetrain_Gear_Reverse Drivetrain_Gear = 1
+	Drivetrain_Gear_Neutral Drivetrain_Gear = 2
+	Drivetrain_Gear_Drive   Drivetrain_Gear = 3
+)
+
+func (v Drivetrain_Gear) String() string {
+	switch v {
+	case 0:
+		return "Park"
+	case 1:
+		return "Reverse"
+	case 2:
+		return "Neutral"
+	case 3:
+		return "Drive"
+	default:
+		return fmt.Sprintf("Drivetrain_Gear(%d)", v)
+	}
+}
+
+// Drivetrain_MotionGroup holds the raw values of the Motion signal group.
+type Drivetrain_MotionGroup struct {
+	Gear  Drivetrain_Gear
+	Speed uint16
+}
+
+func (m *Drivetrain) MotionGroup() Drivetrain_MotionGroup {
+	return Drivetrain_MotionGroup{
+		Gear:  m.xxx_Gear,
+		Speed: m.xxx_Speed,
+	}
+}
+
+func (m *Drivetrain) SetMotionGroup(v Drivetrain_MotionGroup) *Drivetrain {
+	m.SetGear(v.Gear)
+	m.SetRawSpeed(v.Speed)
+	return m
+}
+
+// Frame returns a CAN frame representing the message.
+func (m *Drivetrain) Frame() can.Frame {
+	md := Messages().Drivetrain
+	f := can.Frame{ID: md.ID, IsExtended: md.IsExtended, Length: md.Length}
+	md.Gear.MarshalUnsigned(&f.Data, uint64(m.xxx_Gear))
+	md.Speed.MarshalUnsigned(&f.Data, uint64(m.xxx_Speed))
+	md.Counter.MarshalUnsigned(&f.Data, uint64(m.xxx_Counter))
+	return f
+}
+
+// MarshalFrame encodes the message as a CAN frame.
+func (m *Drivetrain) MarshalFrame() (can.Frame, error) {
+	return m.Frame(), nil
+}
+
+// UnmarshalFrame decodes the message from a CAN frame.
+func (m *Drivetrain) UnmarshalFrame(f can.Frame) error {
+	md := Messages().Drivetrain
+	switch {
+	case f.ID != md.ID:
+		return fmt.Errorf(
+			"unmarshal Drivetrain: expects ID 100 (got %s with ID %d)", f.String(), f.ID,
+		)
+	case f.Length != md.Length:
+		return fmt.Errorf(
+			"unmarshal Drivetrain: expects length 4 (got %s with length %d)", f.String(), f.Length,
+		)
+	case f.IsRemote:
+		return fmt.Errorf(
+			"unmarshal Drivetrain: expects non-remote frame (got remote frame %s)", f.String(),
+		)
+	case f.IsExtended != md.IsExtended:
+		return fmt.Errorf(
+			"unmarshal Drivetrain: expects standard ID (got %s with extended ID)", f.String(),
+		)
+	}
+	m.xxx_Gear = Drivetrain_Gear(md.Gear.UnmarshalUnsigned(f.Data))
+	m.xxx_Speed = uint16(md.Speed.UnmarshalUnsigned(f.Data))
+	m.xxx_Counter = uint8(md.Counter.UnmarshalUnsigned(f.Data))
+	return nil
+}
+
+// DecodeFrame decodes the message from a CAN frame, with the bit layout of each signal resolved at generation
+// time.
+//
+// DecodeFrame decodes the same values as UnmarshalFrame, without allocating unless the frame is invalid, for
+// decoding frames at high rates into reused messages.
+func (m *Drivetrain) DecodeFrame(f *can.Frame) error {
+	switch {
+	case f.ID != 100:
+		return fmt.Errorf(
+			"decode Drivetrain: expects ID 100 (got %s with ID %d)", f.String(), f.ID,
+		)
+	case f.Length != 4:
+		return fmt.Errorf(
+			"decode Drivetrain: expects length 4 (got %s with length %d)", f.String(), f.Length,
+		)
+	case f.IsRemote:
+		return fmt.Errorf(
+			"decode Drivetrain: expects non-remote frame (got remote frame %s)", f.String(),
+		)
+	case f.IsExtended:
+		return fmt.Errorf(
+			"decode Drivetrain: expects standard ID (got %s with extended ID)", f.String(),
+		)
+	}
+	le := f.Data.PackLittleEndian()
+	m.xxx_Gear = Drivetrain_Gear(le & 0x3)
+	m.xxx_Speed = uint16((le >> 8) & 0xffff)
+	m.xxx_Counter = uint8((le >> 24) & 0xff)
+	return nil
+}
+
+type ECU interface {
+	sync.Locker
+	Tx() ECU_Tx
+	Rx() ECU_Rx
+	Run(ctx context.Context) error
+}
+
+type ECU_Rx interface {
+	http.Handler // for debugging
+}
+
+type ECU_Tx interface {
+	http.Handler // for debugging
+	Drivetrain() ECU_Tx_Drivetrain
+}
+
+type ECU_Tx_Drivetrain interface {
+	DrivetrainReader
+	// CopyFrom copies all values from Drivetrain.
+	CopyFrom(DrivetrainReader) ECU_Tx_Drivetrain
+	// SetGear sets the value of the Gear signal.
+	SetGear(Drivetrain_Gear) ECU_Tx_Drivetrain
+	// SetSpeed sets the physical value of the Speed signal.
+	SetSpeed(float64) ECU_Tx_Drivetrain
+	// SetRawSpeed sets the raw (encoded) value of the Speed signal.
+	SetRawSpeed(uint16) ECU_Tx_Drivetrain
+	// SetCounter sets the value of the Counter signal.
+	SetCounter(uint8) ECU_Tx_Drivetrain
+	// SetMotionGroup sets the raw values of the Motion signal group.
+	SetMotionGroup(Drivetrain_MotionGroup) ECU_Tx_Drivetrain
+	TransmitTime() time.Time
+	Transmit(ctx context.Context) error
+	SetBeforeTransmitHook(h func(context.Context) error)
+}
+
+type xxx_ECU struct {
+	sync.Mutex // protects all node state
+	network    string
+	address    string
+	rx         xxx_ECU_Rx
+	tx         xxx_ECU_Tx
+}
+
+var _ ECU = &xxx_ECU{}
+var _ canrunner.Node = &xxx_ECU{}
+
+func NewECU(network, address string) ECU {
+	n := &xxx_ECU{network: network, address: address}
+	n.tx.xxx_Drivetrain.init()
+	n.tx.xxx_Drivetrain.Reset()
+	n.rx.debugHandler = candebug.NewHandler(&n.Mutex, []generated.Message{})
+	n.tx.debugHandler = candebug.NewHandler(&n.Mutex, []generated.Message{
+		&n.tx.xxx_Drivetrain,
+	})
+	return n
+}
+
+func (n *xxx_ECU) Run(ctx context.Context) error {
+	return canrunner.Run(ctx, n)
+}
+
+func (n *xxx_ECU) Rx() ECU_Rx {
+	return &n.rx
+}
+
+func (n *xxx_ECU) Tx() ECU_Tx {
+	return &n.tx
+}
+
+type xxx_ECU_Rx struct {
+	debugHandler *candebug.Handler
+}
+
+var _ ECU_Rx = &xxx_ECU_Rx{}
+
+func (rx *xxx_ECU_Rx) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	rx.debugHandler.ServeHTTP(w, r)
+}
+
+type xxx_ECU_Tx struct {
+	debugHandler   *candebug.Handler
+	xxx_Drivetrain xxx_ECU_Tx_Drivetrain
+}
+
+var _ ECU_Tx = &xxx_ECU_Tx{}
+
+func (tx *xxx_ECU_Tx) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	tx.debugHandler.ServeHTTP(w, r)
+}
+
+func (tx *xxx_ECU_Tx) Drivetrain() ECU_Tx_Drivetrain {
+	return &tx.xxx_Drivetrain
+}
+
+func (n *xxx_ECU) Descriptor() *descriptor.Node {
+	return Nodes().ECU
+}
+
+func (n *xxx_ECU) Connect() (net.Conn, error) {
+	return socketcan.Dial(n.network, n.address)
+}
+
+func (n *xxx_ECU) ReceivedMessage(id uint32) (canrunner.ReceivedMessage, bool) {
+	switch id {
+	default:
+		return nil, false
+	}
+}
+
+func (n *xxx_ECU) TransmittedMessages() []canrunner.TransmittedMessage {
+	return []canrunner.TransmittedMessage{
+		&n.tx.xxx_Drivetrain,
+	}
+}
+
+type xxx_ECU_Tx_Drivetrain struct {
+	Drivetrain
+	transmitTime       time.Time
+	beforeTransmitHook func(context.Context) error
+	isCyclicEnabled    bool
+	wakeUpChan         chan struct{}
+	transmitEventChan  chan struct{}
+	writeTracker       canrunner.WriteTracker
+}
+
+var _ ECU_Tx_Drivetrain = &xxx_ECU_Tx_Drivetrain{}
+var _ canrunner.TransmittedMessage = &xxx_ECU_Tx_Drivetrain{}
+
+func (m *xxx_ECU_Tx_Drivetrain) init() {
+	m.beforeTransmitHook = func(context.Context) error { return nil }
+	m.wakeUpChan = make(chan struct{}, 1)
+	m.transmitEventChan = make(chan struct{})
+}
+
+func (m *xxx_ECU_Tx_Drivetrain) SetBeforeTransmitHook(h func(context.Context) error) {
+	m.beforeTransmitHook = h
+}
+
+func (m *xxx_ECU_Tx_Drivetrain) BeforeTransmitHook() func(context.Context) error {
+	return m.beforeTransmitHook
+}
+
+func (m *xxx_ECU_Tx_Drivetrain) TransmitTime() time.Time {
+	return m.transmitTime
+}
+
+func (m *xxx_ECU_Tx_Drivetrain) SetTransmitTime(t time.Time) {
+	m.transmitTime = t
+}
+
+func (m *xxx_ECU_Tx_Drivetrain) IsCyclicTransmissionEnabled() bool {
+	return m.isCyclicEnabled
+}
+
+func (m *xxx_ECU_Tx_Drivetrain) SetCyclicTransmissionEnabled(b bool) {
+	m.isCyclicEnabled = b
+	select {
+	case m.wakeUpChan <- struct{}{}:
+	default:
+	}
+}
+
+func (m *xxx_ECU_Tx_Drivetrain) WakeUpChan() <-chan struct{} {
+	return m.wakeUpChan
+}
+
+func (m *xxx_ECU_Tx_Drivetrain) Transmit(ctx context.Context) error {
+	select {
+	case m.transmitEventChan <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("event-triggered transmit of Drivetrain: %w", ctx.Err())
+	}
+}
+
+func (m *xxx_ECU_Tx_Drivetrain) TransmitEventChan() <-chan struct{} {
+	return m.transmitEventChan
+}
+
+func (m *xxx_ECU_Tx_Drivetrain) WriteTracker() *canrunner.WriteTracker {
+	return &m.writeTracker
+}
+
+func (m *xxx_ECU_Tx_Drivetrain) CopyFrom(o DrivetrainReader) ECU_Tx_Drivetrain {
+	m.Drivetrain.CopyFrom(o)
+	m.writeTracker.Written(Messages().Drivetrain.Gear)
+	m.writeTracker.Written(Messages().Drivetrain.Speed)
+	m.writeTracker.Written(Messages().Drivetrain.Counter)
+	return m
+}
+
+func (m *xxx_ECU_Tx_Drivetrain) SetGear(v Drivetrain_Gear) ECU_Tx_Drivetrain {
+	m.Drivetrain.SetGear(v)
+	m.writeTracker.Written(Messages().Drivetrain.Gear)
+	return m
+}
+
+func (m *xxx_ECU_Tx_Drivetrain) SetSpeed(v float64) ECU_Tx_Drivetrain {
+	m.Drivetrain.SetSpeed(v)
+	m.writeTracker.Written(Messages().Drivetrain.Speed)
+	return m
+}
+
+func (m *xxx_ECU_Tx_Drivetrain) SetRawSpeed(v uint16) ECU_Tx_Drivetrain {
+	m.Drivetrain.SetRawSpeed(v)
+	m.writeTracker.Written(Messages().Drivetrain.Speed)
+	return m
+}
+
+func (m *xxx_ECU_Tx_Drivetrain) SetCounter(v uint8) ECU_Tx_Drivetrain {
+	m.Drivetrain.SetCounter(v)
+	m.writeTracker.Written(Messages().Drivetrain.Counter)
+	return m
+}
+
+func (m *xxx_ECU_Tx_Drivetrain) SetMotionGroup(v Drivetrain_MotionGroup) ECU_Tx_Drivetrain {
+	m.Drivetrain.SetMotionGroup(v)
+	m.writeTracker.Written(Messages().Drivetrain.Gear)
+	m.writeTracker.Written(Messages().Drivetrain.Speed)
+	return m
+}
+
+var _ canrunner.WriteTracked = &xxx_ECU_Tx_Drivetrain{}
+
+var _ canrunner.TransmittedMessage = &xxx_ECU_Tx_Drivetrain{}
+
+type GATEWAY interface {
+	sync.Locker
+	Tx() GATEWAY_Tx
+	Rx() GATEWAY_Rx
+	Run(ctx context.Context) error
+}
+
+type GATEWAY_Rx interface {
+	http.Handler // for debugging
+	Drivetrain() GATEWAY_Rx_Drivetrain
+}
+
+type GATEWAY_Tx interface {
+	http.Handler // for debugging
+}
+
+type GATEWAY_Rx_Drivetrain interface {
+	DrivetrainReader
+	ReceiveTime() time.Time
+	SetAfterReceiveHook(h func(context.Context) error)
+	// SubscribeGear calls the hook when the trigger fires for the Gear signal.
+	SubscribeGear(trigger canrunner.SignalTrigger, h func(ctx context.Context, prev, curr DrivetrainReader) error)
+	// SubscribeSpeed calls the hook when the trigger fires for the Speed signal.
+	SubscribeSpeed(trigger canrunner.SignalTrigger, h func(ctx context.Context, prev, curr DrivetrainReader) error)
+	// SubscribeCounter calls the hook when the trigger fires for the Counter signal.
+	SubscribeCounter(trigger canrunner.SignalTrigger, h func(ctx context.Context, prev, curr DrivetrainReader) error)
+}
+
+type xxx_GATEWAY struct {
+	sync.Mutex // protects all node state
+	network    string
+	address    string
+	rx         xxx_GATEWAY_Rx
+	tx         xxx_GATEWAY_Tx
+}
+
+var _ GATEWAY = &xxx_GATEWAY{}
+var _ canrunner.Node = &xxx_GATEWAY{}
+
+func NewGATEWAY(network, address string) GATEWAY {
+	n := &xxx_GATEWAY{network: network, address: address}
+	n.rx.xxx_Drivetrain.init()
+	n.rx.xxx_Drivetrain.Reset()
+	n.rx.debugHandler = candebug.NewHandler(&n.Mutex, []generated.Message{
+		&n.rx.xxx_Drivetrain,
+	})
+	n.tx.debugHandler = candebug.NewHandler(&n.Mutex, []generated.Message{})
+	return n
+}
+
+func (n *xxx_GATEWAY) Run(ctx context.Context) error {
+	return canrunner.Run(ctx, n)
+}
+
+func (n *xxx_GATEWAY) Rx() GATEWAY_Rx {
+	return &n.rx
+}
+
+func (n *xxx_GATEWAY) Tx() GATEWAY_Tx {
+	return &n.tx
+}
+
+type xxx_GATEWAY_Rx struct {
+	debugHandler   *candebug.Handler
+	xxx_Drivetrain xxx_GATEWAY_Rx_Drivetrain
+}
+
+var _ GATEWAY_Rx = &xxx_GATEWAY_Rx{}
+
+func (rx *xxx_GATEWAY_Rx) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	rx.debugHandler.ServeHTTP(w, r)
+}
+
+func (rx *xxx_GATEWAY_Rx) Drivetrain() GATEWAY_Rx_Drivetrain {
+	return &rx.xxx_Drivetrain
+}
+
+type xxx_GATEWAY_Tx struct {
+	debugHandler *candebug.Handler
+}
+
+var _ GATEWAY_Tx = &xxx_GATEWAY_Tx{}
+
+func (tx *xxx_GATEWAY_Tx) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	tx.debugHandler.ServeHTTP(w, r)
+}
+
+func (n *xxx_GATEWAY) Descriptor() *descriptor.Node {
+	return Nodes().GATEWAY
+}
+
+func (n *xxx_GATEWAY) Connect() (net.Conn, error) {
+	return socketcan.Dial(n.network, n.address)
+}
+
+func (n *xxx_GATEWAY) ReceivedMessage(id uint32) (canrunner.ReceivedMessage, bool) {
+	switch id {
+	case 100:
+		return &n.rx.xxx_Drivetrain, true
+	default:
+		return nil, false
+	}
+}
+
+func (n *xxx_GATEWAY) TransmittedMessages() []canrunner.TransmittedMessage {
+	return []canrunner.TransmittedMessage{}
+}
+
+type xxx_GATEWAY_Rx_Drivetrain struct {
+	Drivetrain
+	receiveTime         time.Time
+	afterReceiveHook    func(context.Context) error
+	signalSubscriptions canrunner.SignalSubscriptions
+}
+
+func (m *xxx_GATEWAY_Rx_Drivetrain) init() {
+	m.afterReceiveHook = func(context.Context) error { return nil }
+}
+
+func (m *xxx_GATEWAY_Rx_Drivetrain) SetAfterReceiveHook(h func(context.Context) error) {
+	m.afterReceiveHook = h
+}
+
+func (m *xxx_GATEWAY_Rx_Drivetrain) AfterReceiveHook() func(context.Context) error {
+	return m.afterReceiveHook
+}
+
+func (m *xxx_GATEWAY_Rx_Drivetrain) ReceiveTime() time.Time {
+	return m.receiveTime
+}
+
+func (m *xxx_GATEWAY_Rx_Drivetrain) SetReceiveTime(t time.Time) {
+	m.receiveTime = t
+}
+
+func (m *xxx_GATEWAY_Rx_Drivetrain) SignalSubscriptions() *canrunner.SignalSubscriptions {
+	return &m.signalSubscriptions
+}
+
+func (m *xxx_GATEWAY_Rx_Drivetrain) SubscribeGear(
+	trigger canrunner.SignalTrigger,
+	h func(ctx context.Context, prev, curr DrivetrainReader) error,
+) {
+	m.signalSubscriptions.Subscribe(
+		Messages().Drivetrain.Gear,
+		trigger,
+		func(ctx context.Context, prevFrame, currFrame can.Frame) error {
+			var prev, curr Drivetrain
+			if err := prev.UnmarshalFrame(prevFrame); err != nil {
+				return err
+			}
+			if err := curr.UnmarshalFrame(currFrame); err != nil {
+				return err
+			}
+			return h(ctx, &prev, &curr)
+		},
+	)
+}
+
+func (m *xxx_GATEWAY_Rx_Drivetrain) SubscribeSpeed(
+	trigger canrunner.SignalTrigger,
+	h func(ctx context.Context, prev, curr DrivetrainReader) error,
+) {
+	m.signalSubscriptions.Subscribe(
+		Messages().Drivetrain.Speed,
+		trigger,
+		func(ctx context.Context, prevFrame, currFrame can.Frame) error {
+			var prev, curr Drivetrain
+			if err := prev.UnmarshalFrame(prevFrame); err != nil {
+				return err
+			}
+			if err := curr.UnmarshalFrame(currFrame); err != nil {
+				return err
+			}
+			return h(ctx, &prev, &curr)
+		},
+	)
+}
+
+func (m *xxx_GATEWAY_Rx_Drivetrain) SubscribeCounter(
+	trigger canrunner.SignalTrigger,
+	h func(ctx context.Context, prev, curr DrivetrainReader) error,
+) {
+	m.signalSubscriptions.Subscribe(
+		Messages().Drivetrain.Counter,
+		trigger,
+		func(ctx context.Context, prevFrame, currFrame can.Frame) error {
+			var prev, curr Drivetrain
+			if err := prev.UnmarshalFrame(prevFrame); err != nil {
+				return err
+			}
+			if err := curr.UnmarshalFrame(currFrame); err != nil {
+				return err
+			}
+			return h(ctx, &prev, &curr)
+		},
+	)
+}
+
+var _ canrunner.ReceivedMessage = &xxx_GATEWAY_Rx_Drivetrain{}
+
+var _ canrunner.SignalSubscriber = &xxx_GATEWAY_Rx_Drivetrain{}
+
+// FrameBatch holds the messages decoded from batches of frames, in a slice per message.
+//
+// The slices are reused after Reset, so that decoding does not allocate once they have grown to the size of a
+// batch.
+type FrameBatch struct {
+	Drivetrain []Drivetrain
+}
+
+// Reset empties the slices of the batch, keeping their capacity.
+func (b *FrameBatch) Reset() {
+	b.Drivetrain = b.Drivetrain[:0]
+}
+
+// DecodeFrames decodes a batch of frames, and appends the decoded messages to the batch.
+//
+// Remote frames and frames of unknown messages are skipped.
+func (b *FrameBatch) DecodeFrames(frames []can.Frame) error {
+	for i := range frames {
+		f := &frames[i]
+		if f.IsRemote {
+			continue
+		}
+		switch f.ID {
+		case 100:
+			if !f.IsExtended {
+				b.Drivetrain = append(b.Drivetrain, Drivetrain{})
+				m := &b.Drivetrain[len(b.Drivetrain)-1]
+				if err := m.DecodeFrame(f); err != nil {
+					b.Drivetrain = b.Drivetrain[:len(b.Drivetrain)-1]
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// Nodes returns the signalgroups node descriptors.
+func Nodes() *NodesDescriptor {
+	return nd
+}
+
+// NodesDescriptor contains all signalgroups node descriptors.
+type NodesDescriptor struct {
+	ECU     *descriptor.Node
+	GATEWAY *descriptor.Node
+}
+
+// Messages returns the signalgroups message descriptors.
+func Messages() *MessagesDescriptor {
+	return md
+}
+
+// MessagesDescriptor contains all signalgroups message descriptors.
+type MessagesDescriptor struct {
+	Drivetrain *DrivetrainDescriptor
+}
+
+// UnmarshalFrame unmarshals the provided signalgroups CAN frame.
+func (md *MessagesDescriptor) UnmarshalFrame(f can.Frame) (generated.Message, error) {
+	switch f.ID {
+	case md.Drivetrain.ID:
+		var msg Drivetrain
+		if err := msg.UnmarshalFrame(f); err != nil {
+			return nil, fmt.Errorf("unmarshal signalgroups frame: %w", err)
+		}
+		return &msg, nil
+	default:
+		return nil, fmt.Errorf("unmarshal signalgroups frame: ID not in database: %d", f.ID)
+	}
+}
+
+type DrivetrainDescriptor struct {
+	*descriptor.Message
+	Gear    *descriptor.Signal
+	Speed   *descriptor.Signal
+	Counter *descriptor.Signal
+}
+
+// Database returns the signalgroups database descriptor.
+func (md *MessagesDescriptor) Database() *descriptor.Database {
+	return d
+}
+
+var nd = &NodesDescriptor{
+	ECU:     d.Nodes[0],
+	GATEWAY: d.Nodes[1],
+}
+
+var md = &MessagesDescriptor{
+	Drivetrain: &DrivetrainDescriptor{
+		Message: d.Messages[0],
+		Gear:    d.Messages[0].Signals[0],
+		Speed:   d.Messages[0].Signals[1],
+		Counter: d.Messages[0].Signals[2],
+	},
+}
+
+var d = (*descriptor.Database)(&descriptor.Database{
+	SourceFile: (string)("testdata/dbc/signalgroups/signalgroups.dbc"),
+	Version:    (string)(""),
+	Messages: ([]*descriptor.Message)([]*descriptor.Message{
+		(*descriptor.Message)(&descriptor.Message{
+			Name:        (string)("Drivetrain"),
+			ID:          (uint32)(100),
+			IsExtended:  (bool)(false),
+			Length:      (uint8)(4),
+			SendType:    (descriptor.SendType)(3),
+			Description: (string)("Sent when a signal is written"),
+			Signals: ([]*descriptor.Signal)([]*descriptor.Signal{
+				(*descriptor.Signal)(&descriptor.Signal{
+					Name:              (string)("Gear"),
+					Start:             (uint8)(0),
+					Length:            (uint8)(2),
+					IsBigEndian:       (bool)(false),
+					IsSigned:          (bool)(false),
+					IsFloat:           (bool)(false),
+					IsMultiplexer:     (bool)(false),
+					IsMultiplexed:     (bool)(false),
+					MultiplexerValue:  (uint)(0),
+					MultiplexerName:   (string)(""),
+					MultiplexerRanges: ([]*descriptor.MultiplexerRange)(nil),
+					Offset:            (float64)(0),
+					Scale:             (float64)(1),
+					Min:               (float64)(0),
+					Max:               (float64)(3),
+					Unit:              (string)(""),
+					Description:       (string)("Gear selector position"),
+					SignalType:        (string)("GearType"),
+					ValueTable:        (string)("GearTable"),
+					ValueDescriptions: ([]*descriptor.ValueDescription)([]*descriptor.ValueDescription{
+						(*descriptor.ValueDescription)(&descriptor.ValueDescription{
+							Value:       (int64)(0),
+							Description: (string)("Park"),
+						}),
+						(*descriptor.ValueDescription)(&descriptor.ValueDescription{
+							Value:       (int64)(1),
+							Description: (string)("Reverse"),
+						}),
+						(*descriptor.ValueDescription)(&descriptor.ValueDescription{
+							Value:       (int64)(2),
+							Description: (string)("Neutral"),
+						}),
+						(*descriptor.ValueDescription)(&descriptor.ValueDescription{
+							Value:       (int64)(3),
+							Description: (string)("Drive"),
+						}),
+					}),
+					ReceiverNodes: ([]string)([]string{
+						(string)("GATEWAY"),
+					}),
+					DefaultValue:  (int)(2),
+					SendType:      (descriptor.SendType)(0),
+					InactiveValue: (int)(0),
+					Attributes:    ([]*descriptor.Attribute)(nil),
+				}),
+				(*descriptor.Signal)(&descriptor.Signal{
+					Name:              (string)("Speed"),
+					Start:             (uint8)(8),
+					Length:            (uint8)(16),
+					IsBigEndian:       (bool)(false),
+					IsSigned:          (bool)(false),
+					IsFloat:           (bool)(false),
+					IsMultiplexer:     (bool)(false),
+					IsMultiplexed:     (bool)(false),
+					MultiplexerValue:  (uint)(0),
+					MultiplexerName:   (string)(""),
+					MultiplexerRanges: ([]*descriptor.MultiplexerRange)(nil),
+					Offset:            (float64)(0),
+					Scale:             (float64)(0.01),
+					Min:               (float64)(0),
+					Max:               (float64)(655.35),
+					Unit:              (string)("km/h"),
+					Description:       (string)(""),
+					SignalType:        (string)(""),
+					ValueTable:        (string)(""),
+					ValueDescriptions: ([]*descriptor.ValueDescription)(nil),
+					ReceiverNodes: ([]string)([]string{
+						(string)("GATEWAY"),
+					}),
+					DefaultValue:  (int)(0),
+					SendType:      (descriptor.SendType)(0),
+					InactiveValue: (int)(0),
+					Attributes:    ([]*descriptor.Attribute)(nil),
+				}),
+				(*descriptor.Signal)(&descriptor.Signal{
+					Name:              (string)("Counter"),
+					Start:             (uint8)(24),
+					Length:            (uint8)(8),
+					IsBigEndian:       (bool)(false),
+					IsSigned:          (bool)(false),
+					IsFloat:           (bool)(false),
+					IsMultiplexer:     (bool)(false),
+					IsMultiplexed:     (bool)(false),
+					MultiplexerValue:  (uint)(0),
+					MultiplexerName:   (string)(""),
+					MultiplexerRanges: ([]*descriptor.MultiplexerRange)(nil),
+					Offset:            (float64)(0),
+					Scale:             (float64)(1),
+					Min:               (float64)(0),
+					Max:               (float64)(255),
+					Unit:              (string)(""),
+					Description:       (string)(""),
+					SignalType:        (string)(""),
+					ValueTable:        (string)(""),
+					ValueDescriptions: ([]*descriptor.ValueDescription)(nil),
+					ReceiverNodes: ([]string)([]string{
+						(string)("GATEWAY"),
+					}),
+					DefaultValue:  (int)(0),
+					SendType:      (descriptor.SendType)(0),
+					InactiveValue: (int)(0),
+					Attributes:    ([]*descriptor.Attribute)(nil),
+				}),
+			}),
+			SenderNode: (string)("ECU"),
+			TransmitterNodes: ([]string)([]string{
+				(string)("ECU"),
+				(string)("GATEWAY"),
+			}),
+			SignalGroups: ([]*descriptor.SignalGroup)([]*descriptor.SignalGroup{
+				(*descriptor.SignalGroup)(&descriptor.SignalGroup{
+					Name:        (string)("Motion"),
+					Repetitions: (uint64)(1),
+					SignalNames: ([]string)([]string{
+						(string)("Gear"),
+						(string)("Speed"),
+					}),
+				}),
+			}),
+			CycleTime:       (time.Duration)(0),
+			CycleTimeFast:   (time.Duration)(0),
+			DelayTime:       (time.Duration)(0),
+			RepetitionCount: (int)(0),
+			Attributes: ([]*descriptor.Attribute)([]*descriptor.Attribute{
+				(*descriptor.Attribute)(&descriptor.Attribute{
+					Name:        (string)("GenMsgSendType"),
+					Type:        (descriptor.AttributeType)(4),
+					IntValue:    (int64)(1),
+					FloatValue:  (float64)(0),
+					StringValue: (string)("OnWrite"),
+					IsDefault:   (bool)(false),
+				}),
+			}),
+		}),
+	}),
+	Nodes: ([]*descriptor.Node)([]*descriptor.Node{
+		(*descriptor.Node)(&descriptor.Node{
+			Name:        (string)("ECU"),
+			Description: (string)(""),
+			Attributes:  ([]*descriptor.Attribute)(nil),
+		}),
+		(*descriptor.Node)(&descriptor.Node{
+			Name:        (string)("GATEWAY"),
+			Description: (string)(""),
+			Attributes:  ([]*descriptor.Attribute)(nil),
+		}),
+	}),
+	ValueTables: ([]*descriptor.ValueTable)([]*descriptor.ValueTable{
+		(*descriptor.ValueTable)(&descriptor.ValueTable{
+			Name: (string)("GearTable"),
+			ValueDescriptions: ([]*descriptor.ValueDescription)([]*descriptor.ValueDescription{
+				(*descriptor.ValueDescription)(&descriptor.ValueDescription{
+					Value:       (int64)(0),
+					Description: (string)("Park"),
+				}),
+				(*descriptor.ValueDescription)(&descriptor.ValueDescription{
+					Value:       (int64)(1),
+					Description: (string)("Reverse"),
+				}),
+				(*descriptor.ValueDescription)(&descriptor.ValueDescription{
+					Value:       (int64)(2),
+					Description: (string)("Neutral"),
+				}),
+				(*descriptor.ValueDescription)(&descriptor.ValueDescription{
+					Value:       (int64)(3),
+					Description: (string)("Drive"),
+				}),
+			}),
+		}),
+	}),
+	EnvironmentVariables: ([]*descriptor.EnvironmentVariable)(nil),
+	Attributes:           ([]*descriptor.Attribute)(nil),
+})
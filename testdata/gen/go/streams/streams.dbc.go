@@ -0,0 +1,788 @@
+// Package streamscan provides primitives for encoding and decoding streams CAN messages.
+//
+// Source: testdata/streams/streams.dbc
+package streamscan
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"math"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"go.einride.tech/can"
+	"go.einride.tech/can/pkg/candebug"
+	"go.einride.tech/can/pkg/canrunner"
+	"go.einride.tech/can/pkg/cantext"
+	"go.einride.tech/can/pkg/descriptor"
+	"go.einride.tech/can/pkg/generated"
+	"go.einride.tech/can/pkg/socketcan"
+)
+
+// prevent unused imports
+var (
+	_ = context.Background
+	_ = fmt.Print
+	_ iter.Seq[any]
+	_ = math.Float32frombits
+	_ = net.Dial
+	_ = http.Error
+	_ = sync.Mutex{}
+	_ = time.Now
+	_ = socketcan.Dial
+	_ = candebug.ServeMessagesHTTP
+	_ = canrunner.Run
+)
+
+// Generated code. DO NOT EDIT.
+// MotorStatusReader provides read access to a MotorStatus message.
+type MotorStatusReader interface {
+	can.FrameMarshaler
+	// WheelError returns the value of the WheelError signal.
+	WheelError() bool
+	// SpeedKph returns the physical value of the SpeedKph signal.
+	SpeedKph() float64
+	// RawSpeedKph returns the raw (encoded) value of the SpeedKph signal.
+	RawSpeedKph() uint16
+}
+
+// MotorStatusWriter provides write access to a MotorStatus message.
+type MotorStatusWriter interface {
+	// CopyFrom copies all values from MotorStatus.
+	CopyFrom(MotorStatusReader) *MotorStatus
+	// SetWheelError sets the value of the WheelError signal.
+	SetWheelError(bool) *MotorStatus
+	// SetSpeedKph sets the physical value of the SpeedKph signal.
+	SetSpeedKph(float64) *MotorStatus
+	// SetRawSpeedKph sets the raw (encoded) value of the SpeedKph signal.
+	SetRawSpeedKph(uint16) *MotorStatus
+}
+
+type MotorStatus struct {
+	xxx_WheelError bool
+	xxx_SpeedKph   uint16
+}
+
+func NewMotorStatus() *MotorStatus {
+	m := &MotorStatus{}
+	m.Reset()
+	return m
+}
+
+func (m *MotorStatus) Reset() {
+	m.xxx_WheelError = false
+	m.xxx_SpeedKph = 0
+}
+
+func (m *MotorStatus) CopyFrom(o MotorStatusReader) *MotorStatus {
+	f, _ := o.MarshalFrame()
+	_ = m.UnmarshalFrame(f)
+	return m
+}
+
+// Descriptor returns the MotorStatus descriptor.
+func (m *MotorStatus) Descriptor() *descriptor.Message {
+	return Messages().MotorStatus.Message
+}
+
+// String returns a compact string representation of the message.
+func (m *MotorStatus) String() string {
+	return cantext.MessageString(m)
+}
+
+func (m *MotorStatus) WheelError() bool {
+	return m.xxx_WheelError
+}
+
+func (m *MotorStatus) SetWheelError(v bool) *MotorStatus {
+	m.xxx_WheelError = v
+	return m
+}
+
+func (m *MotorStatus) SpeedKph() float64 {
+	return Messages().MotorStatus.SpeedKph.ToPhysical(float64(m.xxx_SpeedKph))
+}
+
+func (m *MotorStatus) SetSpeedKph(v float64) *MotorStatus {
+	m.xxx_SpeedKph = uint16(Messages().MotorStatus.SpeedKph.FromPhysical(v))
+	return m
+}
+
+func (m *MotorStatus) RawSpeedKph() uint16 {
+	return m.xxx_SpeedKph
+}
+
+func (m *MotorStatus) SetRawSpeedKph(v uint16) *MotorStatus {
+	m.xxx_SpeedKph = uint16(Messages().MotorStatus.SpeedKph.SaturatedCastUnsigned(uint64(v)))
+	return m
+}
+
+// Frame returns a CAN frame representing the message.
+func (m *MotorStatus) Frame() can.Frame {
+	md := Messages().MotorStatus
+	f := can.Frame{ID: md.ID, IsExtended: md.IsExtended, Length: md.Length}
+	md.WheelError.MarshalBool(&f.Data, bool(m.xxx_WheelError))
+	md.SpeedKph.MarshalUnsigned(&f.Data, uint64(m.xxx_SpeedKph))
+	return f
+}
+
+// MarshalFrame encodes the message as a CAN frame.
+func (m *MotorStatus) MarshalFrame() (can.Frame, error) {
+	return m.Frame(), nil
+}
+
+// UnmarshalFrame decodes the message from a CAN frame.
+func (m *MotorStatus) UnmarshalFrame(f can.Frame) error {
+	md := Messages().MotorStatus
+	switch {
+	case f.ID != md.ID:
+		return fmt.Errorf(
+			"unmarshal MotorStatus: expects ID 400 (got %s with ID %d)", f.String(), f.ID,
+		)
+	case f.Length != md.Length:
+		return fmt.Errorf(
+			"unmarshal MotorStatus: expects length 3 (got %s with length %d)", f.String(), f.Length,
+		)
+	case f.IsRemote:
+		return fmt.Errorf(
+			"unmarshal MotorStatus: expects non-remote frame (got remote frame %s)", f.String(),
+		)
+	case f.IsExtended != md.IsExtended:
+		return fmt.Errorf(
+			"unmarshal MotorStatus: expects standard ID (got %s with extended ID)", f.String(),
+		)
+	}
+	m.xxx_WheelError = bool(md.WheelError.UnmarshalBool(f.Data))
+	m.xxx_SpeedKph = uint16(md.SpeedKph.UnmarshalUnsigned(f.Data))
+	return nil
+}
+
+// DecodeFrame decodes the message from a CAN frame, with the bit layout of each signal resolved at generation
+// time.
+//
+// DecodeFrame decodes the same values as UnmarshalFrame, without allocating unless the frame is invalid, for
+// decoding frames at high rates into reused messages.
+func (m *MotorStatus) DecodeFrame(f *can.Frame) error {
+	switch {
+	case f.ID != 400:
+		return fmt.Errorf(
+			"decode MotorStatus: expects ID 400 (got %s with ID %d)", f.String(), f.ID,
+		)
+	case f.Length != 3:
+		return fmt.Errorf(
+			"decode MotorStatus: expects length 3 (got %s with length %d)", f.String(), f.Length,
+		)
+	case f.IsRemote:
+		return fmt.Errorf(
+			"decode MotorStatus: expects non-remote frame (got remote frame %s)", f.String(),
+		)
+	case f.IsExtended:
+		return fmt.Errorf(
+			"decode MotorStatus: expects standard ID (got %s with extended ID)", f.String(),
+		)
+	}
+	le := f.Data.PackLittleEndian()
+	m.xxx_WheelError = bool(le&(1<<0) != 0)
+	m.xxx_SpeedKph = uint16((le >> 8) & 0xffff)
+	return nil
+}
+
+type DRIVER interface {
+	sync.Locker
+	Tx() DRIVER_Tx
+	Rx() DRIVER_Rx
+	Run(ctx context.Context) error
+}
+
+type DRIVER_Rx interface {
+	http.Handler // for debugging
+	MotorStatus() DRIVER_Rx_MotorStatus
+}
+
+type DRIVER_Tx interface {
+	http.Handler // for debugging
+}
+
+type DRIVER_Rx_MotorStatus interface {
+	MotorStatusReader
+	ReceiveTime() time.Time
+	SetAfterReceiveHook(h func(context.Context) error)
+	// SubscribeWheelError calls the hook when the trigger fires for the WheelError signal.
+	SubscribeWheelError(trigger canrunner.SignalTrigger, h func(ctx context.Context, prev, curr MotorStatusReader) error)
+	// SubscribeSpeedKph calls the hook when the trigger fires for the SpeedKph signal.
+	SubscribeSpeedKph(trigger canrunner.SignalTrigger, h func(ctx context.Context, prev, curr MotorStatusReader) error)
+	// ReceiveChan returns a channel of received snapshots of the message, closed when the context is done.
+	ReceiveChan(ctx context.Context, size int, policy canrunner.OverflowPolicy) <-chan MotorStatusReader
+	// ReceiveSeq returns a sequence of received snapshots of the message, ending when the context is done.
+	ReceiveSeq(ctx context.Context, size int, policy canrunner.OverflowPolicy) iter.Seq[MotorStatusReader]
+}
+
+type xxx_DRIVER struct {
+	sync.Mutex // protects all node state
+	network    string
+	address    string
+	rx         xxx_DRIVER_Rx
+	tx         xxx_DRIVER_Tx
+}
+
+var _ DRIVER = &xxx_DRIVER{}
+var _ canrunner.Node = &xxx_DRIVER{}
+
+func NewDRIVER(network, address string) DRIVER {
+	n := &xxx_DRIVER{network: network, address: address}
+	n.rx.xxx_MotorStatus.init()
+	n.rx.xxx_MotorStatus.Reset()
+	n.rx.debugHandler = candebug.NewHandler(&n.Mutex, []generated.Message{
+		&n.rx.xxx_MotorStatus,
+	})
+	n.tx.debugHandler = candebug.NewHandler(&n.Mutex, []generated.Message{})
+	return n
+}
+
+func (n *xxx_DRIVER) Run(ctx context.Context) error {
+	return canrunner.Run(ctx, n)
+}
+
+func (n *xxx_DRIVER) Rx() DRIVER_Rx {
+	return &n.rx
+}
+
+func (n *xxx_DRIVER) Tx() DRIVER_Tx {
+	return &n.tx
+}
+
+type xxx_DRIVER_Rx struct {
+	debugHandler    *candebug.Handler
+	xxx_MotorStatus xxx_DRIVER_Rx_MotorStatus
+}
+
+var _ DRIVER_Rx = &xxx_DRIVER_Rx{}
+
+func (rx *xxx_DRIVER_Rx) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	rx.debugHandler.ServeHTTP(w, r)
+}
+
+func (rx *xxx_DRIVER_Rx) MotorStatus() DRIVER_Rx_MotorStatus {
+	return &rx.xxx_MotorStatus
+}
+
+type xxx_DRIVER_Tx struct {
+	debugHandler *candebug.Handler
+}
+
+var _ DRIVER_Tx = &xxx_DRIVER_Tx{}
+
+func (tx *xxx_DRIVER_Tx) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	tx.debugHandler.ServeHTTP(w, r)
+}
+
+func (n *xxx_DRIVER) Descriptor() *descriptor.Node {
+	return Nodes().DRIVER
+}
+
+func (n *xxx_DRIVER) Connect() (net.Conn, error) {
+	return socketcan.Dial(n.network, n.address)
+}
+
+func (n *xxx_DRIVER) ReceivedMessage(id uint32) (canrunner.ReceivedMessage, bool) {
+	switch id {
+	case 400:
+		return &n.rx.xxx_MotorStatus, true
+	default:
+		return nil, false
+	}
+}
+
+func (n *xxx_DRIVER) TransmittedMessages() []canrunner.TransmittedMessage {
+	return []canrunner.TransmittedMessage{}
+}
+
+type xxx_DRIVER_Rx_MotorStatus struct {
+	MotorStatus
+	receiveTime         time.Time
+	afterReceiveHook    func(context.Context) error
+	signalSubscriptions canrunner.SignalSubscriptions
+	receiveStreams      canrunner.ReceiveStreams
+}
+
+func (m *xxx_DRIVER_Rx_MotorStatus) init() {
+	m.afterReceiveHook = func(context.Context) error { return nil }
+}
+
+func (m *xxx_DRIVER_Rx_MotorStatus) SetAfterReceiveHook(h func(context.Context) error) {
+	m.afterReceiveHook = h
+}
+
+func (m *xxx_DRIVER_Rx_MotorStatus) AfterReceiveHook() func(context.Context) error {
+	return m.afterReceiveHook
+}
+
+func (m *xxx_DRIVER_Rx_MotorStatus) ReceiveTime() time.Time {
+	return m.receiveTime
+}
+
+func (m *xxx_DRIVER_Rx_MotorStatus) SetReceiveTime(t time.Time) {
+	m.receiveTime = t
+}
+
+func (m *xxx_DRIVER_Rx_MotorStatus) SignalSubscriptions() *canrunner.SignalSubscriptions {
+	return &m.signalSubscriptions
+}
+
+func (m *xxx_DRIVER_Rx_MotorStatus) SubscribeWheelError(
+	trigger canrunner.SignalTrigger,
+	h func(ctx context.Context, prev, curr MotorStatusReader) error,
+) {
+	m.signalSubscriptions.Subscribe(
+		Messages().MotorStatus.WheelError,
+		trigger,
+		func(ctx context.Context, prevFrame, currFrame can.Frame) error {
+			var prev, curr MotorStatus
+			if err := prev.UnmarshalFrame(prevFrame); err != nil {
+				return err
+			}
+			if err := curr.UnmarshalFrame(currFrame); err != nil {
+				return err
+			}
+			return h(ctx, &prev, &curr)
+		},
+	)
+}
+
+func (m *xxx_DRIVER_Rx_MotorStatus) SubscribeSpeedKph(
+	trigger canrunner.SignalTrigger,
+	h func(ctx context.Context, prev, curr MotorStatusReader) error,
+) {
+	m.signalSubscriptions.Subscribe(
+		Messages().MotorStatus.SpeedKph,
+		trigger,
+		func(ctx context.Context, prevFrame, currFrame can.Frame) error {
+			var prev, curr MotorStatus
+			if err := prev.UnmarshalFrame(prevFrame); err != nil {
+				return err
+			}
+			if err := curr.UnmarshalFrame(currFrame); err != nil {
+				return err
+			}
+			return h(ctx, &prev, &curr)
+		},
+	)
+}
+
+var _ canrunner.ReceivedMessage = &xxx_DRIVER_Rx_MotorStatus{}
+
+func (m *xxx_DRIVER_Rx_MotorStatus) ReceiveStreams() *canrunner.ReceiveStreams {
+	return &m.receiveStreams
+}
+
+func (m *xxx_DRIVER_Rx_MotorStatus) ReceiveChan(
+	ctx context.Context,
+	size int,
+	policy canrunner.OverflowPolicy,
+) <-chan MotorStatusReader {
+	return canrunner.StreamChan(ctx, &m.receiveStreams, size, policy, m.snapshot)
+}
+
+func (m *xxx_DRIVER_Rx_MotorStatus) ReceiveSeq(
+	ctx context.Context,
+	size int,
+	policy canrunner.OverflowPolicy,
+) iter.Seq[MotorStatusReader] {
+	return canrunner.StreamSeq(ctx, &m.receiveStreams, size, policy, m.snapshot)
+}
+
+func (m *xxx_DRIVER_Rx_MotorStatus) snapshot(f can.Frame) MotorStatusReader {
+	var snapshot MotorStatus
+	_ = snapshot.UnmarshalFrame(f) // the frame has already been unmarshaled by the receiver
+	return &snapshot
+}
+
+var _ canrunner.SignalSubscriber = &xxx_DRIVER_Rx_MotorStatus{}
+var _ canrunner.StreamPublisher = &xxx_DRIVER_Rx_MotorStatus{}
+
+type MOTOR interface {
+	sync.Locker
+	Tx() MOTOR_Tx
+	Rx() MOTOR_Rx
+	Run(ctx context.Context) error
+}
+
+type MOTOR_Rx interface {
+	http.Handler // for debugging
+}
+
+type MOTOR_Tx interface {
+	http.Handler // for debugging
+	MotorStatus() MOTOR_Tx_MotorStatus
+}
+
+type MOTOR_Tx_MotorStatus interface {
+	MotorStatusReader
+	MotorStatusWriter
+	TransmitTime() time.Time
+	Transmit(ctx context.Context) error
+	SetBeforeTransmitHook(h func(context.Context) error)
+	// SetCyclicTransmissionEnabled enables/disables cyclic transmission.
+	SetCyclicTransmissionEnabled(bool)
+	// IsCyclicTransmissionEnabled returns whether cyclic transmission is enabled/disabled.
+	IsCyclicTransmissionEnabled() bool
+}
+
+type xxx_MOTOR struct {
+	sync.Mutex // protects all node state
+	network    string
+	address    string
+	rx         xxx_MOTOR_Rx
+	tx         xxx_MOTOR_Tx
+}
+
+var _ MOTOR = &xxx_MOTOR{}
+var _ canrunner.Node = &xxx_MOTOR{}
+
+func NewMOTOR(network, address string) MOTOR {
+	n := &xxx_MOTOR{network: network, address: address}
+	n.tx.xxx_MotorStatus.init()
+	n.tx.xxx_MotorStatus.Reset()
+	n.rx.debugHandler = candebug.NewHandler(&n.Mutex, []generated.Message{})
+	n.tx.debugHandler = candebug.NewHandler(&n.Mutex, []generated.Message{
+		&n.tx.xxx_MotorStatus,
+	})
+	return n
+}
+
+func (n *xxx_MOTOR) Run(ctx context.Context) error {
+	return canrunner.Run(ctx, n)
+}
+
+func (n *xxx_MOTOR) Rx() MOTOR_Rx {
+	return &n.rx
+}
+
+func (n *xxx_MOTOR) Tx() MOTOR_Tx {
+	return &n.tx
+}
+
+type xxx_MOTOR_Rx struct {
+	debugHandler *candebug.Handler
+}
+
+var _ MOTOR_Rx = &xxx_MOTOR_Rx{}
+
+func (rx *xxx_MOTOR_Rx) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	rx.debugHandler.ServeHTTP(w, r)
+}
+
+type xxx_MOTOR_Tx struct {
+	debugHandler    *candebug.Handler
+	xxx_MotorStatus xxx_MOTOR_Tx_MotorStatus
+}
+
+var _ MOTOR_Tx = &xxx_MOTOR_Tx{}
+
+func (tx *xxx_MOTOR_Tx) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	tx.debugHandler.ServeHTTP(w, r)
+}
+
+func (tx *xxx_MOTOR_Tx) MotorStatus() MOTOR_Tx_MotorStatus {
+	return &tx.xxx_MotorStatus
+}
+
+func (n *xxx_MOTOR) Descriptor() *descriptor.Node {
+	return Nodes().MOTOR
+}
+
+func (n *xxx_MOTOR) Connect() (net.Conn, error) {
+	return socketcan.Dial(n.network, n.address)
+}
+
+func (n *xxx_MOTOR) ReceivedMessage(id uint32) (canrunner.ReceivedMessage, bool) {
+	switch id {
+	default:
+		return nil, false
+	}
+}
+
+func (n *xxx_MOTOR) TransmittedMessages() []canrunner.TransmittedMessage {
+	return []canrunner.TransmittedMessage{
+		&n.tx.xxx_MotorStatus,
+	}
+}
+
+type xxx_MOTOR_Tx_MotorStatus struct {
+	MotorStatus
+	transmitTime       time.Time
+	beforeTransmitHook func(context.Context) error
+	isCyclicEnabled    bool
+	wakeUpChan         chan struct{}
+	transmitEventChan  chan struct{}
+}
+
+var _ MOTOR_Tx_MotorStatus = &xxx_MOTOR_Tx_MotorStatus{}
+var _ canrunner.TransmittedMessage = &xxx_MOTOR_Tx_MotorStatus{}
+
+func (m *xxx_MOTOR_Tx_MotorStatus) init() {
+	m.beforeTransmitHook = func(context.Context) error { return nil }
+	m.wakeUpChan = make(chan struct{}, 1)
+	m.transmitEventChan = make(chan struct{})
+}
+
+func (m *xxx_MOTOR_Tx_MotorStatus) SetBeforeTransmitHook(h func(context.Context) error) {
+	m.beforeTransmitHook = h
+}
+
+func (m *xxx_MOTOR_Tx_MotorStatus) BeforeTransmitHook() func(context.Context) error {
+	return m.beforeTransmitHook
+}
+
+func (m *xxx_MOTOR_Tx_MotorStatus) TransmitTime() time.Time {
+	return m.transmitTime
+}
+
+func (m *xxx_MOTOR_Tx_MotorStatus) SetTransmitTime(t time.Time) {
+	m.transmitTime = t
+}
+
+func (m *xxx_MOTOR_Tx_MotorStatus) IsCyclicTransmissionEnabled() bool {
+	return m.isCyclicEnabled
+}
+
+func (m *xxx_MOTOR_Tx_MotorStatus) SetCyclicTransmissionEnabled(b bool) {
+	m.isCyclicEnabled = b
+	select {
+	case m.wakeUpChan <- struct{}{}:
+	default:
+	}
+}
+
+func (m *xxx_MOTOR_Tx_MotorStatus) WakeUpChan() <-chan struct{} {
+	return m.wakeUpChan
+}
+
+func (m *xxx_MOTOR_Tx_MotorStatus) Transmit(ctx context.Context) error {
+	select {
+	case m.transmitEventChan <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("event-triggered transmit of MotorStatus: %w", ctx.Err())
+	}
+}
+
+func (m *xxx_MOTOR_Tx_MotorStatus) TransmitEventChan() <-chan struct{} {
+	return m.transmitEventChan
+}
+
+var _ canrunner.TransmittedMessage = &xxx_MOTOR_Tx_MotorStatus{}
+
+// FrameBatch holds the messages decoded from batches of frames, in a slice per message.
+//
+// The slices are reused after Reset, so that decoding does not allocate once they have grown to the size of a
+// batch.
+type FrameBatch struct {
+	MotorStatus []MotorStatus
+}
+
+// Reset empties the slices of the batch, keeping their capacity.
+func (b *FrameBatch) Reset() {
+	b.MotorStatus = b.MotorStatus[:0]
+}
+
+// DecodeFrames decodes a batch of frames, and appends the decoded messages to the batch.
+//
+// Remote frames and frames of unknown messages are skipped.
+func (b *FrameBatch) DecodeFrames(frames []can.Frame) error {
+	for i := range frames {
+		f := &frames[i]
+		if f.IsRemote {
+			continue
+		}
+		switch f.ID {
+		case 400:
+			if !f.IsExtended {
+				b.MotorStatus = append(b.MotorStatus, MotorStatus{})
+				m := &b.MotorStatus[len(b.MotorStatus)-1]
+				if err := m.DecodeFrame(f); err != nil {
+					b.MotorStatus = b.MotorStatus[:len(b.MotorStatus)-1]
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// Nodes returns the streams node descriptors.
+func Nodes() *NodesDescriptor {
+	return nd
+}
+
+// NodesDescriptor contains all streams node descriptors.
+type NodesDescriptor struct {
+	DRIVER *descriptor.Node
+	MOTOR  *descriptor.Node
+}
+
+// Messages returns the streams message descriptors.
+func Messages() *MessagesDescriptor {
+	return md
+}
+
+// MessagesDescriptor contains all streams message descriptors.
+type MessagesDescriptor struct {
+	MotorStatus *MotorStatusDescriptor
+}
+
+// UnmarshalFrame unmarshals the provided streams CAN frame.
+func (md *MessagesDescriptor) UnmarshalFrame(f can.Frame) (generated.Message, error) {
+	switch f.ID {
+	case md.MotorStatus.ID:
+		var msg MotorStatus
+		if err := msg.UnmarshalFrame(f); err != nil {
+			return nil, fmt.Errorf("unmarshal streams frame: %w", err)
+		}
+		return &msg, nil
+	default:
+		return nil, fmt.Errorf("unmarshal streams frame: ID not in database: %d", f.ID)
+	}
+}
+
+type MotorStatusDescriptor struct {
+	*descriptor.Message
+	WheelError *descriptor.Signal
+	SpeedKph   *descriptor.Signal
+}
+
+// Database returns the streams database descriptor.
+func (md *MessagesDescriptor) Database() *descriptor.Database {
+	return d
+}
+
+var nd = &NodesDescriptor{
+	DRIVER: d.Nodes[0],
+	MOTOR:  d.Nodes[1],
+}
+
+var md = &MessagesDescriptor{
+	MotorStatus: &MotorStatusDescriptor{
+		Message:    d.Messages[0],
+		WheelError: d.Messages[0].Signals[0],
+		SpeedKph:   d.Messages[0].Signals[1],
+	},
+}
+
+var d = (*descriptor.Database)(&descriptor.Database{
+	SourceFile: (string)("testdata/streams/streams.dbc"),
+	Version:    (string)(""),
+	Messages: ([]*descriptor.Message)([]*descriptor.Message{
+		(*descriptor.Message)(&descriptor.Message{
+			Name:        (string)("MotorStatus"),
+			ID:          (uint32)(400),
+			IsExtended:  (bool)(false),
+			Length:      (uint8)(3),
+			SendType:    (descriptor.SendType)(1),
+			Description: (string)(""),
+			Signals: ([]*descriptor.Signal)([]*descriptor.Signal{
+				(*descriptor.Signal)(&descriptor.Signal{
+					Name:              (string)("WheelError"),
+					Start:             (uint8)(0),
+					Length:            (uint8)(1),
+					IsBigEndian:       (bool)(false),
+					IsSigned:          (bool)(false),
+					IsFloat:           (bool)(false),
+					IsMultiplexer:     (bool)(false),
+					IsMultiplexed:     (bool)(false),
+					MultiplexerValue:  (uint)(0),
+					MultiplexerName:   (string)(""),
+					MultiplexerRanges: ([]*descriptor.MultiplexerRange)(nil),
+					Offset:            (float64)(0),
+					Scale:             (float64)(1),
+					Min:               (float64)(0),
+					Max:               (float64)(0),
+					Unit:              (string)(""),
+					Description:       (string)(""),
+					SignalType:        (string)(""),
+					ValueTable:        (string)(""),
+					ValueDescriptions: ([]*descriptor.ValueDescription)(nil),
+					ReceiverNodes: ([]string)([]string{
+						(string)("DRIVER"),
+					}),
+					DefaultValue:  (int)(0),
+					SendType:      (descriptor.SendType)(0),
+					InactiveValue: (int)(0),
+					Attributes:    ([]*descriptor.Attribute)(nil),
+				}),
+				(*descriptor.Signal)(&descriptor.Signal{
+					Name:              (string)("SpeedKph"),
+					Start:             (uint8)(8),
+					Length:            (uint8)(16),
+					IsBigEndian:       (bool)(false),
+					IsSigned:          (bool)(false),
+					IsFloat:           (bool)(false),
+					IsMultiplexer:     (bool)(false),
+					IsMultiplexed:     (bool)(false),
+					MultiplexerValue:  (uint)(0),
+					MultiplexerName:   (string)(""),
+					MultiplexerRanges: ([]*descriptor.MultiplexerRange)(nil),
+					Offset:            (float64)(0),
+					Scale:             (float64)(0.001),
+					Min:               (float64)(0),
+					Max:               (float64)(70),
+					Unit:              (string)("km/h"),
+					Description:       (string)(""),
+					SignalType:        (string)(""),
+					ValueTable:        (string)(""),
+					ValueDescriptions: ([]*descriptor.ValueDescription)(nil),
+					ReceiverNodes: ([]string)([]string{
+						(string)("DRIVER"),
+					}),
+					DefaultValue:  (int)(0),
+					SendType:      (descriptor.SendType)(0),
+					InactiveValue: (int)(0),
+					Attributes:    ([]*descriptor.Attribute)(nil),
+				}),
+			}),
+			SenderNode:       (string)("MOTOR"),
+			TransmitterNodes: ([]string)(nil),
+			SignalGroups:     ([]*descriptor.SignalGroup)(nil),
+			CycleTime:        (time.Duration)(100000000),
+			CycleTimeFast:    (time.Duration)(0),
+			DelayTime:        (time.Duration)(0),
+			RepetitionCount:  (int)(0),
+			Attributes: ([]*descriptor.Attribute)([]*descriptor.Attribute{
+				(*descriptor.Attribute)(&descriptor.Attribute{
+					Name:        (string)("GenMsgCycleTime"),
+					Type:        (descriptor.AttributeType)(0),
+					IntValue:    (int64)(100),
+					FloatValue:  (float64)(0),
+					StringValue: (string)(""),
+					IsDefault:   (bool)(false),
+				}),
+				(*descriptor.Attribute)(&descriptor.Attribute{
+					Name:        (string)("GenMsgSendType"),
+					Type:        (descriptor.AttributeType)(4),
+					IntValue:    (int64)(0),
+					FloatValue:  (float64)(0),
+					StringValue: (string)("Cyclic"),
+					IsDefault:   (bool)(false),
+				}),
+			}),
+		}),
+	}),
+	Nodes: ([]*descriptor.Node)([]*descriptor.Node{
+		(*descriptor.Node)(&descriptor.Node{
+			Name:        (string)("DRIVER"),
+			Description: (string)(""),
+			Attributes:  ([]*descriptor.Attribute)(nil),
+		}),
+		(*descriptor.Node)(&descriptor.Node{
+			Name:        (string)("MOTOR"),
+			Description: (string)(""),
+			Attributes:  ([]*descriptor.Attribute)(nil),
+		}),
+	}),
+	ValueTables:          ([]*descriptor.ValueTable)(nil),
+	EnvironmentVariables: ([]*descriptor.EnvironmentVariable)(nil),
+	Attributes:           ([]*descriptor.Attribute)(nil),
+})
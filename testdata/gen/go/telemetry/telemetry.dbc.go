@@ -0,0 +1,1038 @@
+// Package telemetrycan provides primitives for encoding and decoding telemetry CAN messages.
+//
+// Source: testdata/proto/telemetry.dbc
+package telemetrycan
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"go.einride.tech/can"
+	"go.einride.tech/can/pkg/candebug"
+	"go.einride.tech/can/pkg/canrunner"
+	"go.einride.tech/can/pkg/cantext"
+	"go.einride.tech/can/pkg/descriptor"
+	"go.einride.tech/can/pkg/generated"
+	"go.einride.tech/can/pkg/socketcan"
+)
+
+// prevent unused imports
+var (
+	_ = context.Background
+	_ = fmt.Print
+	_ = math.Float32frombits
+	_ = net.Dial
+	_ = http.Error
+	_ = sync.Mutex{}
+	_ = time.Now
+	_ = socketcan.Dial
+	_ = candebug.ServeMessagesHTTP
+	_ = canrunner.Run
+)
+
+// Generated code. DO NOT EDIT.
+// EngineStatusReader provides read access to a EngineStatus message.
+type EngineStatusReader interface {
+	can.FrameMarshaler
+	// Speed returns the physical value of the Speed signal.
+	Speed() float64
+	// RawSpeed returns the raw (encoded) value of the Speed signal.
+	RawSpeed() uint16
+	// Temperature returns the physical value of the Temperature signal.
+	Temperature() float64
+	// RawTemperature returns the raw (encoded) value of the Temperature signal.
+	RawTemperature() int8
+	// Gear returns the value of the Gear signal.
+	Gear() EngineStatus_Gear
+	// IsRunning returns the value of the IsRunning signal.
+	IsRunning() bool
+	// Counter returns the value of the Counter signal.
+	Counter() uint8
+	// ABSActive returns the value of the ABSActive signal.
+	ABSActive() bool
+}
+
+// EngineStatusWriter provides write access to a EngineStatus message.
+type EngineStatusWriter interface {
+	// CopyFrom copies all values from EngineStatus.
+	CopyFrom(EngineStatusReader) *EngineStatus
+	// SetSpeed sets the physical value of the Speed signal.
+	SetSpeed(float64) *EngineStatus
+	// SetRawSpeed sets the raw (encoded) value of the Speed signal.
+	SetRawSpeed(uint16) *EngineStatus
+	// SetTemperature sets the physical value of the Temperature signal.
+	SetTemperature(float64) *EngineStatus
+	// SetRawTemperature sets the raw (encoded) value of the Temperature signal.
+	SetRawTemperature(int8) *EngineStatus
+	// SetGear sets the value of the Gear signal.
+	SetGear(EngineStatus_Gear) *EngineStatus
+	// SetIsRunning sets the value of the IsRunning signal.
+	SetIsRunning(bool) *EngineStatus
+	// SetCounter sets the value of the Counter signal.
+	SetCounter(uint8) *EngineStatus
+	// SetABSActive sets the value of the ABSActive signal.
+	SetABSActive(bool) *EngineStatus
+}
+
+type EngineStatus struct {
+	xxx_Speed       uint16
+	xxx_Temperature int8
+	xxx_Gear        EngineStatus_Gear
+	xxx_IsRunning   bool
+	xxx_Counter     uint8
+	xxx_ABSActive   bool
+}
+
+func NewEngineStatus() *EngineStatus {
+	m := &EngineStatus{}
+	m.Reset()
+	return m
+}
+
+func (m *EngineStatus) Reset() {
+	m.xxx_Speed = 0
+	m.xxx_Temperature = 0
+	m.xxx_Gear = 0
+	m.xxx_IsRunning = false
+	m.xxx_Counter = 0
+	m.xxx_ABSActive = false
+}
+
+func (m *EngineStatus) CopyFrom(o EngineStatusReader) *EngineStatus {
+	f, _ := o.MarshalFrame()
+	_ = m.UnmarshalFrame(f)
+	return m
+}
+
+// Descriptor returns the EngineStatus descriptor.
+func (m *EngineStatus) Descriptor() *descriptor.Message {
+	return Messages().EngineStatus.Message
+}
+
+// String returns a compact string representation of the message.
+func (m *EngineStatus) String() string {
+	return cantext.MessageString(m)
+}
+
+func (m *EngineStatus) Speed() float64 {
+	return Messages().EngineStatus.Speed.ToPhysical(float64(m.xxx_Speed))
+}
+
+func (m *EngineStatus) SetSpeed(v float64) *EngineStatus {
+	m.xxx_Speed = uint16(Messages().EngineStatus.Speed.FromPhysical(v))
+	return m
+}
+
+func (m *EngineStatus) RawSpeed() uint16 {
+	return m.xxx_Speed
+}
+
+func (m *EngineStatus) SetRawSpeed(v uint16) *EngineStatus {
+	m.xxx_Speed = uint16(Messages().EngineStatus.Speed.SaturatedCastUnsigned(uint64(v)))
+	return m
+}
+
+func (m *EngineStatus) Temperature() float64 {
+	return Messages().EngineStatus.Temperature.ToPhysical(float64(m.xxx_Temperature))
+}
+
+func (m *EngineStatus) SetTemperature(v float64) *EngineStatus {
+	m.xxx_Temperature = int8(Messages().EngineStatus.Temperature.FromPhysical(v))
+	return m
+}
+
+func (m *EngineStatus) RawTemperature() int8 {
+	return m.xxx_Temperature
+}
+
+func (m *EngineStatus) SetRawTemperature(v int8) *EngineStatus {
+	m.xxx_Temperature = int8(Messages().EngineStatus.Temperature.SaturatedCastSigned(int64(v)))
+	return m
+}
+
+func (m *EngineStatus) Gear() EngineStatus_Gear {
+	return m.xxx_Gear
+}
+
+func (m *EngineStatus) SetGear(v EngineStatus_Gear) *EngineStatus {
+	m.xxx_Gear = EngineStatus_Gear(Messages().EngineStatus.Gear.SaturatedCastUnsigned(uint64(v)))
+	return m
+}
+
+func (m *EngineStatus) IsRunning() bool {
+	return m.xxx_IsRunning
+}
+
+func (m *EngineStatus) SetIsRunning(v bool) *EngineStatus {
+	m.xxx_IsRunning = v
+	return m
+}
+
+func (m *EngineStatus) Counter() uint8 {
+	return m.xxx_Counter
+}
+
+func (m *EngineStatus) SetCounter(v uint8) *EngineStatus {
+	m.xxx_Counter = uint8(Messages().EngineStatus.Counter.SaturatedCastUnsigned(uint64(v)))
+	return m
+}
+
+func (m *EngineStatus) ABSActive() bool {
+	return m.xxx_ABSActive
+}
+
+func (m *EngineStatus) SetABSActive(v bool) *EngineStatus {
+	m.xxx_ABSActive = v
+	return m
+}
+
+// EngineStatus_Gear models the Gear signal of the EngineStatus message.
+type EngineStatus_Gear uint8
+
+// Value descriptions for the Gear signal of the EngineStatus message.
+const (
+	EngineStatus_Gear_Park    EngineStatus_Gear = 0
+	EngineStatus_Gear_Reverse EngineStatus_Gear = 1
+	EngineStatus_Gear_Neutral EngineStatus_Gear = 2
+	EngineStatus_Gear_Drive   EngineStatus_Gear = 3
+)
+
+func (v EngineStatus_Gear) String() string {
+	switch v {
+	case 0:
+		return "Park"
+	case 1:
+		return "Reverse"
+	case 2:
+		return "Neutral"
+	case 3:
+		return "Drive"
+	default:
+		return fmt.Sprintf("EngineStatus_Gear(%d)", v)
+	}
+}
+
+// Frame returns a CAN frame representing the message.
+func (m *EngineStatus) Frame() can.Frame {
+	md := Messages().EngineStatus
+	f := can.Frame{ID: md.ID, IsExtended: md.IsExtended, Length: md.Length}
+	md.Speed.MarshalUnsigned(&f.Data, uint64(m.xxx_Speed))
+	md.Temperature.MarshalSigned(&f.Data, int64(m.xxx_Temperature))
+	md.Gear.MarshalUnsigned(&f.Data, uint64(m.xxx_Gear))
+	md.IsRunning.MarshalBool(&f.Data, bool(m.xxx_IsRunning))
+	md.Counter.MarshalUnsigned(&f.Data, uint64(m.xxx_Counter))
+	md.ABSActive.MarshalBool(&f.Data, bool(m.xxx_ABSActive))
+	return f
+}
+
+// MarshalFrame encodes the message as a CAN frame.
+func (m *EngineStatus) MarshalFrame() (can.Frame, error) {
+	return m.Frame(), nil
+}
+
+// UnmarshalFrame decodes the message from a CAN frame.
+func (m *EngineStatus) UnmarshalFrame(f can.Frame) error {
+	md := Messages().EngineStatus
+	switch {
+	case f.ID != md.ID:
+		return fmt.Errorf(
+			"unmarshal EngineStatus: expects ID 100 (got %s with ID %d)", f.String(), f.ID,
+		)
+	case f.Length != md.Length:
+		return fmt.Errorf(
+			"unmarshal EngineStatus: expects length 8 (got %s with length %d)", f.String(), f.Length,
+		)
+	case f.IsRemote:
+		return fmt.Errorf(
+			"unmarshal EngineStatus: expects non-remote frame (got remote frame %s)", f.String(),
+		)
+	case f.IsExtended != md.IsExtended:
+		return fmt.Errorf(
+			"unmarshal EngineStatus: expects standard ID (got %s with extended ID)", f.String(),
+		)
+	}
+	m.xxx_Speed = uint16(md.Speed.UnmarshalUnsigned(f.Data))
+	m.xxx_Temperature = int8(md.Temperature.UnmarshalSigned(f.Data))
+	m.xxx_Gear = EngineStatus_Gear(md.Gear.UnmarshalUnsigned(f.Data))
+	m.xxx_IsRunning = bool(md.IsRunning.UnmarshalBool(f.Data))
+	m.xxx_Counter = uint8(md.Counter.UnmarshalUnsigned(f.Data))
+	m.xxx_ABSActive = bool(md.ABSActive.UnmarshalBool(f.Data))
+	return nil
+}
+
+// DecodeFrame decodes the message from a CAN frame, with the bit layout of each signal resolved at generation
+// time.
+//
+// DecodeFrame decodes the same values as UnmarshalFrame, without allocating unless the frame is invalid, for
+// decoding frames at high rates into reused messages.
+func (m *EngineStatus) DecodeFrame(f *can.Frame) error {
+	switch {
+	case f.ID != 100:
+		return fmt.Errorf(
+			"decode EngineStatus: expects ID 100 (got %s with ID %d)", f.String(), f.ID,
+		)
+	case f.Length != 8:
+		return fmt.Errorf(
+			"decode EngineStatus: expects length 8 (got %s with length %d)", f.String(), f.Length,
+		)
+	case f.IsRemote:
+		return fmt.Errorf(
+			"decode EngineStatus: expects non-remote frame (got remote frame %s)", f.String(),
+		)
+	case f.IsExtended:
+		return fmt.Errorf(
+			"decode EngineStatus: expects standard ID (got %s with extended ID)", f.String(),
+		)
+	}
+	le := f.Data.PackLittleEndian()
+	m.xxx_Speed = uint16(le & 0xffff)
+	m.xxx_Temperature = int8(int64(le<<40) >> 56)
+	m.xxx_Gear = EngineStatus_Gear((le >> 24) & 0x3)
+	m.xxx_IsRunning = bool(le&(1<<26) != 0)
+	m.xxx_Counter = uint8((le >> 28) & 0xf)
+	m.xxx_ABSActive = bool(le&(1<<32) != 0)
+	return nil
+}
+
+// DiagnosticsReader provides read access to a Diagnostics message.
+type DiagnosticsReader interface {
+	can.FrameMarshaler
+	// Page returns the value of the Page signal.
+	Page() uint8
+	// Odometer returns the physical value of the Odometer signal.
+	Odometer() float64
+	// RawOdometer returns the raw (encoded) value of the Odometer signal.
+	RawOdometer() uint32
+	// ErrorCode returns the value of the ErrorCode signal.
+	ErrorCode() int16
+	// DriveMode returns the value of the DriveMode signal.
+	DriveMode() Diagnostics_DriveMode
+}
+
+// DiagnosticsWriter provides write access to a Diagnostics message.
+type DiagnosticsWriter interface {
+	// CopyFrom copies all values from Diagnostics.
+	CopyFrom(DiagnosticsReader) *Diagnostics
+	// SetPage sets the value of the Page signal.
+	SetPage(uint8) *Diagnostics
+	// SetOdometer sets the physical value of the Odometer signal.
+	SetOdometer(float64) *Diagnostics
+	// SetRawOdometer sets the raw (encoded) value of the Odometer signal.
+	SetRawOdometer(uint32) *Diagnostics
+	// SetErrorCode sets the value of the ErrorCode signal.
+	SetErrorCode(int16) *Diagnostics
+	// SetDriveMode sets the value of the DriveMode signal.
+	SetDriveMode(Diagnostics_DriveMode) *Diagnostics
+}
+
+type Diagnostics struct {
+	xxx_Page      uint8
+	xxx_Odometer  uint32
+	xxx_ErrorCode int16
+	xxx_DriveMode Diagnostics_DriveMode
+}
+
+func NewDiagnostics() *Diagnostics {
+	m := &Diagnostics{}
+	m.Reset()
+	return m
+}
+
+func (m *Diagnostics) Reset() {
+	m.xxx_Page = 0
+	m.xxx_Odometer = 0
+	m.xxx_ErrorCode = 0
+	m.xxx_DriveMode = 0
+}
+
+func (m *Diagnostics) CopyFrom(o DiagnosticsReader) *Diagnostics {
+	f, _ := o.MarshalFrame()
+	_ = m.UnmarshalFrame(f)
+	return m
+}
+
+// Descriptor returns the Diagnostics descriptor.
+func (m *Diagnostics) Descriptor() *descriptor.Message {
+	return Messages().Diagnostics.Message
+}
+
+// String returns a compact string representation of the message.
+func (m *Diagnostics) String() string {
+	return cantext.MessageString(m)
+}
+
+func (m *Diagnostics) Page() uint8 {
+	return m.xxx_Page
+}
+
+func (m *Diagnostics) SetPage(v uint8) *Diagnostics {
+	m.xxx_Page = uint8(Messages().Diagnostics.Page.SaturatedCastUnsigned(uint64(v)))
+	return m
+}
+
+func (m *Diagnostics) Odometer() float64 {
+	return Messages().Diagnostics.Odometer.ToPhysical(float64(m.xxx_Odometer))
+}
+
+func (m *Diagnostics) SetOdometer(v float64) *Diagnostics {
+	m.xxx_Odometer = uint32(Messages().Diagnostics.Odometer.FromPhysical(v))
+	return m
+}
+
+func (m *Diagnostics) RawOdometer() uint32 {
+	return m.xxx_Odometer
+}
+
+func (m *Diagnostics) SetRawOdometer(v uint32) *Diagnostics {
+	m.xxx_Odometer = uint32(Messages().Diagnostics.Odometer.SaturatedCastUnsigned(uint64(v)))
+	return m
+}
+
+func (m *Diagnostics) ErrorCode() int16 {
+	return m.xxx_ErrorCode
+}
+
+func (m *Diagnostics) SetErrorCode(v int16) *Diagnostics {
+	m.xxx_ErrorCode = int16(Messages().Diagnostics.ErrorCode.SaturatedCastSigned(int64(v)))
+	return m
+}
+
+func (m *Diagnostics) DriveMode() Diagnostics_DriveMode {
+	return m.xxx_DriveMode
+}
+
+func (m *Diagnostics) SetDriveMode(v Diagnostics_DriveMode) *Diagnostics {
+	m.xxx_DriveMode = Diagnostics_DriveMode(Messages().Diagnostics.DriveMode.SaturatedCastUnsigned(uint64(v)))
+	return m
+}
+
+// Diagnostics_DriveMode models the DriveMode signal of the Diagnostics message.
+type Diagnostics_DriveMode uint8
+
+// Value descriptions for the DriveMode signal of the Diagnostics message.
+const (
+	Diagnostics_DriveMode_Eco   Diagnostics_DriveMode = 1
+	Diagnostics_DriveMode_Sport Diagnostics_DriveMode = 2
+)
+
+func (v Diagnostics_DriveMode) String() string {
+	switch v {
+	case 1:
+		return "Eco"
+	case 2:
+		return "Sport"
+	default:
+		return fmt.Sprintf("Diagnostics_DriveMode(%d)", v)
+	}
+}
+
+// Frame returns a CAN frame representing the message.
+func (m *Diagnostics) Frame() can.Frame {
+	md := Messages().Diagnostics
+	f := can.Frame{ID: md.ID, IsExtended: md.IsExtended, Length: md.Length}
+	md.Page.MarshalUnsigned(&f.Data, uint64(m.xxx_Page))
+	if m.xxx_Page == 0 {
+		md.Odometer.MarshalUnsigned(&f.Data, uint64(m.xxx_Odometer))
+	}
+	if m.xxx_Page == 1 {
+		md.ErrorCode.MarshalSigned(&f.Data, int64(m.xxx_ErrorCode))
+	}
+	if m.xxx_Page == 1 {
+		md.DriveMode.MarshalUnsigned(&f.Data, uint64(m.xxx_DriveMode))
+	}
+	return f
+}
+
+// MarshalFrame encodes the message as a CAN frame.
+func (m *Diagnostics) MarshalFrame() (can.Frame, error) {
+	return m.Frame(), nil
+}
+
+// UnmarshalFrame decodes the message from a CAN frame.
+func (m *Diagnostics) UnmarshalFrame(f can.Frame) error {
+	md := Messages().Diagnostics
+	switch {
+	case f.ID != md.ID:
+		return fmt.Errorf(
+			"unmarshal Diagnostics: expects ID 200 (got %s with ID %d)", f.String(), f.ID,
+		)
+	case f.Length != md.Length:
+		return fmt.Errorf(
+			"unmarshal Diagnostics: expects length 4 (got %s with length %d)", f.String(), f.Length,
+		)
+	case f.IsRemote:
+		return fmt.Errorf(
+			"unmarshal Diagnostics: expects non-remote frame (got remote frame %s)", f.String(),
+		)
+	case f.IsExtended != md.IsExtended:
+		return fmt.Errorf(
+			"unmarshal Diagnostics: expects standard ID (got %s with extended ID)", f.String(),
+		)
+	}
+	m.xxx_Page = uint8(md.Page.UnmarshalUnsigned(f.Data))
+	if m.xxx_Page == 0 {
+		m.xxx_Odometer = uint32(md.Odometer.UnmarshalUnsigned(f.Data))
+	}
+	if m.xxx_Page == 1 {
+		m.xxx_ErrorCode = int16(md.ErrorCode.UnmarshalSigned(f.Data))
+	}
+	if m.xxx_Page == 1 {
+		m.xxx_DriveMode = Diagnostics_DriveMode(md.DriveMode.UnmarshalUnsigned(f.Data))
+	}
+	return nil
+}
+
+// DecodeFrame decodes the message from a CAN frame, with the bit layout of each signal resolved at generation
+// time.
+//
+// DecodeFrame decodes the same values as UnmarshalFrame, without allocating unless the frame is invalid, for
+// decoding frames at high rates into reused messages.
+func (m *Diagnostics) DecodeFrame(f *can.Frame) error {
+	switch {
+	case f.ID != 200:
+		return fmt.Errorf(
+			"decode Diagnostics: expects ID 200 (got %s with ID %d)", f.String(), f.ID,
+		)
+	case f.Length != 4:
+		return fmt.Errorf(
+			"decode Diagnostics: expects length 4 (got %s with length %d)", f.String(), f.Length,
+		)
+	case f.IsRemote:
+		return fmt.Errorf(
+			"decode Diagnostics: expects non-remote frame (got remote frame %s)", f.String(),
+		)
+	case f.IsExtended:
+		return fmt.Errorf(
+			"decode Diagnostics: expects standard ID (got %s with extended ID)", f.String(),
+		)
+	}
+	le := f.Data.PackLittleEndian()
+	m.xxx_Page = uint8(le & 0xf)
+	if m.xxx_Page == 0 {
+		m.xxx_Odometer = uint32((le >> 8) & 0xffffff)
+	}
+	if m.xxx_Page == 1 {
+		m.xxx_ErrorCode = int16(int64(le<<40) >> 48)
+	}
+	if m.xxx_Page == 1 {
+		m.xxx_DriveMode = Diagnostics_DriveMode((le >> 24) & 0x3)
+	}
+	return nil
+}
+
+// FrameBatch holds the messages decoded from batches of frames, in a slice per message.
+//
+// The slices are reused after Reset, so that decoding does not allocate once they have grown to the size of a
+// batch.
+type FrameBatch struct {
+	EngineStatus []EngineStatus
+	Diagnostics  []Diagnostics
+}
+
+// Reset empties the slices of the batch, keeping their capacity.
+func (b *FrameBatch) Reset() {
+	b.EngineStatus = b.EngineStatus[:0]
+	b.Diagnostics = b.Diagnostics[:0]
+}
+
+// DecodeFrames decodes a batch of frames, and appends the decoded messages to the batch.
+//
+// Remote frames and frames of unknown messages are skipped.
+func (b *FrameBatch) DecodeFrames(frames []can.Frame) error {
+	for i := range frames {
+		f := &frames[i]
+		if f.IsRemote {
+			continue
+		}
+		switch f.ID {
+		case 100:
+			if !f.IsExtended {
+				b.EngineStatus = append(b.EngineStatus, EngineStatus{})
+				m := &b.EngineStatus[len(b.EngineStatus)-1]
+				if err := m.DecodeFrame(f); err != nil {
+					b.EngineStatus = b.EngineStatus[:len(b.EngineStatus)-1]
+					return err
+				}
+			}
+		case 200:
+			if !f.IsExtended {
+				b.Diagnostics = append(b.Diagnostics, Diagnostics{})
+				m := &b.Diagnostics[len(b.Diagnostics)-1]
+				m.Reset()
+				if err := m.DecodeFrame(f); err != nil {
+					b.Diagnostics = b.Diagnostics[:len(b.Diagnostics)-1]
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// Nodes returns the telemetry node descriptors.
+func Nodes() *NodesDescriptor {
+	return nd
+}
+
+// NodesDescriptor contains all telemetry node descriptors.
+type NodesDescriptor struct {
+	ECU    *descriptor.Node
+	LOGGER *descriptor.Node
+}
+
+// Messages returns the telemetry message descriptors.
+func Messages() *MessagesDescriptor {
+	return md
+}
+
+// MessagesDescriptor contains all telemetry message descriptors.
+type MessagesDescriptor struct {
+	EngineStatus *EngineStatusDescriptor
+	Diagnostics  *DiagnosticsDescriptor
+}
+
+// UnmarshalFrame unmarshals the provided telemetry CAN frame.
+func (md *MessagesDescriptor) UnmarshalFrame(f can.Frame) (generated.Message, error) {
+	switch f.ID {
+	case md.EngineStatus.ID:
+		var msg EngineStatus
+		if err := msg.UnmarshalFrame(f); err != nil {
+			return nil, fmt.Errorf("unmarshal telemetry frame: %w", err)
+		}
+		return &msg, nil
+	case md.Diagnostics.ID:
+		var msg Diagnostics
+		if err := msg.UnmarshalFrame(f); err != nil {
+			return nil, fmt.Errorf("unmarshal telemetry frame: %w", err)
+		}
+		return &msg, nil
+	default:
+		return nil, fmt.Errorf("unmarshal telemetry frame: ID not in database: %d", f.ID)
+	}
+}
+
+type EngineStatusDescriptor struct {
+	*descriptor.Message
+	Speed       *descriptor.Signal
+	Temperature *descriptor.Signal
+	Gear        *descriptor.Signal
+	IsRunning   *descriptor.Signal
+	Counter     *descriptor.Signal
+	ABSActive   *descriptor.Signal
+}
+
+type DiagnosticsDescriptor struct {
+	*descriptor.Message
+	Page      *descriptor.Signal
+	Odometer  *descriptor.Signal
+	ErrorCode *descriptor.Signal
+	DriveMode *descriptor.Signal
+}
+
+// Database returns the telemetry database descriptor.
+func (md *MessagesDescriptor) Database() *descriptor.Database {
+	return d
+}
+
+var nd = &NodesDescriptor{
+	ECU:    d.Nodes[0],
+	LOGGER: d.Nodes[1],
+}
+
+var md = &MessagesDescriptor{
+	EngineStatus: &EngineStatusDescriptor{
+		Message:     d.Messages[0],
+		Speed:       d.Messages[0].Signals[0],
+		Temperature: d.Messages[0].Signals[1],
+		Gear:        d.Messages[0].Signals[2],
+		IsRunning:   d.Messages[0].Signals[3],
+		Counter:     d.Messages[0].Signals[4],
+		ABSActive:   d.Messages[0].Signals[5],
+	},
+	Diagnostics: &DiagnosticsDescriptor{
+		Message:   d.Messages[1],
+		Page:      d.Messages[1].Signals[0],
+		Odometer:  d.Messages[1].Signals[1],
+		ErrorCode: d.Messages[1].Signals[2],
+		DriveMode: d.Messages[1].Signals[3],
+	},
+}
+
+var d = (*descriptor.Database)(&descriptor.Database{
+	SourceFile: (string)("testdata/proto/telemetry.dbc"),
+	Version:    (string)(""),
+	Messages: ([]*descriptor.Message)([]*descriptor.Message{
+		(*descriptor.Message)(&descriptor.Message{
+			Name:        (string)("EngineStatus"),
+			ID:          (uint32)(100),
+			IsExtended:  (bool)(false),
+			Length:      (uint8)(8),
+			SendType:    (descriptor.SendType)(0),
+			Description: (string)("Status of the engine."),
+			Signals: ([]*descriptor.Signal)([]*descriptor.Signal{
+				(*descriptor.Signal)(&descriptor.Signal{
+					Name:              (string)("Speed"),
+					Start:             (uint8)(0),
+					Length:            (uint8)(16),
+					IsBigEndian:       (bool)(false),
+					IsSigned:          (bool)(false),
+					IsFloat:           (bool)(false),
+					IsMultiplexer:     (bool)(false),
+					IsMultiplexed:     (bool)(false),
+					MultiplexerValue:  (uint)(0),
+					MultiplexerName:   (string)(""),
+					MultiplexerRanges: ([]*descriptor.MultiplexerRange)(nil),
+					Offset:            (float64)(0),
+					Scale:             (float64)(0.25),
+					Min:               (float64)(0),
+					Max:               (float64)(16383.75),
+					Unit:              (string)("rpm"),
+					Description:       (string)("Engine speed."),
+					SignalType:        (string)(""),
+					ValueTable:        (string)(""),
+					ValueDescriptions: ([]*descriptor.ValueDescription)(nil),
+					ReceiverNodes: ([]string)([]string{
+						(string)("LOGGER"),
+					}),
+					DefaultValue:  (int)(0),
+					SendType:      (descriptor.SendType)(0),
+					InactiveValue: (int)(0),
+					Attributes:    ([]*descriptor.Attribute)(nil),
+				}),
+				(*descriptor.Signal)(&descriptor.Signal{
+					Name:              (string)("Temperature"),
+					Start:             (uint8)(16),
+					Length:            (uint8)(8),
+					IsBigEndian:       (bool)(false),
+					IsSigned:          (bool)(true),
+					IsFloat:           (bool)(false),
+					IsMultiplexer:     (bool)(false),
+					IsMultiplexed:     (bool)(false),
+					MultiplexerValue:  (uint)(0),
+					MultiplexerName:   (string)(""),
+					MultiplexerRanges: ([]*descriptor.MultiplexerRange)(nil),
+					Offset:            (float64)(-40),
+					Scale:             (float64)(0.5),
+					Min:               (float64)(-104),
+					Max:               (float64)(23.5),
+					Unit:              (string)("degC"),
+					Description:       (string)(""),
+					SignalType:        (string)(""),
+					ValueTable:        (string)(""),
+					ValueDescriptions: ([]*descriptor.ValueDescription)(nil),
+					ReceiverNodes: ([]string)([]string{
+						(string)("LOGGER"),
+					}),
+					DefaultValue:  (int)(0),
+					SendType:      (descriptor.SendType)(0),
+					InactiveValue: (int)(0),
+					Attributes:    ([]*descriptor.Attribute)(nil),
+				}),
+				(*descriptor.Signal)(&descriptor.Signal{
+					Name:              (string)("Gear"),
+					Start:             (uint8)(24),
+					Length:            (uint8)(2),
+					IsBigEndian:       (bool)(false),
+					IsSigned:          (bool)(false),
+					IsFloat:           (bool)(false),
+					IsMultiplexer:     (bool)(false),
+					IsMultiplexed:     (bool)(false),
+					MultiplexerValue:  (uint)(0),
+					MultiplexerName:   (string)(""),
+					MultiplexerRanges: ([]*descriptor.MultiplexerRange)(nil),
+					Offset:            (float64)(0),
+					Scale:             (float64)(1),
+					Min:               (float64)(0),
+					Max:               (float64)(3),
+					Unit:              (string)(""),
+					Description:       (string)(""),
+					SignalType:        (string)(""),
+					ValueTable:        (string)(""),
+					ValueDescriptions: ([]*descriptor.ValueDescription)([]*descriptor.ValueDescription{
+						(*descriptor.ValueDescription)(&descriptor.ValueDescription{
+							Value:       (int64)(0),
+							Description: (string)("Park"),
+						}),
+						(*descriptor.ValueDescription)(&descriptor.ValueDescription{
+							Value:       (int64)(1),
+							Description: (string)("Reverse"),
+						}),
+						(*descriptor.ValueDescription)(&descriptor.ValueDescription{
+							Value:       (int64)(2),
+							Description: (string)("Neutral"),
+						}),
+						(*descriptor.ValueDescription)(&descriptor.ValueDescription{
+							Value:       (int64)(3),
+							Description: (string)("Drive"),
+						}),
+					}),
+					ReceiverNodes: ([]string)([]string{
+						(string)("LOGGER"),
+					}),
+					DefaultValue:  (int)(0),
+					SendType:      (descriptor.SendType)(0),
+					InactiveValue: (int)(0),
+					Attributes:    ([]*descriptor.Attribute)(nil),
+				}),
+				(*descriptor.Signal)(&descriptor.Signal{
+					Name:              (string)("IsRunning"),
+					Start:             (uint8)(26),
+					Length:            (uint8)(1),
+					IsBigEndian:       (bool)(false),
+					IsSigned:          (bool)(false),
+					IsFloat:           (bool)(false),
+					IsMultiplexer:     (bool)(false),
+					IsMultiplexed:     (bool)(false),
+					MultiplexerValue:  (uint)(0),
+					MultiplexerName:   (string)(""),
+					MultiplexerRanges: ([]*descriptor.MultiplexerRange)(nil),
+					Offset:            (float64)(0),
+					Scale:             (float64)(1),
+					Min:               (float64)(0),
+					Max:               (float64)(1),
+					Unit:              (string)(""),
+					Description:       (string)(""),
+					SignalType:        (string)(""),
+					ValueTable:        (string)(""),
+					ValueDescriptions: ([]*descriptor.ValueDescription)(nil),
+					ReceiverNodes: ([]string)([]string{
+						(string)("LOGGER"),
+					}),
+					DefaultValue:  (int)(0),
+					SendType:      (descriptor.SendType)(0),
+					InactiveValue: (int)(0),
+					Attributes:    ([]*descriptor.Attribute)(nil),
+				}),
+				(*descriptor.Signal)(&descriptor.Signal{
+					Name:              (string)("Counter"),
+					Start:             (uint8)(28),
+					Length:            (uint8)(4),
+					IsBigEndian:       (bool)(false),
+					IsSigned:          (bool)(false),
+					IsFloat:           (bool)(false),
+					IsMultiplexer:     (bool)(false),
+					IsMultiplexed:     (bool)(false),
+					MultiplexerValue:  (uint)(0),
+					MultiplexerName:   (string)(""),
+					MultiplexerRanges: ([]*descriptor.MultiplexerRange)(nil),
+					Offset:            (float64)(0),
+					Scale:             (float64)(1),
+					Min:               (float64)(0),
+					Max:               (float64)(15),
+					Unit:              (string)(""),
+					Description:       (string)(""),
+					SignalType:        (string)(""),
+					ValueTable:        (string)(""),
+					ValueDescriptions: ([]*descriptor.ValueDescription)(nil),
+					ReceiverNodes: ([]string)([]string{
+						(string)("LOGGER"),
+					}),
+					DefaultValue:  (int)(0),
+					SendType:      (descriptor.SendType)(0),
+					InactiveValue: (int)(0),
+					Attributes:    ([]*descriptor.Attribute)(nil),
+				}),
+				(*descriptor.Signal)(&descriptor.Signal{
+					Name:              (string)("ABSActive"),
+					Start:             (uint8)(32),
+					Length:            (uint8)(1),
+					IsBigEndian:       (bool)(false),
+					IsSigned:          (bool)(false),
+					IsFloat:           (bool)(false),
+					IsMultiplexer:     (bool)(false),
+					IsMultiplexed:     (bool)(false),
+					MultiplexerValue:  (uint)(0),
+					MultiplexerName:   (string)(""),
+					MultiplexerRanges: ([]*descriptor.MultiplexerRange)(nil),
+					Offset:            (float64)(0),
+					Scale:             (float64)(1),
+					Min:               (float64)(0),
+					Max:               (float64)(1),
+					Unit:              (string)(""),
+					Description:       (string)(""),
+					SignalType:        (string)(""),
+					ValueTable:        (string)(""),
+					ValueDescriptions: ([]*descriptor.ValueDescription)(nil),
+					ReceiverNodes: ([]string)([]string{
+						(string)("LOGGER"),
+					}),
+					DefaultValue:  (int)(0),
+					SendType:      (descriptor.SendType)(0),
+					InactiveValue: (int)(0),
+					Attributes:    ([]*descriptor.Attribute)(nil),
+				}),
+			}),
+			SenderNode:       (string)("ECU"),
+			TransmitterNodes: ([]string)(nil),
+			SignalGroups:     ([]*descriptor.SignalGroup)(nil),
+			CycleTime:        (time.Duration)(0),
+			CycleTimeFast:    (time.Duration)(0),
+			DelayTime:        (time.Duration)(0),
+			RepetitionCount:  (int)(0),
+			Attributes:       ([]*descriptor.Attribute)(nil),
+		}),
+		(*descriptor.Message)(&descriptor.Message{
+			Name:        (string)("Diagnostics"),
+			ID:          (uint32)(200),
+			IsExtended:  (bool)(false),
+			Length:      (uint8)(4),
+			SendType:    (descriptor.SendType)(0),
+			Description: (string)(""),
+			Signals: ([]*descriptor.Signal)([]*descriptor.Signal{
+				(*descriptor.Signal)(&descriptor.Signal{
+					Name:              (string)("Page"),
+					Start:             (uint8)(0),
+					Length:            (uint8)(4),
+					IsBigEndian:       (bool)(false),
+					IsSigned:          (bool)(false),
+					IsFloat:           (bool)(false),
+					IsMultiplexer:     (bool)(true),
+					IsMultiplexed:     (bool)(false),
+					MultiplexerValue:  (uint)(0),
+					MultiplexerName:   (string)(""),
+					MultiplexerRanges: ([]*descriptor.MultiplexerRange)(nil),
+					Offset:            (float64)(0),
+					Scale:             (float64)(1),
+					Min:               (float64)(0),
+					Max:               (float64)(15),
+					Unit:              (string)(""),
+					Description:       (string)(""),
+					SignalType:        (string)(""),
+					ValueTable:        (string)(""),
+					ValueDescriptions: ([]*descriptor.ValueDescription)(nil),
+					ReceiverNodes: ([]string)([]string{
+						(string)("LOGGER"),
+					}),
+					DefaultValue:  (int)(0),
+					SendType:      (descriptor.SendType)(0),
+					InactiveValue: (int)(0),
+					Attributes:    ([]*descriptor.Attribute)(nil),
+				}),
+				(*descriptor.Signal)(&descriptor.Signal{
+					Name:              (string)("Odometer"),
+					Start:             (uint8)(8),
+					Length:            (uint8)(24),
+					IsBigEndian:       (bool)(false),
+					IsSigned:          (bool)(false),
+					IsFloat:           (bool)(false),
+					IsMultiplexer:     (bool)(false),
+					IsMultiplexed:     (bool)(true),
+					MultiplexerValue:  (uint)(0),
+					MultiplexerName:   (string)(""),
+					MultiplexerRanges: ([]*descriptor.MultiplexerRange)(nil),
+					Offset:            (float64)(0),
+					Scale:             (float64)(0.1),
+					Min:               (float64)(0),
+					Max:               (float64)(1.6777215e+06),
+					Unit:              (string)("km"),
+					Description:       (string)("Total distance travelled."),
+					SignalType:        (string)(""),
+					ValueTable:        (string)(""),
+					ValueDescriptions: ([]*descriptor.ValueDescription)(nil),
+					ReceiverNodes: ([]string)([]string{
+						(string)("LOGGER"),
+					}),
+					DefaultValue:  (int)(0),
+					SendType:      (descriptor.SendType)(0),
+					InactiveValue: (int)(0),
+					Attributes:    ([]*descriptor.Attribute)(nil),
+				}),
+				(*descriptor.Signal)(&descriptor.Signal{
+					Name:              (string)("ErrorCode"),
+					Start:             (uint8)(8),
+					Length:            (uint8)(16),
+					IsBigEndian:       (bool)(false),
+					IsSigned:          (bool)(true),
+					IsFloat:           (bool)(false),
+					IsMultiplexer:     (bool)(false),
+					IsMultiplexed:     (bool)(true),
+					MultiplexerValue:  (uint)(1),
+					MultiplexerName:   (string)(""),
+					MultiplexerRanges: ([]*descriptor.MultiplexerRange)(nil),
+					Offset:            (float64)(0),
+					Scale:             (float64)(1),
+					Min:               (float64)(-32768),
+					Max:               (float64)(32767),
+					Unit:              (string)(""),
+					Description:       (string)(""),
+					SignalType:        (string)(""),
+					ValueTable:        (string)(""),
+					ValueDescriptions: ([]*descriptor.ValueDescription)(nil),
+					ReceiverNodes: ([]string)([]string{
+						(string)("LOGGER"),
+					}),
+					DefaultValue:  (int)(0),
+					SendType:      (descriptor.SendType)(0),
+					InactiveValue: (int)(0),
+					Attributes:    ([]*descriptor.Attribute)(nil),
+				}),
+				(*descriptor.Signal)(&descriptor.Signal{
+					Name:              (string)("DriveMode"),
+					Start:             (uint8)(24),
+					Length:            (uint8)(2),
+					IsBigEndian:       (bool)(false),
+					IsSigned:          (bool)(false),
+					IsFloat:           (bool)(false),
+					IsMultiplexer:     (bool)(false),
+					IsMultiplexed:     (bool)(true),
+					MultiplexerValue:  (uint)(1),
+					MultiplexerName:   (string)(""),
+					MultiplexerRanges: ([]*descriptor.MultiplexerRange)(nil),
+					Offset:            (float64)(0),
+					Scale:             (float64)(1),
+					Min:               (float64)(0),
+					Max:               (float64)(3),
+					Unit:              (string)(""),
+					Description:       (string)(""),
+					SignalType:        (string)(""),
+					ValueTable:        (string)(""),
+					ValueDescriptions: ([]*descriptor.ValueDescription)([]*descriptor.ValueDescription{
+						(*descriptor.ValueDescription)(&descriptor.ValueDescription{
+							Value:       (int64)(1),
+							Description: (string)("Eco"),
+						}),
+						(*descriptor.ValueDescription)(&descriptor.ValueDescription{
+							Value:       (int64)(2),
+							Description: (string)("Sport"),
+						}),
+					}),
+					ReceiverNodes: ([]string)([]string{
+						(string)("LOGGER"),
+					}),
+					DefaultValue:  (int)(0),
+					SendType:      (descriptor.SendType)(0),
+					InactiveValue: (int)(0),
+					Attributes:    ([]*descriptor.Attribute)(nil),
+				}),
+			}),
+			SenderNode:       (string)("ECU"),
+			TransmitterNodes: ([]string)(nil),
+			SignalGroups:     ([]*descriptor.SignalGroup)(nil),
+			CycleTime:        (time.Duration)(0),
+			CycleTimeFast:    (time.Duration)(0),
+			DelayTime:        (time.Duration)(0),
+			RepetitionCount:  (int)(0),
+			Attributes:       ([]*descriptor.Attribute)(nil),
+		}),
+	}),
+	Nodes: ([]*descriptor.Node)([]*descriptor.Node{
+		(*descriptor.Node)(&descriptor.Node{
+			Name:        (string)("ECU"),
+			Description: (string)(""),
+			Attributes:  ([]*descriptor.Attribute)(nil),
+		}),
+		(*descriptor.Node)(&descriptor.Node{
+			Name:        (string)("LOGGER"),
+			Description: (string)(""),
+			Attributes:  ([]*descriptor.Attribute)(nil),
+		}),
+	}),
+	ValueTables:          ([]*descriptor.ValueTable)(nil),
+	EnvironmentVariables: ([]*descriptor.EnvironmentVariable)(nil),
+	Attributes:           ([]*descriptor.Attribute)(nil),
+})
@@ -0,0 +1,79 @@
+package telemetrycan
+
+import (
+	"fmt"
+
+	"go.einride.tech/can/pkg/generated"
+	telemetrycanpb "go.einride.tech/can/testdata/gen/proto/telemetrycanpb"
+	"google.golang.org/protobuf/proto"
+)
+
+// Generated code. DO NOT EDIT.
+
+// EngineStatusToProto converts a EngineStatus message to its protobuf message.
+func EngineStatusToProto(m EngineStatusReader) *telemetrycanpb.EngineStatus {
+	return &telemetrycanpb.EngineStatus{
+		Speed:       m.Speed(),
+		Temperature: m.Temperature(),
+		Gear:        telemetrycanpb.EngineStatus_Gear(m.Gear()),
+		IsRunning:   m.IsRunning(),
+		Counter:     uint32(m.Counter()),
+		AbsActive:   m.ABSActive(),
+	}
+}
+
+// EngineStatusFromProto converts a protobuf message to a EngineStatus message.
+func EngineStatusFromProto(p *telemetrycanpb.EngineStatus) *EngineStatus {
+	m := NewEngineStatus()
+	m.SetSpeed(p.GetSpeed())
+	m.SetTemperature(p.GetTemperature())
+	m.SetGear(EngineStatus_Gear(p.GetGear()))
+	m.SetIsRunning(p.GetIsRunning())
+	m.SetCounter(uint8(p.GetCounter()))
+	m.SetABSActive(p.GetAbsActive())
+	return m
+}
+
+// DiagnosticsToProto converts a Diagnostics message to its protobuf message.
+func DiagnosticsToProto(m DiagnosticsReader) *telemetrycanpb.Diagnostics {
+	return &telemetrycanpb.Diagnostics{
+		Page:      uint32(m.Page()),
+		Odometer:  m.Odometer(),
+		ErrorCode: int32(m.ErrorCode()),
+		DriveMode: telemetrycanpb.Diagnostics_DriveMode(m.DriveMode()),
+	}
+}
+
+// DiagnosticsFromProto converts a protobuf message to a Diagnostics message.
+func DiagnosticsFromProto(p *telemetrycanpb.Diagnostics) *Diagnostics {
+	m := NewDiagnostics()
+	m.SetPage(uint8(p.GetPage()))
+	m.SetOdometer(p.GetOdometer())
+	m.SetErrorCode(int16(p.GetErrorCode()))
+	m.SetDriveMode(Diagnostics_DriveMode(p.GetDriveMode()))
+	return m
+}
+
+// MessageToProto converts a telemetry message to its protobuf message.
+func MessageToProto(msg generated.Message) (proto.Message, error) {
+	switch msg := msg.(type) {
+	case *EngineStatus:
+		return EngineStatusToProto(msg), nil
+	case *Diagnostics:
+		return DiagnosticsToProto(msg), nil
+	default:
+		return nil, fmt.Errorf("message to proto: unsupported message: %T", msg)
+	}
+}
+
+// MessageFromProto converts a protobuf message to its telemetry message.
+func MessageFromProto(p proto.Message) (generated.Message, error) {
+	switch p := p.(type) {
+	case *telemetrycanpb.EngineStatus:
+		return EngineStatusFromProto(p), nil
+	case *telemetrycanpb.Diagnostics:
+		return DiagnosticsFromProto(p), nil
+	default:
+		return nil, fmt.Errorf("message from proto: unsupported message: %T", p)
+	}
+}
@@ -0,0 +1,1391 @@
+// Package unitscan provides primitives for encoding and decoding units CAN messages.
+//
+// Source: testdata/units/units.dbc
+package unitscan
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"go.einride.tech/can"
+	"go.einride.tech/can/pkg/candebug"
+	"go.einride.tech/can/pkg/canrunner"
+	"go.einride.tech/can/pkg/cantext"
+	"go.einride.tech/can/pkg/canunit"
+	"go.einride.tech/can/pkg/descriptor"
+	"go.einride.tech/can/pkg/generated"
+	"go.einride.tech/can/pkg/socketcan"
+)
+
+// prevent unused imports
+var (
+	_ = context.Background
+	_ = fmt.Print
+	_ = math.Float32frombits
+	_ = net.Dial
+	_ = http.Error
+	_ = sync.Mutex{}
+	_ = time.Now
+	_ = socketcan.Dial
+	_ = candebug.ServeMessagesHTTP
+	_ = canrunner.Run
+	_ = canunit.Lookup
+)
+
+// Generated code. DO NOT EDIT.
+// VehicleStatusReader provides read access to a VehicleStatus message.
+type VehicleStatusReader interface {
+	can.FrameMarshaler
+	// Speed returns the physical value of the Speed signal.
+	Speed() canunit.KilometersPerHour
+	// RawSpeed returns the raw (encoded) value of the Speed signal.
+	RawSpeed() uint16
+	// WheelSpeed returns the physical value of the WheelSpeed signal.
+	WheelSpeed() canunit.MetersPerSecond
+	// RawWheelSpeed returns the raw (encoded) value of the WheelSpeed signal.
+	RawWheelSpeed() uint16
+	// EngineSpeed returns the physical value of the EngineSpeed signal.
+	EngineSpeed() canunit.RevolutionsPerMinute
+	// RawEngineSpeed returns the raw (encoded) value of the EngineSpeed signal.
+	RawEngineSpeed() uint16
+	// CoolantTemperature returns the physical value of the CoolantTemperature signal.
+	CoolantTemperature() canunit.DegreesCelsius
+	// RawCoolantTemperature returns the raw (encoded) value of the CoolantTemperature signal.
+	RawCoolantTemperature() uint8
+	// FuelLevel returns the physical value of the FuelLevel signal.
+	FuelLevel() canunit.Percent
+	// RawFuelLevel returns the raw (encoded) value of the FuelLevel signal.
+	RawFuelLevel() uint8
+}
+
+// VehicleStatusWriter provides write access to a VehicleStatus message.
+type VehicleStatusWriter interface {
+	// CopyFrom copies all values from VehicleStatus.
+	CopyFrom(VehicleStatusReader) *VehicleStatus
+	// SetSpeed sets the physical value of the Speed signal.
+	SetSpeed(canunit.KilometersPerHour) *VehicleStatus
+	// SetRawSpeed sets the raw (encoded) value of the Speed signal.
+	SetRawSpeed(uint16) *VehicleStatus
+	// SetWheelSpeed sets the physical value of the WheelSpeed signal.
+	SetWheelSpeed(canunit.MetersPerSecond) *VehicleStatus
+	// SetRawWheelSpeed sets the raw (encoded) value of the WheelSpeed signal.
+	SetRawWheelSpeed(uint16) *VehicleStatus
+	// SetEngineSpeed sets the physical value of the EngineSpeed signal.
+	SetEngineSpeed(canunit.RevolutionsPerMinute) *VehicleStatus
+	// SetRawEngineSpeed sets the raw (encoded) value of the EngineSpeed signal.
+	SetRawEngineSpeed(uint16) *VehicleStatus
+	// SetCoolantTemperature sets the physical value of the CoolantTemperature signal.
+	SetCoolantTemperature(canunit.DegreesCelsius) *VehicleStatus
+	// SetRawCoolantTemperature sets the raw (encoded) value of the CoolantTemperature signal.
+	SetRawCoolantTemperature(uint8) *VehicleStatus
+	// SetFuelLevel sets the physical value of the FuelLevel signal.
+	SetFuelLevel(canunit.Percent) *VehicleStatus
+	// SetRawFuelLevel sets the raw (encoded) value of the FuelLevel signal.
+	SetRawFuelLevel(uint8) *VehicleStatus
+}
+
+type VehicleStatus struct {
+	xxx_Speed              uint16
+	xxx_WheelSpeed         uint16
+	xxx_EngineSpeed        uint16
+	xxx_CoolantTemperature uint8
+	xxx_FuelLevel          uint8
+}
+
+func NewVehicleStatus() *VehicleStatus {
+	m := &VehicleStatus{}
+	m.Reset()
+	return m
+}
+
+func (m *VehicleStatus) Reset() {
+	m.xxx_Speed = 0
+	m.xxx_WheelSpeed = 0
+	m.xxx_EngineSpeed = 0
+	m.xxx_CoolantTemperature = 0
+	m.xxx_FuelLevel = 0
+}
+
+func (m *VehicleStatus) CopyFrom(o VehicleStatusReader) *VehicleStatus {
+	f, _ := o.MarshalFrame()
+	_ = m.UnmarshalFrame(f)
+	return m
+}
+
+// Descriptor returns the VehicleStatus descriptor.
+func (m *VehicleStatus) Descriptor() *descriptor.Message {
+	return Messages().VehicleStatus.Message
+}
+
+// String returns a compact string representation of the message.
+func (m *VehicleStatus) String() string {
+	return cantext.MessageString(m)
+}
+
+func (m *VehicleStatus) Speed() canunit.KilometersPerHour {
+	return canunit.KilometersPerHour(Messages().VehicleStatus.Speed.ToPhysical(float64(m.xxx_Speed)))
+}
+
+func (m *VehicleStatus) SetSpeed(v canunit.KilometersPerHour) *VehicleStatus {
+	m.xxx_Speed = uint16(Messages().VehicleStatus.Speed.FromPhysical(float64(v)))
+	return m
+}
+
+func (m *VehicleStatus) RawSpeed() uint16 {
+	return m.xxx_Speed
+}
+
+func (m *VehicleStatus) SetRawSpeed(v uint16) *VehicleStatus {
+	m.xxx_Speed = uint16(Messages().VehicleStatus.Speed.SaturatedCastUnsigned(uint64(v)))
+	return m
+}
+
+func (m *VehicleStatus) WheelSpeed() canunit.MetersPerSecond {
+	return canunit.MetersPerSecond(Messages().VehicleStatus.WheelSpeed.ToPhysical(float64(m.xxx_WheelSpeed)))
+}
+
+func (m *VehicleStatus) SetWheelSpeed(v canunit.MetersPerSecond) *VehicleStatus {
+	m.xxx_WheelSpeed = uint16(Messages().VehicleStatus.WheelSpeed.FromPhysical(float64(v)))
+	return m
+}
+
+func (m *VehicleStatus) RawWheelSpeed() uint16 {
+	return m.xxx_WheelSpeed
+}
+
+func (m *VehicleStatus) SetRawWheelSpeed(v uint16) *VehicleStatus {
+	m.xxx_WheelSpeed = uint16(Messages().VehicleStatus.WheelSpeed.SaturatedCastUnsigned(uint64(v)))
+	return m
+}
+
+func (m *VehicleStatus) EngineSpeed() canunit.RevolutionsPerMinute {
+	return canunit.RevolutionsPerMinute(Messages().VehicleStatus.EngineSpeed.ToPhysical(float64(m.xxx_EngineSpeed)))
+}
+
+func (m *VehicleStatus) SetEngineSpeed(v canunit.RevolutionsPerMinute) *VehicleStatus {
+	m.xxx_EngineSpeed = uint16(Messages().VehicleStatus.EngineSpeed.FromPhysical(float64(v)))
+	return m
+}
+
+func (m *VehicleStatus) RawEngineSpeed() uint16 {
+	return m.xxx_EngineSpeed
+}
+
+func (m *VehicleStatus) SetRawEngineSpeed(v uint16) *VehicleStatus {
+	m.xxx_EngineSpeed = uint16(Messages().VehicleStatus.EngineSpeed.SaturatedCastUnsigned(uint64(v)))
+	return m
+}
+
+func (m *VehicleStatus) CoolantTemperature() canunit.DegreesCelsius {
+	return canunit.DegreesCelsius(Messages().VehicleStatus.CoolantTemperature.ToPhysical(float64(m.xxx_CoolantTemperature)))
+}
+
+func (m *VehicleStatus) SetCoolantTemperature(v canunit.DegreesCelsius) *VehicleStatus {
+	m.xxx_CoolantTemperature = uint8(Messages().VehicleStatus.CoolantTemperature.FromPhysical(float64(v)))
+	return m
+}
+
+func (m *VehicleStatus) RawCoolantTemperature() uint8 {
+	return m.xxx_CoolantTemperature
+}
+
+func (m *VehicleStatus) SetRawCoolantTemperature(v uint8) *VehicleStatus {
+	m.xxx_CoolantTemperature = uint8(Messages().VehicleStatus.CoolantTemperature.SaturatedCastUnsigned(uint64(v)))
+	return m
+}
+
+func (m *VehicleStatus) FuelLevel() canunit.Percent {
+	return canunit.Percent(Messages().VehicleStatus.FuelLevel.ToPhysical(float64(m.xxx_FuelLevel)))
+}
+
+func (m *VehicleStatus) SetFuelLevel(v canunit.Percent) *VehicleStatus {
+	m.xxx_FuelLevel = uint8(Messages().VehicleStatus.FuelLevel.FromPhysical(float64(v)))
+	return m
+}
+
+func (m *VehicleStatus) RawFuelLevel() uint8 {
+	return m.xxx_FuelLevel
+}
+
+func (m *VehicleStatus) SetRawFuelLevel(v uint8) *VehicleStatus {
+	m.xxx_FuelLevel = uint8(Messages().VehicleStatus.FuelLevel.SaturatedCastUnsigned(uint64(v)))
+	return m
+}
+
+// Frame returns a CAN frame representing the message.
+func (m *VehicleStatus) Frame() can.Frame {
+	md := Messages().VehicleStatus
+	f := can.Frame{ID: md.ID, IsExtended: md.IsExtended, Length: md.Length}
+	md.Speed.MarshalUnsigned(&f.Data, uint64(m.xxx_Speed))
+	md.WheelSpeed.MarshalUnsigned(&f.Data, uint64(m.xxx_WheelSpeed))
+	md.EngineSpeed.MarshalUnsigned(&f.Data, uint64(m.xxx_EngineSpeed))
+	md.CoolantTemperature.MarshalUnsigned(&f.Data, uint64(m.xxx_CoolantTemperature))
+	md.FuelLevel.MarshalUnsigned(&f.Data, uint64(m.xxx_FuelLevel))
+	return f
+}
+
+// MarshalFrame encodes the message as a CAN frame.
+func (m *VehicleStatus) MarshalFrame() (can.Frame, error) {
+	return m.Frame(), nil
+}
+
+// UnmarshalFrame decodes the message from a CAN frame.
+func (m *VehicleStatus) UnmarshalFrame(f can.Frame) error {
+	md := Messages().VehicleStatus
+	switch {
+	case f.ID != md.ID:
+		return fmt.Errorf(
+			"unmarshal VehicleStatus: expects ID 100 (got %s with ID %d)", f.String(), f.ID,
+		)
+	case f.Length != md.Length:
+		return fmt.Errorf(
+			"unmarshal VehicleStatus: expects length 8 (got %s with length %d)", f.String(), f.Length,
+		)
+	case f.IsRemote:
+		return fmt.Errorf(
+			"unmarshal VehicleStatus: expects non-remote frame (got remote frame %s)", f.String(),
+		)
+	case f.IsExtended != md.IsExtended:
+		return fmt.Errorf(
+			"unmarshal VehicleStatus: expects standard ID (got %s with extended ID)", f.String(),
+		)
+	}
+	m.xxx_Speed = uint16(md.Speed.UnmarshalUnsigned(f.Data))
+	m.xxx_WheelSpeed = uint16(md.WheelSpeed.UnmarshalUnsigned(f.Data))
+	m.xxx_EngineSpeed = uint16(md.EngineSpeed.UnmarshalUnsigned(f.Data))
+	m.xxx_CoolantTemperature = uint8(md.CoolantTemperature.UnmarshalUnsigned(f.Data))
+	m.xxx_FuelLevel = uint8(md.FuelLevel.UnmarshalUnsigned(f.Data))
+	return nil
+}
+
+// DecodeFrame decodes the message from a CAN frame, with the bit layout of each signal resolved at generation
+// time.
+//
+// DecodeFrame decodes the same values as UnmarshalFrame, without allocating unless the frame is invalid, for
+// decoding frames at high rates into reused messages.
+func (m *VehicleStatus) DecodeFrame(f *can.Frame) error {
+	switch {
+	case f.ID != 100:
+		return fmt.Errorf(
+			"decode VehicleStatus: expects ID 100 (got %s with ID %d)", f.String(), f.ID,
+		)
+	case f.Length != 8:
+		return fmt.Errorf(
+			"decode VehicleStatus: expects length 8 (got %s with length %d)", f.String(), f.Length,
+		)
+	case f.IsRemote:
+		return fmt.Errorf(
+			"decode VehicleStatus: expects non-remote frame (got remote frame %s)", f.String(),
+		)
+	case f.IsExtended:
+		return fmt.Errorf(
+			"decode VehicleStatus: expects standard ID (got %s with extended ID)", f.String(),
+		)
+	}
+	le := f.Data.PackLittleEndian()
+	m.xxx_Speed = uint16(le & 0xffff)
+	m.xxx_WheelSpeed = uint16((le >> 16) & 0xffff)
+	m.xxx_EngineSpeed = uint16((le >> 32) & 0xffff)
+	m.xxx_CoolantTemperature = uint8((le >> 48) & 0xff)
+	m.xxx_FuelLevel = uint8((le >> 56) & 0xff)
+	return nil
+}
+
+// LightStatusReader provides read access to a LightStatus message.
+type LightStatusReader interface {
+	can.FrameMarshaler
+	// Illuminance returns the physical value of the Illuminance signal.
+	Illuminance() float64
+	// RawIlluminance returns the raw (encoded) value of the Illuminance signal.
+	RawIlluminance() uint16
+	// Counter returns the value of the Counter signal.
+	Counter() uint8
+}
+
+// LightStatusWriter provides write access to a LightStatus message.
+type LightStatusWriter interface {
+	// CopyFrom copies all values from LightStatus.
+	CopyFrom(LightStatusReader) *LightStatus
+	// SetIlluminance sets the physical value of the Illuminance signal.
+	SetIlluminance(float64) *LightStatus
+	// SetRawIlluminance sets the raw (encoded) value of the Illuminance signal.
+	SetRawIlluminance(uint16) *LightStatus
+	// SetCounter sets the value of the Counter signal.
+	SetCounter(uint8) *LightStatus
+}
+
+type LightStatus struct {
+	xxx_Illuminance uint16
+	xxx_Counter     uint8
+}
+
+func NewLightStatus() *LightStatus {
+	m := &LightStatus{}
+	m.Reset()
+	return m
+}
+
+func (m *LightStatus) Reset() {
+	m.xxx_Illuminance = 0
+	m.xxx_Counter = 0
+}
+
+func (m *LightStatus) CopyFrom(o LightStatusReader) *LightStatus {
+	f, _ := o.MarshalFrame()
+	_ = m.UnmarshalFrame(f)
+	return m
+}
+
+// Descriptor returns the LightStatus descriptor.
+func (m *LightStatus) Descriptor() *descriptor.Message {
+	return Messages().LightStatus.Message
+}
+
+// String returns a compact string representation of the message.
+func (m *LightStatus) String() string {
+	return cantext.MessageString(m)
+}
+
+func (m *LightStatus) Illuminance() float64 {
+	return Messages().LightStatus.Illuminance.ToPhysical(float64(m.xxx_Illuminance))
+}
+
+func (m *LightStatus) SetIlluminance(v float64) *LightStatus {
+	m.xxx_Illuminance = uint16(Messages().LightStatus.Illuminance.FromPhysical(v))
+	return m
+}
+
+func (m *LightStatus) RawIlluminance() uint16 {
+	return m.xxx_Illuminance
+}
+
+func (m *LightStatus) SetRawIlluminance(v uint16) *LightStatus {
+	m.xxx_Illuminance = uint16(Messages().LightStatus.Illuminance.SaturatedCastUnsigned(uint64(v)))
+	return m
+}
+
+func (m *LightStatus) Counter() uint8 {
+	return m.xxx_Counter
+}
+
+func (m *LightStatus) SetCounter(v uint8) *LightStatus {
+	m.xxx_Counter = uint8(Messages().LightStatus.Counter.SaturatedCastUnsigned(uint64(v)))
+	return m
+}
+
+// Frame returns a CAN frame representing the message.
+func (m *LightStatus) Frame() can.Frame {
+	md := Messages().LightStatus
+	f := can.Frame{ID: md.ID, IsExtended: md.IsExtended, Length: md.Length}
+	md.Illuminance.MarshalUnsigned(&f.Data, uint64(m.xxx_Illuminance))
+	md.Counter.MarshalUnsigned(&f.Data, uint64(m.xxx_Counter))
+	return f
+}
+
+// MarshalFrame encodes the message as a CAN frame.
+func (m *LightStatus) MarshalFrame() (can.Frame, error) {
+	return m.Frame(), nil
+}
+
+// UnmarshalFrame decodes the message from a CAN frame.
+func (m *LightStatus) UnmarshalFrame(f can.Frame) error {
+	md := Messages().LightStatus
+	switch {
+	case f.ID != md.ID:
+		return fmt.Errorf(
+			"unmarshal LightStatus: expects ID 200 (got %s with ID %d)", f.String(), f.ID,
+		)
+	case f.Length != md.Length:
+		return fmt.Errorf(
+			"unmarshal LightStatus: expects length 2 (got %s with length %d)", f.String(), f.Length,
+		)
+	case f.IsRemote:
+		return fmt.Errorf(
+			"unmarshal LightStatus: expects non-remote frame (got remote frame %s)", f.String(),
+		)
+	case f.IsExtended != md.IsExtended:
+		return fmt.Errorf(
+			"unmarshal LightStatus: expects standard ID (got %s with extended ID)", f.String(),
+		)
+	}
+	m.xxx_Illuminance = uint16(md.Illuminance.UnmarshalUnsigned(f.Data))
+	m.xxx_Counter = uint8(md.Counter.UnmarshalUnsigned(f.Data))
+	return nil
+}
+
+// DecodeFrame decodes the message from a CAN frame, with the bit layout of each signal resolved at generation
+// time.
+//
+// DecodeFrame decodes the same values as UnmarshalFrame, without allocating unless the frame is invalid, for
+// decoding frames at high rates into reused messages.
+func (m *LightStatus) DecodeFrame(f *can.Frame) error {
+	switch {
+	case f.ID != 200:
+		return fmt.Errorf(
+			"decode LightStatus: expects ID 200 (got %s with ID %d)", f.String(), f.ID,
+		)
+	case f.Length != 2:
+		return fmt.Errorf(
+			"decode LightStatus: expects length 2 (got %s with length %d)", f.String(), f.Length,
+		)
+	case f.IsRemote:
+		return fmt.Errorf(
+			"decode LightStatus: expects non-remote frame (got remote frame %s)", f.String(),
+		)
+	case f.IsExtended:
+		return fmt.Errorf(
+			"decode LightStatus: expects standard ID (got %s with extended ID)", f.String(),
+		)
+	}
+	le := f.Data.PackLittleEndian()
+	m.xxx_Illuminance = uint16(le & 0xffff)
+	m.xxx_Counter = uint8((le >> 16) & 0xf)
+	return nil
+}
+
+type DASH interface {
+	sync.Locker
+	Tx() DASH_Tx
+	Rx() DASH_Rx
+	Run(ctx context.Context) error
+}
+
+type DASH_Rx interface {
+	http.Handler // for debugging
+	VehicleStatus() DASH_Rx_VehicleStatus
+	LightStatus() DASH_Rx_LightStatus
+}
+
+type DASH_Tx interface {
+	http.Handler // for debugging
+}
+
+type DASH_Rx_VehicleStatus interface {
+	VehicleStatusReader
+	ReceiveTime() time.Time
+	SetAfterReceiveHook(h func(context.Context) error)
+	// SubscribeSpeed calls the hook when the trigger fires for the Speed signal.
+	SubscribeSpeed(trigger canrunner.SignalTrigger, h func(ctx context.Context, prev, curr VehicleStatusReader) error)
+	// SubscribeWheelSpeed calls the hook when the trigger fires for the WheelSpeed signal.
+	SubscribeWheelSpeed(trigger canrunner.SignalTrigger, h func(ctx context.Context, prev, curr VehicleStatusReader) error)
+	// SubscribeEngineSpeed calls the hook when the trigger fires for the EngineSpeed signal.
+	SubscribeEngineSpeed(trigger canrunner.SignalTrigger, h func(ctx context.Context, prev, curr VehicleStatusReader) error)
+	// SubscribeCoolantTemperature calls the hook when the trigger fires for the CoolantTemperature signal.
+	SubscribeCoolantTemperature(trigger canrunner.SignalTrigger, h func(ctx context.Context, prev, curr VehicleStatusReader) error)
+	// SubscribeFuelLevel calls the hook when the trigger fires for the FuelLevel signal.
+	SubscribeFuelLevel(trigger canrunner.SignalTrigger, h func(ctx context.Context, prev, curr VehicleStatusReader) error)
+}
+
+type DASH_Rx_LightStatus interface {
+	LightStatusReader
+	ReceiveTime() time.Time
+	SetAfterReceiveHook(h func(context.Context) error)
+	// SubscribeIlluminance calls the hook when the trigger fires for the Illuminance signal.
+	SubscribeIlluminance(trigger canrunner.SignalTrigger, h func(ctx context.Context, prev, curr LightStatusReader) error)
+	// SubscribeCounter calls the hook when the trigger fires for the Counter signal.
+	SubscribeCounter(trigger canrunner.SignalTrigger, h func(ctx context.Context, prev, curr LightStatusReader) error)
+}
+
+type xxx_DASH struct {
+	sync.Mutex // protects all node state
+	network    string
+	address    string
+	rx         xxx_DASH_Rx
+	tx         xxx_DASH_Tx
+}
+
+var _ DASH = &xxx_DASH{}
+var _ canrunner.Node = &xxx_DASH{}
+
+func NewDASH(network, address string) DASH {
+	n := &xxx_DASH{network: network, address: address}
+	n.rx.xxx_VehicleStatus.init()
+	n.rx.xxx_VehicleStatus.Reset()
+	n.rx.xxx_LightStatus.init()
+	n.rx.xxx_LightStatus.Reset()
+	n.rx.debugHandler = candebug.NewHandler(&n.Mutex, []generated.Message{
+		&n.rx.xxx_VehicleStatus,
+		&n.rx.xxx_LightStatus,
+	})
+	n.tx.debugHandler = candebug.NewHandler(&n.Mutex, []generated.Message{})
+	return n
+}
+
+func (n *xxx_DASH) Run(ctx context.Context) error {
+	return canrunner.Run(ctx, n)
+}
+
+func (n *xxx_DASH) Rx() DASH_Rx {
+	return &n.rx
+}
+
+func (n *xxx_DASH) Tx() DASH_Tx {
+	return &n.tx
+}
+
+type xxx_DASH_Rx struct {
+	debugHandler      *candebug.Handler
+	xxx_VehicleStatus xxx_DASH_Rx_VehicleStatus
+	xxx_LightStatus   xxx_DASH_Rx_LightStatus
+}
+
+var _ DASH_Rx = &xxx_DASH_Rx{}
+
+func (rx *xxx_DASH_Rx) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	rx.debugHandler.ServeHTTP(w, r)
+}
+
+func (rx *xxx_DASH_Rx) VehicleStatus() DASH_Rx_VehicleStatus {
+	return &rx.xxx_VehicleStatus
+}
+
+func (rx *xxx_DASH_Rx) LightStatus() DASH_Rx_LightStatus {
+	return &rx.xxx_LightStatus
+}
+
+type xxx_DASH_Tx struct {
+	debugHandler *candebug.Handler
+}
+
+var _ DASH_Tx = &xxx_DASH_Tx{}
+
+func (tx *xxx_DASH_Tx) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	tx.debugHandler.ServeHTTP(w, r)
+}
+
+func (n *xxx_DASH) Descriptor() *descriptor.Node {
+	return Nodes().DASH
+}
+
+func (n *xxx_DASH) Connect() (net.Conn, error) {
+	return socketcan.Dial(n.network, n.address)
+}
+
+func (n *xxx_DASH) ReceivedMessage(id uint32) (canrunner.ReceivedMessage, bool) {
+	switch id {
+	case 100:
+		return &n.rx.xxx_VehicleStatus, true
+	case 200:
+		return &n.rx.xxx_LightStatus, true
+	default:
+		return nil, false
+	}
+}
+
+func (n *xxx_DASH) TransmittedMessages() []canrunner.TransmittedMessage {
+	return []canrunner.TransmittedMessage{}
+}
+
+type xxx_DASH_Rx_VehicleStatus struct {
+	VehicleStatus
+	receiveTime         time.Time
+	afterReceiveHook    func(context.Context) error
+	signalSubscriptions canrunner.SignalSubscriptions
+}
+
+func (m *xxx_DASH_Rx_VehicleStatus) init() {
+	m.afterReceiveHook = func(context.Context) error { return nil }
+}
+
+func (m *xxx_DASH_Rx_VehicleStatus) SetAfterReceiveHook(h func(context.Context) error) {
+	m.afterReceiveHook = h
+}
+
+func (m *xxx_DASH_Rx_VehicleStatus) AfterReceiveHook() func(context.Context) error {
+	return m.afterReceiveHook
+}
+
+func (m *xxx_DASH_Rx_VehicleStatus) ReceiveTime() time.Time {
+	return m.receiveTime
+}
+
+func (m *xxx_DASH_Rx_VehicleStatus) SetReceiveTime(t time.Time) {
+	m.receiveTime = t
+}
+
+func (m *xxx_DASH_Rx_VehicleStatus) SignalSubscriptions() *canrunner.SignalSubscriptions {
+	return &m.signalSubscriptions
+}
+
+func (m *xxx_DASH_Rx_VehicleStatus) SubscribeSpeed(
+	trigger canrunner.SignalTrigger,
+	h func(ctx context.Context, prev, curr VehicleStatusReader) error,
+) {
+	m.signalSubscriptions.Subscribe(
+		Messages().VehicleStatus.Speed,
+		trigger,
+		func(ctx context.Context, prevFrame, currFrame can.Frame) error {
+			var prev, curr VehicleStatus
+			if err := prev.UnmarshalFrame(prevFrame); err != nil {
+				return err
+			}
+			if err := curr.UnmarshalFrame(currFrame); err != nil {
+				return err
+			}
+			return h(ctx, &prev, &curr)
+		},
+	)
+}
+
+func (m *xxx_DASH_Rx_VehicleStatus) SubscribeWheelSpeed(
+	trigger canrunner.SignalTrigger,
+	h func(ctx context.Context, prev, curr VehicleStatusReader) error,
+) {
+	m.signalSubscriptions.Subscribe(
+		Messages().VehicleStatus.WheelSpeed,
+		trigger,
+		func(ctx context.Context, prevFrame, currFrame can.Frame) error {
+			var prev, curr VehicleStatus
+			if err := prev.UnmarshalFrame(prevFrame); err != nil {
+				return err
+			}
+			if err := curr.UnmarshalFrame(currFrame); err != nil {
+				return err
+			}
+			return h(ctx, &prev, &curr)
+		},
+	)
+}
+
+func (m *xxx_DASH_Rx_VehicleStatus) SubscribeEngineSpeed(
+	trigger canrunner.SignalTrigger,
+	h func(ctx context.Context, prev, curr VehicleStatusReader) error,
+) {
+	m.signalSubscriptions.Subscribe(
+		Messages().VehicleStatus.EngineSpeed,
+		trigger,
+		func(ctx context.Context, prevFrame, currFrame can.Frame) error {
+			var prev, curr VehicleStatus
+			if err := prev.UnmarshalFrame(prevFrame); err != nil {
+				return err
+			}
+			if err := curr.UnmarshalFrame(currFrame); err != nil {
+				return err
+			}
+			return h(ctx, &prev, &curr)
+		},
+	)
+}
+
+func (m *xxx_DASH_Rx_VehicleStatus) SubscribeCoolantTemperature(
+	trigger canrunner.SignalTrigger,
+	h func(ctx context.Context, prev, curr VehicleStatusReader) error,
+) {
+	m.signalSubscriptions.Subscribe(
+		Messages().VehicleStatus.CoolantTemperature,
+		trigger,
+		func(ctx context.Context, prevFrame, currFrame can.Frame) error {
+			var prev, curr VehicleStatus
+			if err := prev.UnmarshalFrame(prevFrame); err != nil {
+				return err
+			}
+			if err := curr.UnmarshalFrame(currFrame); err != nil {
+				return err
+			}
+			return h(ctx, &prev, &curr)
+		},
+	)
+}
+
+func (m *xxx_DASH_Rx_VehicleStatus) SubscribeFuelLevel(
+	trigger canrunner.SignalTrigger,
+	h func(ctx context.Context, prev, curr VehicleStatusReader) error,
+) {
+	m.signalSubscriptions.Subscribe(
+		Messages().VehicleStatus.FuelLevel,
+		trigger,
+		func(ctx context.Context, prevFrame, currFrame can.Frame) error {
+			var prev, curr VehicleStatus
+			if err := prev.UnmarshalFrame(prevFrame); err != nil {
+				return err
+			}
+			if err := curr.UnmarshalFrame(currFrame); err != nil {
+				return err
+			}
+			return h(ctx, &prev, &curr)
+		},
+	)
+}
+
+var _ canrunner.ReceivedMessage = &xxx_DASH_Rx_VehicleStatus{}
+
+var _ canrunner.SignalSubscriber = &xxx_DASH_Rx_VehicleStatus{}
+
+type xxx_DASH_Rx_LightStatus struct {
+	LightStatus
+	receiveTime         time.Time
+	afterReceiveHook    func(context.Context) error
+	signalSubscriptions canrunner.SignalSubscriptions
+}
+
+func (m *xxx_DASH_Rx_LightStatus) init() {
+	m.afterReceiveHook = func(context.Context) error { return nil }
+}
+
+func (m *xxx_DASH_Rx_LightStatus) SetAfterReceiveHook(h func(context.Context) error) {
+	m.afterReceiveHook = h
+}
+
+func (m *xxx_DASH_Rx_LightStatus) AfterReceiveHook() func(context.Context) error {
+	return m.afterReceiveHook
+}
+
+func (m *xxx_DASH_Rx_LightStatus) ReceiveTime() time.Time {
+	return m.receiveTime
+}
+
+func (m *xxx_DASH_Rx_LightStatus) SetReceiveTime(t time.Time) {
+	m.receiveTime = t
+}
+
+func (m *xxx_DASH_Rx_LightStatus) SignalSubscriptions() *canrunner.SignalSubscriptions {
+	return &m.signalSubscriptions
+}
+
+func (m *xxx_DASH_Rx_LightStatus) SubscribeIlluminance(
+	trigger canrunner.SignalTrigger,
+	h func(ctx context.Context, prev, curr LightStatusReader) error,
+) {
+	m.signalSubscriptions.Subscribe(
+		Messages().LightStatus.Illuminance,
+		trigger,
+		func(ctx context.Context, prevFrame, currFrame can.Frame) error {
+			var prev, curr LightStatus
+			if err := prev.UnmarshalFrame(prevFrame); err != nil {
+				return err
+			}
+			if err := curr.UnmarshalFrame(currFrame); err != nil {
+				return err
+			}
+			return h(ctx, &prev, &curr)
+		},
+	)
+}
+
+func (m *xxx_DASH_Rx_LightStatus) SubscribeCounter(
+	trigger canrunner.SignalTrigger,
+	h func(ctx context.Context, prev, curr LightStatusReader) error,
+) {
+	m.signalSubscriptions.Subscribe(
+		Messages().LightStatus.Counter,
+		trigger,
+		func(ctx context.Context, prevFrame, currFrame can.Frame) error {
+			var prev, curr LightStatus
+			if err := prev.UnmarshalFrame(prevFrame); err != nil {
+				return err
+			}
+			if err := curr.UnmarshalFrame(currFrame); err != nil {
+				return err
+			}
+			return h(ctx, &prev, &curr)
+		},
+	)
+}
+
+var _ canrunner.ReceivedMessage = &xxx_DASH_Rx_LightStatus{}
+
+var _ canrunner.SignalSubscriber = &xxx_DASH_Rx_LightStatus{}
+
+type ECU interface {
+	sync.Locker
+	Tx() ECU_Tx
+	Rx() ECU_Rx
+	Run(ctx context.Context) error
+}
+
+type ECU_Rx interface {
+	http.Handler // for debugging
+}
+
+type ECU_Tx interface {
+	http.Handler // for debugging
+	VehicleStatus() ECU_Tx_VehicleStatus
+}
+
+type ECU_Tx_VehicleStatus interface {
+	VehicleStatusReader
+	VehicleStatusWriter
+	TransmitTime() time.Time
+	Transmit(ctx context.Context) error
+	SetBeforeTransmitHook(h func(context.Context) error)
+	// SetCyclicTransmissionEnabled enables/disables cyclic transmission.
+	SetCyclicTransmissionEnabled(bool)
+	// IsCyclicTransmissionEnabled returns whether cyclic transmission is enabled/disabled.
+	IsCyclicTransmissionEnabled() bool
+}
+
+type xxx_ECU struct {
+	sync.Mutex // protects all node state
+	network    string
+	address    string
+	rx         xxx_ECU_Rx
+	tx         xxx_ECU_Tx
+}
+
+var _ ECU = &xxx_ECU{}
+var _ canrunner.Node = &xxx_ECU{}
+
+func NewECU(network, address string) ECU {
+	n := &xxx_ECU{network: network, address: address}
+	n.tx.xxx_VehicleStatus.init()
+	n.tx.xxx_VehicleStatus.Reset()
+	n.rx.debugHandler = candebug.NewHandler(&n.Mutex, []generated.Message{})
+	n.tx.debugHandler = candebug.NewHandler(&n.Mutex, []generated.Message{
+		&n.tx.xxx_VehicleStatus,
+	})
+	return n
+}
+
+func (n *xxx_ECU) Run(ctx context.Context) error {
+	return canrunner.Run(ctx, n)
+}
+
+func (n *xxx_ECU) Rx() ECU_Rx {
+	return &n.rx
+}
+
+func (n *xxx_ECU) Tx() ECU_Tx {
+	return &n.tx
+}
+
+type xxx_ECU_Rx struct {
+	debugHandler *candebug.Handler
+}
+
+var _ ECU_Rx = &xxx_ECU_Rx{}
+
+func (rx *xxx_ECU_Rx) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	rx.debugHandler.ServeHTTP(w, r)
+}
+
+type xxx_ECU_Tx struct {
+	debugHandler      *candebug.Handler
+	xxx_VehicleStatus xxx_ECU_Tx_VehicleStatus
+}
+
+var _ ECU_Tx = &xxx_ECU_Tx{}
+
+func (tx *xxx_ECU_Tx) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	tx.debugHandler.ServeHTTP(w, r)
+}
+
+func (tx *xxx_ECU_Tx) VehicleStatus() ECU_Tx_VehicleStatus {
+	return &tx.xxx_VehicleStatus
+}
+
+func (n *xxx_ECU) Descriptor() *descriptor.Node {
+	return Nodes().ECU
+}
+
+func (n *xxx_ECU) Connect() (net.Conn, error) {
+	return socketcan.Dial(n.network, n.address)
+}
+
+func (n *xxx_ECU) ReceivedMessage(id uint32) (canrunner.ReceivedMessage, bool) {
+	switch id {
+	default:
+		return nil, false
+	}
+}
+
+func (n *xxx_ECU) TransmittedMessages() []canrunner.TransmittedMessage {
+	return []canrunner.TransmittedMessage{
+		&n.tx.xxx_VehicleStatus,
+	}
+}
+
+type xxx_ECU_Tx_VehicleStatus struct {
+	VehicleStatus
+	transmitTime       time.Time
+	beforeTransmitHook func(context.Context) error
+	isCyclicEnabled    bool
+	wakeUpChan         chan struct{}
+	transmitEventChan  chan struct{}
+}
+
+var _ ECU_Tx_VehicleStatus = &xxx_ECU_Tx_VehicleStatus{}
+var _ canrunner.TransmittedMessage = &xxx_ECU_Tx_VehicleStatus{}
+
+func (m *xxx_ECU_Tx_VehicleStatus) init() {
+	m.beforeTransmitHook = func(context.Context) error { return nil }
+	m.wakeUpChan = make(chan struct{}, 1)
+	m.transmitEventChan = make(chan struct{})
+}
+
+func (m *xxx_ECU_Tx_VehicleStatus) SetBeforeTransmitHook(h func(context.Context) error) {
+	m.beforeTransmitHook = h
+}
+
+func (m *xxx_ECU_Tx_VehicleStatus) BeforeTransmitHook() func(context.Context) error {
+	return m.beforeTransmitHook
+}
+
+func (m *xxx_ECU_Tx_VehicleStatus) TransmitTime() time.Time {
+	return m.transmitTime
+}
+
+func (m *xxx_ECU_Tx_VehicleStatus) SetTransmitTime(t time.Time) {
+	m.transmitTime = t
+}
+
+func (m *xxx_ECU_Tx_VehicleStatus) IsCyclicTransmissionEnabled() bool {
+	return m.isCyclicEnabled
+}
+
+func (m *xxx_ECU_Tx_VehicleStatus) SetCyclicTransmissionEnabled(b bool) {
+	m.isCyclicEnabled = b
+	select {
+	case m.wakeUpChan <- struct{}{}:
+	default:
+	}
+}
+
+func (m *xxx_ECU_Tx_VehicleStatus) WakeUpChan() <-chan struct{} {
+	return m.wakeUpChan
+}
+
+func (m *xxx_ECU_Tx_VehicleStatus) Transmit(ctx context.Context) error {
+	select {
+	case m.transmitEventChan <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("event-triggered transmit of VehicleStatus: %w", ctx.Err())
+	}
+}
+
+func (m *xxx_ECU_Tx_VehicleStatus) TransmitEventChan() <-chan struct{} {
+	return m.transmitEventChan
+}
+
+var _ canrunner.TransmittedMessage = &xxx_ECU_Tx_VehicleStatus{}
+
+// FrameBatch holds the messages decoded from batches of frames, in a slice per message.
+//
+// The slices are reused after Reset, so that decoding does not allocate once they have grown to the size of a
+// batch.
+type FrameBatch struct {
+	VehicleStatus []VehicleStatus
+	LightStatus   []LightStatus
+}
+
+// Reset empties the slices of the batch, keeping their capacity.
+func (b *FrameBatch) Reset() {
+	b.VehicleStatus = b.VehicleStatus[:0]
+	b.LightStatus = b.LightStatus[:0]
+}
+
+// DecodeFrames decodes a batch of frames, and appends the decoded messages to the batch.
+//
+// Remote frames and frames of unknown messages are skipped.
+func (b *FrameBatch) DecodeFrames(frames []can.Frame) error {
+	for i := range frames {
+		f := &frames[i]
+		if f.IsRemote {
+			continue
+		}
+		switch f.ID {
+		case 100:
+			if !f.IsExtended {
+				b.VehicleStatus = append(b.VehicleStatus, VehicleStatus{})
+				m := &b.VehicleStatus[len(b.VehicleStatus)-1]
+				if err := m.DecodeFrame(f); err != nil {
+					b.VehicleStatus = b.VehicleStatus[:len(b.VehicleStatus)-1]
+					return err
+				}
+			}
+		case 200:
+			if !f.IsExtended {
+				b.LightStatus = append(b.LightStatus, LightStatus{})
+				m := &b.LightStatus[len(b.LightStatus)-1]
+				if err := m.DecodeFrame(f); err != nil {
+					b.LightStatus = b.LightStatus[:len(b.LightStatus)-1]
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// Nodes returns the units node descriptors.
+func Nodes() *NodesDescriptor {
+	return nd
+}
+
+// NodesDescriptor contains all units node descriptors.
+type NodesDescriptor struct {
+	DASH *descriptor.Node
+	ECU  *descriptor.Node
+}
+
+// Messages returns the units message descriptors.
+func Messages() *MessagesDescriptor {
+	return md
+}
+
+// MessagesDescriptor contains all units message descriptors.
+type MessagesDescriptor struct {
+	VehicleStatus *VehicleStatusDescriptor
+	LightStatus   *LightStatusDescriptor
+}
+
+// UnmarshalFrame unmarshals the provided units CAN frame.
+func (md *MessagesDescriptor) UnmarshalFrame(f can.Frame) (generated.Message, error) {
+	switch f.ID {
+	case md.VehicleStatus.ID:
+		var msg VehicleStatus
+		if err := msg.UnmarshalFrame(f); err != nil {
+			return nil, fmt.Errorf("unmarshal units frame: %w", err)
+		}
+		return &msg, nil
+	case md.LightStatus.ID:
+		var msg LightStatus
+		if err := msg.UnmarshalFrame(f); err != nil {
+			return nil, fmt.Errorf("unmarshal units frame: %w", err)
+		}
+		return &msg, nil
+	default:
+		return nil, fmt.Errorf("unmarshal units frame: ID not in database: %d", f.ID)
+	}
+}
+
+type VehicleStatusDescriptor struct {
+	*descriptor.Message
+	Speed              *descriptor.Signal
+	WheelSpeed         *descriptor.Signal
+	EngineSpeed        *descriptor.Signal
+	CoolantTemperature *descriptor.Signal
+	FuelLevel          *descriptor.Signal
+}
+
+type LightStatusDescriptor struct {
+	*descriptor.Message
+	Illuminance *descriptor.Signal
+	Counter     *descriptor.Signal
+}
+
+// Database returns the units database descriptor.
+func (md *MessagesDescriptor) Database() *descriptor.Database {
+	return d
+}
+
+var nd = &NodesDescriptor{
+	DASH: d.Nodes[0],
+	ECU:  d.Nodes[1],
+}
+
+var md = &MessagesDescriptor{
+	VehicleStatus: &VehicleStatusDescriptor{
+		Message:            d.Messages[0],
+		Speed:              d.Messages[0].Signals[0],
+		WheelSpeed:         d.Messages[0].Signals[1],
+		EngineSpeed:        d.Messages[0].Signals[2],
+		CoolantTemperature: d.Messages[0].Signals[3],
+		FuelLevel:          d.Messages[0].Signals[4],
+	},
+	LightStatus: &LightStatusDescriptor{
+		Message:     d.Messages[1],
+		Illuminance: d.Messages[1].Signals[0],
+		Counter:     d.Messages[1].Signals[1],
+	},
+}
+
+var d = (*descriptor.Database)(&descriptor.Database{
+	SourceFile: (string)("testdata/units/units.dbc"),
+	Version:    (string)(""),
+	Messages: ([]*descriptor.Message)([]*descriptor.Message{
+		(*descriptor.Message)(&descriptor.Message{
+			Name:        (string)("VehicleStatus"),
+			ID:          (uint32)(100),
+			IsExtended:  (bool)(false),
+			Length:      (uint8)(8),
+			SendType:    (descriptor.SendType)(1),
+			Description: (string)(""),
+			Signals: ([]*descriptor.Signal)([]*descriptor.Signal{
+				(*descriptor.Signal)(&descriptor.Signal{
+					Name:              (string)("Speed"),
+					Start:             (uint8)(0),
+					Length:            (uint8)(16),
+					IsBigEndian:       (bool)(false),
+					IsSigned:          (bool)(false),
+					IsFloat:           (bool)(false),
+					IsMultiplexer:     (bool)(false),
+					IsMultiplexed:     (bool)(false),
+					MultiplexerValue:  (uint)(0),
+					MultiplexerName:   (string)(""),
+					MultiplexerRanges: ([]*descriptor.MultiplexerRange)(nil),
+					Offset:            (float64)(0),
+					Scale:             (float64)(0.01),
+					Min:               (float64)(0),
+					Max:               (float64)(655.35),
+					Unit:              (string)("km/h"),
+					Description:       (string)(""),
+					SignalType:        (string)(""),
+					ValueTable:        (string)(""),
+					ValueDescriptions: ([]*descriptor.ValueDescription)(nil),
+					ReceiverNodes: ([]string)([]string{
+						(string)("DASH"),
+					}),
+					DefaultValue:  (int)(0),
+					SendType:      (descriptor.SendType)(0),
+					InactiveValue: (int)(0),
+					Attributes:    ([]*descriptor.Attribute)(nil),
+				}),
+				(*descriptor.Signal)(&descriptor.Signal{
+					Name:              (string)("WheelSpeed"),
+					Start:             (uint8)(16),
+					Length:            (uint8)(16),
+					IsBigEndian:       (bool)(false),
+					IsSigned:          (bool)(false),
+					IsFloat:           (bool)(false),
+					IsMultiplexer:     (bool)(false),
+					IsMultiplexed:     (bool)(false),
+					MultiplexerValue:  (uint)(0),
+					MultiplexerName:   (string)(""),
+					MultiplexerRanges: ([]*descriptor.MultiplexerRange)(nil),
+					Offset:            (float64)(0),
+					Scale:             (float64)(0.001),
+					Min:               (float64)(0),
+					Max:               (float64)(65.535),
+					Unit:              (string)("m/s"),
+					Description:       (string)(""),
+					SignalType:        (string)(""),
+					ValueTable:        (string)(""),
+					ValueDescriptions: ([]*descriptor.ValueDescription)(nil),
+					ReceiverNodes: ([]string)([]string{
+						(string)("DASH"),
+					}),
+					DefaultValue:  (int)(0),
+					SendType:      (descriptor.SendType)(0),
+					InactiveValue: (int)(0),
+					Attributes:    ([]*descriptor.Attribute)(nil),
+				}),
+				(*descriptor.Signal)(&descriptor.Signal{
+					Name:              (string)("EngineSpeed"),
+					Start:             (uint8)(32),
+					Length:            (uint8)(16),
+					IsBigEndian:       (bool)(false),
+					IsSigned:          (bool)(false),
+					IsFloat:           (bool)(false),
+					IsMultiplexer:     (bool)(false),
+					IsMultiplexed:     (bool)(false),
+					MultiplexerValue:  (uint)(0),
+					MultiplexerName:   (string)(""),
+					MultiplexerRanges: ([]*descriptor.MultiplexerRange)(nil),
+					Offset:            (float64)(0),
+					Scale:             (float64)(0.25),
+					Min:               (float64)(0),
+					Max:               (float64)(16383.75),
+					Unit:              (string)("rpm"),
+					Description:       (string)(""),
+					SignalType:        (string)(""),
+					ValueTable:        (string)(""),
+					ValueDescriptions: ([]*descriptor.ValueDescription)(nil),
+					ReceiverNodes: ([]string)([]string{
+						(string)("DASH"),
+					}),
+					DefaultValue:  (int)(0),
+					SendType:      (descriptor.SendType)(0),
+					InactiveValue: (int)(0),
+					Attributes:    ([]*descriptor.Attribute)(nil),
+				}),
+				(*descriptor.Signal)(&descriptor.Signal{
+					Name:              (string)("CoolantTemperature"),
+					Start:             (uint8)(48),
+					Length:            (uint8)(8),
+					IsBigEndian:       (bool)(false),
+					IsSigned:          (bool)(false),
+					IsFloat:           (bool)(false),
+					IsMultiplexer:     (bool)(false),
+					IsMultiplexed:     (bool)(false),
+					MultiplexerValue:  (uint)(0),
+					MultiplexerName:   (string)(""),
+					MultiplexerRanges: ([]*descriptor.MultiplexerRange)(nil),
+					Offset:            (float64)(-40),
+					Scale:             (float64)(1),
+					Min:               (float64)(-40),
+					Max:               (float64)(215),
+					Unit:              (string)("°C"),
+					Description:       (string)(""),
+					SignalType:        (string)(""),
+					ValueTable:        (string)(""),
+					ValueDescriptions: ([]*descriptor.ValueDescription)(nil),
+					ReceiverNodes: ([]string)([]string{
+						(string)("DASH"),
+					}),
+					DefaultValue:  (int)(0),
+					SendType:      (descriptor.SendType)(0),
+					InactiveValue: (int)(0),
+					Attributes:    ([]*descriptor.Attribute)(nil),
+				}),
+				(*descriptor.Signal)(&descriptor.Signal{
+					Name:              (string)("FuelLevel"),
+					Start:             (uint8)(56),
+					Length:            (uint8)(8),
+					IsBigEndian:       (bool)(false),
+					IsSigned:          (bool)(false),
+					IsFloat:           (bool)(false),
+					IsMultiplexer:     (bool)(false),
+					IsMultiplexed:     (bool)(false),
+					MultiplexerValue:  (uint)(0),
+					MultiplexerName:   (string)(""),
+					MultiplexerRanges: ([]*descriptor.MultiplexerRange)(nil),
+					Offset:            (float64)(0),
+					Scale:             (float64)(0.5),
+					Min:               (float64)(0),
+					Max:               (float64)(100),
+					Unit:              (string)("%"),
+					Description:       (string)(""),
+					SignalType:        (string)(""),
+					ValueTable:        (string)(""),
+					ValueDescriptions: ([]*descriptor.ValueDescription)(nil),
+					ReceiverNodes: ([]string)([]string{
+						(string)("DASH"),
+					}),
+					DefaultValue:  (int)(0),
+					SendType:      (descriptor.SendType)(0),
+					InactiveValue: (int)(0),
+					Attributes:    ([]*descriptor.Attribute)(nil),
+				}),
+			}),
+			SenderNode:       (string)("ECU"),
+			TransmitterNodes: ([]string)(nil),
+			SignalGroups:     ([]*descriptor.SignalGroup)(nil),
+			CycleTime:        (time.Duration)(100000000),
+			CycleTimeFast:    (time.Duration)(0),
+			DelayTime:        (time.Duration)(0),
+			RepetitionCount:  (int)(0),
+			Attributes: ([]*descriptor.Attribute)([]*descriptor.Attribute{
+				(*descriptor.Attribute)(&descriptor.Attribute{
+					Name:        (string)("GenMsgCycleTime"),
+					Type:        (descriptor.AttributeType)(0),
+					IntValue:    (int64)(100),
+					FloatValue:  (float64)(0),
+					StringValue: (string)(""),
+					IsDefault:   (bool)(false),
+				}),
+				(*descriptor.Attribute)(&descriptor.Attribute{
+					Name:        (string)("GenMsgSendType"),
+					Type:        (descriptor.AttributeType)(4),
+					IntValue:    (int64)(0),
+					FloatValue:  (float64)(0),
+					StringValue: (string)("Cyclic"),
+					IsDefault:   (bool)(false),
+				}),
+			}),
+		}),
+		(*descriptor.Message)(&descriptor.Message{
+			Name:        (string)("LightStatus"),
+			ID:          (uint32)(200),
+			IsExtended:  (bool)(false),
+			Length:      (uint8)(2),
+			SendType:    (descriptor.SendType)(0),
+			Description: (string)(""),
+			Signals: ([]*descriptor.Signal)([]*descriptor.Signal{
+				(*descriptor.Signal)(&descriptor.Signal{
+					Name:              (string)("Illuminance"),
+					Start:             (uint8)(0),
+					Length:            (uint8)(16),
+					IsBigEndian:       (bool)(false),
+					IsSigned:          (bool)(false),
+					IsFloat:           (bool)(false),
+					IsMultiplexer:     (bool)(false),
+					IsMultiplexed:     (bool)(false),
+					MultiplexerValue:  (uint)(0),
+					MultiplexerName:   (string)(""),
+					MultiplexerRanges: ([]*descriptor.MultiplexerRange)(nil),
+					Offset:            (float64)(0),
+					Scale:             (float64)(0.5),
+					Min:               (float64)(0),
+					Max:               (float64)(32767.5),
+					Unit:              (string)("lx"),
+					Description:       (string)(""),
+					SignalType:        (string)(""),
+					ValueTable:        (string)(""),
+					ValueDescriptions: ([]*descriptor.ValueDescription)(nil),
+					ReceiverNodes: ([]string)([]string{
+						(string)("DASH"),
+					}),
+					DefaultValue:  (int)(0),
+					SendType:      (descriptor.SendType)(0),
+					InactiveValue: (int)(0),
+					Attributes:    ([]*descriptor.Attribute)(nil),
+				}),
+				(*descriptor.Signal)(&descriptor.Signal{
+					Name:              (string)("Counter"),
+					Start:             (uint8)(16),
+					Length:            (uint8)(4),
+					IsBigEndian:       (bool)(false),
+					IsSigned:          (bool)(false),
+					IsFloat:           (bool)(false),
+					IsMultiplexer:     (bool)(false),
+					IsMultiplexed:     (bool)(false),
+					MultiplexerValue:  (uint)(0),
+					MultiplexerName:   (string)(""),
+					MultiplexerRanges: ([]*descriptor.MultiplexerRange)(nil),
+					Offset:            (float64)(0),
+					Scale:             (float64)(1),
+					Min:               (float64)(0),
+					Max:               (float64)(15),
+					Unit:              (string)(""),
+					Description:       (string)(""),
+					SignalType:        (string)(""),
+					ValueTable:        (string)(""),
+					ValueDescriptions: ([]*descriptor.ValueDescription)(nil),
+					ReceiverNodes: ([]string)([]string{
+						(string)("DASH"),
+					}),
+					DefaultValue:  (int)(0),
+					SendType:      (descriptor.SendType)(0),
+					InactiveValue: (int)(0),
+					Attributes:    ([]*descriptor.Attribute)(nil),
+				}),
+			}),
+			SenderNode:       (string)("ECU"),
+			TransmitterNodes: ([]string)(nil),
+			SignalGroups:     ([]*descriptor.SignalGroup)(nil),
+			CycleTime:        (time.Duration)(0),
+			CycleTimeFast:    (time.Duration)(0),
+			DelayTime:        (time.Duration)(0),
+			RepetitionCount:  (int)(0),
+			Attributes: ([]*descriptor.Attribute)([]*descriptor.Attribute{
+				(*descriptor.Attribute)(&descriptor.Attribute{
+					Name:        (string)("GenMsgCycleTime"),
+					Type:        (descriptor.AttributeType)(0),
+					IntValue:    (int64)(0),
+					FloatValue:  (float64)(0),
+					StringValue: (string)(""),
+					IsDefault:   (bool)(true),
+				}),
+				(*descriptor.Attribute)(&descriptor.Attribute{
+					Name:        (string)("GenMsgSendType"),
+					Type:        (descriptor.AttributeType)(4),
+					IntValue:    (int64)(1),
+					FloatValue:  (float64)(0),
+					StringValue: (string)("None"),
+					IsDefault:   (bool)(true),
+				}),
+			}),
+		}),
+	}),
+	Nodes: ([]*descriptor.Node)([]*descriptor.Node{
+		(*descriptor.Node)(&descriptor.Node{
+			Name:        (string)("DASH"),
+			Description: (string)(""),
+			Attributes:  ([]*descriptor.Attribute)(nil),
+		}),
+		(*descriptor.Node)(&descriptor.Node{
+			Name:        (string)("ECU"),
+			Description: (string)(""),
+			Attributes:  ([]*descriptor.Attribute)(nil),
+		}),
+	}),
+	ValueTables:          ([]*descriptor.ValueTable)(nil),
+	EnvironmentVariables: ([]*descriptor.EnvironmentVariable)(nil),
+	Attributes:           ([]*descriptor.Attribute)(nil),
+})
@@ -0,0 +1,852 @@
+// Package valuetablescan provides primitives for encoding and decoding valuetables CAN messages.
+//
+// Source: testdata/dbc/valuetables/valuetables.dbc
+package valuetablescan
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"go.einride.tech/can"
+	"go.einride.tech/can/pkg/candebug"
+	"go.einride.tech/can/pkg/canrunner"
+	"go.einride.tech/can/pkg/cantext"
+	"go.einride.tech/can/pkg/descriptor"
+	"go.einride.tech/can/pkg/generated"
+	"go.einride.tech/can/pkg/socketcan"
+)
+
+// prevent unused imports
+var (
+	_ = context.Background
+	_ = fmt.Print
+	_ = math.Float32frombits
+	_ = net.Dial
+	_ = http.Error
+	_ = sync.Mutex{}
+	_ = time.Now
+	_ = socketcan.Dial
+	_ = candebug.ServeMessagesHTTP
+	_ = canrunner.Run
+)
+
+// Generated code. DO NOT EDIT.
+// LightState models the LightState value table.
+type LightState uint8
+
+// Value descriptions for the LightState value table.
+const (
+	LightState_Off   LightState = 0
+	LightState_Low   LightState = 1
+	LightState_High  LightState = 2
+	LightState_Error LightState = 3
+)
+
+func (v LightState) String() string {
+	switch v {
+	case 0:
+		return "Off"
+	case 1:
+		return "Low"
+	case 2:
+		return "High"
+	case 3:
+		return "Error"
+	default:
+		return fmt.Sprintf("LightState(%d)", v)
+	}
+}
+
+// FrontLightsReader provides read access to a FrontLights message.
+type FrontLightsReader interface {
+	can.FrameMarshaler
+	// Left returns the value of the Left signal.
+	Left() FrontLights_Left
+	// Right returns the value of the Right signal.
+	Right() FrontLights_Right
+}
+
+// FrontLightsWriter provides write access to a FrontLights message.
+type FrontLightsWriter interface {
+	// CopyFrom copies all values from FrontLights.
+	CopyFrom(FrontLightsReader) *FrontLights
+	// SetLeft sets the value of the Left signal.
+	SetLeft(FrontLights_Left) *FrontLights
+	// SetRight sets the value of the Right signal.
+	SetRight(FrontLights_Right) *FrontLights
+}
+
+type FrontLights struct {
+	xxx_Left  FrontLights_Left
+	xxx_Right FrontLights_Right
+}
+
+func NewFrontLights() *FrontLights {
+	m := &FrontLights{}
+	m.Reset()
+	return m
+}
+
+func (m *FrontLights) Reset() {
+	m.xxx_Left = 0
+	m.xxx_Right = 0
+}
+
+func (m *FrontLights) CopyFrom(o FrontLightsReader) *FrontLights {
+	f, _ := o.MarshalFrame()
+	_ = m.UnmarshalFrame(f)
+	return m
+}
+
+// Descriptor returns the FrontLights descriptor.
+func (m *FrontLights) Descriptor() *descriptor.Message {
+	return Messages().FrontLights.Message
+}
+
+// String returns a compact string representation of the message.
+func (m *FrontLights) String() string {
+	return cantext.MessageString(m)
+}
+
+func (m *FrontLights) Left() FrontLights_Left {
+	return m.xxx_Left
+}
+
+func (m *FrontLights) SetLeft(v FrontLights_Left) *FrontLights {
+	m.xxx_Left = FrontLights_Left(Messages().FrontLights.Left.SaturatedCastUnsigned(uint64(v)))
+	return m
+}
+
+func (m *FrontLights) Right() FrontLights_Right {
+	return m.xxx_Right
+}
+
+func (m *FrontLights) SetRight(v FrontLights_Right) *FrontLights {
+	m.xxx_Right = FrontLights_Right(Messages().FrontLights.Right.SaturatedCastUnsigned(uint64(v)))
+	return m
+}
+
+// FrontLights_Left models the Left signal of the FrontLights message.
+type FrontLights_Left = LightState
+
+// Value descriptions for the Left signal of the FrontLights message.
+const (
+	FrontLights_Left_Off   = LightState_Off
+	FrontLights_Left_Low   = LightState_Low
+	FrontLights_Left_High  = LightState_High
+	FrontLights_Left_Error = LightState_Error
+)
+
+// FrontLights_Right models the Right signal of the FrontLights message.
+type FrontLights_Right = LightState
+
+// Value descriptions for the Right signal of the FrontLights message.
+const (
+	FrontLights_Right_Off   = LightState_Off
+	FrontLights_Right_Low   = LightState_Low
+	FrontLights_Right_High  = LightState_High
+	FrontLights_Right_Error = LightState_Error
+)
+
+// Frame returns a CAN frame representing the message.
+func (m *FrontLights) Frame() can.Frame {
+	md := Messages().FrontLights
+	f := can.Frame{ID: md.ID, IsExtended: md.IsExtended, Length: md.Length}
+	md.Left.MarshalUnsigned(&f.Data, uint64(m.xxx_Left))
+	md.Right.MarshalUnsigned(&f.Data, uint64(m.xxx_Right))
+	return f
+}
+
+// MarshalFrame encodes the message as a CAN frame.
+func (m *FrontLights) MarshalFrame() (can.Frame, error) {
+	return m.Frame(), nil
+}
+
+// UnmarshalFrame decodes the message from a CAN frame.
+func (m *FrontLights) UnmarshalFrame(f can.Frame) error {
+	md := Messages().FrontLights
+	switch {
+	case f.ID != md.ID:
+		return fmt.Errorf(
+			"unmarshal FrontLights: expects ID 100 (got %s with ID %d)", f.String(), f.ID,
+		)
+	case f.Length != md.Length:
+		return fmt.Errorf(
+			"unmarshal FrontLights: expects length 1 (got %s with length %d)", f.String(), f.Length,
+		)
+	case f.IsRemote:
+		return fmt.Errorf(
+			"unmarshal FrontLights: expects non-remote frame (got remote frame %s)", f.String(),
+		)
+	case f.IsExtended != md.IsExtended:
+		return fmt.Errorf(
+			"unmarshal FrontLights: expects standard ID (got %s with extended ID)", f.String(),
+		)
+	}
+	m.xxx_Left = FrontLights_Left(md.Left.UnmarshalUnsigned(f.Data))
+	m.xxx_Right = FrontLights_Right(md.Right.UnmarshalUnsigned(f.Data))
+	return nil
+}
+
+// DecodeFrame decodes the message from a CAN frame, with the bit layout of each signal resolved at generation
+// time.
+//
+// DecodeFrame decodes the same values as UnmarshalFrame, without allocating unless the frame is invalid, for
+// decoding frames at high rates into reused messages.
+func (m *FrontLights) DecodeFrame(f *can.Frame) error {
+	switch {
+	case f.ID != 100:
+		return fmt.Errorf(
+			"decode FrontLights: expects ID 100 (got %s with ID %d)", f.String(), f.ID,
+		)
+	case f.Length != 1:
+		return fmt.Errorf(
+			"decode FrontLights: expects length 1 (got %s with length %d)", f.String(), f.Length,
+		)
+	case f.IsRemote:
+		return fmt.Errorf(
+			"decode FrontLights: expects non-remote frame (got remote frame %s)", f.String(),
+		)
+	case f.IsExtended:
+		return fmt.Errorf(
+			"decode FrontLights: expects standard ID (got %s with extended ID)", f.String(),
+		)
+	}
+	le := f.Data.PackLittleEndian()
+	m.xxx_Left = FrontLights_Left(le & 0x3)
+	m.xxx_Right = FrontLights_Right((le >> 2) & 0x3)
+	return nil
+}
+
+// RearLightsReader provides read access to a RearLights message.
+type RearLightsReader interface {
+	can.FrameMarshaler
+	// Brake returns the value of the Brake signal.
+	Brake() RearLights_Brake
+	// Fog returns the value of the Fog signal.
+	Fog() RearLights_Fog
+}
+
+// RearLightsWriter provides write access to a RearLights message.
+type RearLightsWriter interface {
+	// CopyFrom copies all values from RearLights.
+	CopyFrom(RearLightsReader) *RearLights
+	// SetBrake sets the value of the Brake signal.
+	SetBrake(RearLights_Brake) *RearLights
+	// SetFog sets the value of the Fog signal.
+	SetFog(RearLights_Fog) *RearLights
+}
+
+type RearLights struct {
+	xxx_Brake RearLights_Brake
+	xxx_Fog   RearLights_Fog
+}
+
+func NewRearLights() *RearLights {
+	m := &RearLights{}
+	m.Reset()
+	return m
+}
+
+func (m *RearLights) Reset() {
+	m.xxx_Brake = 0
+	m.xxx_Fog = false
+}
+
+func (m *RearLights) CopyFrom(o RearLightsReader) *RearLights {
+	f, _ := o.MarshalFrame()
+	_ = m.UnmarshalFrame(f)
+	return m
+}
+
+// Descriptor returns the RearLights descriptor.
+func (m *RearLights) Descriptor() *descriptor.Message {
+	return Messages().RearLights.Message
+}
+
+// String returns a compact string representation of the message.
+func (m *RearLights) String() string {
+	return cantext.MessageString(m)
+}
+
+func (m *RearLights) Brake() RearLights_Brake {
+	return m.xxx_Brake
+}
+
+func (m *RearLights) SetBrake(v RearLights_Brake) *RearLights {
+	m.xxx_Brake = RearLights_Brake(Messages().RearLights.Brake.SaturatedCastUnsigned(uint64(v)))
+	return m
+}
+
+func (m *RearLights) Fog() RearLights_Fog {
+	return m.xxx_Fog
+}
+
+func (m *RearLights) SetFog(v RearLights_Fog) *RearLights {
+	m.xxx_Fog = v
+	return m
+}
+
+// RearLights_Brake models the Brake signal of the RearLights message.
+type RearLights_Brake = LightState
+
+// Value descriptions for the Brake signal of the RearLights message.
+const (
+	RearLights_Brake_Off   = LightState_Off
+	RearLights_Brake_Low   = LightState_Low
+	RearLights_Brake_High  = LightState_High
+	RearLights_Brake_Error = LightState_Error
+)
+
+// RearLights_Fog models the Fog signal of the RearLights message.
+type RearLights_Fog bool
+
+// Value descriptions for the Fog signal of the RearLights message.
+const (
+	RearLights_Fog_Off RearLights_Fog = false
+	RearLights_Fog_On  RearLights_Fog = true
+)
+
+func (v RearLights_Fog) String() string {
+	switch bool(v) {
+	case false:
+		return "Off"
+	case true:
+		return "On"
+	}
+	return fmt.Sprintf("RearLights_Fog(%t)", v)
+}
+
+// Frame returns a CAN frame representing the message.
+func (m *RearLights) Frame() can.Frame {
+	md := Messages().RearLights
+	f := can.Frame{ID: md.ID, IsExtended: md.IsExtended, Length: md.Length}
+	md.Brake.MarshalUnsigned(&f.Data, uint64(m.xxx_Brake))
+	md.Fog.MarshalBool(&f.Data, bool(m.xxx_Fog))
+	return f
+}
+
+// MarshalFrame encodes the message as a CAN frame.
+func (m *RearLights) MarshalFrame() (can.Frame, error) {
+	return m.Frame(), nil
+}
+
+// UnmarshalFrame decodes the message from a CAN frame.
+func (m *RearLights) UnmarshalFrame(f can.Frame) error {
+	md := Messages().RearLights
+	switch {
+	case f.ID != md.ID:
+		return fmt.Errorf(
+			"unmarshal RearLights: expects ID 200 (got %s with ID %d)", f.String(), f.ID,
+		)
+	case f.Length != md.Length:
+		return fmt.Errorf(
+			"unmarshal RearLights: expects length 1 (got %s with length %d)", f.String(), f.Length,
+		)
+	case f.IsRemote:
+		return fmt.Errorf(
+			"unmarshal RearLights: expects non-remote frame (got remote frame %s)", f.String(),
+		)
+	case f.IsExtended != md.IsExtended:
+		return fmt.Errorf(
+			"unmarshal RearLights: expects standard ID (got %s with extended ID)", f.String(),
+		)
+	}
+	m.xxx_Brake = RearLights_Brake(md.Brake.UnmarshalUnsigned(f.Data))
+	m.xxx_Fog = RearLights_Fog(md.Fog.UnmarshalBool(f.Data))
+	return nil
+}
+
+// DecodeFrame decodes the message from a CAN frame, with the bit layout of each signal resolved at generation
+// time.
+//
+// DecodeFrame decodes the same values as UnmarshalFrame, without allocating unless the frame is invalid, for
+// decoding frames at high rates into reused messages.
+func (m *RearLights) DecodeFrame(f *can.Frame) error {
+	switch {
+	case f.ID != 200:
+		return fmt.Errorf(
+			"decode RearLights: expects ID 200 (got %s with ID %d)", f.String(), f.ID,
+		)
+	case f.Length != 1:
+		return fmt.Errorf(
+			"decode RearLights: expects length 1 (got %s with length %d)", f.String(), f.Length,
+		)
+	case f.IsRemote:
+		return fmt.Errorf(
+			"decode RearLights: expects non-remote frame (got remote frame %s)", f.String(),
+		)
+	case f.IsExtended:
+		return fmt.Errorf(
+			"decode RearLights: expects standard ID (got %s with extended ID)", f.String(),
+		)
+	}
+	le := f.Data.PackLittleEndian()
+	m.xxx_Brake = RearLights_Brake(le & 0x3)
+	m.xxx_Fog = RearLights_Fog(le&(1<<2) != 0)
+	return nil
+}
+
+// FrameBatch holds the messages decoded from batches of frames, in a slice per message.
+//
+// The slices are reused after Reset, so that decoding does not allocate once they have grown to the size of a
+// batch.
+type FrameBatch struct {
+	FrontLights []FrontLights
+	RearLights  []RearLights
+}
+
+// Reset empties the slices of the batch, keeping their capacity.
+func (b *FrameBatch) Reset() {
+	b.FrontLights = b.FrontLights[:0]
+	b.RearLights = b.RearLights[:0]
+}
+
+// DecodeFrames decodes a batch of frames, and appends the decoded messages to the batch.
+//
+// Remote frames and frames of unknown messages are skipped.
+func (b *FrameBatch) DecodeFrames(frames []can.Frame) error {
+	for i := range frames {
+		f := &frames[i]
+		if f.IsRemote {
+			continue
+		}
+		switch f.ID {
+		case 100:
+			if !f.IsExtended {
+				b.FrontLights = append(b.FrontLights, FrontLights{})
+				m := &b.FrontLights[len(b.FrontLights)-1]
+				if err := m.DecodeFrame(f); err != nil {
+					b.FrontLights = b.FrontLights[:len(b.FrontLights)-1]
+					return err
+				}
+			}
+		case 200:
+			if !f.IsExtended {
+				b.RearLights = append(b.RearLights, RearLights{})
+				m := &b.RearLights[len(b.RearLights)-1]
+				if err := m.DecodeFrame(f); err != nil {
+					b.RearLights = b.RearLights[:len(b.RearLights)-1]
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// Nodes returns the valuetables node descriptors.
+func Nodes() *NodesDescriptor {
+	return nd
+}
+
+// NodesDescriptor contains all valuetables node descriptors.
+type NodesDescriptor struct {
+	DASH *descriptor.Node
+	ECU  *descriptor.Node
+}
+
+// Messages returns the valuetables message descriptors.
+func Messages() *MessagesDescriptor {
+	return md
+}
+
+// MessagesDescriptor contains all valuetables message descriptors.
+type MessagesDescriptor struct {
+	FrontLights *FrontLightsDescriptor
+	RearLights  *RearLightsDescriptor
+}
+
+// UnmarshalFrame unmarshals the provided valuetables CAN frame.
+func (md *MessagesDescriptor) UnmarshalFrame(f can.Frame) (generated.Message, error) {
+	switch f.ID {
+	case md.FrontLights.ID:
+		var msg FrontLights
+		if err := msg.UnmarshalFrame(f); err != nil {
+			return nil, fmt.Errorf("unmarshal valuetables frame: %w", err)
+		}
+		return &msg, nil
+	case md.RearLights.ID:
+		var msg RearLights
+		if err := msg.UnmarshalFrame(f); err != nil {
+			return nil, fmt.Errorf("unmarshal valuetables frame: %w", err)
+		}
+		return &msg, nil
+	default:
+		return nil, fmt.Errorf("unmarshal valuetables frame: ID not in database: %d", f.ID)
+	}
+}
+
+type FrontLightsDescriptor struct {
+	*descriptor.Message
+	Left  *descriptor.Signal
+	Right *descriptor.Signal
+}
+
+type RearLightsDescriptor struct {
+	*descriptor.Message
+	Brake *descriptor.Signal
+	Fog   *descriptor.Signal
+}
+
+// Database returns the valuetables database descriptor.
+func (md *MessagesDescriptor) Database() *descriptor.Database {
+	return d
+}
+
+var nd = &NodesDescriptor{
+	DASH: d.Nodes[0],
+	ECU:  d.Nodes[1],
+}
+
+var md = &MessagesDescriptor{
+	FrontLights: &FrontLightsDescriptor{
+		Message: d.Messages[0],
+		Left:    d.Messages[0].Signals[0],
+		Right:   d.Messages[0].Signals[1],
+	},
+	RearLights: &RearLightsDescriptor{
+		Message: d.Messages[1],
+		Brake:   d.Messages[1].Signals[0],
+		Fog:     d.Messages[1].Signals[1],
+	},
+}
+
+var d = (*descriptor.Database)(&descriptor.Database{
+	SourceFile: (string)("testdata/dbc/valuetables/valuetables.dbc"),
+	Version:    (string)(""),
+	Messages: ([]*descriptor.Message)([]*descriptor.Message{
+		(*descriptor.Message)(&descriptor.Message{
+			Name:        (string)("FrontLights"),
+			ID:          (uint32)(100),
+			IsExtended:  (bool)(false),
+			Length:      (uint8)(1),
+			SendType:    (descriptor.SendType)(0),
+			Description: (string)(""),
+			Signals: ([]*descriptor.Signal)([]*descriptor.Signal{
+				(*descriptor.Signal)(&descriptor.Signal{
+					Name:              (string)("Left"),
+					Start:             (uint8)(0),
+					Length:            (uint8)(2),
+					IsBigEndian:       (bool)(false),
+					IsSigned:          (bool)(false),
+					IsFloat:           (bool)(false),
+					IsMultiplexer:     (bool)(false),
+					IsMultiplexed:     (bool)(false),
+					MultiplexerValue:  (uint)(0),
+					MultiplexerName:   (string)(""),
+					MultiplexerRanges: ([]*descriptor.MultiplexerRange)(nil),
+					Offset:            (float64)(0),
+					Scale:             (float64)(1),
+					Min:               (float64)(0),
+					Max:               (float64)(3),
+					Unit:              (string)(""),
+					Description:       (string)(""),
+					SignalType:        (string)(""),
+					ValueTable:        (string)("LightState"),
+					ValueDescriptions: ([]*descriptor.ValueDescription)([]*descriptor.ValueDescription{
+						(*descriptor.ValueDescription)(&descriptor.ValueDescription{
+							Value:       (int64)(0),
+							Description: (string)("Off"),
+						}),
+						(*descriptor.ValueDescription)(&descriptor.ValueDescription{
+							Value:       (int64)(1),
+							Description: (string)("Low"),
+						}),
+						(*descriptor.ValueDescription)(&descriptor.ValueDescription{
+							Value:       (int64)(2),
+							Description: (string)("High"),
+						}),
+						(*descriptor.ValueDescription)(&descriptor.ValueDescription{
+							Value:       (int64)(3),
+							Description: (string)("Error"),
+						}),
+					}),
+					ReceiverNodes: ([]string)([]string{
+						(string)("DASH"),
+					}),
+					DefaultValue:  (int)(0),
+					SendType:      (descriptor.SendType)(0),
+					InactiveValue: (int)(0),
+					Attributes:    ([]*descriptor.Attribute)(nil),
+				}),
+				(*descriptor.Signal)(&descriptor.Signal{
+					Name:              (string)("Right"),
+					Start:             (uint8)(2),
+					Length:            (uint8)(2),
+					IsBigEndian:       (bool)(false),
+					IsSigned:          (bool)(false),
+					IsFloat:           (bool)(false),
+					IsMultiplexer:     (bool)(false),
+					IsMultiplexed:     (bool)(false),
+					MultiplexerValue:  (uint)(0),
+					MultiplexerName:   (string)(""),
+					MultiplexerRanges: ([]*descriptor.MultiplexerRange)(nil),
+					Offset:            (float64)(0),
+					Scale:             (float64)(1),
+					Min:               (float64)(0),
+					Max:               (float64)(3),
+					Unit:              (string)(""),
+					Description:       (string)(""),
+					SignalType:        (string)(""),
+					ValueTable:        (string)("LightState"),
+					ValueDescriptions: ([]*descriptor.ValueDescription)([]*descriptor.ValueDescription{
+						(*descriptor.ValueDescription)(&descriptor.ValueDescription{
+							Value:       (int64)(0),
+							Description: (string)("Off"),
+						}),
+						(*descriptor.ValueDescription)(&descriptor.ValueDescription{
+							Value:       (int64)(1),
+							Description: (string)("Low"),
+						}),
+						(*descriptor.ValueDescription)(&descriptor.ValueDescription{
+							Value:       (int64)(2),
+							Description: (string)("High"),
+						}),
+						(*descriptor.ValueDescription)(&descriptor.ValueDescription{
+							Value:       (int64)(3),
+							Description: (string)("Error"),
+						}),
+					}),
+					ReceiverNodes: ([]string)([]string{
+						(string)("DASH"),
+					}),
+					DefaultValue:  (int)(0),
+					SendType:      (descriptor.SendType)(0),
+					InactiveValue: (int)(0),
+					Attributes:    ([]*descriptor.Attribute)(nil),
+				}),
+			}),
+			SenderNode:       (string)("ECU"),
+			TransmitterNodes: ([]string)(nil),
+			SignalGroups:     ([]*descriptor.SignalGroup)(nil),
+			CycleTime:        (time.Duration)(0),
+			CycleTimeFast:    (time.Duration)(0),
+			DelayTime:        (time.Duration)(0),
+			RepetitionCount:  (int)(0),
+			Attributes:       ([]*descriptor.Attribute)(nil),
+		}),
+		(*descriptor.Message)(&descriptor.Message{
+			Name:        (string)("RearLights"),
+			ID:          (uint32)(200),
+			IsExtended:  (bool)(false),
+			Length:      (uint8)(1),
+			SendType:    (descriptor.SendType)(0),
+			Description: (string)(""),
+			Signals: ([]*descriptor.Signal)([]*descriptor.Signal{
+				(*descriptor.Signal)(&descriptor.Signal{
+					Name:              (string)("Brake"),
+					Start:             (uint8)(0),
+					Length:            (uint8)(2),
+					IsBigEndian:       (bool)(false),
+					IsSigned:          (bool)(false),
+					IsFloat:           (bool)(false),
+					IsMultiplexer:     (bool)(false),
+					IsMultiplexed:     (bool)(false),
+					MultiplexerValue:  (uint)(0),
+					MultiplexerName:   (string)(""),
+					MultiplexerRanges: ([]*descriptor.MultiplexerRange)(nil),
+					Offset:            (float64)(0),
+					Scale:             (float64)(1),
+					Min:               (float64)(0),
+					Max:               (float64)(3),
+					Unit:              (string)(""),
+					Description:       (string)(""),
+					SignalType:        (string)(""),
+					ValueTable:        (string)("LightState"),
+					ValueDescriptions: ([]*descriptor.ValueDescription)([]*descriptor.ValueDescription{
+						(*descriptor.ValueDescription)(&descriptor.ValueDescription{
+							Value:       (int64)(0),
+							Description: (string)("Off"),
+						}),
+						(*descriptor.ValueDescription)(&descriptor.ValueDescription{
+							Value:       (int64)(1),
+							Description: (string)("Low"),
+						}),
+						(*descriptor.ValueDescription)(&descriptor.ValueDescription{
+							Value:       (int64)(2),
+							Description: (string)("High"),
+						}),
+						(*descriptor.ValueDescription)(&descriptor.ValueDescription{
+							Value:       (int64)(3),
+							Description: (string)("Error"),
+						}),
+					}),
+					ReceiverNodes: ([]string)([]string{
+						(string)("DASH"),
+					}),
+					DefaultValue:  (int)(0),
+					SendType:      (descriptor.SendType)(0),
+					InactiveValue: (int)(0),
+					Attributes:    ([]*descriptor.Attribute)(nil),
+				}),
+				(*descriptor.Signal)(&descriptor.Signal{
+					Name:              (string)("Fog"),
+					Start:             (uint8)(2),
+					Length:            (uint8)(1),
+					IsBigEndian:       (bool)(false),
+					IsSigned:          (bool)(false),
+					IsFloat:           (bool)(false),
+					IsMultiplexer:     (bool)(false),
+					IsMultiplexed:     (bool)(false),
+					MultiplexerValue:  (uint)(0),
+					MultiplexerName:   (string)(""),
+					MultiplexerRanges: ([]*descriptor.MultiplexerRange)(nil),
+					Offset:            (float64)(0),
+					Scale:             (float64)(1),
+					Min:               (float64)(0),
+					Max:               (float64)(1),
+					Unit:              (string)(""),
+					Description:       (string)(""),
+					SignalType:        (string)(""),
+					ValueTable:        (string)("OnOff"),
+					ValueDescriptions: ([]*descriptor.ValueDescription)([]*descriptor.ValueDescription{
+						(*descriptor.ValueDescription)(&descriptor.ValueDescription{
+							Value:       (int64)(0),
+							Description: (string)("Off"),
+						}),
+						(*descriptor.ValueDescription)(&descriptor.ValueDescription{
+							Value:       (int64)(1),
+							Description: (string)("On"),
+						}),
+					}),
+					ReceiverNodes: ([]string)([]string{
+						(string)("DASH"),
+					}),
+					DefaultValue:  (int)(0),
+					SendType:      (descriptor.SendType)(0),
+					InactiveValue: (int)(0),
+					Attributes:    ([]*descriptor.Attribute)(nil),
+				}),
+			}),
+			SenderNode:       (string)("ECU"),
+			TransmitterNodes: ([]string)(nil),
+			SignalGroups:     ([]*descriptor.SignalGroup)(nil),
+			CycleTime:        (time.Duration)(0),
+			CycleTimeFast:    (time.Duration)(0),
+			DelayTime:        (time.Duration)(0),
+			RepetitionCount:  (int)(0),
+			Attributes:       ([]*descriptor.Attribute)(nil),
+		}),
+	}),
+	Nodes: ([]*descriptor.Node)([]*descriptor.Node{
+		(*descriptor.Node)(&descriptor.Node{
+			Name:        (string)("DASH"),
+			Description: (string)(""),
+			Attributes:  ([]*descriptor.Attribute)(nil),
+		}),
+		(*descriptor.Node)(&descriptor.Node{
+			Name:        (string)("ECU"),
+			Description: (string)(""),
+			Attributes:  ([]*descriptor.Attribute)(nil),
+		}),
+	}),
+	ValueTables: ([]*descriptor.ValueTable)([]*descriptor.ValueTable{
+		(*descriptor.ValueTable)(&descriptor.ValueTable{
+			Name: (string)("LightState"),
+			ValueDescriptions: ([]*descriptor.ValueDescription)([]*descriptor.ValueDescription{
+				(*descriptor.ValueDescription)(&descriptor.ValueDescription{
+					Value:       (int64)(0),
+					Description: (string)("Off"),
+				}),
+				(*descriptor.ValueDescription)(&descriptor.ValueDescription{
+					Value:       (int64)(1),
+					Description: (string)("Low"),
+				}),
+				(*descriptor.ValueDescription)(&descriptor.ValueDescription{
+					Value:       (int64)(2),
+					Description: (string)("High"),
+				}),
+				(*descriptor.ValueDescription)(&descriptor.ValueDescription{
+					Value:       (int64)(3),
+					Description: (string)("Error"),
+				}),
+			}),
+		}),
+		(*descriptor.ValueTable)(&descriptor.ValueTable{
+			Name: (string)("OnOff"),
+			ValueDescriptions: ([]*descriptor.ValueDescription)([]*descriptor.ValueDescription{
+				(*descriptor.ValueDescription)(&descriptor.ValueDescription{
+					Value:       (int64)(0),
+					Description: (string)("Off"),
+				}),
+				(*descriptor.ValueDescription)(&descriptor.ValueDescription{
+					Value:       (int64)(1),
+					Description: (string)("On"),
+				}),
+			}),
+		}),
+	}),
+	EnvironmentVariables: ([]*descriptor.EnvironmentVariable)([]*descriptor.EnvironmentVariable{
+		(*descriptor.EnvironmentVariable)(&descriptor.EnvironmentVariable{
+			Name:         (string)("Calibration"),
+			ID:           (uint64)(2),
+			Type:         (descriptor.EnvironmentVariableType)(3),
+			Min:          (float64)(0),
+			Max:          (float64)(0),
+			Unit:         (string)(""),
+			InitialValue: (float64)(0),
+			DataSize:     (uint64)(8),
+			AccessType:   (descriptor.AccessType)(3),
+			AccessNodes: ([]string)([]string{
+				(string)("ECU"),
+				(string)("DASH"),
+			}),
+			Description:       (string)(""),
+			ValueTable:        (string)(""),
+			ValueDescriptions: ([]*descriptor.ValueDescription)(nil),
+			Attributes: ([]*descriptor.Attribute)([]*descriptor.Attribute{
+				(*descriptor.Attribute)(&descriptor.Attribute{
+					Name:        (string)("Persistent"),
+					Type:        (descriptor.AttributeType)(0),
+					IntValue:    (int64)(0),
+					FloatValue:  (float64)(0),
+					StringValue: (string)(""),
+					IsDefault:   (bool)(true),
+				}),
+			}),
+		}),
+		(*descriptor.EnvironmentVariable)(&descriptor.EnvironmentVariable{
+			Name:         (string)("Ignition"),
+			ID:           (uint64)(1),
+			Type:         (descriptor.EnvironmentVariableType)(0),
+			Min:          (float64)(0),
+			Max:          (float64)(1),
+			Unit:         (string)(""),
+			InitialValue: (float64)(0),
+			DataSize:     (uint64)(0),
+			AccessType:   (descriptor.AccessType)(1),
+			AccessNodes: ([]string)([]string{
+				(string)("ECU"),
+			}),
+			Description: (string)("Ignition switch position"),
+			ValueTable:  (string)("OnOff"),
+			ValueDescriptions: ([]*descriptor.ValueDescription)([]*descriptor.ValueDescription{
+				(*descriptor.ValueDescription)(&descriptor.ValueDescription{
+					Value:       (int64)(0),
+					Description: (string)("Off"),
+				}),
+				(*descriptor.ValueDescription)(&descriptor.ValueDescription{
+					Value:       (int64)(1),
+					Description: (string)("On"),
+				}),
+			}),
+			Attributes: ([]*descriptor.Attribute)([]*descriptor.Attribute{
+				(*descriptor.Attribute)(&descriptor.Attribute{
+					Name:        (string)("Persistent"),
+					Type:        (descriptor.AttributeType)(0),
+					IntValue:    (int64)(1),
+					FloatValue:  (float64)(0),
+					StringValue: (string)(""),
+					IsDefault:   (bool)(false),
+				}),
+			}),
+		}),
+	}),
+	Attributes: ([]*descriptor.Attribute)(nil),
+})
@@ -0,0 +1,670 @@
+// Package powertraincan provides primitives for encoding and decoding powertrain CAN messages.
+//
+// Source: testdata/network/vehicle/powertrain.dbc
+package powertraincan
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"go.einride.tech/can"
+	"go.einride.tech/can/pkg/candebug"
+	"go.einride.tech/can/pkg/canrunner"
+	"go.einride.tech/can/pkg/cantext"
+	"go.einride.tech/can/pkg/descriptor"
+	"go.einride.tech/can/pkg/generated"
+	"go.einride.tech/can/pkg/socketcan"
+)
+
+// prevent unused imports
+var (
+	_ = context.Background
+	_ = fmt.Print
+	_ = math.Float32frombits
+	_ = net.Dial
+	_ = http.Error
+	_ = sync.Mutex{}
+	_ = time.Now
+	_ = socketcan.Dial
+	_ = candebug.ServeMessagesHTTP
+	_ = canrunner.Run
+)
+
+// Generated code. DO NOT EDIT.
+// EngineStatusReader provides read access to a EngineStatus message.
+type EngineStatusReader interface {
+	can.FrameMarshaler
+	// Speed returns the value of the Speed signal.
+	Speed() uint16
+}
+
+// EngineStatusWriter provides write access to a EngineStatus message.
+type EngineStatusWriter interface {
+	// CopyFrom copies all values from EngineStatus.
+	CopyFrom(EngineStatusReader) *EngineStatus
+	// SetSpeed sets the value of the Speed signal.
+	SetSpeed(uint16) *EngineStatus
+}
+
+type EngineStatus struct {
+	xxx_Speed uint16
+}
+
+func NewEngineStatus() *EngineStatus {
+	m := &EngineStatus{}
+	m.Reset()
+	return m
+}
+
+func (m *EngineStatus) Reset() {
+	m.xxx_Speed = 0
+}
+
+func (m *EngineStatus) CopyFrom(o EngineStatusReader) *EngineStatus {
+	f, _ := o.MarshalFrame()
+	_ = m.UnmarshalFrame(f)
+	return m
+}
+
+// Descriptor returns the EngineStatus descriptor.
+func (m *EngineStatus) Descriptor() *descriptor.Message {
+	return Messages().EngineStatus.Message
+}
+
+// String returns a compact string representation of the message.
+func (m *EngineStatus) String() string {
+	return cantext.MessageString(m)
+}
+
+func (m *EngineStatus) Speed() uint16 {
+	return m.xxx_Speed
+}
+
+func (m *EngineStatus) SetSpeed(v uint16) *EngineStatus {
+	m.xxx_Speed = uint16(Messages().EngineStatus.Speed.SaturatedCastUnsigned(uint64(v)))
+	return m
+}
+
+// Frame returns a CAN frame representing the message.
+func (m *EngineStatus) Frame() can.Frame {
+	md := Messages().EngineStatus
+	f := can.Frame{ID: md.ID, IsExtended: md.IsExtended, Length: md.Length}
+	md.Speed.MarshalUnsigned(&f.Data, uint64(m.xxx_Speed))
+	return f
+}
+
+// MarshalFrame encodes the message as a CAN frame.
+func (m *EngineStatus) MarshalFrame() (can.Frame, error) {
+	return m.Frame(), nil
+}
+
+// UnmarshalFrame decodes the message from a CAN frame.
+func (m *EngineStatus) UnmarshalFrame(f can.Frame) error {
+	md := Messages().EngineStatus
+	switch {
+	case f.ID != md.ID:
+		return fmt.Errorf(
+			"unmarshal EngineStatus: expects ID 100 (got %s with ID %d)", f.String(), f.ID,
+		)
+	case f.Length != md.Length:
+		return fmt.Errorf(
+			"unmarshal EngineStatus: expects length 2 (got %s with length %d)", f.String(), f.Length,
+		)
+	case f.IsRemote:
+		return fmt.Errorf(
+			"unmarshal EngineStatus: expects non-remote frame (got remote frame %s)", f.String(),
+		)
+	case f.IsExtended != md.IsExtended:
+		return fmt.Errorf(
+			"unmarshal EngineStatus: expects standard ID (got %s with extended ID)", f.String(),
+		)
+	}
+	m.xxx_Speed = uint16(md.Speed.UnmarshalUnsigned(f.Data))
+	return nil
+}
+
+// DecodeFrame decodes the message from a CAN frame, with the bit layout of each signal resolved at generation
+// time.
+//
+// DecodeFrame decodes the same values as UnmarshalFrame, without allocating unless the frame is invalid, for
+// decoding frames at high rates into reused messages.
+func (m *EngineStatus) DecodeFrame(f *can.Frame) error {
+	switch {
+	case f.ID != 100:
+		return fmt.Errorf(
+			"decode EngineStatus: expects ID 100 (got %s with ID %d)", f.String(), f.ID,
+		)
+	case f.Length != 2:
+		return fmt.Errorf(
+			"decode EngineStatus: expects length 2 (got %s with length %d)", f.String(), f.Length,
+		)
+	case f.IsRemote:
+		return fmt.Errorf(
+			"decode EngineStatus: expects non-remote frame (got remote frame %s)", f.String(),
+		)
+	case f.IsExtended:
+		return fmt.Errorf(
+			"decode EngineStatus: expects standard ID (got %s with extended ID)", f.String(),
+		)
+	}
+	le := f.Data.PackLittleEndian()
+	m.xxx_Speed = uint16(le & 0xffff)
+	return nil
+}
+
+type ECU interface {
+	sync.Locker
+	Tx() ECU_Tx
+	Rx() ECU_Rx
+	Run(ctx context.Context) error
+}
+
+type ECU_Rx interface {
+	http.Handler // for debugging
+}
+
+type ECU_Tx interface {
+	http.Handler // for debugging
+	EngineStatus() ECU_Tx_EngineStatus
+}
+
+type ECU_Tx_EngineStatus interface {
+	EngineStatusReader
+	EngineStatusWriter
+	TransmitTime() time.Time
+	Transmit(ctx context.Context) error
+	SetBeforeTransmitHook(h func(context.Context) error)
+	// SetCyclicTransmissionEnabled enables/disables cyclic transmission.
+	SetCyclicTransmissionEnabled(bool)
+	// IsCyclicTransmissionEnabled returns whether cyclic transmission is enabled/disabled.
+	IsCyclicTransmissionEnabled() bool
+}
+
+type xxx_ECU struct {
+	sync.Mutex // protects all node state
+	network    string
+	address    string
+	rx         xxx_ECU_Rx
+	tx         xxx_ECU_Tx
+}
+
+var _ ECU = &xxx_ECU{}
+var _ canrunner.Node = &xxx_ECU{}
+
+func NewECU(network, address string) ECU {
+	n := &xxx_ECU{network: network, address: address}
+	n.tx.xxx_EngineStatus.init()
+	n.tx.xxx_EngineStatus.Reset()
+	n.rx.debugHandler = candebug.NewHandler(&n.Mutex, []generated.Message{})
+	n.tx.debugHandler = candebug.NewHandler(&n.Mutex, []generated.Message{
+		&n.tx.xxx_EngineStatus,
+	})
+	return n
+}
+
+func (n *xxx_ECU) Run(ctx context.Context) error {
+	return canrunner.Run(ctx, n)
+}
+
+func (n *xxx_ECU) Rx() ECU_Rx {
+	return &n.rx
+}
+
+func (n *xxx_ECU) Tx() ECU_Tx {
+	return &n.tx
+}
+
+type xxx_ECU_Rx struct {
+	debugHandler *candebug.Handler
+}
+
+var _ ECU_Rx = &xxx_ECU_Rx{}
+
+func (rx *xxx_ECU_Rx) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	rx.debugHandler.ServeHTTP(w, r)
+}
+
+type xxx_ECU_Tx struct {
+	debugHandler     *candebug.Handler
+	xxx_EngineStatus xxx_ECU_Tx_EngineStatus
+}
+
+var _ ECU_Tx = &xxx_ECU_Tx{}
+
+func (tx *xxx_ECU_Tx) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	tx.debugHandler.ServeHTTP(w, r)
+}
+
+func (tx *xxx_ECU_Tx) EngineStatus() ECU_Tx_EngineStatus {
+	return &tx.xxx_EngineStatus
+}
+
+func (n *xxx_ECU) Descriptor() *descriptor.Node {
+	return Nodes().ECU
+}
+
+func (n *xxx_ECU) Connect() (net.Conn, error) {
+	return socketcan.Dial(n.network, n.address)
+}
+
+func (n *xxx_ECU) ReceivedMessage(id uint32) (canrunner.ReceivedMessage, bool) {
+	switch id {
+	default:
+		return nil, false
+	}
+}
+
+func (n *xxx_ECU) TransmittedMessages() []canrunner.TransmittedMessage {
+	return []canrunner.TransmittedMessage{
+		&n.tx.xxx_EngineStatus,
+	}
+}
+
+type xxx_ECU_Tx_EngineStatus struct {
+	EngineStatus
+	transmitTime       time.Time
+	beforeTransmitHook func(context.Context) error
+	isCyclicEnabled    bool
+	wakeUpChan         chan struct{}
+	transmitEventChan  chan struct{}
+}
+
+var _ ECU_Tx_EngineStatus = &xxx_ECU_Tx_EngineStatus{}
+var _ canrunner.TransmittedMessage = &xxx_ECU_Tx_EngineStatus{}
+
+func (m *xxx_ECU_Tx_EngineStatus) init() {
+	m.beforeTransmitHook = func(context.Context) error { return nil }
+	m.wakeUpChan = make(chan struct{}, 1)
+	m.transmitEventChan = make(chan struct{})
+}
+
+func (m *xxx_ECU_Tx_EngineStatus) SetBeforeTransmitHook(h func(context.Context) error) {
+	m.beforeTransmitHook = h
+}
+
+func (m *xxx_ECU_Tx_EngineStatus) BeforeTransmitHook() func(context.Context) error {
+	return m.beforeTransmitHook
+}
+
+func (m *xxx_ECU_Tx_EngineStatus) TransmitTime() time.Time {
+	return m.transmitTime
+}
+
+func (m *xxx_ECU_Tx_EngineStatus) SetTransmitTime(t time.Time) {
+	m.transmitTime = t
+}
+
+func (m *xxx_ECU_Tx_EngineStatus) IsCyclicTransmissionEnabled() bool {
+	return m.isCyclicEnabled
+}
+
+func (m *xxx_ECU_Tx_EngineStatus) SetCyclicTransmissionEnabled(b bool) {
+	m.isCyclicEnabled = b
+	select {
+	case m.wakeUpChan <- struct{}{}:
+	default:
+	}
+}
+
+func (m *xxx_ECU_Tx_EngineStatus) WakeUpChan() <-chan struct{} {
+	return m.wakeUpChan
+}
+
+func (m *xxx_ECU_Tx_EngineStatus) Transmit(ctx context.Context) error {
+	select {
+	case m.transmitEventChan <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("event-triggered transmit of EngineStatus: %w", ctx.Err())
+	}
+}
+
+func (m *xxx_ECU_Tx_EngineStatus) TransmitEventChan() <-chan struct{} {
+	return m.transmitEventChan
+}
+
+var _ canrunner.TransmittedMessage = &xxx_ECU_Tx_EngineStatus{}
+
+type GATEWAY interface {
+	sync.Locker
+	Tx() GATEWAY_Tx
+	Rx() GATEWAY_Rx
+	Run(ctx context.Context) error
+}
+
+type GATEWAY_Rx interface {
+	http.Handler // for debugging
+	EngineStatus() GATEWAY_Rx_EngineStatus
+}
+
+type GATEWAY_Tx interface {
+	http.Handler // for debugging
+}
+
+type GATEWAY_Rx_EngineStatus interface {
+	EngineStatusReader
+	ReceiveTime() time.Time
+	SetAfterReceiveHook(h func(context.Context) error)
+	// SubscribeSpeed calls the hook when the trigger fires for the Speed signal.
+	SubscribeSpeed(trigger canrunner.SignalTrigger, h func(ctx context.Context, prev, curr EngineStatusReader) error)
+}
+
+type xxx_GATEWAY struct {
+	sync.Mutex // protects all node state
+	network    string
+	address    string
+	rx         xxx_GATEWAY_Rx
+	tx         xxx_GATEWAY_Tx
+}
+
+var _ GATEWAY = &xxx_GATEWAY{}
+var _ canrunner.Node = &xxx_GATEWAY{}
+
+func NewGATEWAY(network, address string) GATEWAY {
+	n := &xxx_GATEWAY{network: network, address: address}
+	n.rx.xxx_EngineStatus.init()
+	n.rx.xxx_EngineStatus.Reset()
+	n.rx.debugHandler = candebug.NewHandler(&n.Mutex, []generated.Message{
+		&n.rx.xxx_EngineStatus,
+	})
+	n.tx.debugHandler = candebug.NewHandler(&n.Mutex, []generated.Message{})
+	return n
+}
+
+func (n *xxx_GATEWAY) Run(ctx context.Context) error {
+	return canrunner.Run(ctx, n)
+}
+
+func (n *xxx_GATEWAY) Rx() GATEWAY_Rx {
+	return &n.rx
+}
+
+func (n *xxx_GATEWAY) Tx() GATEWAY_Tx {
+	return &n.tx
+}
+
+type xxx_GATEWAY_Rx struct {
+	debugHandler     *candebug.Handler
+	xxx_EngineStatus xxx_GATEWAY_Rx_EngineStatus
+}
+
+var _ GATEWAY_Rx = &xxx_GATEWAY_Rx{}
+
+func (rx *xxx_GATEWAY_Rx) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	rx.debugHandler.ServeHTTP(w, r)
+}
+
+func (rx *xxx_GATEWAY_Rx) EngineStatus() GATEWAY_Rx_EngineStatus {
+	return &rx.xxx_EngineStatus
+}
+
+type xxx_GATEWAY_Tx struct {
+	debugHandler *candebug.Handler
+}
+
+var _ GATEWAY_Tx = &xxx_GATEWAY_Tx{}
+
+func (tx *xxx_GATEWAY_Tx) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	tx.debugHandler.ServeHTTP(w, r)
+}
+
+func (n *xxx_GATEWAY) Descriptor() *descriptor.Node {
+	return Nodes().GATEWAY
+}
+
+func (n *xxx_GATEWAY) Connect() (net.Conn, error) {
+	return socketcan.Dial(n.network, n.address)
+}
+
+func (n *xxx_GATEWAY) ReceivedMessage(id uint32) (canrunner.ReceivedMessage, bool) {
+	switch id {
+	case 100:
+		return &n.rx.xxx_EngineStatus, true
+	default:
+		return nil, false
+	}
+}
+
+func (n *xxx_GATEWAY) TransmittedMessages() []canrunner.TransmittedMessage {
+	return []canrunner.TransmittedMessage{}
+}
+
+type xxx_GATEWAY_Rx_EngineStatus struct {
+	EngineStatus
+	receiveTime         time.Time
+	afterReceiveHook    func(context.Context) error
+	signalSubscriptions canrunner.SignalSubscriptions
+}
+
+func (m *xxx_GATEWAY_Rx_EngineStatus) init() {
+	m.afterReceiveHook = func(context.Context) error { return nil }
+}
+
+func (m *xxx_GATEWAY_Rx_EngineStatus) SetAfterReceiveHook(h func(context.Context) error) {
+	m.afterReceiveHook = h
+}
+
+func (m *xxx_GATEWAY_Rx_EngineStatus) AfterReceiveHook() func(context.Context) error {
+	return m.afterReceiveHook
+}
+
+func (m *xxx_GATEWAY_Rx_EngineStatus) ReceiveTime() time.Time {
+	return m.receiveTime
+}
+
+func (m *xxx_GATEWAY_Rx_EngineStatus) SetReceiveTime(t time.Time) {
+	m.receiveTime = t
+}
+
+func (m *xxx_GATEWAY_Rx_EngineStatus) SignalSubscriptions() *canrunner.SignalSubscriptions {
+	return &m.signalSubscriptions
+}
+
+func (m *xxx_GATEWAY_Rx_EngineStatus) SubscribeSpeed(
+	trigger canrunner.SignalTrigger,
+	h func(ctx context.Context, prev, curr EngineStatusReader) error,
+) {
+	m.signalSubscriptions.Subscribe(
+		Messages().EngineStatus.Speed,
+		trigger,
+		func(ctx context.Context, prevFrame, currFrame can.Frame) error {
+			var prev, curr EngineStatus
+			if err := prev.UnmarshalFrame(prevFrame); err != nil {
+				return err
+			}
+			if err := curr.UnmarshalFrame(currFrame); err != nil {
+				return err
+			}
+			return h(ctx, &prev, &curr)
+		},
+	)
+}
+
+var _ canrunner.ReceivedMessage = &xxx_GATEWAY_Rx_EngineStatus{}
+
+var _ canrunner.SignalSubscriber = &xxx_GATEWAY_Rx_EngineStatus{}
+
+// FrameBatch holds the messages decoded from batches of frames, in a slice per message.
+//
+// The slices are reused after Reset, so that decoding does not allocate once they have grown to the size of a
+// batch.
+type FrameBatch struct {
+	EngineStatus []EngineStatus
+}
+
+// Reset empties the slices of the batch, keeping their capacity.
+func (b *FrameBatch) Reset() {
+	b.EngineStatus = b.EngineStatus[:0]
+}
+
+// DecodeFrames decodes a batch of frames, and appends the decoded messages to the batch.
+//
+// Remote frames and frames of unknown messages are skipped.
+func (b *FrameBatch) DecodeFrames(frames []can.Frame) error {
+	for i := range frames {
+		f := &frames[i]
+		if f.IsRemote {
+			continue
+		}
+		switch f.ID {
+		case 100:
+			if !f.IsExtended {
+				b.EngineStatus = append(b.EngineStatus, EngineStatus{})
+				m := &b.EngineStatus[len(b.EngineStatus)-1]
+				if err := m.DecodeFrame(f); err != nil {
+					b.EngineStatus = b.EngineStatus[:len(b.EngineStatus)-1]
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// Nodes returns the powertrain node descriptors.
+func Nodes() *NodesDescriptor {
+	return nd
+}
+
+// NodesDescriptor contains all powertrain node descriptors.
+type NodesDescriptor struct {
+	ECU     *descriptor.Node
+	GATEWAY *descriptor.Node
+}
+
+// Messages returns the powertrain message descriptors.
+func Messages() *MessagesDescriptor {
+	return md
+}
+
+// MessagesDescriptor contains all powertrain message descriptors.
+type MessagesDescriptor struct {
+	EngineStatus *EngineStatusDescriptor
+}
+
+// UnmarshalFrame unmarshals the provided powertrain CAN frame.
+func (md *MessagesDescriptor) UnmarshalFrame(f can.Frame) (generated.Message, error) {
+	switch f.ID {
+	case md.EngineStatus.ID:
+		var msg EngineStatus
+		if err := msg.UnmarshalFrame(f); err != nil {
+			return nil, fmt.Errorf("unmarshal powertrain frame: %w", err)
+		}
+		return &msg, nil
+	default:
+		return nil, fmt.Errorf("unmarshal powertrain frame: ID not in database: %d", f.ID)
+	}
+}
+
+type EngineStatusDescriptor struct {
+	*descriptor.Message
+	Speed *descriptor.Signal
+}
+
+// Database returns the powertrain database descriptor.
+func (md *MessagesDescriptor) Database() *descriptor.Database {
+	return d
+}
+
+var nd = &NodesDescriptor{
+	ECU:     d.Nodes[0],
+	GATEWAY: d.Nodes[1],
+}
+
+var md = &MessagesDescriptor{
+	EngineStatus: &EngineStatusDescriptor{
+		Message: d.Messages[0],
+		Speed:   d.Messages[0].Signals[0],
+	},
+}
+
+var d = (*descriptor.Database)(&descriptor.Database{
+	SourceFile: (string)("testdata/network/vehicle/powertrain.dbc"),
+	Version:    (string)(""),
+	Messages: ([]*descriptor.Message)([]*descriptor.Message{
+		(*descriptor.Message)(&descriptor.Message{
+			Name:        (string)("EngineStatus"),
+			ID:          (uint32)(100),
+			IsExtended:  (bool)(false),
+			Length:      (uint8)(2),
+			SendType:    (descriptor.SendType)(1),
+			Description: (string)("Engine status, routed to the body bus by the gateway"),
+			Signals: ([]*descriptor.Signal)([]*descriptor.Signal{
+				(*descriptor.Signal)(&descriptor.Signal{
+					Name:              (string)("Speed"),
+					Start:             (uint8)(0),
+					Length:            (uint8)(16),
+					IsBigEndian:       (bool)(false),
+					IsSigned:          (bool)(false),
+					IsFloat:           (bool)(false),
+					IsMultiplexer:     (bool)(false),
+					IsMultiplexed:     (bool)(false),
+					MultiplexerValue:  (uint)(0),
+					MultiplexerName:   (string)(""),
+					MultiplexerRanges: ([]*descriptor.MultiplexerRange)(nil),
+					Offset:            (float64)(0),
+					Scale:             (float64)(1),
+					Min:               (float64)(0),
+					Max:               (float64)(65535),
+					Unit:              (string)("rpm"),
+					Description:       (string)(""),
+					SignalType:        (string)(""),
+					ValueTable:        (string)(""),
+					ValueDescriptions: ([]*descriptor.ValueDescription)(nil),
+					ReceiverNodes: ([]string)([]string{
+						(string)("GATEWAY"),
+					}),
+					DefaultValue:  (int)(0),
+					SendType:      (descriptor.SendType)(0),
+					InactiveValue: (int)(0),
+					Attributes:    ([]*descriptor.Attribute)(nil),
+				}),
+			}),
+			SenderNode:       (string)("ECU"),
+			TransmitterNodes: ([]string)(nil),
+			SignalGroups:     ([]*descriptor.SignalGroup)(nil),
+			CycleTime:        (time.Duration)(100000000),
+			CycleTimeFast:    (time.Duration)(0),
+			DelayTime:        (time.Duration)(0),
+			RepetitionCount:  (int)(0),
+			Attributes: ([]*descriptor.Attribute)([]*descriptor.Attribute{
+				(*descriptor.Attribute)(&descriptor.Attribute{
+					Name:        (string)("GenMsgCycleTime"),
+					Type:        (descriptor.AttributeType)(0),
+					IntValue:    (int64)(100),
+					FloatValue:  (float64)(0),
+					StringValue: (string)(""),
+					IsDefault:   (bool)(false),
+				}),
+				(*descriptor.Attribute)(&descriptor.Attribute{
+					Name:        (string)("GenMsgSendType"),
+					Type:        (descriptor.AttributeType)(4),
+					IntValue:    (int64)(0),
+					FloatValue:  (float64)(0),
+					StringValue: (string)("Cyclic"),
+					IsDefault:   (bool)(false),
+				}),
+			}),
+		}),
+	}),
+	Nodes: ([]*descriptor.Node)([]*descriptor.Node{
+		(*descriptor.Node)(&descriptor.Node{
+			Name:        (string)("ECU"),
+			Description: (string)(""),
+			Attributes:  ([]*descriptor.Attribute)(nil),
+		}),
+		(*descriptor.Node)(&descriptor.Node{
+			Name:        (string)("GATEWAY"),
+			Description: (string)(""),
+			Attributes:  ([]*descriptor.Attribute)(nil),
+		}),
+	}),
+	ValueTables:          ([]*descriptor.ValueTable)(nil),
+	EnvironmentVariables: ([]*descriptor.EnvironmentVariable)(nil),
+	Attributes:           ([]*descriptor.Attribute)(nil),
+})
@@ -0,0 +1,195 @@
+// Package vehiclecan provides the nodes of the vehicle CAN network.
+//
+// Source: testdata/network/vehicle/body.dbc
+// Source: testdata/network/vehicle/powertrain.dbc
+package vehiclecan
+
+import (
+	"context"
+	"sync"
+
+	"go.einride.tech/can/pkg/canrunner"
+	"go.einride.tech/can/pkg/descriptor"
+	bodycan "go.einride.tech/can/testdata/gen/go/vehicle/body"
+	powertraincan "go.einride.tech/can/testdata/gen/go/vehicle/powertrain"
+)
+
+// prevent unused imports
+var (
+	_ = context.Background
+	_ = canrunner.RunNetwork
+)
+
+// Generated code. DO NOT EDIT.
+// DASH is the DASH node of the vehicle network.
+//
+// The node runs on each of its buses, and locking the node locks the node on all buses.
+type DASH interface {
+	sync.Locker
+	Descriptor() *descriptor.NetworkNode
+	// Body returns the node on the body bus.
+	Body() bodycan.DASH
+	Run(ctx context.Context) error
+}
+
+type xxx_DASH struct {
+	body bodycan.DASH
+}
+
+var _ DASH = &xxx_DASH{}
+
+// NewDASH returns a new DASH node, connecting to each bus at the provided address.
+func NewDASH(network string, bodyAddress string) DASH {
+	return &xxx_DASH{
+		body: bodycan.NewDASH(network, bodyAddress),
+	}
+}
+
+func (n *xxx_DASH) Lock() {
+	n.body.Lock()
+}
+
+func (n *xxx_DASH) Unlock() {
+	n.body.Unlock()
+}
+
+func (n *xxx_DASH) Descriptor() *descriptor.NetworkNode {
+	nn, _ := network.Node("DASH")
+	return nn
+}
+
+func (n *xxx_DASH) Body() bodycan.DASH {
+	return n.body
+}
+
+func (n *xxx_DASH) Run(ctx context.Context) error {
+	return canrunner.RunNetwork(
+		ctx,
+		n.body,
+	)
+}
+
+// ECU is the ECU node of the vehicle network.
+//
+// The node runs on each of its buses, and locking the node locks the node on all buses.
+type ECU interface {
+	sync.Locker
+	Descriptor() *descriptor.NetworkNode
+	// Powertrain returns the node on the powertrain bus.
+	Powertrain() powertraincan.ECU
+	Run(ctx context.Context) error
+}
+
+type xxx_ECU struct {
+	powertrain powertraincan.ECU
+}
+
+var _ ECU = &xxx_ECU{}
+
+// NewECU returns a new ECU node, connecting to each bus at the provided address.
+func NewECU(network string, powertrainAddress string) ECU {
+	return &xxx_ECU{
+		powertrain: powertraincan.NewECU(network, powertrainAddress),
+	}
+}
+
+func (n *xxx_ECU) Lock() {
+	n.powertrain.Lock()
+}
+
+func (n *xxx_ECU) Unlock() {
+	n.powertrain.Unlock()
+}
+
+func (n *xxx_ECU) Descriptor() *descriptor.NetworkNode {
+	nn, _ := network.Node("ECU")
+	return nn
+}
+
+func (n *xxx_ECU) Powertrain() powertraincan.ECU {
+	return n.powertrain
+}
+
+func (n *xxx_ECU) Run(ctx context.Context) error {
+	return canrunner.RunNetwork(
+		ctx,
+		n.powertrain,
+	)
+}
+
+// GATEWAY is the GATEWAY node of the vehicle network.
+//
+// The node runs on each of its buses, and locking the node locks the node on all buses.
+type GATEWAY interface {
+	sync.Locker
+	Descriptor() *descriptor.NetworkNode
+	// Body returns the node on the body bus.
+	Body() bodycan.GATEWAY
+	// Powertrain returns the node on the powertrain bus.
+	Powertrain() powertraincan.GATEWAY
+	Run(ctx context.Context) error
+}
+
+type xxx_GATEWAY struct {
+	body       bodycan.GATEWAY
+	powertrain powertraincan.GATEWAY
+}
+
+var _ GATEWAY = &xxx_GATEWAY{}
+
+// NewGATEWAY returns a new GATEWAY node, connecting to each bus at the provided address.
+func NewGATEWAY(network string, bodyAddress, powertrainAddress string) GATEWAY {
+	return &xxx_GATEWAY{
+		body:       bodycan.NewGATEWAY(network, bodyAddress),
+		powertrain: powertraincan.NewGATEWAY(network, powertrainAddress),
+	}
+}
+
+func (n *xxx_GATEWAY) Lock() {
+	n.body.Lock()
+	n.powertrain.Lock()
+}
+
+func (n *xxx_GATEWAY) Unlock() {
+	n.powertrain.Unlock()
+	n.body.Unlock()
+}
+
+func (n *xxx_GATEWAY) Descriptor() *descriptor.NetworkNode {
+	nn, _ := network.Node("GATEWAY")
+	return nn
+}
+
+func (n *xxx_GATEWAY) Body() bodycan.GATEWAY {
+	return n.body
+}
+
+func (n *xxx_GATEWAY) Powertrain() powertraincan.GATEWAY {
+	return n.powertrain
+}
+
+func (n *xxx_GATEWAY) Run(ctx context.Context) error {
+	return canrunner.RunNetwork(
+		ctx,
+		n.body,
+		n.powertrain,
+	)
+}
+
+// Network returns the vehicle network descriptor.
+func Network() *descriptor.Network {
+	return network
+}
+
+var network = &descriptor.Network{
+	Name: "vehicle",
+	Buses: []*descriptor.Bus{
+		{Name: "body", Database: bodycan.Messages().Database()},
+		{Name: "powertrain", Database: powertraincan.Messages().Database()},
+	},
+	Nodes: []*descriptor.NetworkNode{
+		{Name: "DASH", Buses: []string{"body"}},
+		{Name: "ECU", Buses: []string{"powertrain"}},
+		{Name: "GATEWAY", Buses: []string{"body", "powertrain"}},
+	},
+}
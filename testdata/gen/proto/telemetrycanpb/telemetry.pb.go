@@ -0,0 +1,362 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: telemetrycanpb/telemetry.proto
+
+package telemetrycanpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type EngineStatus_Gear int32
+
+const (
+	EngineStatus_GEAR_PARK    EngineStatus_Gear = 0
+	EngineStatus_GEAR_REVERSE EngineStatus_Gear = 1
+	EngineStatus_GEAR_NEUTRAL EngineStatus_Gear = 2
+	EngineStatus_GEAR_DRIVE   EngineStatus_Gear = 3
+)
+
+// Enum value maps for EngineStatus_Gear.
+var (
+	EngineStatus_Gear_name = map[int32]string{
+		0: "GEAR_PARK",
+		1: "GEAR_REVERSE",
+		2: "GEAR_NEUTRAL",
+		3: "GEAR_DRIVE",
+	}
+	EngineStatus_Gear_value = map[string]int32{
+		"GEAR_PARK":    0,
+		"GEAR_REVERSE": 1,
+		"GEAR_NEUTRAL": 2,
+		"GEAR_DRIVE":   3,
+	}
+)
+
+func (x EngineStatus_Gear) Enum() *EngineStatus_Gear {
+	p := new(EngineStatus_Gear)
+	*p = x
+	return p
+}
+
+func (x EngineStatus_Gear) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EngineStatus_Gear) Descriptor() protoreflect.EnumDescriptor {
+	return file_telemetrycanpb_telemetry_proto_enumTypes[0].Descriptor()
+}
+
+func (EngineStatus_Gear) Type() protoreflect.EnumType {
+	return &file_telemetrycanpb_telemetry_proto_enumTypes[0]
+}
+
+func (x EngineStatus_Gear) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EngineStatus_Gear.Descriptor instead.
+func (EngineStatus_Gear) EnumDescriptor() ([]byte, []int) {
+	return file_telemetrycanpb_telemetry_proto_rawDescGZIP(), []int{0, 0}
+}
+
+type Diagnostics_DriveMode int32
+
+const (
+	Diagnostics_DRIVE_MODE_UNSPECIFIED Diagnostics_DriveMode = 0
+	Diagnostics_DRIVE_MODE_ECO         Diagnostics_DriveMode = 1
+	Diagnostics_DRIVE_MODE_SPORT       Diagnostics_DriveMode = 2
+)
+
+// Enum value maps for Diagnostics_DriveMode.
+var (
+	Diagnostics_DriveMode_name = map[int32]string{
+		0: "DRIVE_MODE_UNSPECIFIED",
+		1: "DRIVE_MODE_ECO",
+		2: "DRIVE_MODE_SPORT",
+	}
+	Diagnostics_DriveMode_value = map[string]int32{
+		"DRIVE_MODE_UNSPECIFIED": 0,
+		"DRIVE_MODE_ECO":         1,
+		"DRIVE_MODE_SPORT":       2,
+	}
+)
+
+func (x Diagnostics_DriveMode) Enum() *Diagnostics_DriveMode {
+	p := new(Diagnostics_DriveMode)
+	*p = x
+	return p
+}
+
+func (x Diagnostics_DriveMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Diagnostics_DriveMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_telemetrycanpb_telemetry_proto_enumTypes[1].Descriptor()
+}
+
+func (Diagnostics_DriveMode) Type() protoreflect.EnumType {
+	return &file_telemetrycanpb_telemetry_proto_enumTypes[1]
+}
+
+func (x Diagnostics_DriveMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Diagnostics_DriveMode.Descriptor instead.
+func (Diagnostics_DriveMode) EnumDescriptor() ([]byte, []int) {
+	return file_telemetrycanpb_telemetry_proto_rawDescGZIP(), []int{1, 0}
+}
+
+type EngineStatus struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Speed         float64                `protobuf:"fixed64,1,opt,name=speed,proto3" json:"speed,omitempty"`
+	Temperature   float64                `protobuf:"fixed64,2,opt,name=temperature,proto3" json:"temperature,omitempty"`
+	Gear          EngineStatus_Gear      `protobuf:"varint,3,opt,name=gear,proto3,enum=telemetrycan.EngineStatus_Gear" json:"gear,omitempty"`
+	IsRunning     bool                   `protobuf:"varint,4,opt,name=is_running,json=isRunning,proto3" json:"is_running,omitempty"`
+	Counter       uint32                 `protobuf:"varint,5,opt,name=counter,proto3" json:"counter,omitempty"`
+	AbsActive     bool                   `protobuf:"varint,6,opt,name=abs_active,json=absActive,proto3" json:"abs_active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EngineStatus) Reset() {
+	*x = EngineStatus{}
+	mi := &file_telemetrycanpb_telemetry_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EngineStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EngineStatus) ProtoMessage() {}
+
+func (x *EngineStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetrycanpb_telemetry_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EngineStatus.ProtoReflect.Descriptor instead.
+func (*EngineStatus) Descriptor() ([]byte, []int) {
+	return file_telemetrycanpb_telemetry_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *EngineStatus) GetSpeed() float64 {
+	if x != nil {
+		return x.Speed
+	}
+	return 0
+}
+
+func (x *EngineStatus) GetTemperature() float64 {
+	if x != nil {
+		return x.Temperature
+	}
+	return 0
+}
+
+func (x *EngineStatus) GetGear() EngineStatus_Gear {
+	if x != nil {
+		return x.Gear
+	}
+	return EngineStatus_GEAR_PARK
+}
+
+func (x *EngineStatus) GetIsRunning() bool {
+	if x != nil {
+		return x.IsRunning
+	}
+	return false
+}
+
+func (x *EngineStatus) GetCounter() uint32 {
+	if x != nil {
+		return x.Counter
+	}
+	return 0
+}
+
+func (x *EngineStatus) GetAbsActive() bool {
+	if x != nil {
+		return x.AbsActive
+	}
+	return false
+}
+
+type Diagnostics struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          uint32                 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Odometer      float64                `protobuf:"fixed64,2,opt,name=odometer,proto3" json:"odometer,omitempty"`
+	ErrorCode     int32                  `protobuf:"varint,3,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	DriveMode     Diagnostics_DriveMode  `protobuf:"varint,4,opt,name=drive_mode,json=driveMode,proto3,enum=telemetrycan.Diagnostics_DriveMode" json:"drive_mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Diagnostics) Reset() {
+	*x = Diagnostics{}
+	mi := &file_telemetrycanpb_telemetry_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Diagnostics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Diagnostics) ProtoMessage() {}
+
+func (x *Diagnostics) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetrycanpb_telemetry_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Diagnostics.ProtoReflect.Descriptor instead.
+func (*Diagnostics) Descriptor() ([]byte, []int) {
+	return file_telemetrycanpb_telemetry_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Diagnostics) GetPage() uint32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *Diagnostics) GetOdometer() float64 {
+	if x != nil {
+		return x.Odometer
+	}
+	return 0
+}
+
+func (x *Diagnostics) GetErrorCode() int32 {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return 0
+}
+
+func (x *Diagnostics) GetDriveMode() Diagnostics_DriveMode {
+	if x != nil {
+		return x.DriveMode
+	}
+	return Diagnostics_DRIVE_MODE_UNSPECIFIED
+}
+
+var File_telemetrycanpb_telemetry_proto protoreflect.FileDescriptor
+
+const file_telemetrycanpb_telemetry_proto_rawDesc = "" +
+	"\n" +
+	"\x1etelemetrycanpb/telemetry.proto\x12\ftelemetrycan\"\x9e\x02\n" +
+	"\fEngineStatus\x12\x14\n" +
+	"\x05speed\x18\x01 \x01(\x01R\x05speed\x12 \n" +
+	"\vtemperature\x18\x02 \x01(\x01R\vtemperature\x123\n" +
+	"\x04gear\x18\x03 \x01(\x0e2\x1f.telemetrycan.EngineStatus.GearR\x04gear\x12\x1d\n" +
+	"\n" +
+	"is_running\x18\x04 \x01(\bR\tisRunning\x12\x18\n" +
+	"\acounter\x18\x05 \x01(\rR\acounter\x12\x1d\n" +
+	"\n" +
+	"abs_active\x18\x06 \x01(\bR\tabsActive\"I\n" +
+	"\x04Gear\x12\r\n" +
+	"\tGEAR_PARK\x10\x00\x12\x10\n" +
+	"\fGEAR_REVERSE\x10\x01\x12\x10\n" +
+	"\fGEAR_NEUTRAL\x10\x02\x12\x0e\n" +
+	"\n" +
+	"GEAR_DRIVE\x10\x03\"\xf3\x01\n" +
+	"\vDiagnostics\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\rR\x04page\x12\x1a\n" +
+	"\bodometer\x18\x02 \x01(\x01R\bodometer\x12\x1d\n" +
+	"\n" +
+	"error_code\x18\x03 \x01(\x05R\terrorCode\x12B\n" +
+	"\n" +
+	"drive_mode\x18\x04 \x01(\x0e2#.telemetrycan.Diagnostics.DriveModeR\tdriveMode\"Q\n" +
+	"\tDriveMode\x12\x1a\n" +
+	"\x16DRIVE_MODE_UNSPECIFIED\x10\x00\x12\x12\n" +
+	"\x0eDRIVE_MODE_ECO\x10\x01\x12\x14\n" +
+	"\x10DRIVE_MODE_SPORT\x10\x02BFZDgo.einride.tech/can/testdata/gen/proto/telemetrycanpb;telemetrycanpbb\x06proto3"
+
+var (
+	file_telemetrycanpb_telemetry_proto_rawDescOnce sync.Once
+	file_telemetrycanpb_telemetry_proto_rawDescData []byte
+)
+
+func file_telemetrycanpb_telemetry_proto_rawDescGZIP() []byte {
+	file_telemetrycanpb_telemetry_proto_rawDescOnce.Do(func() {
+		file_telemetrycanpb_telemetry_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_telemetrycanpb_telemetry_proto_rawDesc), len(file_telemetrycanpb_telemetry_proto_rawDesc)))
+	})
+	return file_telemetrycanpb_telemetry_proto_rawDescData
+}
+
+var file_telemetrycanpb_telemetry_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_telemetrycanpb_telemetry_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_telemetrycanpb_telemetry_proto_goTypes = []any{
+	(EngineStatus_Gear)(0),     // 0: telemetrycan.EngineStatus.Gear
+	(Diagnostics_DriveMode)(0), // 1: telemetrycan.Diagnostics.DriveMode
+	(*EngineStatus)(nil),       // 2: telemetrycan.EngineStatus
+	(*Diagnostics)(nil),        // 3: telemetrycan.Diagnostics
+}
+var file_telemetrycanpb_telemetry_proto_depIdxs = []int32{
+	0, // 0: telemetrycan.EngineStatus.gear:type_name -> telemetrycan.EngineStatus.Gear
+	1, // 1: telemetrycan.Diagnostics.drive_mode:type_name -> telemetrycan.Diagnostics.DriveMode
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_telemetrycanpb_telemetry_proto_init() }
+func file_telemetrycanpb_telemetry_proto_init() {
+	if File_telemetrycanpb_telemetry_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_telemetrycanpb_telemetry_proto_rawDesc), len(file_telemetrycanpb_telemetry_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_telemetrycanpb_telemetry_proto_goTypes,
+		DependencyIndexes: file_telemetrycanpb_telemetry_proto_depIdxs,
+		EnumInfos:         file_telemetrycanpb_telemetry_proto_enumTypes,
+		MessageInfos:      file_telemetrycanpb_telemetry_proto_msgTypes,
+	}.Build()
+	File_telemetrycanpb_telemetry_proto = out.File
+	file_telemetrycanpb_telemetry_proto_goTypes = nil
+	file_telemetrycanpb_telemetry_proto_depIdxs = nil
+}